@@ -143,6 +143,16 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return response, err
 		}
 
+		// record the prices reported by each validator against the final prices. performance tracking is
+		// best-effort, so a failure is logged and does not fail the block
+		if err := h.updateValidatorPerformance(ctx, req.DecidedLastCommit, prices); err != nil {
+			h.logger.Error(
+				"failed to update validator performance",
+				"height", req.Height,
+				"error", err,
+			)
+		}
+
 		return response, nil
 	}
 }
@@ -215,6 +225,16 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 			return &sdk.ResponsePreBlock{}, err
 		}
 
+		// record the prices reported by each validator against the final prices. performance tracking is
+		// best-effort, so a failure is logged and does not fail the block
+		if err := h.updateValidatorPerformance(ctx, req.DecidedLastCommit, prices); err != nil {
+			h.logger.Error(
+				"failed to update validator performance",
+				"height", req.Height,
+				"error", err,
+			)
+		}
+
 		return &sdk.ResponsePreBlock{}, nil
 	}
 }
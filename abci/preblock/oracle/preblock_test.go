@@ -2,9 +2,11 @@ package oracle_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -18,6 +20,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	preblock "github.com/skip-mev/connect/v2/abci/preblock/oracle"
+	priceapplier "github.com/skip-mev/connect/v2/abci/strategies/aggregator"
 	compression "github.com/skip-mev/connect/v2/abci/strategies/codec"
 	codecmock "github.com/skip-mev/connect/v2/abci/strategies/codec/mocks"
 	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
@@ -32,8 +35,10 @@ import (
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	metricmock "github.com/skip-mev/connect/v2/service/metrics/mocks"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	"github.com/skip-mev/connect/v2/x/oracle/keeper"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	oraclemocks "github.com/skip-mev/connect/v2/x/oracle/types/mocks"
 )
 
 var maxUint256, _ = new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
@@ -126,8 +131,11 @@ func (s *PreBlockTestSuite) SetupSubTest() {
 	// Use mock metrics
 	s.mockMetrics = metricmock.NewMetrics(s.T())
 
-	// Create the oracle keeper
-	s.oracleKeeper = testutils.CreateTestOracleKeeperWithGenesis(s.T(), s.ctx, s.key, s.genesis)
+	// Create the oracle keeper. Markets are not found in the market map keeper, so the legacy decimals are
+	// reported in the price events.
+	mmKeeper := oraclemocks.NewMarketMapKeeper(s.T())
+	mmKeeper.On("GetMarket", mock.Anything, mock.Anything).Return(mmtypes.Market{}, collections.ErrNotFound).Maybe()
+	s.oracleKeeper = testutils.CreateTestOracleKeeperWithMarketMapKeeper(s.T(), s.ctx, s.key, s.genesis, mmKeeper)
 
 	s.cpID = currencypairmock.NewCurrencyPairStrategy(s.T())
 
@@ -320,6 +328,20 @@ func (s *PreBlockTestSuite) TestPreBlocker() {
 
 			require.Equal(s.T(), uint64(0), nonce)
 		}
+
+		// expect a missing price event for each currency pair
+		events := s.ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, len(cps))
+		for _, event := range events {
+			msg, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+
+			missing, ok := msg.(*oracletypes.EventPriceMissing)
+			s.Require().True(ok)
+			s.Require().Equal(priceapplier.ReasonNoPrice, missing.Reason)
+			s.Require().Equal(uint64(0), missing.NumVotes)
+			s.Require().Equal(uint64(3), missing.BlockHeight)
+		}
 	})
 
 	s.Run("multiple assets to write a price for", func() {
@@ -402,6 +424,21 @@ func (s *PreBlockTestSuite) TestPreBlocker() {
 
 			require.Equal(s.T(), uint64(1), nonce)
 		}
+
+		// expect a price updated event for each currency pair
+		events := s.ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, len(cps))
+		for _, event := range events {
+			msg, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+
+			updated, ok := msg.(*oracletypes.EventPriceUpdated)
+			s.Require().True(ok)
+			s.Require().Equal(uint64(1), updated.Nonce)
+			s.Require().Equal(uint64(2), updated.NumVotes)
+			s.Require().Equal(uint64(3), updated.BlockHeight)
+			s.Require().Equal(uint64(8), updated.Decimals)
+		}
 	})
 }
 
@@ -561,6 +598,60 @@ func (s *PreBlockTestSuite) TestPreBlockStatus() {
 		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
 		s.ctx = s.ctx.WithBlockHeight(4)
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return(nil)
+		mockOracleKeeper.On("UpdateValidatorPerformance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		// run preblocker
+		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
+			Txs: [][]byte{
+				[]byte("abc"),
+			},
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("a failure to update validator performance does not fail the block", func() {
+		metrics := metricmock.NewMetrics(s.T())
+		extCodec := codecmock.NewExtendedCommitCodec(s.T())
+		veCodec := codecmock.NewVoteExtensionCodec(s.T())
+		mockOracleKeeper := connectabcimocks.NewOracleKeeper(s.T())
+		handler := preblock.NewOraclePreBlockHandler(
+			log.NewTestLogger(s.T()),
+			func(_ sdk.Context) aggregator.AggregateFn[string, map[connecttypes.CurrencyPair]*big.Int] {
+				return func(_ aggregator.AggregatedProviderData[string, map[connecttypes.CurrencyPair]*big.Int]) map[connecttypes.CurrencyPair]*big.Int {
+					return nil
+				}
+			},
+			mockOracleKeeper,
+			metrics,
+			nil,
+			veCodec,
+			extCodec,
+		)
+
+		ca := sdk.ConsAddress("val")
+		extCodec.On("Decode", mock.Anything).Return(cometabci.ExtendedCommitInfo{
+			Votes: []cometabci.ExtendedVoteInfo{
+				{
+					VoteExtension: []byte("ve"),
+					Validator: cometabci.Validator{
+						Address: ca,
+					},
+				},
+			},
+		}, nil)
+		veCodec.On("Decode", []byte("ve")).Return(vetypes.OracleVoteExtension{
+			Prices: map[uint64][]byte{
+				1: make([]byte, 34),
+			},
+		}, nil)
+
+		metrics.On("ObserveABCIMethodLatency", servicemetrics.PreBlock, mock.Anything).Return()
+		metrics.On("AddABCIRequest", servicemetrics.PreBlock, servicemetrics.Success{}).Return()
+		// make ves enabled
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+		s.ctx = s.ctx.WithBlockHeight(4)
+		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return(nil)
+		mockOracleKeeper.On("UpdateValidatorPerformance", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("performance error"))
 
 		// run preblocker
 		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
@@ -678,6 +769,16 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return([]connecttypes.CurrencyPair{btcUsd, mogUsd}, nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, mogUsd, mock.Anything).Return(nil)
+		mockOracleKeeper.On("ApplyCircuitBreaker", s.ctx, btcUsd, mock.Anything).Return(math.NewInt(1), nil)
+		mockOracleKeeper.On("ApplyCircuitBreaker", s.ctx, mogUsd, mock.Anything).Return(math.NewIntFromBigInt(maxUint256), nil)
+		mockOracleKeeper.On("GetNonceForCurrencyPair", s.ctx, btcUsd).Return(uint64(1), nil)
+		mockOracleKeeper.On("GetNonceForCurrencyPair", s.ctx, mogUsd).Return(uint64(1), nil)
+		mockOracleKeeper.On("GetDecimalsForCurrencyPair", s.ctx, btcUsd).Return(uint64(8), nil)
+		mockOracleKeeper.On("GetDecimalsForCurrencyPair", s.ctx, mogUsd).Return(uint64(18), nil)
+		mockOracleKeeper.On("UpdateValidatorPerformance", mock.Anything, mock.Anything, mock.MatchedBy(func(validators []oracletypes.ValidatorPrices) bool {
+			// val3 did not commit the block, so it is recorded as having reported no prices
+			return len(validators) == 3 && validators[0].Prices != nil && validators[1].Prices != nil && validators[2].Prices == nil
+		})).Return(nil)
 
 		// create extended commit info
 		val1Vote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{
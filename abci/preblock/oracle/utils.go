@@ -9,6 +9,7 @@ import (
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // recordPrice records all the given prices per ticker, and reports them as a float64.
@@ -56,3 +57,33 @@ func (h *PreBlockHandler) recordValidatorReports(ctx sdk.Context, decidedCommit
 		}
 	}
 }
+
+// updateValidatorPerformance takes the commit decided for this block, and for each validator in the commit, records
+// the prices they reported against the final prices in the oracle module's validator performance tracking. Validators
+// whose vote was not included in the commit are recorded as having reported no prices. The performance is updated in
+// a cached context, so that no state is written if the update fails.
+func (h *PreBlockHandler) updateValidatorPerformance(
+	ctx sdk.Context,
+	decidedCommit cometabci.CommitInfo,
+	prices map[connecttypes.CurrencyPair]*big.Int,
+) error {
+	validators := make([]oracletypes.ValidatorPrices, len(decidedCommit.Votes))
+	for i, vote := range decidedCommit.Votes {
+		validator := sdk.ConsAddress(vote.Validator.Address)
+		validators[i] = oracletypes.ValidatorPrices{
+			ConsAddress: validator,
+		}
+
+		if vote.BlockIdFlag == cometproto.BlockIDFlagCommit {
+			validators[i].Prices = h.pa.GetPricesForValidator(validator)
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := h.keeper.UpdateValidatorPerformance(cacheCtx, prices, validators); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
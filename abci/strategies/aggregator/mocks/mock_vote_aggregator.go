@@ -86,6 +86,53 @@ func (_c *VoteAggregator_AggregateOracleVotes_Call) RunAndReturn(run func(types.
 	return _c
 }
 
+// GetAggregatedDispersions provides a mock function with no fields
+func (_m *VoteAggregator) GetAggregatedDispersions() map[pkgtypes.CurrencyPair]*big.Int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAggregatedDispersions")
+	}
+
+	var r0 map[pkgtypes.CurrencyPair]*big.Int
+	if rf, ok := ret.Get(0).(func() map[pkgtypes.CurrencyPair]*big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[pkgtypes.CurrencyPair]*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// VoteAggregator_GetAggregatedDispersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAggregatedDispersions'
+type VoteAggregator_GetAggregatedDispersions_Call struct {
+	*mock.Call
+}
+
+// GetAggregatedDispersions is a helper method to define mock.On call
+func (_e *VoteAggregator_Expecter) GetAggregatedDispersions() *VoteAggregator_GetAggregatedDispersions_Call {
+	return &VoteAggregator_GetAggregatedDispersions_Call{Call: _e.mock.On("GetAggregatedDispersions")}
+}
+
+func (_c *VoteAggregator_GetAggregatedDispersions_Call) Run(run func()) *VoteAggregator_GetAggregatedDispersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *VoteAggregator_GetAggregatedDispersions_Call) Return(_a0 map[pkgtypes.CurrencyPair]*big.Int) *VoteAggregator_GetAggregatedDispersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VoteAggregator_GetAggregatedDispersions_Call) RunAndReturn(run func() map[pkgtypes.CurrencyPair]*big.Int) *VoteAggregator_GetAggregatedDispersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPriceForValidator provides a mock function with given fields: validator
 func (_m *VoteAggregator) GetPriceForValidator(validator types.ConsAddress) map[pkgtypes.CurrencyPair]*big.Int {
 	ret := _m.Called(validator)
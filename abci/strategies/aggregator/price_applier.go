@@ -1,6 +1,7 @@
 package aggregator
 
 import (
+	"errors"
 	"math/big"
 
 	"cosmossdk.io/log"
@@ -15,6 +16,24 @@ import (
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 )
 
+const (
+	// ReasonNoPrice is the reason reported in an EventPriceMissing when no price could be aggregated
+	// for a currency pair from the vote extensions.
+	ReasonNoPrice = "no price"
+
+	// ReasonNegativePrice is the reason reported in an EventPriceMissing when the aggregated price
+	// for a currency pair is negative.
+	ReasonNegativePrice = "negative price"
+
+	// ReasonHalted is the reason reported in an EventPriceMissing when price updates for a currency
+	// pair have been halted by the circuit breaker.
+	ReasonHalted = "halted"
+
+	// ReasonCircuitBreakerTripped is the reason reported in an EventPriceMissing when the aggregated
+	// price for a currency pair tripped the circuit breaker.
+	ReasonCircuitBreakerTripped = "circuit breaker tripped"
+)
+
 // PriceApplier is an interface used in `ExtendVote` and `PreBlock` to apply the prices
 // derived from the latest votes to state.
 //
@@ -100,6 +119,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		return nil, err
 	}
 
+	dispersions := opa.va.GetAggregatedDispersions()
+	numVotes := opa.countVotes(votes)
 	currencyPairs := opa.ok.GetAllCurrencyPairs(ctx)
 	for _, cp := range currencyPairs {
 		price, ok := prices[cp]
@@ -109,6 +130,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 				"currency_pair", cp.String(),
 			)
 
+			opa.emitPriceMissing(ctx, cp, numVotes[cp], ReasonNoPrice)
+
 			continue
 		}
 
@@ -119,16 +142,51 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 				"price", price.String(),
 			)
 
+			opa.emitPriceMissing(ctx, cp, numVotes[cp], ReasonNegativePrice)
+
+			continue
+		}
+
+		// Bound the change of the price by the currency pair's circuit breaker.
+		boundedPrice, err := opa.ok.ApplyCircuitBreaker(ctx, cp, math.NewIntFromBigInt(price))
+		if err != nil {
+			reason, ok := circuitBreakerReason(err)
+			if !ok {
+				opa.logger.Error(
+					"failed to apply circuit breaker for currency pair",
+					"currency_pair", cp.String(),
+					"err", err,
+				)
+
+				return nil, err
+			}
+
+			opa.logger.Info(
+				"price update rejected by circuit breaker",
+				"currency_pair", cp.String(),
+				"price", price.String(),
+				"reason", err,
+			)
+
+			opa.emitPriceMissing(ctx, cp, numVotes[cp], reason)
+
 			continue
 		}
 
 		// Convert the price to a quote price and write it to state.
 		quotePrice := oracletypes.QuotePrice{
-			Price:          math.NewIntFromBigInt(price),
+			Price:          boundedPrice,
 			BlockTimestamp: ctx.BlockHeader().Time,
 			BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
 		}
 
+		// Dispersions are optional, so the price is written without one if validators did not
+		// report it.
+		if dispersion, ok := dispersions[cp]; ok && dispersion != nil && dispersion.Sign() >= 0 {
+			quoteDispersion := math.NewIntFromBigInt(dispersion)
+			quotePrice.Dispersion = &quoteDispersion
+		}
+
 		if err := opa.ok.SetPriceForCurrencyPair(ctx, cp, quotePrice); err != nil {
 			opa.logger.Error(
 				"failed to set price for currency pair",
@@ -145,6 +203,8 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			"currency_pair", cp.String(),
 			"quote_price", quotePrice.Price.String(),
 		)
+
+		opa.emitPriceUpdated(ctx, cp, quotePrice, numVotes[cp])
 	}
 
 	return prices, nil
@@ -153,3 +213,100 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int {
 	return opa.va.GetPriceForValidator(validator)
 }
+
+// circuitBreakerReason returns the EventPriceMissing reason for an error returned by the circuit breaker,
+// if the error indicates that the price update was rejected.
+func circuitBreakerReason(err error) (string, bool) {
+	var haltedErr oracletypes.CurrencyPairHaltedError
+	if errors.As(err, &haltedErr) {
+		return ReasonHalted, true
+	}
+
+	var trippedErr oracletypes.CircuitBreakerTrippedError
+	if errors.As(err, &trippedErr) {
+		return ReasonCircuitBreakerTripped, true
+	}
+
+	return "", false
+}
+
+// countVotes returns the number of validators that reported a price for each currency pair. This
+// depends on the prices from the latest set of aggregated votes.
+func (opa *oraclePriceApplier) countVotes(votes []Vote) map[connecttypes.CurrencyPair]uint64 {
+	numVotes := make(map[connecttypes.CurrencyPair]uint64)
+	for _, vote := range votes {
+		for cp := range opa.va.GetPriceForValidator(vote.ConsAddress) {
+			numVotes[cp]++
+		}
+	}
+
+	return numVotes
+}
+
+// emitPriceUpdated emits an EventPriceUpdated for a currency pair whose price was written to state. Events
+// are not consensus-critical, so failures are logged rather than returned.
+func (opa *oraclePriceApplier) emitPriceUpdated(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	qp oracletypes.QuotePrice,
+	numVotes uint64,
+) {
+	nonce, err := opa.ok.GetNonceForCurrencyPair(ctx, cp)
+	if err != nil {
+		opa.logger.Error(
+			"failed to get nonce for price updated event",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+
+		return
+	}
+
+	decimals, err := opa.ok.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		opa.logger.Error(
+			"failed to get decimals for price updated event",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&oracletypes.EventPriceUpdated{
+		CurrencyPair: cp.String(),
+		Price:        qp.Price,
+		Decimals:     decimals,
+		Nonce:        nonce,
+		NumVotes:     numVotes,
+		BlockHeight:  qp.BlockHeight,
+	}); err != nil {
+		opa.logger.Error(
+			"failed to emit price updated event",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+	}
+}
+
+// emitPriceMissing emits an EventPriceMissing for a currency pair whose price was not updated. Events are
+// not consensus-critical, so failures are logged rather than returned.
+func (opa *oraclePriceApplier) emitPriceMissing(
+	ctx sdk.Context,
+	cp connecttypes.CurrencyPair,
+	numVotes uint64,
+	reason string,
+) {
+	if err := ctx.EventManager().EmitTypedEvent(&oracletypes.EventPriceMissing{
+		CurrencyPair: cp.String(),
+		NumVotes:     numVotes,
+		Reason:       reason,
+		BlockHeight:  uint64(ctx.BlockHeight()), //nolint:gosec
+	}); err != nil {
+		opa.logger.Error(
+			"failed to emit price missing event",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+	}
+}
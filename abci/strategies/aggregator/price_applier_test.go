@@ -16,6 +16,7 @@ import (
 	abcimocks "github.com/skip-mev/connect/v2/abci/types/mocks"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -114,7 +115,7 @@ func TestPriceApplier(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		ctx := sdk.Context{}
+		ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
 
 		// succeed vote aggregation
 		cp := connecttypes.NewCurrencyPair("BTC", "USD")
@@ -128,16 +129,114 @@ func TestPriceApplier(t *testing.T) {
 		}).Return(map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(-100),
 		}, nil)
+		va.On("GetAggregatedDispersions").Return(nil).Once()
 
 		ok.On("GetAllCurrencyPairs", ctx).Return(
 			[]connecttypes.CurrencyPair{cp},
 		)
 
+		va.On("GetPriceForValidator", ca).Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(-100),
+		}).Once()
+
 		_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
 			Txs: [][]byte{extCommitInfoBz},
 		})
 
 		require.NoError(t, err)
+
+		// expect a missing price event
+		events := ctx.EventManager().ABCIEvents()
+		require.Len(t, events, 1)
+
+		msg, err := sdk.ParseTypedEvent(events[0])
+		require.NoError(t, err)
+		require.Equal(t, &oracletypes.EventPriceMissing{
+			CurrencyPair: cp.String(),
+			NumVotes:     1,
+			Reason:       aggregator.ReasonNegativePrice,
+		}, msg)
+	})
+
+	t.Run("skip prices rejected by the circuit breaker", func(t *testing.T) {
+		prices := map[uint64][]byte{
+			1: big.NewInt(1000).Bytes(),
+		}
+
+		ca := sdk.ConsAddress("val1")
+
+		vote1, err := testutils.CreateExtendedVoteInfo(
+			ca,
+			prices,
+			veCodec,
+		)
+		require.NoError(t, err)
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+			[]abcitypes.ExtendedVoteInfo{vote1},
+			extCommitcodec,
+		)
+		require.NoError(t, err)
+
+		ctx := sdk.Context{}.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+
+		tripped := connecttypes.NewCurrencyPair("BTC", "USD")
+		halted := connecttypes.NewCurrencyPair("ETH", "USD")
+		va.On("AggregateOracleVotes", ctx, []aggregator.Vote{
+			{
+				OracleVoteExtension: vetypes.OracleVoteExtension{
+					Prices: prices,
+				},
+				ConsAddress: ca,
+			},
+		}).Return(map[connecttypes.CurrencyPair]*big.Int{
+			tripped: big.NewInt(1000),
+			halted:  big.NewInt(1000),
+		}, nil)
+		va.On("GetAggregatedDispersions").Return(nil).Once()
+
+		ok.On("GetAllCurrencyPairs", ctx).Return(
+			[]connecttypes.CurrencyPair{tripped, halted},
+		)
+
+		va.On("GetPriceForValidator", ca).Return(map[connecttypes.CurrencyPair]*big.Int{
+			tripped: big.NewInt(1000),
+			halted:  big.NewInt(1000),
+		}).Once()
+
+		ok.On("ApplyCircuitBreaker", ctx, tripped, math.NewInt(1000)).Return(
+			math.Int{}, oracletypes.NewCircuitBreakerTrippedError(tripped, oracletypes.CircuitBreakerActionSkip),
+		)
+		ok.On("ApplyCircuitBreaker", ctx, halted, math.NewInt(1000)).Return(
+			math.Int{}, oracletypes.NewCurrencyPairHaltedError(halted),
+		)
+
+		_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+
+		// expect a missing price event for each cp, and no price updates
+		events := ctx.EventManager().ABCIEvents()
+		require.Len(t, events, 2)
+
+		msg, err := sdk.ParseTypedEvent(events[0])
+		require.NoError(t, err)
+		require.Equal(t, &oracletypes.EventPriceMissing{
+			CurrencyPair: tripped.String(),
+			NumVotes:     1,
+			Reason:       aggregator.ReasonCircuitBreakerTripped,
+			BlockHeight:  2,
+		}, msg)
+
+		msg, err = sdk.ParseTypedEvent(events[1])
+		require.NoError(t, err)
+		require.Equal(t, &oracletypes.EventPriceMissing{
+			CurrencyPair: halted.String(),
+			NumVotes:     1,
+			Reason:       aggregator.ReasonHalted,
+			BlockHeight:  2,
+		}, msg)
 	})
 
 	t.Run("update prices in state", func(t *testing.T) {
@@ -176,7 +275,7 @@ func TestPriceApplier(t *testing.T) {
 
 		ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
 			Time: time.Now(),
-		}).WithBlockHeight(1)
+		}).WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
 
 		// succeed vote aggregation
 		cp := connecttypes.NewCurrencyPair("BTC", "USD")
@@ -197,18 +296,35 @@ func TestPriceApplier(t *testing.T) {
 		}).Return(map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(150),
 		}, nil)
+		va.On("GetAggregatedDispersions").Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(50),
+		}).Once()
 
 		// return multiple prices
+		ethUsd := connecttypes.NewCurrencyPair("ETH", "USD")
 		ok.On("GetAllCurrencyPairs", ctx).Return(
-			[]connecttypes.CurrencyPair{cp, connecttypes.NewCurrencyPair("ETH", "USD")}, // ignore last cp
+			[]connecttypes.CurrencyPair{cp, ethUsd}, // ignore last cp
 		)
 
+		va.On("GetPriceForValidator", ca1).Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(100),
+		}).Once()
+		va.On("GetPriceForValidator", ca2).Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(200),
+		}).Once()
+
+		ok.On("ApplyCircuitBreaker", ctx, cp, math.NewInt(150)).Return(math.NewInt(150), nil)
+		ok.On("GetNonceForCurrencyPair", ctx, cp).Return(uint64(1), nil)
+		ok.On("GetDecimalsForCurrencyPair", ctx, cp).Return(uint64(8), nil)
+
 		ok.On("SetPriceForCurrencyPair", ctx, cp, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 			qp := args.Get(2).(oracletypes.QuotePrice)
 
 			require.Equal(t, qp.Price.BigInt(), big.NewInt(150))
 			require.Equal(t, qp.BlockTimestamp, ctx.BlockHeader().Time)
 			require.Equal(t, qp.BlockHeight, uint64(ctx.BlockHeight())) //nolint:gosec
+			require.NotNil(t, qp.Dispersion)
+			require.Equal(t, qp.Dispersion.BigInt(), big.NewInt(50))
 		})
 
 		prices, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
@@ -219,6 +335,29 @@ func TestPriceApplier(t *testing.T) {
 			cp: big.NewInt(150),
 		}, prices)
 
+		// expect a price updated event for the updated cp, and a missing price event for the ignored cp
+		events := ctx.EventManager().ABCIEvents()
+		require.Len(t, events, 2)
+
+		msg, err := sdk.ParseTypedEvent(events[0])
+		require.NoError(t, err)
+		require.Equal(t, &oracletypes.EventPriceUpdated{
+			CurrencyPair: cp.String(),
+			Price:        math.NewInt(150),
+			Decimals:     8,
+			Nonce:        1,
+			NumVotes:     2,
+			BlockHeight:  1,
+		}, msg)
+
+		msg, err = sdk.ParseTypedEvent(events[1])
+		require.NoError(t, err)
+		require.Equal(t, &oracletypes.EventPriceMissing{
+			CurrencyPair: ethUsd.String(),
+			Reason:       aggregator.ReasonNoPrice,
+			BlockHeight:  1,
+		}, msg)
+
 		// get prices from validators
 		expPrices := map[connecttypes.CurrencyPair]*big.Int{
 			cp: big.NewInt(150),
@@ -229,4 +368,49 @@ func TestPriceApplier(t *testing.T) {
 		valPrices := pa.GetPricesForValidator(ca1)
 		require.Equal(t, expPrices, valPrices)
 	})
+	t.Run("event failures do not fail the price update", func(t *testing.T) {
+		va := mocks.NewVoteAggregator(t)
+		ok := abcimocks.NewOracleKeeper(t)
+		pa := aggregator.NewOraclePriceApplier(va, ok, veCodec, extCommitcodec, log.NewNopLogger())
+
+		ca := sdk.ConsAddress("val1")
+		prices := map[uint64][]byte{
+			1: big.NewInt(100).Bytes(),
+		}
+
+		vote, err := testutils.CreateExtendedVoteInfo(ca, prices, veCodec)
+		require.NoError(t, err)
+
+		_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+			[]abcitypes.ExtendedVoteInfo{vote},
+			extCommitcodec,
+		)
+		require.NoError(t, err)
+
+		ctx := sdk.Context{}.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+		cp := connecttypes.NewCurrencyPair("BTC", "USD")
+
+		va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(100),
+		}, nil)
+		va.On("GetAggregatedDispersions").Return(map[connecttypes.CurrencyPair]*big.Int{})
+		va.On("GetPriceForValidator", ca).Return(map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(100),
+		})
+
+		ok.On("GetAllCurrencyPairs", ctx).Return([]connecttypes.CurrencyPair{cp})
+		ok.On("ApplyCircuitBreaker", ctx, cp, math.NewInt(100)).Return(math.NewInt(100), nil)
+		ok.On("SetPriceForCurrencyPair", ctx, cp, mock.Anything).Return(nil)
+		ok.On("GetNonceForCurrencyPair", ctx, cp).Return(uint64(1), nil)
+		ok.On("GetDecimalsForCurrencyPair", ctx, cp).Return(uint64(0), fmt.Errorf("market map unavailable"))
+
+		applied, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[connecttypes.CurrencyPair]*big.Int{
+			cp: big.NewInt(100),
+		}, applied)
+		require.Empty(t, ctx.EventManager().ABCIEvents())
+	})
 }
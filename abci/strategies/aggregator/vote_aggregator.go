@@ -84,6 +84,11 @@ type VoteAggregator interface {
 	// Notice: This method overwrites the VoteAggregator's local view of prices.
 	AggregateOracleVotes(ctx sdk.Context, votes []Vote) (map[connecttypes.CurrencyPair]*big.Int, error)
 
+	// GetAggregatedDispersions gets the dispersions aggregated from the optional dispersions reported
+	// alongside the prices in the latest set of aggregated votes. Dispersions are aggregated with the
+	// same aggregation function as prices.
+	GetAggregatedDispersions() map[connecttypes.CurrencyPair]*big.Int
+
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
 	// on the prices from the latest set of aggregated votes.
 	GetPriceForValidator(validator sdk.ConsAddress) map[connecttypes.CurrencyPair]*big.Int
@@ -99,6 +104,9 @@ func NewDefaultVoteAggregator(
 		priceAggregator: aggregator.NewDataAggregator(
 			aggregator.WithAggregateFnFromContext(aggregateFn),
 		),
+		dispersionAggregator: aggregator.NewDataAggregator(
+			aggregator.WithAggregateFnFromContext(aggregateFn),
+		),
 		currencyPairStrategy: strategy,
 	}
 }
@@ -107,6 +115,9 @@ type DefaultVoteAggregator struct {
 	// validator address -> currency-pair -> price
 	priceAggregator *aggregator.DataAggregator[string, map[connecttypes.CurrencyPair]*big.Int]
 
+	// validator address -> currency-pair -> dispersion
+	dispersionAggregator *aggregator.DataAggregator[string, map[connecttypes.CurrencyPair]*big.Int]
+
 	// decoding prices / currency-pair ids
 	currencyPairStrategy currencypair.CurrencyPairStrategy
 
@@ -116,6 +127,7 @@ type DefaultVoteAggregator struct {
 func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []Vote) (map[connecttypes.CurrencyPair]*big.Int, error) {
 	// Reset the price aggregator and set the aggregationFn to use the latest application-state.
 	dva.priceAggregator.ResetProviderData()
+	dva.dispersionAggregator.ResetProviderData()
 
 	// Iterate through all vote extensions and consolidate all price info before
 	// aggregating.
@@ -137,9 +149,13 @@ func (dva *DefaultVoteAggregator) AggregateOracleVotes(ctx sdk.Context, votes []
 	dva.priceAggregator.AggregateDataFromContext(ctx)
 	prices := dva.priceAggregator.GetAggregatedData()
 
+	// Compute the final dispersions for each currency pair.
+	dva.dispersionAggregator.AggregateDataFromContext(ctx)
+
 	dva.logger.Debug(
 		"aggregated oracle data",
 		"num_prices", len(prices),
+		"num_dispersions", len(dva.dispersionAggregator.GetAggregatedData()),
 	)
 
 	return prices, nil
@@ -202,6 +218,35 @@ func (dva *DefaultVoteAggregator) addVoteToAggregator(ctx sdk.Context, address s
 
 	dva.priceAggregator.SetProviderData(address, prices)
 
+	// Format all dispersions into a map of currency pair -> dispersion. A dispersion is only
+	// considered if the validator's price for the currency pair was accepted.
+	dispersions := make(map[connecttypes.CurrencyPair]*big.Int, len(oracleData.Dispersions))
+	for cpID, dispersionBz := range oracleData.Dispersions {
+		if len(dispersionBz) > connectabci.MaximumPriceSize {
+			dva.logger.Debug(
+				"failed to store dispersion, bytes are too long",
+				"currency_pair_id", cpID,
+				"num_bytes", len(dispersionBz),
+			)
+			continue
+		}
+
+		cp, err := dva.currencyPairStrategy.FromID(ctx, cpID)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := prices[cp]; !ok {
+			continue
+		}
+
+		dispersions[cp] = new(big.Int).SetBytes(dispersionBz)
+	}
+
+	if len(dispersions) > 0 {
+		dva.dispersionAggregator.SetProviderData(address, dispersions)
+	}
+
 	return nil
 }
 
@@ -209,3 +254,7 @@ func (dva *DefaultVoteAggregator) GetPriceForValidator(validator sdk.ConsAddress
 	consAddrStr := validator.String()
 	return dva.priceAggregator.GetDataByProvider(consAddrStr)
 }
+
+func (dva *DefaultVoteAggregator) GetAggregatedDispersions() map[connecttypes.CurrencyPair]*big.Int {
+	return dva.dispersionAggregator.GetAggregatedData()
+}
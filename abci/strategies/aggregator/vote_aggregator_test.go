@@ -16,6 +16,7 @@ import (
 	"github.com/skip-mev/connect/v2/abci/strategies/codec"
 	currencypairmocks "github.com/skip-mev/connect/v2/abci/strategies/currencypair/mocks"
 	"github.com/skip-mev/connect/v2/abci/testutils"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted"
 	"github.com/skip-mev/connect/v2/pkg/math/voteweighted/mocks"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -461,4 +462,53 @@ func (s *VoteAggregatorTestSuite) TestAggregateOracleVotes() {
 		s.Require().NoError(err)
 		s.Require().Len(prices, 0)
 	})
+	s.Run("dispersions are aggregated alongside prices", func() {
+		votes := []aggregator.Vote{
+			{
+				ConsAddress: s.myVal,
+				OracleVoteExtension: vetypes.OracleVoteExtension{
+					Prices:      map[uint64][]byte{0: oneHundred.Bytes()},
+					Dispersions: map[uint64][]byte{0: big.NewInt(3).Bytes()},
+				},
+			},
+			{
+				ConsAddress: val1,
+				OracleVoteExtension: vetypes.OracleVoteExtension{
+					Prices:      map[uint64][]byte{0: twoHundred.Bytes()},
+					Dispersions: map[uint64][]byte{0: big.NewInt(5).Bytes()},
+				},
+			},
+		}
+
+		// Assume the validators have an equal stake. The stake is looked up once for the prices
+		// and once for the dispersions.
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, s.myVal).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(50),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Twice()
+		mockValidatorStore.On("ValidatorByConsAddr", mock.Anything, val1).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(50),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		).Twice()
+
+		cpID.On("FromID", s.ctx, uint64(0)).Return(btcUSD, nil).Times(4)
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, oneHundred.Bytes()).Return(oneHundred, nil).Once()
+		cpID.On("GetDecodedPrice", s.ctx, btcUSD, twoHundred.Bytes()).Return(twoHundred, nil).Once()
+
+		// Aggregate oracle data
+		prices, err := handler.AggregateOracleVotes(s.ctx, votes)
+		s.Require().NoError(err)
+		s.Require().Equal(oneHundred.String(), prices[btcUSD].String())
+
+		// Check that the dispersions are aggregated with the same function as the prices
+		dispersions := handler.GetAggregatedDispersions()
+		s.Require().Len(dispersions, 1)
+		s.Require().Equal(big.NewInt(3).String(), dispersions[btcUSD].String())
+	})
 }
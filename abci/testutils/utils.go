@@ -22,13 +22,27 @@ import (
 func CreateTestOracleKeeperWithGenesis(t *testing.T, ctx sdk.Context, key *storetypes.KVStoreKey, genesis oracletypes.GenesisState) keeper.Keeper {
 	t.Helper()
 
+	return CreateTestOracleKeeperWithMarketMapKeeper(t, ctx, key, genesis, mocks.NewMarketMapKeeper(t))
+}
+
+// CreateTestOracleKeeperWithMarketMapKeeper creates a test oracle keeper with the given genesis state and
+// market map keeper.
+func CreateTestOracleKeeperWithMarketMapKeeper(
+	t *testing.T,
+	ctx sdk.Context,
+	key *storetypes.KVStoreKey,
+	genesis oracletypes.GenesisState,
+	mmKeeper oracletypes.MarketMapKeeper,
+) keeper.Keeper {
+	t.Helper()
+
 	ss := runtime.NewKVStoreService(key)
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	k := keeper.NewKeeper(
 		ss,
 		encCfg.Codec,
-		mocks.NewMarketMapKeeper(t),
+		mmKeeper,
 		sdk.AccAddress("authority"),
 	)
 
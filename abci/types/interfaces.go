@@ -2,7 +2,9 @@ package types
 
 import (
 	"context"
+	"math/big"
 
+	"cosmossdk.io/math"
 	"google.golang.org/grpc"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -18,6 +20,10 @@ import (
 type OracleKeeper interface { //golint:ignore
 	GetAllCurrencyPairs(ctx context.Context) []connecttypes.CurrencyPair
 	SetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair, qp oracletypes.QuotePrice) error
+	GetNonceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (uint64, error)
+	GetDecimalsForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (uint64, error)
+	ApplyCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair, price math.Int) (math.Int, error)
+	UpdateValidatorPerformance(ctx context.Context, finalPrices map[connecttypes.CurrencyPair]*big.Int, validators []oracletypes.ValidatorPrices) error
 }
 
 // OracleClient defines the interface that must be fulfilled by the connect client.
@@ -4,6 +4,9 @@ package mocks
 
 import (
 	context "context"
+	big "math/big"
+
+	math "cosmossdk.io/math"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -25,6 +28,64 @@ func (_m *OracleKeeper) EXPECT() *OracleKeeper_Expecter {
 	return &OracleKeeper_Expecter{mock: &_m.Mock}
 }
 
+// ApplyCircuitBreaker provides a mock function with given fields: ctx, cp, price
+func (_m *OracleKeeper) ApplyCircuitBreaker(ctx context.Context, cp types.CurrencyPair, price math.Int) (math.Int, error) {
+	ret := _m.Called(ctx, cp, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyCircuitBreaker")
+	}
+
+	var r0 math.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair, math.Int) (math.Int, error)); ok {
+		return rf(ctx, cp, price)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair, math.Int) math.Int); ok {
+		r0 = rf(ctx, cp, price)
+	} else {
+		r0 = ret.Get(0).(math.Int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.CurrencyPair, math.Int) error); ok {
+		r1 = rf(ctx, cp, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_ApplyCircuitBreaker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyCircuitBreaker'
+type OracleKeeper_ApplyCircuitBreaker_Call struct {
+	*mock.Call
+}
+
+// ApplyCircuitBreaker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cp types.CurrencyPair
+//   - price math.Int
+func (_e *OracleKeeper_Expecter) ApplyCircuitBreaker(ctx interface{}, cp interface{}, price interface{}) *OracleKeeper_ApplyCircuitBreaker_Call {
+	return &OracleKeeper_ApplyCircuitBreaker_Call{Call: _e.mock.On("ApplyCircuitBreaker", ctx, cp, price)}
+}
+
+func (_c *OracleKeeper_ApplyCircuitBreaker_Call) Run(run func(ctx context.Context, cp types.CurrencyPair, price math.Int)) *OracleKeeper_ApplyCircuitBreaker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CurrencyPair), args[2].(math.Int))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_ApplyCircuitBreaker_Call) Return(_a0 math.Int, _a1 error) *OracleKeeper_ApplyCircuitBreaker_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_ApplyCircuitBreaker_Call) RunAndReturn(run func(context.Context, types.CurrencyPair, math.Int) (math.Int, error)) *OracleKeeper_ApplyCircuitBreaker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllCurrencyPairs provides a mock function with given fields: ctx
 func (_m *OracleKeeper) GetAllCurrencyPairs(ctx context.Context) []types.CurrencyPair {
 	ret := _m.Called(ctx)
@@ -73,6 +134,120 @@ func (_c *OracleKeeper_GetAllCurrencyPairs_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetDecimalsForCurrencyPair provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) GetDecimalsForCurrencyPair(ctx context.Context, cp types.CurrencyPair) (uint64, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDecimalsForCurrencyPair")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) (uint64, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) uint64); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_GetDecimalsForCurrencyPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDecimalsForCurrencyPair'
+type OracleKeeper_GetDecimalsForCurrencyPair_Call struct {
+	*mock.Call
+}
+
+// GetDecimalsForCurrencyPair is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cp types.CurrencyPair
+func (_e *OracleKeeper_Expecter) GetDecimalsForCurrencyPair(ctx interface{}, cp interface{}) *OracleKeeper_GetDecimalsForCurrencyPair_Call {
+	return &OracleKeeper_GetDecimalsForCurrencyPair_Call{Call: _e.mock.On("GetDecimalsForCurrencyPair", ctx, cp)}
+}
+
+func (_c *OracleKeeper_GetDecimalsForCurrencyPair_Call) Run(run func(ctx context.Context, cp types.CurrencyPair)) *OracleKeeper_GetDecimalsForCurrencyPair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CurrencyPair))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_GetDecimalsForCurrencyPair_Call) Return(_a0 uint64, _a1 error) *OracleKeeper_GetDecimalsForCurrencyPair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_GetDecimalsForCurrencyPair_Call) RunAndReturn(run func(context.Context, types.CurrencyPair) (uint64, error)) *OracleKeeper_GetDecimalsForCurrencyPair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNonceForCurrencyPair provides a mock function with given fields: ctx, cp
+func (_m *OracleKeeper) GetNonceForCurrencyPair(ctx context.Context, cp types.CurrencyPair) (uint64, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNonceForCurrencyPair")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) (uint64, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.CurrencyPair) uint64); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleKeeper_GetNonceForCurrencyPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNonceForCurrencyPair'
+type OracleKeeper_GetNonceForCurrencyPair_Call struct {
+	*mock.Call
+}
+
+// GetNonceForCurrencyPair is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cp types.CurrencyPair
+func (_e *OracleKeeper_Expecter) GetNonceForCurrencyPair(ctx interface{}, cp interface{}) *OracleKeeper_GetNonceForCurrencyPair_Call {
+	return &OracleKeeper_GetNonceForCurrencyPair_Call{Call: _e.mock.On("GetNonceForCurrencyPair", ctx, cp)}
+}
+
+func (_c *OracleKeeper_GetNonceForCurrencyPair_Call) Run(run func(ctx context.Context, cp types.CurrencyPair)) *OracleKeeper_GetNonceForCurrencyPair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.CurrencyPair))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_GetNonceForCurrencyPair_Call) Return(_a0 uint64, _a1 error) *OracleKeeper_GetNonceForCurrencyPair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleKeeper_GetNonceForCurrencyPair_Call) RunAndReturn(run func(context.Context, types.CurrencyPair) (uint64, error)) *OracleKeeper_GetNonceForCurrencyPair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPriceForCurrencyPair provides a mock function with given fields: ctx, cp, qp
 func (_m *OracleKeeper) SetPriceForCurrencyPair(ctx context.Context, cp types.CurrencyPair, qp oracletypes.QuotePrice) error {
 	ret := _m.Called(ctx, cp, qp)
@@ -121,6 +296,54 @@ func (_c *OracleKeeper_SetPriceForCurrencyPair_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// UpdateValidatorPerformance provides a mock function with given fields: ctx, finalPrices, validators
+func (_m *OracleKeeper) UpdateValidatorPerformance(ctx context.Context, finalPrices map[types.CurrencyPair]*big.Int, validators []oracletypes.ValidatorPrices) error {
+	ret := _m.Called(ctx, finalPrices, validators)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateValidatorPerformance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[types.CurrencyPair]*big.Int, []oracletypes.ValidatorPrices) error); ok {
+		r0 = rf(ctx, finalPrices, validators)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleKeeper_UpdateValidatorPerformance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateValidatorPerformance'
+type OracleKeeper_UpdateValidatorPerformance_Call struct {
+	*mock.Call
+}
+
+// UpdateValidatorPerformance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - finalPrices map[types.CurrencyPair]*big.Int
+//   - validators []oracletypes.ValidatorPrices
+func (_e *OracleKeeper_Expecter) UpdateValidatorPerformance(ctx interface{}, finalPrices interface{}, validators interface{}) *OracleKeeper_UpdateValidatorPerformance_Call {
+	return &OracleKeeper_UpdateValidatorPerformance_Call{Call: _e.mock.On("UpdateValidatorPerformance", ctx, finalPrices, validators)}
+}
+
+func (_c *OracleKeeper_UpdateValidatorPerformance_Call) Run(run func(ctx context.Context, finalPrices map[types.CurrencyPair]*big.Int, validators []oracletypes.ValidatorPrices)) *OracleKeeper_UpdateValidatorPerformance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[types.CurrencyPair]*big.Int), args[2].([]oracletypes.ValidatorPrices))
+	})
+	return _c
+}
+
+func (_c *OracleKeeper_UpdateValidatorPerformance_Call) Return(_a0 error) *OracleKeeper_UpdateValidatorPerformance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleKeeper_UpdateValidatorPerformance_Call) RunAndReturn(run func(context.Context, map[types.CurrencyPair]*big.Int, []oracletypes.ValidatorPrices) error) *OracleKeeper_UpdateValidatorPerformance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOracleKeeper creates a new instance of OracleKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleKeeper(t interface {
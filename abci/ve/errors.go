@@ -69,3 +69,17 @@ func (e ValidateVoteExtensionError) Error() string {
 func (e ValidateVoteExtensionError) Label() string {
 	return "ValidateVoteExtensionError"
 }
+
+// PriceDeviationError is an error that is returned when a price in a vote extension deviates too far from the
+// on-chain price.
+type PriceDeviationError struct {
+	Err error
+}
+
+func (e PriceDeviationError) Error() string {
+	return fmt.Sprintf("price deviation error: %s", e.Err.Error())
+}
+
+func (e PriceDeviationError) Label() string {
+	return "PriceDeviationError"
+}
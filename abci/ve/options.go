@@ -0,0 +1,12 @@
+package ve
+
+// Option is a function that enables optional configuration of the VoteExtensionHandler.
+type Option func(*VoteExtensionHandler)
+
+// WithPriceDeviationVerifier returns an Option that configures the VoteExtensionHandler to verify the prices
+// of each vote extension against the current on-chain prices in VerifyVoteExtension.
+func WithPriceDeviationVerifier(verifier *PriceDeviationVerifier) Option {
+	return func(h *VoteExtensionHandler) {
+		h.priceVerifier = verifier
+	}
+}
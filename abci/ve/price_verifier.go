@@ -0,0 +1,126 @@
+package ve
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/abci/strategies/currencypair"
+	vetypes "github.com/skip-mev/connect/v2/abci/ve/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// PriceKeeper defines the interface used by the PriceDeviationVerifier to retrieve the current on-chain prices.
+type PriceKeeper interface {
+	GetPriceForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error)
+}
+
+// PriceDeviationVerifier verifies that the prices reported in a vote extension are within a band around
+// the current on-chain prices. This surfaces misconfigured validator sidecars before their votes reach
+// aggregation. Deviating prices are only flagged: rejecting the vote extension would reject the validator's
+// precommit, which can stall consensus when the on-chain price lags a real market move.
+type PriceDeviationVerifier struct {
+	// keeper is used to retrieve the current on-chain prices.
+	keeper PriceKeeper
+
+	// strategy is used to decode the prices reported in vote extensions.
+	strategy currencypair.CurrencyPairStrategy
+
+	// metrics is used to record the deviation of the reported prices per validator.
+	metrics servicemetrics.Metrics
+
+	// maxDeviationBps is the maximum deviation, in basis points of the on-chain price, of a reported price.
+	maxDeviationBps uint64
+}
+
+// NewPriceDeviationVerifier returns a new PriceDeviationVerifier.
+func NewPriceDeviationVerifier(
+	keeper PriceKeeper,
+	strategy currencypair.CurrencyPairStrategy,
+	metrics servicemetrics.Metrics,
+	maxDeviationBps uint64,
+) (*PriceDeviationVerifier, error) {
+	if keeper == nil {
+		return nil, fmt.Errorf("price keeper cannot be nil")
+	}
+
+	if strategy == nil {
+		return nil, fmt.Errorf("currency pair strategy cannot be nil")
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics cannot be nil")
+	}
+
+	if maxDeviationBps == 0 {
+		return nil, fmt.Errorf("max deviation must be positive")
+	}
+
+	return &PriceDeviationVerifier{
+		keeper:          keeper,
+		strategy:        strategy,
+		metrics:         metrics,
+		maxDeviationBps: maxDeviationBps,
+	}, nil
+}
+
+// Verify compares each price reported in the vote extension against the current on-chain price, and returns
+// a PriceDeviationError for the first price outside the band. Prices for currency pairs that are not supported,
+// cannot be decoded, or do not have an on-chain price are skipped, as they are also ignored in aggregation.
+func (v *PriceDeviationVerifier) Verify(ctx sdk.Context, validator sdk.ConsAddress, ve vetypes.OracleVoteExtension) error {
+	var deviationErr error
+	for id, bz := range ve.Prices {
+		cp, err := v.strategy.FromID(ctx, id)
+		if err != nil {
+			continue
+		}
+
+		price, err := v.strategy.GetDecodedPrice(ctx, cp, bz)
+		if err != nil {
+			continue
+		}
+
+		qp, err := v.keeper.GetPriceForCurrencyPair(ctx, cp)
+		if err != nil || qp.Price.IsNil() || !qp.Price.IsPositive() {
+			continue
+		}
+
+		deviation := deviationBps(qp.Price.BigInt(), price)
+		v.metrics.ObserveValidatorPriceDeviation(validator.String(), cp, float64(deviation))
+
+		if deviation > v.maxDeviationBps {
+			v.metrics.AddValidatorDeviatingPrice(validator.String(), cp)
+
+			// report every deviating price in metrics, but only the first in the error
+			if deviationErr == nil {
+				deviationErr = PriceDeviationError{
+					Err: fmt.Errorf(
+						"price %s for %s deviates %d bps from on-chain price %s; max %d bps",
+						price, cp, deviation, qp.Price, v.maxDeviationBps,
+					),
+				}
+			}
+		}
+	}
+
+	return deviationErr
+}
+
+// deviationBps returns the absolute deviation of the price from the positive reference price in basis points,
+// saturating at the maximum uint64.
+func deviationBps(reference, price *big.Int) uint64 {
+	diff := new(big.Int).Sub(price, reference)
+	diff.Abs(diff)
+	diff.Mul(diff, big.NewInt(oracletypes.BasisPoints))
+	diff.Quo(diff, reference)
+
+	if !diff.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return diff.Uint64()
+}
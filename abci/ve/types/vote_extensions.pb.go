@@ -28,6 +28,12 @@ type OracleVoteExtension struct {
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Dispersions defines an optional map of id(CurrencyPair) ->
+	// dispersion.Bytes(), where the dispersion is the median absolute deviation
+	// of the provider prices the validator's oracle aggregated, reported with the
+	// same decimals as the price. A dispersion may only be reported for a
+	// currency pair that has a price in the vote extension.
+	Dispersions map[uint64][]byte `protobuf:"bytes,2,rep,name=dispersions,proto3" json:"dispersions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *OracleVoteExtension) Reset()         { *m = OracleVoteExtension{} }
@@ -70,8 +76,16 @@ func (m *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (m *OracleVoteExtension) GetDispersions() map[uint64][]byte {
+	if m != nil {
+		return m.Dispersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OracleVoteExtension)(nil), "connect.abci.v2.OracleVoteExtension")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "connect.abci.v2.OracleVoteExtension.DispersionsEntry")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "connect.abci.v2.OracleVoteExtension.PricesEntry")
 }
 
@@ -80,22 +94,25 @@ func init() {
 }
 
 var fileDescriptor_185ec0708d9f4b6a = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
+	// 273 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0xcf, 0xcb,
 	0x4b, 0x4d, 0x2e, 0xd1, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0x33, 0xd2, 0x2f, 0xcb, 0x2f, 0x49,
 	0x8d, 0x4f, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x87, 0x2a, 0xd3, 0x03, 0x29, 0xd3, 0x2b, 0x33, 0x52, 0x9a, 0xc5, 0xc8, 0x25,
+	0xc9, 0x17, 0xe2, 0x87, 0x2a, 0xd3, 0x03, 0x29, 0xd3, 0x2b, 0x33, 0x52, 0xda, 0xcc, 0xc4, 0x25,
 	0xec, 0x5f, 0x94, 0x98, 0x9c, 0x93, 0x1a, 0x96, 0x5f, 0x92, 0xea, 0x0a, 0x53, 0x2f, 0xe4, 0xc1,
 	0xc5, 0x56, 0x50, 0x94, 0x99, 0x9c, 0x5a, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x64, 0xa0,
 	0x87, 0xa6, 0x53, 0x0f, 0x8b, 0x2e, 0xbd, 0x00, 0xb0, 0x16, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20,
-	0xa8, 0x7e, 0x29, 0x4b, 0x2e, 0x6e, 0x24, 0x61, 0x21, 0x01, 0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34,
-	0x55, 0x82, 0x49, 0x81, 0x51, 0x83, 0x27, 0x08, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0x74, 0x72, 0x3b,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xec, 0xcc, 0x02, 0xdd, 0xdc, 0xd4, 0x32, 0x7d, 0x58,
-	0x10, 0x94, 0x19, 0x41, 0x43, 0x21, 0x55, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec,
-	0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x31, 0x8c, 0x89, 0x25, 0x01, 0x00, 0x00,
+	0xa8, 0x7e, 0xa1, 0x70, 0x2e, 0xee, 0x94, 0xcc, 0xe2, 0x82, 0xd4, 0x22, 0xb0, 0x3b, 0x24, 0x98,
+	0xc0, 0xc6, 0x99, 0x12, 0x65, 0x9c, 0x0b, 0x42, 0x1f, 0xc4, 0x4c, 0x64, 0x93, 0xa4, 0x2c, 0xb9,
+	0xb8, 0x91, 0xec, 0x13, 0x12, 0xe0, 0x62, 0xce, 0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0x60,
+	0x09, 0x02, 0x31, 0x85, 0x44, 0xb8, 0x58, 0xcb, 0x12, 0x73, 0x4a, 0x53, 0x25, 0x98, 0x14, 0x18,
+	0x35, 0x78, 0x82, 0x20, 0x1c, 0x2b, 0x26, 0x0b, 0x46, 0x29, 0x3b, 0x2e, 0x01, 0x74, 0xb3, 0x49,
+	0xd1, 0xef, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
+	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9,
+	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc5, 0xd9, 0x99, 0x05, 0xba, 0xb9,
+	0xa9, 0x65, 0xfa, 0xb0, 0xb8, 0x29, 0x33, 0x82, 0x46, 0x4f, 0xaa, 0x7e, 0x49, 0x65, 0x41, 0x6a,
+	0x71, 0x12, 0x1b, 0x38, 0x56, 0x8c, 0x01, 0x03, 0x00, 0x64, 0xe5, 0x06, 0x3f, 0xbe, 0x01, 0x00,
+	0x00,
 }
 
 func (m *OracleVoteExtension) Marshal() (dAtA []byte, err error) {
@@ -118,6 +135,25 @@ func (m *OracleVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dispersions) > 0 {
+		for k := range m.Dispersions {
+			v := m.Dispersions[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintVoteExtensions(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintVoteExtensions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Prices) > 0 {
 		for k := range m.Prices {
 			v := m.Prices[k]
@@ -169,6 +205,18 @@ func (m *OracleVoteExtension) Size() (n int) {
 			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Dispersions) > 0 {
+		for k, v := range m.Dispersions {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovVoteExtensions(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovVoteExtensions(uint64(k)) + l
+			n += mapEntrySize + 1 + sovVoteExtensions(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -321,6 +369,120 @@ func (m *OracleVoteExtension) Unmarshal(dAtA []byte) error {
 			}
 			m.Prices[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispersions == nil {
+				m.Dispersions = make(map[uint64][]byte)
+			}
+			var mapkey uint64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVoteExtensions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVoteExtensions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVoteExtensions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthVoteExtensions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dispersions[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
@@ -43,6 +43,18 @@ func ValidateOracleVoteExtension(
 		}
 	}
 
+	// Verify dispersions are valid. Dispersions are optional, but may only be reported alongside a price.
+	for id, bz := range ve.Dispersions {
+		if _, ok := ve.Prices[id]; !ok {
+			return fmt.Errorf("dispersion reported for currency pair id %d without a price", id)
+		}
+
+		// Ensure that the dispersion bytes are not too long.
+		if len(bz) > connectabci.MaximumPriceSize {
+			return fmt.Errorf("dispersion bytes are too long: %d", len(bz))
+		}
+	}
+
 	return nil
 }
 
@@ -47,6 +47,9 @@ type VoteExtensionHandler struct {
 
 	// metrics is the service metrics interface that the vote-extension handler will use to report metrics.
 	metrics servicemetrics.Metrics
+
+	// priceVerifier is an optional verifier of the prices in vote extensions against the on-chain prices.
+	priceVerifier *PriceDeviationVerifier
 }
 
 // NewVoteExtensionHandler returns a new VoteExtensionHandler.
@@ -58,8 +61,9 @@ func NewVoteExtensionHandler(
 	codec compression.VoteExtensionCodec,
 	priceApplier aggregator.PriceApplier,
 	metrics servicemetrics.Metrics,
+	opts ...Option,
 ) *VoteExtensionHandler {
-	return &VoteExtensionHandler{
+	h := &VoteExtensionHandler{
 		logger:               logger,
 		oracleClient:         oracleClient,
 		timeout:              timeout,
@@ -68,6 +72,12 @@ func NewVoteExtensionHandler(
 		metrics:              metrics,
 		priceApplier:         priceApplier,
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 // ExtendVoteHandler returns a handler that extends a vote with the oracle's
@@ -167,7 +177,7 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 		}
 
 		// Transform the response prices into a vote extension.
-		voteExt, err := h.transformOracleServicePrices(ctx, oracleResp.Prices)
+		voteExt, err := h.transformOracleServicePrices(ctx, oracleResp.Prices, oracleResp.Dispersions)
 		if err != nil {
 			h.logger.Error(
 				"failed to transform oracle prices for vote extension; returning empty vote extension",
@@ -271,6 +281,19 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 			return &cometabci.ResponseVerifyVoteExtension{Status: cometabci.ResponseVerifyVoteExtension_REJECT}, err
 		}
 
+		// flag prices that deviate from the on-chain prices, if configured. the vote extension is accepted
+		// regardless, as rejecting it would reject the validator's precommit
+		if h.priceVerifier != nil {
+			if err := h.priceVerifier.Verify(ctx, req.ValidatorAddress, voteExtension); err != nil {
+				h.logger.Info(
+					"vote extension contains deviating prices",
+					"height", req.Height,
+					"validator", sdk.ConsAddress(req.ValidatorAddress).String(),
+					"err", err,
+				)
+			}
+		}
+
 		h.logger.Debug(
 			"validated vote extension",
 			"height", req.Height,
@@ -286,9 +309,15 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 
 // transformOracleServicePrices transforms the oracle service prices into a vote extension. It
 // does this by iterating over the prices submitted by the oracle service and determining the
-// correct decoded price / ID based on the currency pair strategy.
-func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, prices map[string]string) (types.OracleVoteExtension, error) {
+// correct decoded price / ID based on the currency pair strategy. The dispersion of each price, if
+// reported by the oracle service, is included alongside the price.
+func (h *VoteExtensionHandler) transformOracleServicePrices(
+	ctx sdk.Context,
+	prices map[string]string,
+	dispersions map[string]string,
+) (types.OracleVoteExtension, error) {
 	strategyPrices := make(map[uint64][]byte)
+	strategyDispersions := make(map[uint64][]byte)
 
 	// Iterate over the prices and transform them into the correct format.
 	for currencyPairID, priceString := range prices {
@@ -333,11 +362,40 @@ func (h *VoteExtensionHandler) transformOracleServicePrices(ctx sdk.Context, pri
 		)
 
 		strategyPrices[cpID] = encodedPrice
+
+		// Dispersions are optional, so a dispersion that cannot be converted is dropped rather than
+		// failing the vote extension.
+		dispersionString, ok := dispersions[currencyPairID]
+		if !ok {
+			continue
+		}
+
+		dispersion, converted := new(big.Int).SetString(dispersionString, 10)
+		if !converted || dispersion.Sign() < 0 {
+			h.logger.Debug(
+				"failed to convert dispersion string to big.Int",
+				"currency_pair", cp,
+				"dispersion", dispersionString,
+			)
+
+			continue
+		}
+
+		strategyDispersions[cpID] = dispersion.Bytes()
 	}
 
-	h.logger.Debug("transformed oracle prices", "prices", len(strategyPrices))
+	h.logger.Debug(
+		"transformed oracle prices",
+		"prices", len(strategyPrices),
+		"dispersions", len(strategyDispersions),
+	)
 
-	return types.OracleVoteExtension{
+	voteExt := types.OracleVoteExtension{
 		Prices: strategyPrices,
-	}, nil
+	}
+	if len(strategyDispersions) > 0 {
+		voteExt.Dispersions = strategyDispersions
+	}
+
+	return voteExt, nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/mock"
@@ -26,6 +27,7 @@ import (
 	servicemetrics "github.com/skip-mev/connect/v2/service/metrics"
 	metricsmocks "github.com/skip-mev/connect/v2/service/metrics/mocks"
 	servicetypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 var (
@@ -157,6 +159,45 @@ func (s *VoteExtensionTestSuite) TestExtendVoteExtension() {
 				},
 			},
 		},
+		{
+			name: "oracle service returns prices with dispersions",
+			oracleService: func() client.OracleClient {
+				mockServer := mocks.NewOracleClient(s.T())
+
+				mockServer.On("Prices", mock.Anything, mock.Anything).Return(
+					&servicetypes.QueryPricesResponse{
+						Prices: multiplePrices,
+						Dispersions: map[string]string{
+							btcUSD.String(): "5",
+							ethUSD.String(): "not a number",
+						},
+					},
+					nil,
+				)
+
+				return mockServer
+			},
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cps := mockstrategies.NewCurrencyPairStrategy(s.T())
+
+				cps.On("ID", mock.Anything, btcUSD).Return(uint64(0), nil)
+				cps.On("GetEncodedPrice", mock.Anything, btcUSD, oneHundred).Return(oneHundred.Bytes(), nil)
+
+				cps.On("ID", mock.Anything, ethUSD).Return(uint64(1), nil)
+				cps.On("GetEncodedPrice", mock.Anything, ethUSD, twoHundred).Return(twoHundred.Bytes(), nil)
+
+				return cps
+			},
+			expectedResponse: &abcitypes.OracleVoteExtension{
+				Prices: map[uint64][]byte{
+					0: oneHundred.Bytes(),
+					1: twoHundred.Bytes(),
+				},
+				Dispersions: map[uint64][]byte{
+					0: big.NewInt(5).Bytes(),
+				},
+			},
+		},
 		{
 			name: "oracle service panics",
 			oracleService: func() client.OracleClient {
@@ -315,6 +356,7 @@ func (s *VoteExtensionTestSuite) TestExtendVoteExtension() {
 				ext, err := cdc.Decode(resp.VoteExtension)
 				s.Require().NoError(err)
 				s.Require().Equal(tc.expectedResponse.Prices, ext.Prices)
+				s.Require().Equal(tc.expectedResponse.Dispersions, ext.Dispersions)
 			} else {
 				s.Require().Error(err)
 			}
@@ -419,6 +461,62 @@ func (s *VoteExtensionTestSuite) TestVerifyVoteExtension() {
 			},
 			expectedError: false,
 		},
+		{
+			name: "valid vote extension with dispersions",
+			getReq: func() *cometabci.RequestVerifyVoteExtension {
+				ext, err := cdc.Encode(abcitypes.OracleVoteExtension{
+					Prices: map[uint64][]byte{
+						0: oneHundred.Bytes(),
+					},
+					Dispersions: map[uint64][]byte{
+						0: big.NewInt(5).Bytes(),
+					},
+				})
+				s.Require().NoError(err)
+
+				return &cometabci.RequestVerifyVoteExtension{
+					VoteExtension: ext,
+					Height:        1,
+				}
+			},
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(1), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_ACCEPT,
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid vote extension - dispersion without a price - should fail",
+			getReq: func() *cometabci.RequestVerifyVoteExtension {
+				ext, err := cdc.Encode(abcitypes.OracleVoteExtension{
+					Prices: map[uint64][]byte{
+						0: oneHundred.Bytes(),
+					},
+					Dispersions: map[uint64][]byte{
+						1: big.NewInt(5).Bytes(),
+					},
+				})
+				s.Require().NoError(err)
+
+				return &cometabci.RequestVerifyVoteExtension{
+					VoteExtension: ext,
+					Height:        1,
+				}
+			},
+			currencyPairStrategy: func() *mockstrategies.CurrencyPairStrategy {
+				cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+				cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil).Once()
+				return cpStrategy
+			},
+			expectedResponse: &cometabci.ResponseVerifyVoteExtension{
+				Status: cometabci.ResponseVerifyVoteExtension_REJECT,
+			},
+			expectedError: true,
+		},
 		{
 			name: "invalid vote extension - 1 cp in prev state - should fail",
 			getReq: func() *cometabci.RequestVerifyVoteExtension {
@@ -529,6 +627,96 @@ func (s *VoteExtensionTestSuite) TestVerifyVoteExtension() {
 	}
 }
 
+func (s *VoteExtensionTestSuite) TestVerifyVoteExtensionPriceDeviation() {
+	cdc := codec.NewDefaultVoteExtensionCodec()
+	validator := sdk.ConsAddress("validator")
+
+	ext, err := testutils.CreateVoteExtensionBytes(map[uint64][]byte{
+		0: oneHundred.Bytes(),
+		1: twoHundred.Bytes(),
+	}, cdc)
+	s.Require().NoError(err)
+
+	req := &cometabci.RequestVerifyVoteExtension{
+		VoteExtension:    ext,
+		ValidatorAddress: validator,
+		Height:           1,
+	}
+
+	cases := []struct {
+		name       string
+		ethOnChain math.Int
+	}{
+		{
+			name:       "prices within the band are accepted",
+			ethOnChain: math.NewInt(190),
+		},
+		{
+			name:       "prices outside the band are flagged, but accepted",
+			ethOnChain: math.NewInt(100),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+			cpStrategy.On("GetMaxNumCP", mock.Anything).Return(uint64(2), nil)
+			cpStrategy.On("FromID", mock.Anything, uint64(0)).Return(btcUSD, nil)
+			cpStrategy.On("FromID", mock.Anything, uint64(1)).Return(ethUSD, nil)
+			cpStrategy.On("GetDecodedPrice", mock.Anything, btcUSD, oneHundred.Bytes()).Return(oneHundred, nil)
+			cpStrategy.On("GetDecodedPrice", mock.Anything, ethUSD, twoHundred.Bytes()).Return(twoHundred, nil)
+
+			keeper := mockstrategies.NewOracleKeeper(s.T())
+			keeper.On("GetPriceForCurrencyPair", mock.Anything, btcUSD).Return(oracletypes.QuotePrice{Price: math.NewInt(100)}, nil)
+			keeper.On("GetPriceForCurrencyPair", mock.Anything, ethUSD).Return(oracletypes.QuotePrice{Price: tc.ethOnChain}, nil)
+
+			// deviations are recorded per validator
+			m := metricsmocks.NewMetrics(s.T())
+			m.On("ObserveValidatorPriceDeviation", validator.String(), btcUSD, float64(0)).Once()
+			m.On("ObserveValidatorPriceDeviation", validator.String(), ethUSD, mock.Anything).Once()
+			if tc.ethOnChain.Equal(math.NewInt(100)) {
+				m.On("AddValidatorDeviatingPrice", validator.String(), ethUSD).Once()
+			}
+			m.On("ObserveABCIMethodLatency", servicemetrics.VerifyVoteExtension, mock.Anything)
+			m.On("AddABCIRequest", servicemetrics.VerifyVoteExtension, mock.Anything)
+			m.On("ObserveMessageSize", servicemetrics.VoteExtension, mock.Anything).Maybe()
+
+			verifier, err := ve.NewPriceDeviationVerifier(keeper, cpStrategy, m, 1000)
+			s.Require().NoError(err)
+
+			handler := ve.NewVoteExtensionHandler(
+				log.NewTestLogger(s.T()),
+				mocks.NewOracleClient(s.T()),
+				time.Second,
+				cpStrategy,
+				cdc,
+				aggregatormocks.NewPriceApplier(s.T()),
+				m,
+				ve.WithPriceDeviationVerifier(verifier),
+			).VerifyVoteExtensionHandler()
+
+			resp, err := handler(s.ctx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(cometabci.ResponseVerifyVoteExtension_ACCEPT, resp.Status)
+		})
+	}
+}
+
+func (s *VoteExtensionTestSuite) TestNewPriceDeviationVerifier() {
+	keeper := mockstrategies.NewOracleKeeper(s.T())
+	cpStrategy := mockstrategies.NewCurrencyPairStrategy(s.T())
+	m := servicemetrics.NewNopMetrics()
+
+	_, err := ve.NewPriceDeviationVerifier(keeper, cpStrategy, m, 0)
+	s.Require().Error(err)
+
+	_, err = ve.NewPriceDeviationVerifier(nil, cpStrategy, m, 100)
+	s.Require().Error(err)
+
+	_, err = ve.NewPriceDeviationVerifier(keeper, cpStrategy, m, 100)
+	s.Require().NoError(err)
+}
+
 func (s *VoteExtensionTestSuite) TestExtendVoteLatency() {
 	m := metricsmocks.NewMetrics(s.T())
 	os := mocks.NewOracleClient(s.T())
@@ -95,15 +95,99 @@ func (x *_OracleVoteExtension_1_map) IsValid() bool {
 	return x.m != nil
 }
 
+var _ protoreflect.Map = (*_OracleVoteExtension_2_map)(nil)
+
+type _OracleVoteExtension_2_map struct {
+	m *map[uint64][]byte
+}
+
+func (x *_OracleVoteExtension_2_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_OracleVoteExtension_2_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
+		mapValue := protoreflect.ValueOfBytes(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_OracleVoteExtension_2_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_OracleVoteExtension_2_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_OracleVoteExtension_2_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_OracleVoteExtension_2_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_OracleVoteExtension_2_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_OracleVoteExtension_2_map) NewValue() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_OracleVoteExtension_2_map) IsValid() bool {
+	return x.m != nil
+}
+
 var (
-	md_OracleVoteExtension        protoreflect.MessageDescriptor
-	fd_OracleVoteExtension_prices protoreflect.FieldDescriptor
+	md_OracleVoteExtension             protoreflect.MessageDescriptor
+	fd_OracleVoteExtension_prices      protoreflect.FieldDescriptor
+	fd_OracleVoteExtension_dispersions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_connect_abci_v2_vote_extensions_proto_init()
 	md_OracleVoteExtension = File_connect_abci_v2_vote_extensions_proto.Messages().ByName("OracleVoteExtension")
 	fd_OracleVoteExtension_prices = md_OracleVoteExtension.Fields().ByName("prices")
+	fd_OracleVoteExtension_dispersions = md_OracleVoteExtension.Fields().ByName("dispersions")
 }
 
 var _ protoreflect.Message = (*fastReflection_OracleVoteExtension)(nil)
@@ -177,6 +261,12 @@ func (x *fastReflection_OracleVoteExtension) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.Dispersions) != 0 {
+		value := protoreflect.ValueOfMap(&_OracleVoteExtension_2_map{m: &x.Dispersions})
+		if !f(fd_OracleVoteExtension_dispersions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -194,6 +284,8 @@ func (x *fastReflection_OracleVoteExtension) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		return len(x.Prices) != 0
+	case "connect.abci.v2.OracleVoteExtension.dispersions":
+		return len(x.Dispersions) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -212,6 +304,8 @@ func (x *fastReflection_OracleVoteExtension) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		x.Prices = nil
+	case "connect.abci.v2.OracleVoteExtension.dispersions":
+		x.Dispersions = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -234,6 +328,12 @@ func (x *fastReflection_OracleVoteExtension) Get(descriptor protoreflect.FieldDe
 		}
 		mapValue := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(mapValue)
+	case "connect.abci.v2.OracleVoteExtension.dispersions":
+		if len(x.Dispersions) == 0 {
+			return protoreflect.ValueOfMap(&_OracleVoteExtension_2_map{})
+		}
+		mapValue := &_OracleVoteExtension_2_map{m: &x.Dispersions}
+		return protoreflect.ValueOfMap(mapValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -258,6 +358,10 @@ func (x *fastReflection_OracleVoteExtension) Set(fd protoreflect.FieldDescriptor
 		mv := value.Map()
 		cmv := mv.(*_OracleVoteExtension_1_map)
 		x.Prices = *cmv.m
+	case "connect.abci.v2.OracleVoteExtension.dispersions":
+		mv := value.Map()
+		cmv := mv.(*_OracleVoteExtension_2_map)
+		x.Dispersions = *cmv.m
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -284,6 +388,12 @@ func (x *fastReflection_OracleVoteExtension) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_OracleVoteExtension_1_map{m: &x.Prices}
 		return protoreflect.ValueOfMap(value)
+	case "connect.abci.v2.OracleVoteExtension.dispersions":
+		if x.Dispersions == nil {
+			x.Dispersions = make(map[uint64][]byte)
+		}
+		value := &_OracleVoteExtension_2_map{m: &x.Dispersions}
+		return protoreflect.ValueOfMap(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -300,6 +410,9 @@ func (x *fastReflection_OracleVoteExtension) NewField(fd protoreflect.FieldDescr
 	case "connect.abci.v2.OracleVoteExtension.prices":
 		m := make(map[uint64][]byte)
 		return protoreflect.ValueOfMap(&_OracleVoteExtension_1_map{m: &m})
+	case "connect.abci.v2.OracleVoteExtension.dispersions":
+		m := make(map[uint64][]byte)
+		return protoreflect.ValueOfMap(&_OracleVoteExtension_2_map{m: &m})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.abci.v2.OracleVoteExtension"))
@@ -393,6 +506,30 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 			}
 		}
+		if len(x.Dispersions) > 0 {
+			SiZeMaP := func(k uint64, v []byte) {
+				l = 1 + len(v) + runtime.Sov(uint64(len(v)))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.Dispersions))
+				for k := range x.Dispersions {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Dispersions[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Dispersions {
+					SiZeMaP(k, v)
+				}
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -422,6 +559,47 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Dispersions) > 0 {
+			MaRsHaLmAp := func(k uint64, v []byte) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x12
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForDispersions := make([]uint64, 0, len(x.Dispersions))
+				for k := range x.Dispersions {
+					keysForDispersions = append(keysForDispersions, uint64(k))
+				}
+				sort.Slice(keysForDispersions, func(i, j int) bool {
+					return keysForDispersions[i] < keysForDispersions[j]
+				})
+				for iNdEx := len(keysForDispersions) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Dispersions[uint64(keysForDispersions[iNdEx])]
+					out, err := MaRsHaLmAp(keysForDispersions[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Dispersions {
+					v := x.Dispersions[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
 		if len(x.Prices) > 0 {
 			MaRsHaLmAp := func(k uint64, v []byte) (protoiface.MarshalOutput, error) {
 				baseI := i
@@ -626,6 +804,120 @@ func (x *fastReflection_OracleVoteExtension) ProtoMethods() *protoiface.Methods
 				}
 				x.Prices[mapkey] = mapvalue
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Dispersions == nil {
+					x.Dispersions = make(map[uint64][]byte)
+				}
+				var mapkey uint64
+				var mapvalue []byte
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapbyteLen uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapbyteLen |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intMapbyteLen := int(mapbyteLen)
+						if intMapbyteLen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postbytesIndex := iNdEx + intMapbyteLen
+						if postbytesIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postbytesIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = make([]byte, mapbyteLen)
+						copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+						iNdEx = postbytesIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Dispersions[mapkey] = mapvalue
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -684,6 +976,12 @@ type OracleVoteExtension struct {
 	// 0x123.. (bytes). Notice the `id` function is determined by the
 	// `CurrencyPairIDStrategy` used in the VoteExtensionHandler.
 	Prices map[uint64][]byte `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Dispersions defines an optional map of id(CurrencyPair) ->
+	// dispersion.Bytes(), where the dispersion is the median absolute deviation
+	// of the provider prices the validator's oracle aggregated, reported with the
+	// same decimals as the price. A dispersion may only be reported for a
+	// currency pair that has a price in the vote extension.
+	Dispersions map[uint64][]byte `protobuf:"bytes,2,rep,name=dispersions,proto3" json:"dispersions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *OracleVoteExtension) Reset() {
@@ -713,35 +1011,51 @@ func (x *OracleVoteExtension) GetPrices() map[uint64][]byte {
 	return nil
 }
 
+func (x *OracleVoteExtension) GetDispersions() map[uint64][]byte {
+	if x != nil {
+		return x.Dispersions
+	}
+	return nil
+}
+
 var File_connect_abci_v2_vote_extensions_proto protoreflect.FileDescriptor
 
 var file_connect_abci_v2_vote_extensions_proto_rawDesc = []byte{
 	0x0a, 0x25, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76,
 	0x32, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x4f, 0x72, 0x61,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x22, 0xb3, 0x02, 0x0a, 0x13, 0x4f, 0x72, 0x61,
 	0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x48, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
 	0x76, 0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74,
 	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x42, 0x13, 0x56,
-	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61,
-	0x62, 0x63, 0x69, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x62, 0x63, 0x69, 0x76, 0x32, 0xa2, 0x02, 0x03,
-	0x43, 0x41, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x62,
-	0x63, 0x69, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c,
-	0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a,
-	0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x72, 0x79, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76,
+	0x32, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
+	0x0a, 0x10, 0x44, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xb1,
+	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x61,
+	0x62, 0x63, 0x69, 0x2e, 0x76, 0x32, 0x42, 0x13, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x32, 0x3b,
+	0x61, 0x62, 0x63, 0x69, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x0f, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x62, 0x63, 0x69, 0x2e, 0x56, 0x32, 0xca, 0x02,
+	0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x56, 0x32,
+	0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c,
+	0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x3a, 0x3a,
+	0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -756,18 +1070,20 @@ func file_connect_abci_v2_vote_extensions_proto_rawDescGZIP() []byte {
 	return file_connect_abci_v2_vote_extensions_proto_rawDescData
 }
 
-var file_connect_abci_v2_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_abci_v2_vote_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_connect_abci_v2_vote_extensions_proto_goTypes = []interface{}{
 	(*OracleVoteExtension)(nil), // 0: connect.abci.v2.OracleVoteExtension
 	nil,                         // 1: connect.abci.v2.OracleVoteExtension.PricesEntry
+	nil,                         // 2: connect.abci.v2.OracleVoteExtension.DispersionsEntry
 }
 var file_connect_abci_v2_vote_extensions_proto_depIdxs = []int32{
 	1, // 0: connect.abci.v2.OracleVoteExtension.prices:type_name -> connect.abci.v2.OracleVoteExtension.PricesEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: connect.abci.v2.OracleVoteExtension.dispersions:type_name -> connect.abci.v2.OracleVoteExtension.DispersionsEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_connect_abci_v2_vote_extensions_proto_init() }
@@ -795,7 +1111,7 @@ func file_connect_abci_v2_vote_extensions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_abci_v2_vote_extensions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
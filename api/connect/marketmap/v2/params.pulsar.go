@@ -59,9 +59,12 @@ func (x *_Params_1_list) IsValid() bool {
 }
 
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_market_authorities protoreflect.FieldDescriptor
-	fd_Params_admin              protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_market_authorities     protoreflect.FieldDescriptor
+	fd_Params_admin                  protoreflect.FieldDescriptor
+	fd_Params_proposal_review_period protoreflect.FieldDescriptor
+	fd_Params_proposal_quorum        protoreflect.FieldDescriptor
+	fd_Params_require_proposals      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -69,6 +72,9 @@ func init() {
 	md_Params = File_connect_marketmap_v2_params_proto.Messages().ByName("Params")
 	fd_Params_market_authorities = md_Params.Fields().ByName("market_authorities")
 	fd_Params_admin = md_Params.Fields().ByName("admin")
+	fd_Params_proposal_review_period = md_Params.Fields().ByName("proposal_review_period")
+	fd_Params_proposal_quorum = md_Params.Fields().ByName("proposal_quorum")
+	fd_Params_require_proposals = md_Params.Fields().ByName("require_proposals")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -148,6 +154,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ProposalReviewPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalReviewPeriod)
+		if !f(fd_Params_proposal_review_period, value) {
+			return
+		}
+	}
+	if x.ProposalQuorum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalQuorum)
+		if !f(fd_Params_proposal_quorum, value) {
+			return
+		}
+	}
+	if x.RequireProposals != false {
+		value := protoreflect.ValueOfBool(x.RequireProposals)
+		if !f(fd_Params_require_proposals, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -167,6 +191,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.MarketAuthorities) != 0
 	case "connect.marketmap.v2.Params.admin":
 		return x.Admin != ""
+	case "connect.marketmap.v2.Params.proposal_review_period":
+		return x.ProposalReviewPeriod != uint64(0)
+	case "connect.marketmap.v2.Params.proposal_quorum":
+		return x.ProposalQuorum != uint64(0)
+	case "connect.marketmap.v2.Params.require_proposals":
+		return x.RequireProposals != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.Params"))
@@ -187,6 +217,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MarketAuthorities = nil
 	case "connect.marketmap.v2.Params.admin":
 		x.Admin = ""
+	case "connect.marketmap.v2.Params.proposal_review_period":
+		x.ProposalReviewPeriod = uint64(0)
+	case "connect.marketmap.v2.Params.proposal_quorum":
+		x.ProposalQuorum = uint64(0)
+	case "connect.marketmap.v2.Params.require_proposals":
+		x.RequireProposals = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.Params"))
@@ -212,6 +248,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "connect.marketmap.v2.Params.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
+	case "connect.marketmap.v2.Params.proposal_review_period":
+		value := x.ProposalReviewPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.Params.proposal_quorum":
+		value := x.ProposalQuorum
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.Params.require_proposals":
+		value := x.RequireProposals
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.Params"))
@@ -238,6 +283,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MarketAuthorities = *clv.list
 	case "connect.marketmap.v2.Params.admin":
 		x.Admin = value.Interface().(string)
+	case "connect.marketmap.v2.Params.proposal_review_period":
+		x.ProposalReviewPeriod = value.Uint()
+	case "connect.marketmap.v2.Params.proposal_quorum":
+		x.ProposalQuorum = value.Uint()
+	case "connect.marketmap.v2.Params.require_proposals":
+		x.RequireProposals = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.Params"))
@@ -266,6 +317,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(value)
 	case "connect.marketmap.v2.Params.admin":
 		panic(fmt.Errorf("field admin of message connect.marketmap.v2.Params is not mutable"))
+	case "connect.marketmap.v2.Params.proposal_review_period":
+		panic(fmt.Errorf("field proposal_review_period of message connect.marketmap.v2.Params is not mutable"))
+	case "connect.marketmap.v2.Params.proposal_quorum":
+		panic(fmt.Errorf("field proposal_quorum of message connect.marketmap.v2.Params is not mutable"))
+	case "connect.marketmap.v2.Params.require_proposals":
+		panic(fmt.Errorf("field require_proposals of message connect.marketmap.v2.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.Params"))
@@ -284,6 +341,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "connect.marketmap.v2.Params.admin":
 		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.Params.proposal_review_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.Params.proposal_quorum":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.Params.require_proposals":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.Params"))
@@ -363,6 +426,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ProposalReviewPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalReviewPeriod))
+		}
+		if x.ProposalQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalQuorum))
+		}
+		if x.RequireProposals {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -392,6 +464,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RequireProposals {
+			i--
+			if x.RequireProposals {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ProposalQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalQuorum))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ProposalReviewPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalReviewPeriod))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Admin) > 0 {
 			i -= len(x.Admin)
 			copy(dAtA[i:], x.Admin)
@@ -521,6 +613,64 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalReviewPeriod", wireType)
+				}
+				x.ProposalReviewPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalReviewPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalQuorum", wireType)
+				}
+				x.ProposalQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalQuorum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireProposals", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireProposals = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -581,6 +731,17 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// ProposalReviewPeriod is the number of blocks a MarketMapProposal is held
+	// for review before it is applied to the market map.
+	ProposalReviewPeriod uint64 `protobuf:"varint,3,opt,name=proposal_review_period,json=proposalReviewPeriod,proto3" json:"proposal_review_period,omitempty"`
+	// ProposalQuorum is the number of market authorities that must approve or
+	// veto a MarketMapProposal to apply or discard it before the end of its
+	// review period. If zero, a majority of the market authorities is required.
+	ProposalQuorum uint64 `protobuf:"varint,4,opt,name=proposal_quorum,json=proposalQuorum,proto3" json:"proposal_quorum,omitempty"`
+	// RequireProposals, if true, rejects MsgCreateMarkets, MsgUpdateMarkets and
+	// MsgUpsertMarkets, so that market authorities can only change the market
+	// map through a MarketMapProposal.
+	RequireProposals bool `protobuf:"varint,5,opt,name=require_proposals,json=requireProposals,proto3" json:"require_proposals,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -617,31 +778,61 @@ func (x *Params) GetAdmin() string {
 	return ""
 }
 
+func (x *Params) GetProposalReviewPeriod() uint64 {
+	if x != nil {
+		return x.ProposalReviewPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetProposalQuorum() uint64 {
+	if x != nil {
+		return x.ProposalQuorum
+	}
+	return 0
+}
+
+func (x *Params) GetRequireProposals() bool {
+	if x != nil {
+		return x.RequireProposals
+	}
+	return false
+}
+
 var File_connect_marketmap_v2_params_proto protoreflect.FileDescriptor
 
 var file_connect_marketmap_v2_params_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
 	0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x22, 0x4d, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0xcc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x14,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
-	0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x20, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c,
-	0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x22, 0xd9, 0x01, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0xcc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
+	0x76, 0x32, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56,
+	0x32, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
+	0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
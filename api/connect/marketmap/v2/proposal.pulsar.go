@@ -0,0 +1,1184 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package marketmapv2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_MarketMapProposal_3_list)(nil)
+
+type _MarketMapProposal_3_list struct {
+	list *[]*Market
+}
+
+func (x *_MarketMapProposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketMapProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MarketMapProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketMapProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketMapProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(Market)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketMapProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketMapProposal_3_list) NewElement() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketMapProposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketMapProposal_6_list)(nil)
+
+type _MarketMapProposal_6_list struct {
+	list *[]string
+}
+
+func (x *_MarketMapProposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketMapProposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketMapProposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketMapProposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketMapProposal_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketMapProposal at list field Approvals as it is not of Message kind"))
+}
+
+func (x *_MarketMapProposal_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketMapProposal_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketMapProposal_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketMapProposal_7_list)(nil)
+
+type _MarketMapProposal_7_list struct {
+	list *[]string
+}
+
+func (x *_MarketMapProposal_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MarketMapProposal_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketMapProposal_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketMapProposal_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketMapProposal_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketMapProposal at list field Vetoes as it is not of Message kind"))
+}
+
+func (x *_MarketMapProposal_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketMapProposal_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketMapProposal_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MarketMapProposal                   protoreflect.MessageDescriptor
+	fd_MarketMapProposal_id                protoreflect.FieldDescriptor
+	fd_MarketMapProposal_proposer          protoreflect.FieldDescriptor
+	fd_MarketMapProposal_markets           protoreflect.FieldDescriptor
+	fd_MarketMapProposal_submit_height     protoreflect.FieldDescriptor
+	fd_MarketMapProposal_review_end_height protoreflect.FieldDescriptor
+	fd_MarketMapProposal_approvals         protoreflect.FieldDescriptor
+	fd_MarketMapProposal_vetoes            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_marketmap_v2_proposal_proto_init()
+	md_MarketMapProposal = File_connect_marketmap_v2_proposal_proto.Messages().ByName("MarketMapProposal")
+	fd_MarketMapProposal_id = md_MarketMapProposal.Fields().ByName("id")
+	fd_MarketMapProposal_proposer = md_MarketMapProposal.Fields().ByName("proposer")
+	fd_MarketMapProposal_markets = md_MarketMapProposal.Fields().ByName("markets")
+	fd_MarketMapProposal_submit_height = md_MarketMapProposal.Fields().ByName("submit_height")
+	fd_MarketMapProposal_review_end_height = md_MarketMapProposal.Fields().ByName("review_end_height")
+	fd_MarketMapProposal_approvals = md_MarketMapProposal.Fields().ByName("approvals")
+	fd_MarketMapProposal_vetoes = md_MarketMapProposal.Fields().ByName("vetoes")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketMapProposal)(nil)
+
+type fastReflection_MarketMapProposal MarketMapProposal
+
+func (x *MarketMapProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketMapProposal)(x)
+}
+
+func (x *MarketMapProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_marketmap_v2_proposal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketMapProposal_messageType fastReflection_MarketMapProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MarketMapProposal_messageType{}
+
+type fastReflection_MarketMapProposal_messageType struct{}
+
+func (x fastReflection_MarketMapProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketMapProposal)(nil)
+}
+func (x fastReflection_MarketMapProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketMapProposal)
+}
+func (x fastReflection_MarketMapProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketMapProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMapProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketMapProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MarketMapProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketMapProposal) New() protoreflect.Message {
+	return new(fastReflection_MarketMapProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketMapProposal) Interface() protoreflect.ProtoMessage {
+	return (*MarketMapProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketMapProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MarketMapProposal_id, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_MarketMapProposal_proposer, value) {
+			return
+		}
+	}
+	if len(x.Markets) != 0 {
+		value := protoreflect.ValueOfList(&_MarketMapProposal_3_list{list: &x.Markets})
+		if !f(fd_MarketMapProposal_markets, value) {
+			return
+		}
+	}
+	if x.SubmitHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubmitHeight)
+		if !f(fd_MarketMapProposal_submit_height, value) {
+			return
+		}
+	}
+	if x.ReviewEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReviewEndHeight)
+		if !f(fd_MarketMapProposal_review_end_height, value) {
+			return
+		}
+	}
+	if len(x.Approvals) != 0 {
+		value := protoreflect.ValueOfList(&_MarketMapProposal_6_list{list: &x.Approvals})
+		if !f(fd_MarketMapProposal_approvals, value) {
+			return
+		}
+	}
+	if len(x.Vetoes) != 0 {
+		value := protoreflect.ValueOfList(&_MarketMapProposal_7_list{list: &x.Vetoes})
+		if !f(fd_MarketMapProposal_vetoes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketMapProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapProposal.id":
+		return x.Id != uint64(0)
+	case "connect.marketmap.v2.MarketMapProposal.proposer":
+		return x.Proposer != ""
+	case "connect.marketmap.v2.MarketMapProposal.markets":
+		return len(x.Markets) != 0
+	case "connect.marketmap.v2.MarketMapProposal.submit_height":
+		return x.SubmitHeight != uint64(0)
+	case "connect.marketmap.v2.MarketMapProposal.review_end_height":
+		return x.ReviewEndHeight != uint64(0)
+	case "connect.marketmap.v2.MarketMapProposal.approvals":
+		return len(x.Approvals) != 0
+	case "connect.marketmap.v2.MarketMapProposal.vetoes":
+		return len(x.Vetoes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapProposal"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapProposal.id":
+		x.Id = uint64(0)
+	case "connect.marketmap.v2.MarketMapProposal.proposer":
+		x.Proposer = ""
+	case "connect.marketmap.v2.MarketMapProposal.markets":
+		x.Markets = nil
+	case "connect.marketmap.v2.MarketMapProposal.submit_height":
+		x.SubmitHeight = uint64(0)
+	case "connect.marketmap.v2.MarketMapProposal.review_end_height":
+		x.ReviewEndHeight = uint64(0)
+	case "connect.marketmap.v2.MarketMapProposal.approvals":
+		x.Approvals = nil
+	case "connect.marketmap.v2.MarketMapProposal.vetoes":
+		x.Vetoes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapProposal"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketMapProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.marketmap.v2.MarketMapProposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.MarketMapProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "connect.marketmap.v2.MarketMapProposal.markets":
+		if len(x.Markets) == 0 {
+			return protoreflect.ValueOfList(&_MarketMapProposal_3_list{})
+		}
+		listValue := &_MarketMapProposal_3_list{list: &x.Markets}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketMapProposal.submit_height":
+		value := x.SubmitHeight
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.MarketMapProposal.review_end_height":
+		value := x.ReviewEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "connect.marketmap.v2.MarketMapProposal.approvals":
+		if len(x.Approvals) == 0 {
+			return protoreflect.ValueOfList(&_MarketMapProposal_6_list{})
+		}
+		listValue := &_MarketMapProposal_6_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(listValue)
+	case "connect.marketmap.v2.MarketMapProposal.vetoes":
+		if len(x.Vetoes) == 0 {
+			return protoreflect.ValueOfList(&_MarketMapProposal_7_list{})
+		}
+		listValue := &_MarketMapProposal_7_list{list: &x.Vetoes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapProposal"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapProposal.id":
+		x.Id = value.Uint()
+	case "connect.marketmap.v2.MarketMapProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "connect.marketmap.v2.MarketMapProposal.markets":
+		lv := value.List()
+		clv := lv.(*_MarketMapProposal_3_list)
+		x.Markets = *clv.list
+	case "connect.marketmap.v2.MarketMapProposal.submit_height":
+		x.SubmitHeight = value.Uint()
+	case "connect.marketmap.v2.MarketMapProposal.review_end_height":
+		x.ReviewEndHeight = value.Uint()
+	case "connect.marketmap.v2.MarketMapProposal.approvals":
+		lv := value.List()
+		clv := lv.(*_MarketMapProposal_6_list)
+		x.Approvals = *clv.list
+	case "connect.marketmap.v2.MarketMapProposal.vetoes":
+		lv := value.List()
+		clv := lv.(*_MarketMapProposal_7_list)
+		x.Vetoes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapProposal"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapProposal.markets":
+		if x.Markets == nil {
+			x.Markets = []*Market{}
+		}
+		value := &_MarketMapProposal_3_list{list: &x.Markets}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketMapProposal.approvals":
+		if x.Approvals == nil {
+			x.Approvals = []string{}
+		}
+		value := &_MarketMapProposal_6_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketMapProposal.vetoes":
+		if x.Vetoes == nil {
+			x.Vetoes = []string{}
+		}
+		value := &_MarketMapProposal_7_list{list: &x.Vetoes}
+		return protoreflect.ValueOfList(value)
+	case "connect.marketmap.v2.MarketMapProposal.id":
+		panic(fmt.Errorf("field id of message connect.marketmap.v2.MarketMapProposal is not mutable"))
+	case "connect.marketmap.v2.MarketMapProposal.proposer":
+		panic(fmt.Errorf("field proposer of message connect.marketmap.v2.MarketMapProposal is not mutable"))
+	case "connect.marketmap.v2.MarketMapProposal.submit_height":
+		panic(fmt.Errorf("field submit_height of message connect.marketmap.v2.MarketMapProposal is not mutable"))
+	case "connect.marketmap.v2.MarketMapProposal.review_end_height":
+		panic(fmt.Errorf("field review_end_height of message connect.marketmap.v2.MarketMapProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapProposal"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketMapProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.marketmap.v2.MarketMapProposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.MarketMapProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "connect.marketmap.v2.MarketMapProposal.markets":
+		list := []*Market{}
+		return protoreflect.ValueOfList(&_MarketMapProposal_3_list{list: &list})
+	case "connect.marketmap.v2.MarketMapProposal.submit_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.MarketMapProposal.review_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.marketmap.v2.MarketMapProposal.approvals":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketMapProposal_6_list{list: &list})
+	case "connect.marketmap.v2.MarketMapProposal.vetoes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketMapProposal_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.marketmap.v2.MarketMapProposal"))
+		}
+		panic(fmt.Errorf("message connect.marketmap.v2.MarketMapProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketMapProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.marketmap.v2.MarketMapProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketMapProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMapProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketMapProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketMapProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketMapProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Markets) > 0 {
+			for _, e := range x.Markets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SubmitHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmitHeight))
+		}
+		if x.ReviewEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReviewEndHeight))
+		}
+		if len(x.Approvals) > 0 {
+			for _, s := range x.Approvals {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Vetoes) > 0 {
+			for _, s := range x.Vetoes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMapProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Vetoes) > 0 {
+			for iNdEx := len(x.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Vetoes[iNdEx])
+				copy(dAtA[i:], x.Vetoes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vetoes[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Approvals) > 0 {
+			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Approvals[iNdEx])
+				copy(dAtA[i:], x.Approvals[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approvals[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.ReviewEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReviewEndHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SubmitHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Markets) > 0 {
+			for iNdEx := len(x.Markets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Markets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMapProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMapProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Markets = append(x.Markets, &Market{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Markets[len(x.Markets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+				}
+				x.SubmitHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmitHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewEndHeight", wireType)
+				}
+				x.ReviewEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReviewEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Approvals = append(x.Approvals, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vetoes = append(x.Vetoes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/marketmap/v2/proposal.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MarketMapProposal is a set of market creates and updates submitted by a
+// market authority that is held for review before it is applied to the market
+// map. During the review period, the admin or a quorum of market authorities
+// can approve the proposal, applying it immediately, or veto it, discarding
+// it. If neither happens, the proposal is applied at the end of the review
+// period.
+type MarketMapProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Proposer is the market authority that submitted the proposal.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// Markets is the list of markets to upsert (create if they do not exist,
+	// update otherwise) when the proposal is applied.
+	Markets []*Market `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`
+	// SubmitHeight is the block height at which the proposal was submitted.
+	SubmitHeight uint64 `protobuf:"varint,4,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// ReviewEndHeight is the block height at which the proposal is applied if
+	// it has not been approved or vetoed before.
+	ReviewEndHeight uint64 `protobuf:"varint,5,opt,name=review_end_height,json=reviewEndHeight,proto3" json:"review_end_height,omitempty"`
+	// Approvals is the list of market authorities that approved the proposal.
+	Approvals []string `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// Vetoes is the list of market authorities that vetoed the proposal.
+	Vetoes []string `protobuf:"bytes,7,rep,name=vetoes,proto3" json:"vetoes,omitempty"`
+}
+
+func (x *MarketMapProposal) Reset() {
+	*x = MarketMapProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_marketmap_v2_proposal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketMapProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketMapProposal) ProtoMessage() {}
+
+// Deprecated: Use MarketMapProposal.ProtoReflect.Descriptor instead.
+func (*MarketMapProposal) Descriptor() ([]byte, []int) {
+	return file_connect_marketmap_v2_proposal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarketMapProposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarketMapProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *MarketMapProposal) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *MarketMapProposal) GetSubmitHeight() uint64 {
+	if x != nil {
+		return x.SubmitHeight
+	}
+	return 0
+}
+
+func (x *MarketMapProposal) GetReviewEndHeight() uint64 {
+	if x != nil {
+		return x.ReviewEndHeight
+	}
+	return 0
+}
+
+func (x *MarketMapProposal) GetApprovals() []string {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *MarketMapProposal) GetVetoes() []string {
+	if x != nil {
+		return x.Vetoes
+	}
+	return nil
+}
+
+var File_connect_marketmap_v2_proposal_proto protoreflect.FileDescriptor
+
+var file_connect_marketmap_v2_proposal_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x21, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d,
+	0x61, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x45, 0x6e, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x74, 0x6f, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x74, 0x6f, 0x65, 0x73, 0x42, 0xce, 0x01, 0x0a, 0x18,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x32, 0x42, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x32,
+	0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43,
+	0x4d, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32,
+	0xe2, 0x02, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_connect_marketmap_v2_proposal_proto_rawDescOnce sync.Once
+	file_connect_marketmap_v2_proposal_proto_rawDescData = file_connect_marketmap_v2_proposal_proto_rawDesc
+)
+
+func file_connect_marketmap_v2_proposal_proto_rawDescGZIP() []byte {
+	file_connect_marketmap_v2_proposal_proto_rawDescOnce.Do(func() {
+		file_connect_marketmap_v2_proposal_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_marketmap_v2_proposal_proto_rawDescData)
+	})
+	return file_connect_marketmap_v2_proposal_proto_rawDescData
+}
+
+var file_connect_marketmap_v2_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_connect_marketmap_v2_proposal_proto_goTypes = []interface{}{
+	(*MarketMapProposal)(nil), // 0: connect.marketmap.v2.MarketMapProposal
+	(*Market)(nil),            // 1: connect.marketmap.v2.Market
+}
+var file_connect_marketmap_v2_proposal_proto_depIdxs = []int32{
+	1, // 0: connect.marketmap.v2.MarketMapProposal.markets:type_name -> connect.marketmap.v2.Market
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_connect_marketmap_v2_proposal_proto_init() }
+func file_connect_marketmap_v2_proposal_proto_init() {
+	if File_connect_marketmap_v2_proposal_proto != nil {
+		return
+	}
+	file_connect_marketmap_v2_market_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_connect_marketmap_v2_proposal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketMapProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_connect_marketmap_v2_proposal_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_connect_marketmap_v2_proposal_proto_goTypes,
+		DependencyIndexes: file_connect_marketmap_v2_proposal_proto_depIdxs,
+		MessageInfos:      file_connect_marketmap_v2_proposal_proto_msgTypes,
+	}.Build()
+	File_connect_marketmap_v2_proposal_proto = out.File
+	file_connect_marketmap_v2_proposal_proto_rawDesc = nil
+	file_connect_marketmap_v2_proposal_proto_goTypes = nil
+	file_connect_marketmap_v2_proposal_proto_depIdxs = nil
+}
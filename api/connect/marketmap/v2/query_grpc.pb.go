@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_MarketMap_FullMethodName   = "/connect.marketmap.v2.Query/MarketMap"
-	Query_Markets_FullMethodName     = "/connect.marketmap.v2.Query/Markets"
-	Query_Market_FullMethodName      = "/connect.marketmap.v2.Query/Market"
-	Query_LastUpdated_FullMethodName = "/connect.marketmap.v2.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/connect.marketmap.v2.Query/Params"
+	Query_MarketMap_FullMethodName             = "/connect.marketmap.v2.Query/MarketMap"
+	Query_Markets_FullMethodName               = "/connect.marketmap.v2.Query/Markets"
+	Query_Market_FullMethodName                = "/connect.marketmap.v2.Query/Market"
+	Query_LastUpdated_FullMethodName           = "/connect.marketmap.v2.Query/LastUpdated"
+	Query_Params_FullMethodName                = "/connect.marketmap.v2.Query/Params"
+	Query_PendingMarkets_FullMethodName        = "/connect.marketmap.v2.Query/PendingMarkets"
+	Query_MarketMapProposals_FullMethodName    = "/connect.marketmap.v2.Query/MarketMapProposals"
+	Query_MarketMapProposal_FullMethodName     = "/connect.marketmap.v2.Query/MarketMapProposal"
+	Query_SimulateMarketUpdates_FullMethodName = "/connect.marketmap.v2.Query/SimulateMarketUpdates"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +50,19 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingMarkets returns the market creates and updates that are scheduled
+	// to be applied at a future block height or time.
+	PendingMarkets(ctx context.Context, in *PendingMarketsRequest, opts ...grpc.CallOption) (*PendingMarketsResponse, error)
+	// MarketMapProposals returns all market map proposals under review.
+	MarketMapProposals(ctx context.Context, in *MarketMapProposalsRequest, opts ...grpc.CallOption) (*MarketMapProposalsResponse, error)
+	// MarketMapProposal returns the market map proposal under review with the
+	// given id.
+	MarketMapProposal(ctx context.Context, in *MarketMapProposalRequest, opts ...grpc.CallOption) (*MarketMapProposalResponse, error)
+	// SimulateMarketUpdates performs a dry run of upserting the given markets
+	// against the current state. It returns the diff of each market against
+	// the current market map and every validation error the upsert would
+	// encounter, without modifying state.
+	SimulateMarketUpdates(ctx context.Context, in *SimulateMarketUpdatesRequest, opts ...grpc.CallOption) (*SimulateMarketUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +123,46 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) PendingMarkets(ctx context.Context, in *PendingMarketsRequest, opts ...grpc.CallOption) (*PendingMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PendingMarketsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketMapProposals(ctx context.Context, in *MarketMapProposalsRequest, opts ...grpc.CallOption) (*MarketMapProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketMapProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_MarketMapProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketMapProposal(ctx context.Context, in *MarketMapProposalRequest, opts ...grpc.CallOption) (*MarketMapProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, Query_MarketMapProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateMarketUpdates(ctx context.Context, in *SimulateMarketUpdatesRequest, opts ...grpc.CallOption) (*SimulateMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +183,19 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingMarkets returns the market creates and updates that are scheduled
+	// to be applied at a future block height or time.
+	PendingMarkets(context.Context, *PendingMarketsRequest) (*PendingMarketsResponse, error)
+	// MarketMapProposals returns all market map proposals under review.
+	MarketMapProposals(context.Context, *MarketMapProposalsRequest) (*MarketMapProposalsResponse, error)
+	// MarketMapProposal returns the market map proposal under review with the
+	// given id.
+	MarketMapProposal(context.Context, *MarketMapProposalRequest) (*MarketMapProposalResponse, error)
+	// SimulateMarketUpdates performs a dry run of upserting the given markets
+	// against the current state. It returns the diff of each market against
+	// the current market map and every validation error the upsert would
+	// encounter, without modifying state.
+	SimulateMarketUpdates(context.Context, *SimulateMarketUpdatesRequest) (*SimulateMarketUpdatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +221,18 @@ func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingMarkets(context.Context, *PendingMarketsRequest) (*PendingMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMarkets not implemented")
+}
+func (UnimplementedQueryServer) MarketMapProposals(context.Context, *MarketMapProposalsRequest) (*MarketMapProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapProposals not implemented")
+}
+func (UnimplementedQueryServer) MarketMapProposal(context.Context, *MarketMapProposalRequest) (*MarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapProposal not implemented")
+}
+func (UnimplementedQueryServer) SimulateMarketUpdates(context.Context, *SimulateMarketUpdatesRequest) (*SimulateMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMarketUpdates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +344,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMarkets(ctx, req.(*PendingMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketMapProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketMapProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapProposals(ctx, req.(*MarketMapProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketMapProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapProposal(ctx, req.(*MarketMapProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMarketUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMarketUpdates(ctx, req.(*SimulateMarketUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +443,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingMarkets",
+			Handler:    _Query_PendingMarkets_Handler,
+		},
+		{
+			MethodName: "MarketMapProposals",
+			Handler:    _Query_MarketMapProposals_Handler,
+		},
+		{
+			MethodName: "MarketMapProposal",
+			Handler:    _Query_MarketMapProposal_Handler,
+		},
+		{
+			MethodName: "SimulateMarketUpdates",
+			Handler:    _Query_SimulateMarketUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/marketmap/v2/query.proto",
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/CreateMarkets"
-	Msg_UpdateMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/UpdateMarkets"
-	Msg_UpdateParams_FullMethodName            = "/connect.marketmap.v2.Msg/UpdateParams"
-	Msg_RemoveMarketAuthorities_FullMethodName = "/connect.marketmap.v2.Msg/RemoveMarketAuthorities"
-	Msg_UpsertMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/UpsertMarkets"
-	Msg_RemoveMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/RemoveMarkets"
+	Msg_CreateMarkets_FullMethodName            = "/connect.marketmap.v2.Msg/CreateMarkets"
+	Msg_UpdateMarkets_FullMethodName            = "/connect.marketmap.v2.Msg/UpdateMarkets"
+	Msg_UpdateParams_FullMethodName             = "/connect.marketmap.v2.Msg/UpdateParams"
+	Msg_RemoveMarketAuthorities_FullMethodName  = "/connect.marketmap.v2.Msg/RemoveMarketAuthorities"
+	Msg_UpsertMarkets_FullMethodName            = "/connect.marketmap.v2.Msg/UpsertMarkets"
+	Msg_RemoveMarkets_FullMethodName            = "/connect.marketmap.v2.Msg/RemoveMarkets"
+	Msg_EnableMarkets_FullMethodName            = "/connect.marketmap.v2.Msg/EnableMarkets"
+	Msg_DisableMarkets_FullMethodName           = "/connect.marketmap.v2.Msg/DisableMarkets"
+	Msg_CancelPendingMarkets_FullMethodName     = "/connect.marketmap.v2.Msg/CancelPendingMarkets"
+	Msg_SubmitMarketMapProposal_FullMethodName  = "/connect.marketmap.v2.Msg/SubmitMarketMapProposal"
+	Msg_ApproveMarketMapProposal_FullMethodName = "/connect.marketmap.v2.Msg/ApproveMarketMapProposal"
+	Msg_VetoMarketMapProposal_FullMethodName    = "/connect.marketmap.v2.Msg/VetoMarketMapProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +57,27 @@ type MsgClient interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// EnableMarkets enables the given markets in the marketmap. The markets
+	// must exist, and any markets they are normalized by must be enabled.
+	EnableMarkets(ctx context.Context, in *MsgEnableMarkets, opts ...grpc.CallOption) (*MsgEnableMarketsResponse, error)
+	// DisableMarkets disables the given markets in the marketmap. The markets
+	// must exist, and must not be used to normalize any enabled market.
+	DisableMarkets(ctx context.Context, in *MsgDisableMarkets, opts ...grpc.CallOption) (*MsgDisableMarketsResponse, error)
+	// CancelPendingMarkets cancels the scheduled activations of the given
+	// markets. The markets must have a pending activation.
+	CancelPendingMarkets(ctx context.Context, in *MsgCancelPendingMarkets, opts ...grpc.CallOption) (*MsgCancelPendingMarketsResponse, error)
+	// SubmitMarketMapProposal submits a set of market upserts to be held for
+	// review before being applied to the marketmap. The signer must be a market
+	// authority.
+	SubmitMarketMapProposal(ctx context.Context, in *MsgSubmitMarketMapProposal, opts ...grpc.CallOption) (*MsgSubmitMarketMapProposalResponse, error)
+	// ApproveMarketMapProposal approves a proposal under review. The signer must
+	// be the admin or a market authority. The proposal is applied once it is
+	// approved by the admin or a quorum of market authorities.
+	ApproveMarketMapProposal(ctx context.Context, in *MsgApproveMarketMapProposal, opts ...grpc.CallOption) (*MsgApproveMarketMapProposalResponse, error)
+	// VetoMarketMapProposal vetoes a proposal under review. The signer must be
+	// the admin or a market authority. The proposal is discarded once it is
+	// vetoed by the admin or a quorum of market authorities.
+	VetoMarketMapProposal(ctx context.Context, in *MsgVetoMarketMapProposal, opts ...grpc.CallOption) (*MsgVetoMarketMapProposalResponse, error)
 }
 
 type msgClient struct {
@@ -121,6 +148,66 @@ func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) EnableMarkets(ctx context.Context, in *MsgEnableMarkets, opts ...grpc.CallOption) (*MsgEnableMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgEnableMarketsResponse)
+	err := c.cc.Invoke(ctx, Msg_EnableMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableMarkets(ctx context.Context, in *MsgDisableMarkets, opts ...grpc.CallOption) (*MsgDisableMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDisableMarketsResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPendingMarkets(ctx context.Context, in *MsgCancelPendingMarkets, opts ...grpc.CallOption) (*MsgCancelPendingMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelPendingMarketsResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPendingMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitMarketMapProposal(ctx context.Context, in *MsgSubmitMarketMapProposal, opts ...grpc.CallOption) (*MsgSubmitMarketMapProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitMarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitMarketMapProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMarketMapProposal(ctx context.Context, in *MsgApproveMarketMapProposal, opts ...grpc.CallOption) (*MsgApproveMarketMapProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApproveMarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveMarketMapProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoMarketMapProposal(ctx context.Context, in *MsgVetoMarketMapProposal, opts ...grpc.CallOption) (*MsgVetoMarketMapProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgVetoMarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoMarketMapProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -145,6 +232,27 @@ type MsgServer interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// EnableMarkets enables the given markets in the marketmap. The markets
+	// must exist, and any markets they are normalized by must be enabled.
+	EnableMarkets(context.Context, *MsgEnableMarkets) (*MsgEnableMarketsResponse, error)
+	// DisableMarkets disables the given markets in the marketmap. The markets
+	// must exist, and must not be used to normalize any enabled market.
+	DisableMarkets(context.Context, *MsgDisableMarkets) (*MsgDisableMarketsResponse, error)
+	// CancelPendingMarkets cancels the scheduled activations of the given
+	// markets. The markets must have a pending activation.
+	CancelPendingMarkets(context.Context, *MsgCancelPendingMarkets) (*MsgCancelPendingMarketsResponse, error)
+	// SubmitMarketMapProposal submits a set of market upserts to be held for
+	// review before being applied to the marketmap. The signer must be a market
+	// authority.
+	SubmitMarketMapProposal(context.Context, *MsgSubmitMarketMapProposal) (*MsgSubmitMarketMapProposalResponse, error)
+	// ApproveMarketMapProposal approves a proposal under review. The signer must
+	// be the admin or a market authority. The proposal is applied once it is
+	// approved by the admin or a quorum of market authorities.
+	ApproveMarketMapProposal(context.Context, *MsgApproveMarketMapProposal) (*MsgApproveMarketMapProposalResponse, error)
+	// VetoMarketMapProposal vetoes a proposal under review. The signer must be
+	// the admin or a market authority. The proposal is discarded once it is
+	// vetoed by the admin or a quorum of market authorities.
+	VetoMarketMapProposal(context.Context, *MsgVetoMarketMapProposal) (*MsgVetoMarketMapProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +281,24 @@ func (UnimplementedMsgServer) UpsertMarkets(context.Context, *MsgUpsertMarkets)
 func (UnimplementedMsgServer) RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
 }
+func (UnimplementedMsgServer) EnableMarkets(context.Context, *MsgEnableMarkets) (*MsgEnableMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMarkets not implemented")
+}
+func (UnimplementedMsgServer) DisableMarkets(context.Context, *MsgDisableMarkets) (*MsgDisableMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMarkets not implemented")
+}
+func (UnimplementedMsgServer) CancelPendingMarkets(context.Context, *MsgCancelPendingMarkets) (*MsgCancelPendingMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingMarkets not implemented")
+}
+func (UnimplementedMsgServer) SubmitMarketMapProposal(context.Context, *MsgSubmitMarketMapProposal) (*MsgSubmitMarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMarketMapProposal not implemented")
+}
+func (UnimplementedMsgServer) ApproveMarketMapProposal(context.Context, *MsgApproveMarketMapProposal) (*MsgApproveMarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMarketMapProposal not implemented")
+}
+func (UnimplementedMsgServer) VetoMarketMapProposal(context.Context, *MsgVetoMarketMapProposal) (*MsgVetoMarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoMarketMapProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -302,6 +428,114 @@ func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EnableMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EnableMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableMarkets(ctx, req.(*MsgEnableMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableMarkets(ctx, req.(*MsgDisableMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPendingMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPendingMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingMarkets(ctx, req.(*MsgCancelPendingMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMarketMapProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitMarketMapProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMarketMapProposal(ctx, req.(*MsgSubmitMarketMapProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMarketMapProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveMarketMapProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMarketMapProposal(ctx, req.(*MsgApproveMarketMapProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoMarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoMarketMapProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoMarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoMarketMapProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoMarketMapProposal(ctx, req.(*MsgVetoMarketMapProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,6 +567,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarkets",
 			Handler:    _Msg_RemoveMarkets_Handler,
 		},
+		{
+			MethodName: "EnableMarkets",
+			Handler:    _Msg_EnableMarkets_Handler,
+		},
+		{
+			MethodName: "DisableMarkets",
+			Handler:    _Msg_DisableMarkets_Handler,
+		},
+		{
+			MethodName: "CancelPendingMarkets",
+			Handler:    _Msg_CancelPendingMarkets_Handler,
+		},
+		{
+			MethodName: "SubmitMarketMapProposal",
+			Handler:    _Msg_SubmitMarketMapProposal_Handler,
+		},
+		{
+			MethodName: "ApproveMarketMapProposal",
+			Handler:    _Msg_ApproveMarketMapProposal_Handler,
+		},
+		{
+			MethodName: "VetoMarketMapProposal",
+			Handler:    _Msg_VetoMarketMapProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/marketmap/v2/tx.proto",
@@ -0,0 +1,1052 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_max_price_history      protoreflect.FieldDescriptor
+	fd_Params_max_price_change_bps   protoreflect.FieldDescriptor
+	fd_Params_circuit_breaker_action protoreflect.FieldDescriptor
+	fd_Params_max_staleness_blocks   protoreflect.FieldDescriptor
+	fd_Params_max_staleness_duration protoreflect.FieldDescriptor
+	fd_Params_performance_window     protoreflect.FieldDescriptor
+	fd_Params_min_performance_bps    protoreflect.FieldDescriptor
+	fd_Params_max_deviation_bps      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_params_proto_init()
+	md_Params = File_connect_oracle_v2_params_proto.Messages().ByName("Params")
+	fd_Params_max_price_history = md_Params.Fields().ByName("max_price_history")
+	fd_Params_max_price_change_bps = md_Params.Fields().ByName("max_price_change_bps")
+	fd_Params_circuit_breaker_action = md_Params.Fields().ByName("circuit_breaker_action")
+	fd_Params_max_staleness_blocks = md_Params.Fields().ByName("max_staleness_blocks")
+	fd_Params_max_staleness_duration = md_Params.Fields().ByName("max_staleness_duration")
+	fd_Params_performance_window = md_Params.Fields().ByName("performance_window")
+	fd_Params_min_performance_bps = md_Params.Fields().ByName("min_performance_bps")
+	fd_Params_max_deviation_bps = md_Params.Fields().ByName("max_deviation_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxPriceHistory != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceHistory)
+		if !f(fd_Params_max_price_history, value) {
+			return
+		}
+	}
+	if x.MaxPriceChangeBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPriceChangeBps)
+		if !f(fd_Params_max_price_change_bps, value) {
+			return
+		}
+	}
+	if x.CircuitBreakerAction != "" {
+		value := protoreflect.ValueOfString(x.CircuitBreakerAction)
+		if !f(fd_Params_circuit_breaker_action, value) {
+			return
+		}
+	}
+	if x.MaxStalenessBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxStalenessBlocks)
+		if !f(fd_Params_max_staleness_blocks, value) {
+			return
+		}
+	}
+	if x.MaxStalenessDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxStalenessDuration.ProtoReflect())
+		if !f(fd_Params_max_staleness_duration, value) {
+			return
+		}
+	}
+	if x.PerformanceWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerformanceWindow)
+		if !f(fd_Params_performance_window, value) {
+			return
+		}
+	}
+	if x.MinPerformanceBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinPerformanceBps)
+		if !f(fd_Params_min_performance_bps, value) {
+			return
+		}
+	}
+	if x.MaxDeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxDeviationBps)
+		if !f(fd_Params_max_deviation_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.max_price_history":
+		return x.MaxPriceHistory != uint64(0)
+	case "connect.oracle.v2.Params.max_price_change_bps":
+		return x.MaxPriceChangeBps != uint64(0)
+	case "connect.oracle.v2.Params.circuit_breaker_action":
+		return x.CircuitBreakerAction != ""
+	case "connect.oracle.v2.Params.max_staleness_blocks":
+		return x.MaxStalenessBlocks != uint64(0)
+	case "connect.oracle.v2.Params.max_staleness_duration":
+		return x.MaxStalenessDuration != nil
+	case "connect.oracle.v2.Params.performance_window":
+		return x.PerformanceWindow != uint64(0)
+	case "connect.oracle.v2.Params.min_performance_bps":
+		return x.MinPerformanceBps != uint64(0)
+	case "connect.oracle.v2.Params.max_deviation_bps":
+		return x.MaxDeviationBps != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.max_price_history":
+		x.MaxPriceHistory = uint64(0)
+	case "connect.oracle.v2.Params.max_price_change_bps":
+		x.MaxPriceChangeBps = uint64(0)
+	case "connect.oracle.v2.Params.circuit_breaker_action":
+		x.CircuitBreakerAction = ""
+	case "connect.oracle.v2.Params.max_staleness_blocks":
+		x.MaxStalenessBlocks = uint64(0)
+	case "connect.oracle.v2.Params.max_staleness_duration":
+		x.MaxStalenessDuration = nil
+	case "connect.oracle.v2.Params.performance_window":
+		x.PerformanceWindow = uint64(0)
+	case "connect.oracle.v2.Params.min_performance_bps":
+		x.MinPerformanceBps = uint64(0)
+	case "connect.oracle.v2.Params.max_deviation_bps":
+		x.MaxDeviationBps = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.Params.max_price_history":
+		value := x.MaxPriceHistory
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.max_price_change_bps":
+		value := x.MaxPriceChangeBps
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.circuit_breaker_action":
+		value := x.CircuitBreakerAction
+		return protoreflect.ValueOfString(value)
+	case "connect.oracle.v2.Params.max_staleness_blocks":
+		value := x.MaxStalenessBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.max_staleness_duration":
+		value := x.MaxStalenessDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "connect.oracle.v2.Params.performance_window":
+		value := x.PerformanceWindow
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.min_performance_bps":
+		value := x.MinPerformanceBps
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.Params.max_deviation_bps":
+		value := x.MaxDeviationBps
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.max_price_history":
+		x.MaxPriceHistory = value.Uint()
+	case "connect.oracle.v2.Params.max_price_change_bps":
+		x.MaxPriceChangeBps = value.Uint()
+	case "connect.oracle.v2.Params.circuit_breaker_action":
+		x.CircuitBreakerAction = value.Interface().(string)
+	case "connect.oracle.v2.Params.max_staleness_blocks":
+		x.MaxStalenessBlocks = value.Uint()
+	case "connect.oracle.v2.Params.max_staleness_duration":
+		x.MaxStalenessDuration = value.Message().Interface().(*durationpb.Duration)
+	case "connect.oracle.v2.Params.performance_window":
+		x.PerformanceWindow = value.Uint()
+	case "connect.oracle.v2.Params.min_performance_bps":
+		x.MinPerformanceBps = value.Uint()
+	case "connect.oracle.v2.Params.max_deviation_bps":
+		x.MaxDeviationBps = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.max_staleness_duration":
+		if x.MaxStalenessDuration == nil {
+			x.MaxStalenessDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxStalenessDuration.ProtoReflect())
+	case "connect.oracle.v2.Params.max_price_history":
+		panic(fmt.Errorf("field max_price_history of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.max_price_change_bps":
+		panic(fmt.Errorf("field max_price_change_bps of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.circuit_breaker_action":
+		panic(fmt.Errorf("field circuit_breaker_action of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.max_staleness_blocks":
+		panic(fmt.Errorf("field max_staleness_blocks of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.performance_window":
+		panic(fmt.Errorf("field performance_window of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.min_performance_bps":
+		panic(fmt.Errorf("field min_performance_bps of message connect.oracle.v2.Params is not mutable"))
+	case "connect.oracle.v2.Params.max_deviation_bps":
+		panic(fmt.Errorf("field max_deviation_bps of message connect.oracle.v2.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.Params.max_price_history":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.max_price_change_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.circuit_breaker_action":
+		return protoreflect.ValueOfString("")
+	case "connect.oracle.v2.Params.max_staleness_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.max_staleness_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "connect.oracle.v2.Params.performance_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.min_performance_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.Params.max_deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.Params"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxPriceHistory != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceHistory))
+		}
+		if x.MaxPriceChangeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceChangeBps))
+		}
+		l = len(x.CircuitBreakerAction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxStalenessBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxStalenessBlocks))
+		}
+		if x.MaxStalenessDuration != nil {
+			l = options.Size(x.MaxStalenessDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PerformanceWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerformanceWindow))
+		}
+		if x.MinPerformanceBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinPerformanceBps))
+		}
+		if x.MaxDeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxDeviationBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxDeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxDeviationBps))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MinPerformanceBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinPerformanceBps))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.PerformanceWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerformanceWindow))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxStalenessDuration != nil {
+			encoded, err := options.Marshal(x.MaxStalenessDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.MaxStalenessBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxStalenessBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.CircuitBreakerAction) > 0 {
+			i -= len(x.CircuitBreakerAction)
+			copy(dAtA[i:], x.CircuitBreakerAction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CircuitBreakerAction)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxPriceChangeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceChangeBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxPriceHistory != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceHistory))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceHistory", wireType)
+				}
+				x.MaxPriceHistory = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceHistory |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeBps", wireType)
+				}
+				x.MaxPriceChangeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceChangeBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitBreakerAction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessBlocks", wireType)
+				}
+				x.MaxStalenessBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxStalenessBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxStalenessDuration == nil {
+					x.MaxStalenessDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxStalenessDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+				}
+				x.PerformanceWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerformanceWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPerformanceBps", wireType)
+				}
+				x.MinPerformanceBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinPerformanceBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBps", wireType)
+				}
+				x.MaxDeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxDeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/oracle/v2/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MaxPriceHistory is the maximum number of historical QuotePrices retained
+	// per currency-pair. The history is used to serve price history and TWAP
+	// queries. If zero, no price history is retained.
+	MaxPriceHistory uint64 `protobuf:"varint,1,opt,name=max_price_history,json=maxPriceHistory,proto3" json:"max_price_history,omitempty"`
+	// MaxPriceChangeBps is the maximum change, in basis points of the previous
+	// price, that a currency-pair's price may move in a single block. Markets
+	// may override this value in their ticker metadata. If zero, the circuit
+	// breaker is disabled.
+	MaxPriceChangeBps uint64 `protobuf:"varint,2,opt,name=max_price_change_bps,json=maxPriceChangeBps,proto3" json:"max_price_change_bps,omitempty"`
+	// CircuitBreakerAction is the action taken when a price update exceeds
+	// MaxPriceChangeBps. It is one of "clamp" (the price is clamped to the
+	// maximum change), "skip" (the update is skipped), or "halt" (the update is
+	// skipped and the currency-pair is halted until it is resumed by the
+	// authority).
+	CircuitBreakerAction string `protobuf:"bytes,3,opt,name=circuit_breaker_action,json=circuitBreakerAction,proto3" json:"circuit_breaker_action,omitempty"`
+	// MaxStalenessBlocks is the maximum number of blocks since a currency-pair's
+	// latest price update before its price is considered stale. If zero, prices
+	// are not considered stale based on their block height.
+	MaxStalenessBlocks uint64 `protobuf:"varint,4,opt,name=max_staleness_blocks,json=maxStalenessBlocks,proto3" json:"max_staleness_blocks,omitempty"`
+	// MaxStalenessDuration is the maximum time since a currency-pair's latest
+	// price update before its price is considered stale. If zero, prices are not
+	// considered stale based on their block timestamp.
+	MaxStalenessDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=max_staleness_duration,json=maxStalenessDuration,proto3" json:"max_staleness_duration,omitempty"`
+	// PerformanceWindow is the number of blocks over which the prices reported
+	// by each validator are tracked. If zero, validator performance is not
+	// tracked.
+	PerformanceWindow uint64 `protobuf:"varint,6,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty"`
+	// MinPerformanceBps is the minimum share, in basis points, of currency-pairs
+	// that a validator must report a non-deviating price for over a full
+	// performance window. Validators below the threshold are reported to the
+	// module's slashing hooks.
+	MinPerformanceBps uint64 `protobuf:"varint,7,opt,name=min_performance_bps,json=minPerformanceBps,proto3" json:"min_performance_bps,omitempty"`
+	// MaxDeviationBps is the maximum deviation, in basis points of the final
+	// price, of a validator's reported price before it is considered deviating.
+	// If zero, reported prices are never considered deviating.
+	MaxDeviationBps uint64 `protobuf:"varint,8,opt,name=max_deviation_bps,json=maxDeviationBps,proto3" json:"max_deviation_bps,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMaxPriceHistory() uint64 {
+	if x != nil {
+		return x.MaxPriceHistory
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPriceChangeBps() uint64 {
+	if x != nil {
+		return x.MaxPriceChangeBps
+	}
+	return 0
+}
+
+func (x *Params) GetCircuitBreakerAction() string {
+	if x != nil {
+		return x.CircuitBreakerAction
+	}
+	return ""
+}
+
+func (x *Params) GetMaxStalenessBlocks() uint64 {
+	if x != nil {
+		return x.MaxStalenessBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetMaxStalenessDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxStalenessDuration
+	}
+	return nil
+}
+
+func (x *Params) GetPerformanceWindow() uint64 {
+	if x != nil {
+		return x.PerformanceWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinPerformanceBps() uint64 {
+	if x != nil {
+		return x.MinPerformanceBps
+	}
+	return 0
+}
+
+func (x *Params) GetMaxDeviationBps() uint64 {
+	if x != nil {
+		return x.MaxDeviationBps
+	}
+	return 0
+}
+
+var File_connect_oracle_v2_params_proto protoreflect.FileDescriptor
+
+var file_connect_oracle_v2_params_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x32, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x03, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x70,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x59, 0x0a, 0x16, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x42,
+	0xb7, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x32, 0xca,
+	0x02, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x3a, 0x3a, 0x4f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_connect_oracle_v2_params_proto_rawDescOnce sync.Once
+	file_connect_oracle_v2_params_proto_rawDescData = file_connect_oracle_v2_params_proto_rawDesc
+)
+
+func file_connect_oracle_v2_params_proto_rawDescGZIP() []byte {
+	file_connect_oracle_v2_params_proto_rawDescOnce.Do(func() {
+		file_connect_oracle_v2_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_oracle_v2_params_proto_rawDescData)
+	})
+	return file_connect_oracle_v2_params_proto_rawDescData
+}
+
+var file_connect_oracle_v2_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_connect_oracle_v2_params_proto_goTypes = []interface{}{
+	(*Params)(nil),              // 0: connect.oracle.v2.Params
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+}
+var file_connect_oracle_v2_params_proto_depIdxs = []int32{
+	1, // 0: connect.oracle.v2.Params.max_staleness_duration:type_name -> google.protobuf.Duration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_connect_oracle_v2_params_proto_init() }
+func file_connect_oracle_v2_params_proto_init() {
+	if File_connect_oracle_v2_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_connect_oracle_v2_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_connect_oracle_v2_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_connect_oracle_v2_params_proto_goTypes,
+		DependencyIndexes: file_connect_oracle_v2_params_proto_depIdxs,
+		MessageInfos:      file_connect_oracle_v2_params_proto_msgTypes,
+	}.Build()
+	File_connect_oracle_v2_params_proto = out.File
+	file_connect_oracle_v2_params_proto_rawDesc = nil
+	file_connect_oracle_v2_params_proto_goTypes = nil
+	file_connect_oracle_v2_params_proto_depIdxs = nil
+}
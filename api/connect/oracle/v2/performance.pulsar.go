@@ -0,0 +1,1438 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev2
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ValidatorBlockReport               protoreflect.MessageDescriptor
+	fd_ValidatorBlockReport_num_pairs     protoreflect.FieldDescriptor
+	fd_ValidatorBlockReport_num_reported  protoreflect.FieldDescriptor
+	fd_ValidatorBlockReport_num_deviating protoreflect.FieldDescriptor
+	fd_ValidatorBlockReport_deviation_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_performance_proto_init()
+	md_ValidatorBlockReport = File_connect_oracle_v2_performance_proto.Messages().ByName("ValidatorBlockReport")
+	fd_ValidatorBlockReport_num_pairs = md_ValidatorBlockReport.Fields().ByName("num_pairs")
+	fd_ValidatorBlockReport_num_reported = md_ValidatorBlockReport.Fields().ByName("num_reported")
+	fd_ValidatorBlockReport_num_deviating = md_ValidatorBlockReport.Fields().ByName("num_deviating")
+	fd_ValidatorBlockReport_deviation_bps = md_ValidatorBlockReport.Fields().ByName("deviation_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorBlockReport)(nil)
+
+type fastReflection_ValidatorBlockReport ValidatorBlockReport
+
+func (x *ValidatorBlockReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorBlockReport)(x)
+}
+
+func (x *ValidatorBlockReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_performance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorBlockReport_messageType fastReflection_ValidatorBlockReport_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorBlockReport_messageType{}
+
+type fastReflection_ValidatorBlockReport_messageType struct{}
+
+func (x fastReflection_ValidatorBlockReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorBlockReport)(nil)
+}
+func (x fastReflection_ValidatorBlockReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorBlockReport)
+}
+func (x fastReflection_ValidatorBlockReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorBlockReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorBlockReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorBlockReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorBlockReport) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorBlockReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorBlockReport) New() protoreflect.Message {
+	return new(fastReflection_ValidatorBlockReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorBlockReport) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorBlockReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorBlockReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NumPairs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumPairs)
+		if !f(fd_ValidatorBlockReport_num_pairs, value) {
+			return
+		}
+	}
+	if x.NumReported != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumReported)
+		if !f(fd_ValidatorBlockReport_num_reported, value) {
+			return
+		}
+	}
+	if x.NumDeviating != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumDeviating)
+		if !f(fd_ValidatorBlockReport_num_deviating, value) {
+			return
+		}
+	}
+	if x.DeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeviationBps)
+		if !f(fd_ValidatorBlockReport_deviation_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorBlockReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorBlockReport.num_pairs":
+		return x.NumPairs != uint64(0)
+	case "connect.oracle.v2.ValidatorBlockReport.num_reported":
+		return x.NumReported != uint64(0)
+	case "connect.oracle.v2.ValidatorBlockReport.num_deviating":
+		return x.NumDeviating != uint64(0)
+	case "connect.oracle.v2.ValidatorBlockReport.deviation_bps":
+		return x.DeviationBps != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorBlockReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorBlockReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorBlockReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorBlockReport.num_pairs":
+		x.NumPairs = uint64(0)
+	case "connect.oracle.v2.ValidatorBlockReport.num_reported":
+		x.NumReported = uint64(0)
+	case "connect.oracle.v2.ValidatorBlockReport.num_deviating":
+		x.NumDeviating = uint64(0)
+	case "connect.oracle.v2.ValidatorBlockReport.deviation_bps":
+		x.DeviationBps = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorBlockReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorBlockReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorBlockReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.ValidatorBlockReport.num_pairs":
+		value := x.NumPairs
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorBlockReport.num_reported":
+		value := x.NumReported
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorBlockReport.num_deviating":
+		value := x.NumDeviating
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorBlockReport.deviation_bps":
+		value := x.DeviationBps
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorBlockReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorBlockReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorBlockReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorBlockReport.num_pairs":
+		x.NumPairs = value.Uint()
+	case "connect.oracle.v2.ValidatorBlockReport.num_reported":
+		x.NumReported = value.Uint()
+	case "connect.oracle.v2.ValidatorBlockReport.num_deviating":
+		x.NumDeviating = value.Uint()
+	case "connect.oracle.v2.ValidatorBlockReport.deviation_bps":
+		x.DeviationBps = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorBlockReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorBlockReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorBlockReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorBlockReport.num_pairs":
+		panic(fmt.Errorf("field num_pairs of message connect.oracle.v2.ValidatorBlockReport is not mutable"))
+	case "connect.oracle.v2.ValidatorBlockReport.num_reported":
+		panic(fmt.Errorf("field num_reported of message connect.oracle.v2.ValidatorBlockReport is not mutable"))
+	case "connect.oracle.v2.ValidatorBlockReport.num_deviating":
+		panic(fmt.Errorf("field num_deviating of message connect.oracle.v2.ValidatorBlockReport is not mutable"))
+	case "connect.oracle.v2.ValidatorBlockReport.deviation_bps":
+		panic(fmt.Errorf("field deviation_bps of message connect.oracle.v2.ValidatorBlockReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorBlockReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorBlockReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorBlockReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorBlockReport.num_pairs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorBlockReport.num_reported":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorBlockReport.num_deviating":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorBlockReport.deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorBlockReport"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorBlockReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorBlockReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.ValidatorBlockReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorBlockReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorBlockReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorBlockReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorBlockReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorBlockReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NumPairs != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumPairs))
+		}
+		if x.NumReported != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumReported))
+		}
+		if x.NumDeviating != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumDeviating))
+		}
+		if x.DeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorBlockReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NumDeviating != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumDeviating))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.NumReported != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumReported))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.NumPairs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumPairs))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorBlockReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorBlockReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorBlockReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumPairs", wireType)
+				}
+				x.NumPairs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumPairs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumReported", wireType)
+				}
+				x.NumReported = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumReported |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumDeviating", wireType)
+				}
+				x.NumDeviating = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumDeviating |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+				}
+				x.DeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorPerformance               protoreflect.MessageDescriptor
+	fd_ValidatorPerformance_num_blocks    protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_pairs     protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_reported  protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_deviating protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_deviation_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_connect_oracle_v2_performance_proto_init()
+	md_ValidatorPerformance = File_connect_oracle_v2_performance_proto.Messages().ByName("ValidatorPerformance")
+	fd_ValidatorPerformance_num_blocks = md_ValidatorPerformance.Fields().ByName("num_blocks")
+	fd_ValidatorPerformance_num_pairs = md_ValidatorPerformance.Fields().ByName("num_pairs")
+	fd_ValidatorPerformance_num_reported = md_ValidatorPerformance.Fields().ByName("num_reported")
+	fd_ValidatorPerformance_num_deviating = md_ValidatorPerformance.Fields().ByName("num_deviating")
+	fd_ValidatorPerformance_deviation_bps = md_ValidatorPerformance.Fields().ByName("deviation_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorPerformance)(nil)
+
+type fastReflection_ValidatorPerformance ValidatorPerformance
+
+func (x *ValidatorPerformance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(x)
+}
+
+func (x *ValidatorPerformance) slowProtoReflect() protoreflect.Message {
+	mi := &file_connect_oracle_v2_performance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorPerformance_messageType fastReflection_ValidatorPerformance_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPerformance_messageType{}
+
+type fastReflection_ValidatorPerformance_messageType struct{}
+
+func (x fastReflection_ValidatorPerformance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(nil)
+}
+func (x fastReflection_ValidatorPerformance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
+}
+func (x fastReflection_ValidatorPerformance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPerformance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPerformance) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPerformance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPerformance) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPerformance) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPerformance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPerformance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NumBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumBlocks)
+		if !f(fd_ValidatorPerformance_num_blocks, value) {
+			return
+		}
+	}
+	if x.NumPairs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumPairs)
+		if !f(fd_ValidatorPerformance_num_pairs, value) {
+			return
+		}
+	}
+	if x.NumReported != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumReported)
+		if !f(fd_ValidatorPerformance_num_reported, value) {
+			return
+		}
+	}
+	if x.NumDeviating != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumDeviating)
+		if !f(fd_ValidatorPerformance_num_deviating, value) {
+			return
+		}
+	}
+	if x.DeviationBps != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeviationBps)
+		if !f(fd_ValidatorPerformance_deviation_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPerformance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.num_blocks":
+		return x.NumBlocks != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.num_pairs":
+		return x.NumPairs != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.num_reported":
+		return x.NumReported != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.num_deviating":
+		return x.NumDeviating != uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.deviation_bps":
+		return x.DeviationBps != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.num_blocks":
+		x.NumBlocks = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.num_pairs":
+		x.NumPairs = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.num_reported":
+		x.NumReported = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.num_deviating":
+		x.NumDeviating = uint64(0)
+	case "connect.oracle.v2.ValidatorPerformance.deviation_bps":
+		x.DeviationBps = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPerformance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.num_blocks":
+		value := x.NumBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.num_pairs":
+		value := x.NumPairs
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.num_reported":
+		value := x.NumReported
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.num_deviating":
+		value := x.NumDeviating
+		return protoreflect.ValueOfUint64(value)
+	case "connect.oracle.v2.ValidatorPerformance.deviation_bps":
+		value := x.DeviationBps
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.num_blocks":
+		x.NumBlocks = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.num_pairs":
+		x.NumPairs = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.num_reported":
+		x.NumReported = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.num_deviating":
+		x.NumDeviating = value.Uint()
+	case "connect.oracle.v2.ValidatorPerformance.deviation_bps":
+		x.DeviationBps = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.num_blocks":
+		panic(fmt.Errorf("field num_blocks of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.num_pairs":
+		panic(fmt.Errorf("field num_pairs of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.num_reported":
+		panic(fmt.Errorf("field num_reported of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.num_deviating":
+		panic(fmt.Errorf("field num_deviating of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	case "connect.oracle.v2.ValidatorPerformance.deviation_bps":
+		panic(fmt.Errorf("field deviation_bps of message connect.oracle.v2.ValidatorPerformance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPerformance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "connect.oracle.v2.ValidatorPerformance.num_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.num_pairs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.num_reported":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.num_deviating":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "connect.oracle.v2.ValidatorPerformance.deviation_bps":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: connect.oracle.v2.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message connect.oracle.v2.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPerformance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in connect.oracle.v2.ValidatorPerformance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPerformance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPerformance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPerformance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NumBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumBlocks))
+		}
+		if x.NumPairs != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumPairs))
+		}
+		if x.NumReported != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumReported))
+		}
+		if x.NumDeviating != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumDeviating))
+		}
+		if x.DeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBps))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.NumDeviating != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumDeviating))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NumReported != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumReported))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.NumPairs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumPairs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.NumBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumBlocks))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+				}
+				x.NumBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumPairs", wireType)
+				}
+				x.NumPairs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumPairs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumReported", wireType)
+				}
+				x.NumReported = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumReported |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumDeviating", wireType)
+				}
+				x.NumDeviating = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumDeviating |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+				}
+				x.DeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationBps |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: connect/oracle/v2/performance.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorBlockReport summarizes the prices reported by a validator in a
+// single block, compared to the final prices of the block.
+type ValidatorBlockReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NumPairs is the number of currency-pairs that received a final price.
+	NumPairs uint64 `protobuf:"varint,1,opt,name=num_pairs,json=numPairs,proto3" json:"num_pairs,omitempty"`
+	// NumReported is the number of those currency-pairs for which the validator
+	// reported a price.
+	NumReported uint64 `protobuf:"varint,2,opt,name=num_reported,json=numReported,proto3" json:"num_reported,omitempty"`
+	// NumDeviating is the number of reported prices that deviated from the final
+	// price by more than the module's maximum deviation.
+	NumDeviating uint64 `protobuf:"varint,3,opt,name=num_deviating,json=numDeviating,proto3" json:"num_deviating,omitempty"`
+	// DeviationBps is the sum of the deviations of the reported prices from the
+	// final prices, in basis points. Each deviation is capped at 10,000 bps.
+	DeviationBps uint64 `protobuf:"varint,4,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+}
+
+func (x *ValidatorBlockReport) Reset() {
+	*x = ValidatorBlockReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_performance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorBlockReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorBlockReport) ProtoMessage() {}
+
+// Deprecated: Use ValidatorBlockReport.ProtoReflect.Descriptor instead.
+func (*ValidatorBlockReport) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_performance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorBlockReport) GetNumPairs() uint64 {
+	if x != nil {
+		return x.NumPairs
+	}
+	return 0
+}
+
+func (x *ValidatorBlockReport) GetNumReported() uint64 {
+	if x != nil {
+		return x.NumReported
+	}
+	return 0
+}
+
+func (x *ValidatorBlockReport) GetNumDeviating() uint64 {
+	if x != nil {
+		return x.NumDeviating
+	}
+	return 0
+}
+
+func (x *ValidatorBlockReport) GetDeviationBps() uint64 {
+	if x != nil {
+		return x.DeviationBps
+	}
+	return 0
+}
+
+// ValidatorPerformance summarizes the prices reported by a validator over the
+// module's performance window.
+type ValidatorPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// NumBlocks is the number of blocks in the window for which the validator's
+	// reports were recorded.
+	NumBlocks uint64 `protobuf:"varint,1,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// NumPairs is the number of currency-pairs that received a final price,
+	// summed over the blocks in the window.
+	NumPairs uint64 `protobuf:"varint,2,opt,name=num_pairs,json=numPairs,proto3" json:"num_pairs,omitempty"`
+	// NumReported is the number of those currency-pairs for which the validator
+	// reported a price, summed over the blocks in the window.
+	NumReported uint64 `protobuf:"varint,3,opt,name=num_reported,json=numReported,proto3" json:"num_reported,omitempty"`
+	// NumDeviating is the number of reported prices that deviated from the final
+	// price by more than the module's maximum deviation, summed over the blocks
+	// in the window.
+	NumDeviating uint64 `protobuf:"varint,4,opt,name=num_deviating,json=numDeviating,proto3" json:"num_deviating,omitempty"`
+	// DeviationBps is the sum of the deviations of the reported prices from the
+	// final prices in basis points, summed over the blocks in the window.
+	DeviationBps uint64 `protobuf:"varint,5,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+}
+
+func (x *ValidatorPerformance) Reset() {
+	*x = ValidatorPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_oracle_v2_performance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformance) ProtoMessage() {}
+
+// Deprecated: Use ValidatorPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return file_connect_oracle_v2_performance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorPerformance) GetNumBlocks() uint64 {
+	if x != nil {
+		return x.NumBlocks
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetNumPairs() uint64 {
+	if x != nil {
+		return x.NumPairs
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetNumReported() uint64 {
+	if x != nil {
+		return x.NumReported
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetNumDeviating() uint64 {
+	if x != nil {
+		return x.NumDeviating
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetDeviationBps() uint64 {
+	if x != nil {
+		return x.DeviationBps
+	}
+	return 0
+}
+
+var File_connect_oracle_v2_performance_proto protoreflect.FileDescriptor
+
+var file_connect_oracle_v2_performance_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2f, 0x76, 0x32, 0x2f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x14,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x69, 0x72, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x44,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x42, 0xbc, 0x01,
+	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x42, 0x10, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x3b,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02,
+	0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x56, 0x32, 0xca, 0x02, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_connect_oracle_v2_performance_proto_rawDescOnce sync.Once
+	file_connect_oracle_v2_performance_proto_rawDescData = file_connect_oracle_v2_performance_proto_rawDesc
+)
+
+func file_connect_oracle_v2_performance_proto_rawDescGZIP() []byte {
+	file_connect_oracle_v2_performance_proto_rawDescOnce.Do(func() {
+		file_connect_oracle_v2_performance_proto_rawDescData = protoimpl.X.CompressGZIP(file_connect_oracle_v2_performance_proto_rawDescData)
+	})
+	return file_connect_oracle_v2_performance_proto_rawDescData
+}
+
+var file_connect_oracle_v2_performance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_connect_oracle_v2_performance_proto_goTypes = []interface{}{
+	(*ValidatorBlockReport)(nil), // 0: connect.oracle.v2.ValidatorBlockReport
+	(*ValidatorPerformance)(nil), // 1: connect.oracle.v2.ValidatorPerformance
+}
+var file_connect_oracle_v2_performance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_connect_oracle_v2_performance_proto_init() }
+func file_connect_oracle_v2_performance_proto_init() {
+	if File_connect_oracle_v2_performance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_connect_oracle_v2_performance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorBlockReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_oracle_v2_performance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_connect_oracle_v2_performance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_connect_oracle_v2_performance_proto_goTypes,
+		DependencyIndexes: file_connect_oracle_v2_performance_proto_depIdxs,
+		MessageInfos:      file_connect_oracle_v2_performance_proto_msgTypes,
+	}.Build()
+	File_connect_oracle_v2_performance_proto = out.File
+	file_connect_oracle_v2_performance_proto_rawDesc = nil
+	file_connect_oracle_v2_performance_proto_goTypes = nil
+	file_connect_oracle_v2_performance_proto_depIdxs = nil
+}
@@ -128,15 +128,28 @@ func ReadOracleConfigWithOverrides(path string, marketMapProvider string) (confi
 		return config.OracleConfig{}, fmt.Errorf("market map provider %s not found", marketMapProvider)
 	}
 
-	// filter the unused market-map providers
+	// filter the unused market-map providers. If market map sources are configured, the oracle follows
+	// all of them, otherwise it only follows the selected market-map provider.
 	for name, provider := range cfg.Providers {
-		if provider.Type == mmtypes.ConfigType {
-			if name != marketMapProvider {
+		if provider.Type != mmtypes.ConfigType {
+			continue
+		}
+
+		if len(cfg.MarketMap.Sources) > 0 {
+			if !slices.Contains(cfg.MarketMap.Sources, name) {
 				delete(cfg.Providers, name)
 			}
+		} else if name != marketMapProvider {
+			delete(cfg.Providers, name)
 		}
 	}
 
+	// market map providers without a default config, e.g. the verified market map provider, must be
+	// configured in the oracle config.
+	if _, ok := cfg.Providers[marketMapProvider]; !ok && len(cfg.MarketMap.Sources) == 0 {
+		return config.OracleConfig{}, fmt.Errorf("market map provider %s is not configured", marketMapProvider)
+	}
+
 	return cfg, cfg.ValidateBasic()
 }
 
@@ -223,8 +236,21 @@ func updateEndpointFromEnvironment(endpoint config.Endpoint, providerName string
 	return endpoint, endpointURL != nil || endpointAPIKey != nil || endpointAPIKeyHeader != nil
 }
 
+// GetNodeEndpointFromConfig returns the node endpoint of the market map provider. If market map sources are
+// configured, this is the endpoint of the highest priority source.
 func GetNodeEndpointFromConfig(cfg config.OracleConfig) (config.Endpoint, error) {
-	for _, provider := range cfg.Providers {
+	providers := make([]config.ProviderConfig, 0, len(cfg.Providers))
+	if len(cfg.MarketMap.Sources) > 0 {
+		for _, source := range cfg.MarketMap.Sources {
+			providers = append(providers, cfg.Providers[source])
+		}
+	} else {
+		for _, provider := range cfg.Providers {
+			providers = append(providers, provider)
+		}
+	}
+
+	for _, provider := range providers {
 		if provider.Type == mmtypes.ConfigType {
 			isAlternativeMMProvider := slices.IndexFunc(constants.AlternativeMarketMapProviders, func(c config.ProviderConfig) bool {
 				return c.Name == provider.Name
@@ -395,6 +395,143 @@ func TestReadOracleConfigWithOverrides(t *testing.T) {
 		_, err = cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.Name)
 		require.ErrorContains(t, err, "overridden key")
 	})
+
+	t.Run("market map sources retain all of their market map providers", func(t *testing.T) {
+		// create a temp file in the current directory
+		tmpfile, err := os.CreateTemp("", "connect-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		secondary := marketmap.Name + "_secondary"
+		overrides := fmt.Sprintf(`
+		{
+			"marketMap": {
+				"sources": ["%s", "%s"]
+			},
+			"providers": {
+				"%s": {
+					"name": "%s",
+					"type": "%s",
+					"api": {
+						"name": "%s",
+						"enabled": true,
+						"atomic": true,
+						"timeout": "20s",
+						"interval": "10s",
+						"reconnectTimeout": "2s",
+						"maxQueries": 1,
+						"endpoints": [
+							{
+								"url": "localhost:9091"
+							}
+						]
+					}
+				}
+			}
+		}
+		`,
+			marketmap.Name,
+			secondary,
+			secondary,
+			secondary,
+			mmtypes.ConfigType,
+			secondary,
+		)
+		tmpfile.Write([]byte(overrides))
+
+		cfg, err := cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.Name)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{marketmap.Name, secondary}, cfg.MarketMap.Sources)
+
+		var mmProviders []string
+		for name, provider := range cfg.Providers {
+			if provider.Type == mmtypes.ConfigType {
+				mmProviders = append(mmProviders, name)
+			}
+		}
+		require.ElementsMatch(t, cfg.MarketMap.Sources, mmProviders)
+
+		endpoint, err := cmdconfig.GetNodeEndpointFromConfig(cfg)
+		require.NoError(t, err)
+		require.Equal(t, marketmap.DefaultAPIConfig.Endpoints[0], endpoint)
+	})
+
+	t.Run("the verified market map provider must be configured", func(t *testing.T) {
+		// create a temp file in the current directory
+		tmpfile, err := os.CreateTemp("", "connect-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		tmpfile.Write([]byte("{}"))
+
+		_, err = cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.VerifiedName)
+		require.ErrorContains(t, err, "is not configured")
+	})
+
+	t.Run("the verified market map provider can be configured via config", func(t *testing.T) {
+		// create a temp file in the current directory
+		tmpfile, err := os.CreateTemp("", "connect-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		overrides := fmt.Sprintf(`
+		{
+			"providers": {
+				"%s": {
+					"name": "%s",
+					"type": "%s",
+					"api": {
+						"name": "%s",
+						"enabled": true,
+						"atomic": true,
+						"timeout": "20s",
+						"interval": "10s",
+						"reconnectTimeout": "2s",
+						"maxQueries": 1,
+						"endpoints": [
+							{
+								"url": "http://localhost:26657"
+							}
+						],
+						"lightClient": {
+							"chainId": "test-chain",
+							"trustHeight": 10,
+							"trustHash": "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+							"trustPeriod": "168h",
+							"maxBlockAge": "1m"
+						}
+					}
+				}
+			}
+		}
+		`,
+			marketmap.VerifiedName,
+			marketmap.VerifiedName,
+			mmtypes.ConfigType,
+			marketmap.VerifiedName,
+		)
+		tmpfile.Write([]byte(overrides))
+
+		cfg, err := cmdconfig.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.VerifiedName)
+		require.NoError(t, err)
+
+		provider, ok := cfg.Providers[marketmap.VerifiedName]
+		require.True(t, ok)
+		require.Equal(t, &oracleconfig.LightClientConfig{
+			ChainID:     "test-chain",
+			TrustHeight: 10,
+			TrustHash:   "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			TrustPeriod: 168 * time.Hour,
+			MaxBlockAge: time.Minute,
+		}, provider.API.LightClient)
+
+		_, ok = cfg.Providers[marketmap.Name]
+		require.False(t, ok)
+	})
 }
 
 func TestOracleConfigWithExtraKeys(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/signal"
 	"regexp"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/cmd/build"
 	cmdconfig "github.com/skip-mev/connect/v2/cmd/connect/config"
+	"github.com/skip-mev/connect/v2/cmd/constants"
 	"github.com/skip-mev/connect/v2/oracle"
 	"github.com/skip-mev/connect/v2/oracle/config"
 	oraclemetrics "github.com/skip-mev/connect/v2/oracle/metrics"
@@ -102,7 +104,7 @@ func init() {
 		"marketmap-provider",
 		"",
 		marketmap.Name,
-		"MarketMap provider to use (marketmap_api, dydx_api, dydx_migration_api).",
+		"MarketMap provider to use (marketmap_api, marketmap_last_updated_api, marketmap_verified_api, dydx_api, dydx_migration_api).",
 	)
 	rootCmd.Flags().StringVarP(
 		&oracleCfgPath,
@@ -116,7 +118,7 @@ func init() {
 		"market-config-path",
 		"",
 		"",
-		"Path to the market config file. If you supplied a node URL in your config, this will not be required.",
+		"Path to the market config file. If you supplied a node URL in your config, this will not be required. If market map providers are configured, its markets override theirs.",
 	)
 	rootCmd.Flags().StringVarP(
 		&updateMarketCfgPath,
@@ -266,7 +268,6 @@ func init() {
 	}
 
 	rootCmd.MarkFlagsMutuallyExclusive("update-market-config-path", "market-config-path")
-	rootCmd.MarkFlagsMutuallyExclusive("market-map-endpoint", "market-config-path")
 
 	rootCmd.AddCommand(versionCmd)
 }
@@ -302,28 +303,9 @@ func runOracle() error {
 	logger := log.NewLogger(logCfg)
 	defer logger.Sync()
 
-	var cfg config.OracleConfig
-	var err error
-
-	cfg, err = cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	cfg, err := readOracleConfig()
 	if err != nil {
-		return fmt.Errorf("failed to get oracle config: %w", err)
-	}
-
-	// overwrite endpoint
-	if marketMapEndPoint != "" {
-		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
-		if err != nil {
-			return fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
-		}
-	}
-
-	// check that the marketmap endpoint they provided is correct.
-	if marketMapProvider == marketmap.Name {
-		mmEndpoint := cfg.Providers[marketMapProvider].API.Endpoints[0].URL
-		if err := isValidGRPCEndpoint(mmEndpoint); err != nil {
-			return err
-		}
+		return err
 	}
 
 	var marketCfg mmtypes.MarketMap
@@ -355,6 +337,7 @@ func runOracle() error {
 		logger,
 		marketCfg,
 		metrics,
+		oraclemath.WithAggregationConfig(cfg.Aggregation),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create data aggregator: %w", err)
@@ -369,6 +352,10 @@ func runOracle() error {
 		oracle.WithMarketMapperFactory(oraclefactory.MarketMapProviderFactory),
 		oracle.WithMetrics(metrics),
 	}
+	if marketCfgPath != "" {
+		// the local market config overrides the markets of the market map providers.
+		oracleOpts = append(oracleOpts, oracle.WithMarketMapOverride(marketCfg))
+	}
 	if updateMarketCfgPath != "" {
 		oracleOpts = append(oracleOpts, oracle.WithWriteTo(updateMarketCfgPath))
 	}
@@ -389,6 +376,9 @@ func runOracle() error {
 	}()
 	defer orc.Stop()
 
+	// reload the oracle config on hangup, restarting only the providers whose config changed
+	go reloadOracleConfigOnHangup(ctx, logger, orc)
+
 	srv := oracleserver.NewOracleServer(orc, logger)
 
 	// cancel oracle on interrupt or terminate
@@ -456,8 +446,75 @@ func runOracle() error {
 	return nil
 }
 
+// readOracleConfig reads the oracle config from the config path, and applies the overrides
+// provided via flags and the environment.
+func readOracleConfig() (config.OracleConfig, error) {
+	cfg, err := cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+	if err != nil {
+		return config.OracleConfig{}, fmt.Errorf("failed to get oracle config: %w", err)
+	}
+
+	// overwrite endpoint
+	if marketMapEndPoint != "" {
+		cfg, err = overwriteMarketMapEndpoint(cfg, marketMapEndPoint)
+		if err != nil {
+			return config.OracleConfig{}, fmt.Errorf("failed to overwrite market endpoint %s: %w", marketMapEndPoint, err)
+		}
+	}
+
+	// check that the marketmap endpoints they provided are correct.
+	for name, provider := range cfg.Providers {
+		// the verified market map provider queries a CometBFT RPC endpoint.
+		if provider.Type != mmservicetypes.ConfigType || isAlternativeMarketMapProvider(name) || name == marketmap.VerifiedName {
+			continue
+		}
+
+		if err := isValidGRPCEndpoint(provider.API.Endpoints[0].URL); err != nil {
+			return config.OracleConfig{}, err
+		}
+	}
+
+	return cfg, nil
+}
+
+// reloadOracleConfigOnHangup re-reads the oracle config each time the process receives a SIGHUP,
+// and updates the running oracle with it. An invalid config is logged and ignored.
+func reloadOracleConfigOnHangup(ctx context.Context, logger *zap.Logger, orc oracle.Oracle) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	defer signal.Stop(hangups)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangups:
+			logger.Info("received hangup signal; reloading oracle config", zap.String("oracle_config_path", oracleCfgPath))
+
+			cfg, err := readOracleConfig()
+			if err != nil {
+				logger.Error("failed to reload oracle config", zap.Error(err))
+				continue
+			}
+
+			if err := orc.UpdateConfig(cfg); err != nil {
+				logger.Error("failed to update oracle config", zap.Error(err))
+				continue
+			}
+
+			logger.Info("reloaded oracle config")
+		}
+	}
+}
+
+// overwriteMarketMapEndpoint overwrites the endpoint of the market map provider. If market map sources are
+// configured, the endpoint of the highest priority source is overwritten.
 func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (config.OracleConfig, error) {
 	for providerName, provider := range cfg.Providers {
+		if len(cfg.MarketMap.Sources) > 0 && providerName != cfg.MarketMap.Sources[0] {
+			continue
+		}
+
 		if provider.Type == mmservicetypes.ConfigType {
 			provider.API.Endpoints = []config.Endpoint{
 				{
@@ -472,6 +529,14 @@ func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (conf
 	return cfg, fmt.Errorf("no market-map provider found in config")
 }
 
+// isAlternativeMarketMapProvider returns true if the market map provider with the given name does not
+// fetch the market map from a node's gRPC endpoint.
+func isAlternativeMarketMapProvider(name string) bool {
+	return slices.ContainsFunc(constants.AlternativeMarketMapProviders, func(c config.ProviderConfig) bool {
+		return c.Name == name
+	})
+}
+
 // isValidGRPCEndpoint checks that the string s is a valid gRPC endpoint. (doesn't start with http, ends with a port).
 func isValidGRPCEndpoint(s string) error {
 	if strings.HasPrefix(s, "http") {
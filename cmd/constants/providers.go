@@ -191,12 +191,17 @@ var (
 			Type: types.ConfigType,
 		},
 
-		// MarketMap provider
+		// MarketMap providers
 		{
 			Name: marketmap.Name,
 			API:  marketmap.DefaultAPIConfig,
 			Type: mmtypes.ConfigType,
 		},
+		{
+			Name: marketmap.LastUpdatedName,
+			API:  marketmap.DefaultLastUpdatedAPIConfig,
+			Type: mmtypes.ConfigType,
+		},
 	}
 
 	AlternativeMarketMapProviders = []config.ProviderConfig{
@@ -228,5 +233,7 @@ var (
 		dydx.ResearchAPIHandlerName:    {},
 		dydx.ResearchCMCAPIHandlerName: {},
 		marketmap.Name:                 {},
+		marketmap.LastUpdatedName:      {},
+		marketmap.VerifiedName:         {},
 	}
 )
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// MedianStrategy aggregates the converted provider prices of a market via their median.
+	MedianStrategy = "median"
+	// TrimmedMeanStrategy aggregates the converted provider prices of a market via their mean,
+	// after discarding a fraction of the lowest and highest prices.
+	TrimmedMeanStrategy = "trimmed_mean"
+	// LiquidityWeightedMedianStrategy aggregates the converted provider prices of a market via
+	// their median, weighted by the liquidity declared in each provider config's metadata.
+	LiquidityWeightedMedianStrategy = "liquidity_weighted_median"
+	// ProviderWeightedMedianStrategy aggregates the converted provider prices of a market via
+	// their median, weighted by the weight declared in each provider config's metadata.
+	ProviderWeightedMedianStrategy = "provider_weighted_median"
+
+	// MADOutlierFilter rejects converted provider prices whose distance from the median exceeds a
+	// multiple of the median absolute deviation (MAD) of the prices.
+	MADOutlierFilter = "mad"
+	// MaxDeviationOutlierFilter rejects converted provider prices whose relative deviation from the
+	// median exceeds a fixed fraction.
+	MaxDeviationOutlierFilter = "max_deviation"
+)
+
+// AggregationConfig configures how the oracle aggregates the converted provider prices of each
+// market into a single price. Markets may additionally select a strategy via the aggregation
+// field of their ticker's metadata JSON. Precedence is given to the per-market overrides in this
+// config, then to the ticker metadata, and finally to the default strategy.
+type AggregationConfig struct {
+	// Default is the strategy used for markets that do not select one. If empty, prices are
+	// aggregated via the median.
+	Default AggregationStrategyConfig `json:"default"`
+
+	// Markets contains per-market strategy overrides, indexed by ticker (e.g. BTC/USD).
+	Markets map[string]AggregationStrategyConfig `json:"markets"`
+}
+
+// AggregationStrategyConfig selects and parameterizes a single aggregation strategy.
+type AggregationStrategyConfig struct {
+	// Strategy is the name of the aggregation strategy. If empty, prices are aggregated via the
+	// median.
+	Strategy string `json:"strategy"`
+
+	// TrimFraction is the fraction of prices that is discarded from each end before averaging.
+	// This is only used by the trimmed mean strategy.
+	TrimFraction float64 `json:"trimFraction"`
+
+	// OutlierFilter configures the outlier filter that is applied to the converted provider prices
+	// before they are aggregated. By default, no prices are rejected.
+	OutlierFilter OutlierFilterConfig `json:"outlierFilter"`
+}
+
+// OutlierFilterConfig selects and parameterizes the outlier filter of a market.
+type OutlierFilterConfig struct {
+	// Method is the name of the outlier filter. If empty, no outlier filtering is applied.
+	Method string `json:"method"`
+
+	// Threshold is the rejection threshold of the filter. For the MAD filter this is the number of
+	// median absolute deviations a price may be from the median. For the max deviation filter this
+	// is the maximum relative deviation from the median, e.g. 0.05 for 5%.
+	Threshold float64 `json:"threshold"`
+
+	// MinPrices is the minimum number of converted prices required to apply the filter. Markets
+	// with fewer prices are aggregated unfiltered. This must be at least 3, since an outlier
+	// cannot be distinguished amongst two prices, and defaults to 3 if unset.
+	MinPrices int `json:"minPrices"`
+}
+
+// ValidateBasic performs basic validation of the aggregation config.
+func (c *AggregationConfig) ValidateBasic() error {
+	if err := c.Default.ValidateBasic(); err != nil {
+		return fmt.Errorf("default aggregation strategy is not formatted correctly: %w", err)
+	}
+
+	for ticker, strategy := range c.Markets {
+		if err := strategy.ValidateBasic(); err != nil {
+			return fmt.Errorf("aggregation strategy for %s is not formatted correctly: %w", ticker, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the aggregation strategy config.
+func (c *AggregationStrategyConfig) ValidateBasic() error {
+	switch c.Strategy {
+	case "", MedianStrategy, LiquidityWeightedMedianStrategy, ProviderWeightedMedianStrategy:
+	case TrimmedMeanStrategy:
+		if c.TrimFraction < 0 || c.TrimFraction >= 0.5 {
+			return fmt.Errorf("trim fraction must be in [0, 0.5); got %f", c.TrimFraction)
+		}
+	default:
+		return fmt.Errorf("unknown aggregation strategy: %s", c.Strategy)
+	}
+
+	if err := c.OutlierFilter.ValidateBasic(); err != nil {
+		return fmt.Errorf("outlier filter is not formatted correctly: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the outlier filter config.
+func (c *OutlierFilterConfig) ValidateBasic() error {
+	switch c.Method {
+	case "":
+		return nil
+	case MADOutlierFilter, MaxDeviationOutlierFilter:
+	default:
+		return fmt.Errorf("unknown outlier filter: %s", c.Method)
+	}
+
+	if c.Threshold <= 0 {
+		return fmt.Errorf("outlier filter threshold must be positive; got %f", c.Threshold)
+	}
+
+	if c.MinPrices != 0 && c.MinPrices < 3 {
+		return fmt.Errorf("outlier filter min prices must be at least 3; got %d", c.MinPrices)
+	}
+
+	return nil
+}
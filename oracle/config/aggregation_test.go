@@ -0,0 +1,154 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+func TestAggregationConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.AggregationConfig
+		expectedErr bool
+	}{
+		{
+			name:        "empty config",
+			config:      config.AggregationConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config with default and market overrides",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					Strategy: config.MedianStrategy,
+				},
+				Markets: map[string]config.AggregationStrategyConfig{
+					"BTC/USD": {
+						Strategy:     config.TrimmedMeanStrategy,
+						TrimFraction: 0.2,
+					},
+					"ETH/USD": {
+						Strategy: config.LiquidityWeightedMedianStrategy,
+					},
+					"SOL/USD": {
+						Strategy: config.ProviderWeightedMedianStrategy,
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with unknown default strategy",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					Strategy: "mode",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with unknown market strategy",
+			config: config.AggregationConfig{
+				Markets: map[string]config.AggregationStrategyConfig{
+					"BTC/USD": {
+						Strategy: "mode",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative trim fraction",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					Strategy:     config.TrimmedMeanStrategy,
+					TrimFraction: -0.1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with trim fraction that discards every price",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					Strategy:     config.TrimmedMeanStrategy,
+					TrimFraction: 0.5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with outlier filters",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					OutlierFilter: config.OutlierFilterConfig{
+						Method:    config.MADOutlierFilter,
+						Threshold: 3,
+					},
+				},
+				Markets: map[string]config.AggregationStrategyConfig{
+					"BTC/USD": {
+						OutlierFilter: config.OutlierFilterConfig{
+							Method:    config.MaxDeviationOutlierFilter,
+							Threshold: 0.05,
+							MinPrices: 4,
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with unknown outlier filter",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					OutlierFilter: config.OutlierFilterConfig{
+						Method:    "zscore",
+						Threshold: 3,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with non-positive outlier threshold",
+			config: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					OutlierFilter: config.OutlierFilterConfig{
+						Method: config.MADOutlierFilter,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with too few outlier min prices",
+			config: config.AggregationConfig{
+				Markets: map[string]config.AggregationStrategyConfig{
+					"BTC/USD": {
+						OutlierFilter: config.OutlierFilterConfig{
+							Method:    config.MaxDeviationOutlierFilter,
+							Threshold: 0.05,
+							MinPrices: 2,
+						},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
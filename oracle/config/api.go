@@ -5,6 +5,16 @@ import (
 	"time"
 )
 
+const (
+	// EndpointSelectionPrimaryBackup queries the first healthy endpoint in the order they are configured, and
+	// fails over to the next endpoint on errors. This is the default.
+	EndpointSelectionPrimaryBackup = "primary_backup"
+
+	// EndpointSelectionRoundRobin rotates requests across the healthy endpoints, and fails over to the next
+	// endpoint on errors.
+	EndpointSelectionRoundRobin = "round_robin"
+)
+
 // APIConfig defines a config for an API based data provider.
 type APIConfig struct {
 	// Enabled indicates if the provider is enabled.
@@ -45,6 +55,23 @@ type APIConfig struct {
 	// block height incremented.  In the case where a data source has exceeded this limit and the block
 	// height is not increasing, price reporting will be skipped until the block height increases.
 	MaxBlockHeightAge time.Duration `json:"maxBlockHeightAge"`
+
+	// EndpointSelection is the strategy used to select the endpoint to query when multiple endpoints are
+	// configured. It must be one of primary_backup or round_robin, and defaults to primary_backup if empty.
+	EndpointSelection string `json:"endpointSelection"`
+
+	// EndpointTimeout is the maximum amount of time a request to a single endpoint may take, so that a
+	// hanging endpoint leaves time to fail over to the remaining endpoints within the Timeout. If zero,
+	// the first endpoint queried is given the full Timeout.
+	EndpointTimeout time.Duration `json:"endpointTimeout"`
+
+	// GenericJSON configures how responses are parsed by the generic JSON provider. It is ignored by
+	// all other providers.
+	GenericJSON *GenericJSONConfig `json:"genericJson"`
+
+	// LightClient configures how the verified market map provider verifies the market map. It is ignored
+	// by all other providers.
+	LightClient *LightClientConfig `json:"lightClient"`
 }
 
 // Endpoint holds all data necessary for an API provider to connect to a given endpoint
@@ -132,5 +159,27 @@ func (c *APIConfig) ValidateBasic() error {
 		return fmt.Errorf("max_block_height_age cannot be negative")
 	}
 
+	if c.EndpointTimeout < 0 || c.EndpointTimeout > c.Timeout {
+		return fmt.Errorf("endpoint timeout must be in [0, timeout]")
+	}
+
+	switch c.EndpointSelection {
+	case "", EndpointSelectionPrimaryBackup, EndpointSelectionRoundRobin:
+	default:
+		return fmt.Errorf("invalid endpoint selection %q", c.EndpointSelection)
+	}
+
+	if c.GenericJSON != nil {
+		if err := c.GenericJSON.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if c.LightClient != nil {
+		if err := c.LightClient.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
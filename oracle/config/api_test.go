@@ -150,6 +150,188 @@ func TestAPIConfig(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "good config with round robin endpoint selection",
+			config: config.APIConfig{
+				Enabled:           true,
+				Timeout:           time.Second,
+				Interval:          time.Second,
+				ReconnectTimeout:  time.Second,
+				MaxQueries:        1,
+				Name:              "test",
+				Endpoints:         []config.Endpoint{{URL: "http://test.com"}, {URL: "http://backup.test.com"}},
+				BatchSize:         1,
+				EndpointSelection: config.EndpointSelectionRoundRobin,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with unknown endpoint selection",
+			config: config.APIConfig{
+				Enabled:           true,
+				Timeout:           time.Second,
+				Interval:          time.Second,
+				ReconnectTimeout:  time.Second,
+				MaxQueries:        1,
+				Name:              "test",
+				Endpoints:         []config.Endpoint{{URL: "http://test.com"}},
+				BatchSize:         1,
+				EndpointSelection: "random",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with endpoint timeout",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://backup.test.com"}},
+				BatchSize:        1,
+				EndpointTimeout:  500 * time.Millisecond,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with endpoint timeout above the timeout",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}, {URL: "http://backup.test.com"}},
+				BatchSize:        1,
+				EndpointTimeout:  2 * time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with generic json",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{ticker}"}},
+				GenericJSON: &config.GenericJSONConfig{
+					PricePath:       "data.price",
+					TimestampFormat: config.TimestampFormatRFC3339,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with generic json without price path",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{ticker}"}},
+				GenericJSON:      &config.GenericJSONConfig{},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with generic json with unknown timestamp format",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com/{ticker}"}},
+				GenericJSON: &config.GenericJSONConfig{
+					PricePath:       "data.price",
+					TimestampFormat: "unix_ns",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with light client",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:26657"}},
+				LightClient: &config.LightClientConfig{
+					ChainID:     "test-chain",
+					TrustHeight: 1,
+					TrustHash:   "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					TrustPeriod: time.Hour,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with light client without chain id",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:26657"}},
+				LightClient: &config.LightClientConfig{
+					TrustHeight: 1,
+					TrustHash:   "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					TrustPeriod: time.Hour,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with light client with invalid trust hash",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:26657"}},
+				LightClient: &config.LightClientConfig{
+					ChainID:     "test-chain",
+					TrustHeight: 1,
+					TrustHash:   "0123",
+					TrustPeriod: time.Hour,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with light client without trust period",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://localhost:26657"}},
+				LightClient: &config.LightClientConfig{
+					ChainID:     "test-chain",
+					TrustHeight: 1,
+					TrustHash:   "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config with negative max_block_height_age",
 			config: config.APIConfig{
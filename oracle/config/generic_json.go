@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// TimestampFormatUnix parses timestamps as seconds since the unix epoch.
+	TimestampFormatUnix = "unix"
+	// TimestampFormatUnixMilli parses timestamps as milliseconds since the unix epoch.
+	TimestampFormatUnixMilli = "unix_ms"
+	// TimestampFormatRFC3339 parses timestamps as RFC 3339 strings.
+	TimestampFormatRFC3339 = "rfc3339"
+)
+
+// GenericJSONConfig configures how the generic JSON REST provider parses the responses of an API. The URL
+// of the API is taken from the provider's endpoints, and may contain a {ticker} placeholder to query a
+// single ticker per request, or a {tickers} placeholder to query a batch of tickers per request.
+//
+// Selectors are JSONPath-like paths of object keys and array indices, e.g. "data.tickers[0].last". A
+// selector may contain a {ticker} placeholder, which is replaced by the off-chain ticker being parsed.
+type GenericJSONConfig struct {
+	// ResultsPath selects the results in the response. If the results are an object, its keys are expected
+	// to be the tickers, unless TickerPath is set. If the results are an array, TickerPath must be set. If
+	// the URL queries a single ticker, or PricePath contains a {ticker} placeholder, ResultsPath selects the
+	// result shared by all tickers. Defaults to the root.
+	ResultsPath string `json:"resultsPath"`
+
+	// TickerPath selects the ticker within each result.
+	TickerPath string `json:"tickerPath"`
+
+	// PricePath selects the price within each result. The price may be a JSON number or string.
+	PricePath string `json:"pricePath"`
+
+	// TimestampPath selects the timestamp within each result. If empty, the time the response was received
+	// is used.
+	TimestampPath string `json:"timestampPath"`
+
+	// TimestampFormat is the format of the timestamp, one of unix, unix_ms or rfc3339. Defaults to unix.
+	TimestampFormat string `json:"timestampFormat"`
+
+	// TickerSeparator is the separator used to join the tickers of a batch in the {tickers} placeholder.
+	// Defaults to ",".
+	TickerSeparator string `json:"tickerSeparator"`
+}
+
+// ValidateBasic performs basic validation of the generic JSON config.
+func (c *GenericJSONConfig) ValidateBasic() error {
+	if len(c.PricePath) == 0 {
+		return fmt.Errorf("generic json price path cannot be empty")
+	}
+
+	switch c.TimestampFormat {
+	case "", TimestampFormatUnix, TimestampFormatUnixMilli, TimestampFormatRFC3339:
+	default:
+		return fmt.Errorf("invalid generic json timestamp format %q", c.TimestampFormat)
+	}
+
+	return nil
+}
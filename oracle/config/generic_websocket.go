@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenericWebSocketConfig configures the messages sent by the generic WebSocket provider, and how it parses
+// the messages it receives.
+//
+// The subscribe message is a JSON template. A {ticker} placeholder is replaced by the off-chain ticker, and
+// a message is sent per ticker. A {tickers} placeholder is replaced by the comma-separated JSON strings of
+// the off-chain tickers of a batch, e.g. {"op": "subscribe", "args": [{tickers}]}, and a message is sent
+// per batch of MaxSubscriptionsPerBatch tickers.
+//
+// Selectors are JSONPath-like paths of object keys and array indices, e.g. "data[0].last". A selector
+// may contain a {ticker} placeholder, which is replaced by the off-chain ticker being parsed.
+type GenericWebSocketConfig struct {
+	// SubscribeMessage is the template of the subscribe message.
+	SubscribeMessage string `json:"subscribeMessage"`
+
+	// HeartbeatMessage is the message sent every PingInterval to keep the connection alive, if set.
+	HeartbeatMessage string `json:"heartbeatMessage"`
+
+	// TypePath selects the message type discriminator of a received message. If empty, every message is
+	// parsed as a price update.
+	TypePath string `json:"typePath"`
+
+	// PriceTypes are the message types that carry price updates. Messages of other types are ignored.
+	PriceTypes []string `json:"priceTypes"`
+
+	// PingType is the message type of the pings sent by the server, if any.
+	PingType string `json:"pingType"`
+
+	// PongMessage is the message sent in response to a ping of PingType.
+	PongMessage string `json:"pongMessage"`
+
+	// ResultsPath selects the results in a price update. The results may be a single result or an array of
+	// results. Defaults to the root.
+	ResultsPath string `json:"resultsPath"`
+
+	// TickerPath selects the ticker within each result. It may only be empty if PricePath contains a
+	// {ticker} placeholder, in which case the price of every subscribed ticker is selected from the results.
+	TickerPath string `json:"tickerPath"`
+
+	// PricePath selects the price within each result. The price may be a JSON number or string.
+	PricePath string `json:"pricePath"`
+
+	// TimestampPath selects the timestamp within each result. If empty, the time the message was received
+	// is used.
+	TimestampPath string `json:"timestampPath"`
+
+	// TimestampFormat is the format of the timestamp, one of unix, unix_ms or rfc3339. Defaults to unix.
+	TimestampFormat string `json:"timestampFormat"`
+}
+
+// ValidateBasic performs basic validation of the generic WebSocket config.
+func (c *GenericWebSocketConfig) ValidateBasic() error {
+	if len(c.SubscribeMessage) == 0 {
+		return fmt.Errorf("generic websocket subscribe message cannot be empty")
+	}
+
+	if len(c.PricePath) == 0 {
+		return fmt.Errorf("generic websocket price path cannot be empty")
+	}
+
+	if len(c.TickerPath) == 0 && !strings.Contains(c.PricePath, "{ticker}") {
+		return fmt.Errorf("generic websocket ticker path cannot be empty unless the price path contains {ticker}")
+	}
+
+	if len(c.TypePath) > 0 && len(c.PriceTypes) == 0 {
+		return fmt.Errorf("generic websocket price types cannot be empty if a type path is set")
+	}
+
+	if (len(c.PingType) == 0) != (len(c.PongMessage) == 0) {
+		return fmt.Errorf("generic websocket ping type and pong message must be set together")
+	}
+
+	if len(c.PingType) > 0 && len(c.TypePath) == 0 {
+		return fmt.Errorf("generic websocket type path must be set to respond to pings")
+	}
+
+	switch c.TimestampFormat {
+	case "", TimestampFormatUnix, TimestampFormatUnixMilli, TimestampFormatRFC3339:
+	default:
+		return fmt.Errorf("invalid generic websocket timestamp format %q", c.TimestampFormat)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// LightClientConfig configures the light client used by the verified market map provider to verify the
+// market map it fetches from a CometBFT RPC endpoint. The light client is initialized from a trusted
+// header (the trust root), and verifies every subsequent header against the validator set of the last
+// header it trusts.
+type LightClientConfig struct {
+	// ChainID is the chain ID of the chain the market map is fetched from.
+	ChainID string `json:"chainId"`
+
+	// TrustHeight is the height of the trusted header.
+	TrustHeight int64 `json:"trustHeight"`
+
+	// TrustHash is the hex-encoded hash of the trusted header.
+	TrustHash string `json:"trustHash"`
+
+	// TrustPeriod is the period for which a trusted header can be used to verify new headers. It should be
+	// significantly less than the unbonding period of the chain.
+	TrustPeriod time.Duration `json:"trustPeriod"`
+
+	// MaxClockDrift is the maximum amount of time the time of a header may be ahead of the local clock.
+	MaxClockDrift time.Duration `json:"maxClockDrift"`
+
+	// MaxBlockAge is the maximum age of the latest header served by the RPC endpoint. Older headers are
+	// rejected, so that a lagging node cannot serve an outdated market map. If zero, the age of the latest
+	// header is not checked.
+	MaxBlockAge time.Duration `json:"maxBlockAge"`
+}
+
+// ValidateBasic performs basic validation of the light client config.
+func (c *LightClientConfig) ValidateBasic() error {
+	if len(c.ChainID) == 0 {
+		return fmt.Errorf("light client chain id cannot be empty")
+	}
+
+	if c.TrustHeight <= 0 {
+		return fmt.Errorf("light client trust height must be strictly positive")
+	}
+
+	if _, err := c.TrustHashBytes(); err != nil {
+		return err
+	}
+
+	if c.TrustPeriod <= 0 {
+		return fmt.Errorf("light client trust period must be strictly positive")
+	}
+
+	if c.MaxClockDrift < 0 || c.MaxBlockAge < 0 {
+		return fmt.Errorf("light client max clock drift and max block age cannot be negative")
+	}
+
+	return nil
+}
+
+// TrustHashBytes returns the decoded hash of the trusted header.
+func (c *LightClientConfig) TrustHashBytes() ([]byte, error) {
+	hash, err := hex.DecodeString(c.TrustHash)
+	if err != nil {
+		return nil, fmt.Errorf("light client trust hash must be hex-encoded: %w", err)
+	}
+
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("light client trust hash must be 32 bytes, got %d", len(hash))
+	}
+
+	return hash, nil
+}
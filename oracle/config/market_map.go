@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+)
+
+// MarketMapConfig configures how the oracle combines the market maps of its market map providers
+// (i.e. the on-chain x/marketmap module of one or more chains) into the market map it fetches
+// prices for.
+type MarketMapConfig struct {
+	// Sources are the names of the market map providers the oracle follows, ordered from the
+	// highest to the lowest priority. A market that is defined by several providers is taken from
+	// the provider with the highest priority, and markets that are only defined by a single provider
+	// are always included. Sources must be set if more than one market map provider is configured.
+	Sources []string `json:"sources"`
+}
+
+// ValidateBasic performs basic validation of the market map config.
+func (c *MarketMapConfig) ValidateBasic() error {
+	seen := make(map[string]struct{}, len(c.Sources))
+	for _, source := range c.Sources {
+		if len(source) == 0 {
+			return fmt.Errorf("market map source cannot be empty")
+		}
+
+		if _, ok := seen[source]; ok {
+			return fmt.Errorf("duplicate market map source: %s", source)
+		}
+		seen[source] = struct{}{}
+	}
+
+	return nil
+}
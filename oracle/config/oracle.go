@@ -26,6 +26,12 @@ type OracleConfig struct {
 	// Metrics is the metrics configurations for the oracle.
 	Metrics MetricsConfig `json:"metrics"`
 
+	// Aggregation configures how the oracle aggregates provider prices for each market.
+	Aggregation AggregationConfig `json:"aggregation"`
+
+	// MarketMap configures how the oracle combines the market maps of its market map providers.
+	MarketMap MarketMapConfig `json:"marketMap"`
+
 	// Host is the host that the oracle will listen on.
 	Host string `json:"host"`
 
@@ -57,6 +63,20 @@ func (c *OracleConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle port cannot be empty")
 	}
 
+	if err := c.Aggregation.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := c.MarketMap.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, source := range c.MarketMap.Sources {
+		if _, ok := c.Providers[source]; !ok {
+			return fmt.Errorf("market map source %s is not a configured provider", source)
+		}
+	}
+
 	return c.Metrics.ValidateBasic()
 }
 
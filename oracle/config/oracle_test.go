@@ -49,6 +49,117 @@ func TestOracleConfig(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "good config with market map sources",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Providers: map[string]config.ProviderConfig{
+					"test": {
+						Name: "test",
+						WebSocket: config.WebSocketConfig{
+							Enabled:             true,
+							MaxBufferSize:       1,
+							ReconnectionTimeout: time.Second,
+							Endpoints: []config.Endpoint{
+								{
+									URL: "wss://test.com",
+								},
+							},
+							Name:                     "test",
+							ReadBufferSize:           config.DefaultReadBufferSize,
+							WriteBufferSize:          config.DefaultWriteBufferSize,
+							HandshakeTimeout:         config.DefaultHandshakeTimeout,
+							EnableCompression:        config.DefaultEnableCompression,
+							ReadTimeout:              config.DefaultReadTimeout,
+							WriteTimeout:             config.DefaultWriteTimeout,
+							MaxSubscriptionsPerBatch: config.DefaultMaxSubscriptionsPerBatch,
+						},
+						Type: "price_provider",
+					},
+				},
+				MarketMap: config.MarketMapConfig{
+					Sources: []string{"test"},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with unknown market map source",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Providers: map[string]config.ProviderConfig{
+					"test": {
+						Name: "test",
+						WebSocket: config.WebSocketConfig{
+							Enabled:             true,
+							MaxBufferSize:       1,
+							ReconnectionTimeout: time.Second,
+							Endpoints: []config.Endpoint{
+								{
+									URL: "wss://test.com",
+								},
+							},
+							Name:                     "test",
+							ReadBufferSize:           config.DefaultReadBufferSize,
+							WriteBufferSize:          config.DefaultWriteBufferSize,
+							HandshakeTimeout:         config.DefaultHandshakeTimeout,
+							EnableCompression:        config.DefaultEnableCompression,
+							ReadTimeout:              config.DefaultReadTimeout,
+							WriteTimeout:             config.DefaultWriteTimeout,
+							MaxSubscriptionsPerBatch: config.DefaultMaxSubscriptionsPerBatch,
+						},
+						Type: "price_provider",
+					},
+				},
+				MarketMap: config.MarketMapConfig{
+					Sources: []string{"test", "unknown"},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with duplicate market map source",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Providers: map[string]config.ProviderConfig{
+					"test": {
+						Name: "test",
+						WebSocket: config.WebSocketConfig{
+							Enabled:             true,
+							MaxBufferSize:       1,
+							ReconnectionTimeout: time.Second,
+							Endpoints: []config.Endpoint{
+								{
+									URL: "wss://test.com",
+								},
+							},
+							Name:                     "test",
+							ReadBufferSize:           config.DefaultReadBufferSize,
+							WriteBufferSize:          config.DefaultWriteBufferSize,
+							HandshakeTimeout:         config.DefaultHandshakeTimeout,
+							EnableCompression:        config.DefaultEnableCompression,
+							ReadTimeout:              config.DefaultReadTimeout,
+							WriteTimeout:             config.DefaultWriteTimeout,
+							MaxSubscriptionsPerBatch: config.DefaultMaxSubscriptionsPerBatch,
+						},
+						Type: "price_provider",
+					},
+				},
+				MarketMap: config.MarketMapConfig{
+					Sources: []string{"test", "test"},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config w/ no max-price-age",
 			config: config.OracleConfig{
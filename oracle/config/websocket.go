@@ -141,6 +141,10 @@ type WebSocketConfig struct {
 	// MaxSubscriptionsPerBatch is the maximum number of subscription messages that the
 	// provider will send in a single batch/write.
 	MaxSubscriptionsPerBatch int `json:"maxSubscriptionsPerBatch"`
+
+	// GenericWebSocket configures the messages of the generic WebSocket provider. It is ignored by all other
+	// providers.
+	GenericWebSocket *GenericWebSocketConfig `json:"genericWebSocket"`
 }
 
 // ValidateBasic performs basic validation of the websocket config.
@@ -215,5 +219,11 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket max subscriptions per batch must be greater than 0")
 	}
 
+	if c.GenericWebSocket != nil {
+		if err := c.GenericWebSocket.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
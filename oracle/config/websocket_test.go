@@ -438,6 +438,140 @@ func TestWebSocketConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with generic websocket",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints:                     []config.Endpoint{{URL: "wss://test.com"}},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+				GenericWebSocket: &config.GenericWebSocketConfig{
+					SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+					TypePath:         "channel",
+					PriceTypes:       []string{"ticker"},
+					TickerPath:       "data.s",
+					PricePath:        "data.p",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with generic websocket without subscribe message",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints:                     []config.Endpoint{{URL: "wss://test.com"}},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+				GenericWebSocket: &config.GenericWebSocketConfig{
+					TickerPath: "data.s",
+					PricePath:  "data.p",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with generic websocket without ticker path",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints:                     []config.Endpoint{{URL: "wss://test.com"}},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+				GenericWebSocket: &config.GenericWebSocketConfig{
+					SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+					PricePath:        "data.p",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with generic websocket with type path and no price types",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints:                     []config.Endpoint{{URL: "wss://test.com"}},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+				GenericWebSocket: &config.GenericWebSocketConfig{
+					SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+					TypePath:         "channel",
+					TickerPath:       "data.s",
+					PricePath:        "data.p",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with generic websocket with ping type and no pong message",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				PostConnectionTimeout:         config.DefaultPostConnectionTimeout,
+				Name:                          "test",
+				Endpoints:                     []config.Endpoint{{URL: "wss://test.com"}},
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
+				GenericWebSocket: &config.GenericWebSocketConfig{
+					SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+					TypePath:         "channel",
+					PriceTypes:       []string{"ticker"},
+					PingType:         "ping",
+					TickerPath:       "data.s",
+					PricePath:        "data.p",
+				},
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
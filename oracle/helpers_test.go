@@ -1,6 +1,7 @@
 package oracle_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -337,6 +338,16 @@ func createTestMarketMapProvider(
 ) (*mocks.APIDataHandler[mmclienttypes.Chain, *mmtypes.MarketMapResponse], *mmclienttypes.MarketMapProvider) {
 	t.Helper()
 
+	return createNamedTestMarketMapProvider(t, mockMapperCfg.Name, ids)
+}
+
+func createNamedTestMarketMapProvider(
+	t *testing.T,
+	name string,
+	ids []mmclienttypes.Chain,
+) (*mocks.APIDataHandler[mmclienttypes.Chain, *mmtypes.MarketMapResponse], *mmclienttypes.MarketMapProvider) {
+	t.Helper()
+
 	// Create a market map api handler.
 	handler := mocks.NewAPIDataHandler[mmclienttypes.Chain, *mmtypes.MarketMapResponse](
 		t,
@@ -354,7 +365,7 @@ func createTestMarketMapProvider(
 	var provider *mmclienttypes.MarketMapProvider
 	if len(ids) != 0 {
 		provider, err = mmclienttypes.NewMarketMapProvider(
-			base.WithName[mmclienttypes.Chain, *mmtypes.MarketMapResponse](mockMapperCfg.Name),
+			base.WithName[mmclienttypes.Chain, *mmtypes.MarketMapResponse](name),
 			base.WithLogger[mmclienttypes.Chain, *mmtypes.MarketMapResponse](logger),
 			base.WithAPIQueryHandler(queryHandler),
 			base.WithAPIConfig[mmclienttypes.Chain, *mmtypes.MarketMapResponse](mockMapperCfg.API),
@@ -363,7 +374,7 @@ func createTestMarketMapProvider(
 		)
 	} else {
 		provider, err = mmclienttypes.NewMarketMapProvider(
-			base.WithName[mmclienttypes.Chain, *mmtypes.MarketMapResponse](mockMapperCfg.Name),
+			base.WithName[mmclienttypes.Chain, *mmtypes.MarketMapResponse](name),
 			base.WithLogger[mmclienttypes.Chain, *mmtypes.MarketMapResponse](logger),
 			base.WithAPIQueryHandler(queryHandler),
 			base.WithAPIConfig[mmclienttypes.Chain, *mmtypes.MarketMapResponse](mockMapperCfg.API),
@@ -396,6 +407,25 @@ func marketMapperFactory(
 	}
 }
 
+// multiMarketMapperFactory returns a market map factory that returns the given providers by name.
+func multiMarketMapperFactory(
+	providers map[string]*mmclienttypes.MarketMapProvider,
+) mmclienttypes.MarketMapFactory {
+	return func(
+		_ *zap.Logger,
+		_ providermetrics.ProviderMetrics,
+		_ apimetrics.APIMetrics,
+		cfg config.ProviderConfig,
+	) (*mmclienttypes.MarketMapProvider, error) {
+		provider, ok := providers[cfg.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown market map provider %s", cfg.Name)
+		}
+
+		return provider, nil
+	}
+}
+
 func copyConfig(cfg config.OracleConfig) config.OracleConfig {
 	// copy providers map
 	newCfg := cfg
@@ -18,6 +18,7 @@ func (o *OracleImpl) Init(ctx context.Context) error {
 	o.mut.Lock()
 	defer o.mut.Unlock()
 
+	mmProviders := make(map[string]*mmclienttypes.MarketMapProvider)
 	for _, cfg := range o.cfg.Providers {
 		// Initialize the provider.
 		var err error
@@ -25,7 +26,7 @@ func (o *OracleImpl) Init(ctx context.Context) error {
 		case types.ConfigType:
 			err = o.createPriceProvider(ctx, cfg)
 		case mmclienttypes.ConfigType:
-			err = o.createMarketMapProvider(cfg)
+			mmProviders[cfg.Name], err = o.createMarketMapProvider(cfg)
 		default:
 			err = fmt.Errorf("unknown provider type: %s", cfg.Type)
 		}
@@ -41,16 +42,45 @@ func (o *OracleImpl) Init(ctx context.Context) error {
 		}
 	}
 
+	// Order the market map providers by their priority.
+	var err error
+	o.mmProviders, err = o.orderMarketMapProviders(mmProviders)
+	if err != nil {
+		o.logger.Error("failed to order market map providers", zap.Error(err))
+		return err
+	}
+
 	return nil
 }
 
-// createPriceProvider creates a new price provider for the given provider configuration.
+// createPriceProvider creates a new price provider for the given provider configuration and adds it to
+// the oracle.
 func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.ProviderConfig) error {
+	state, err := o.newPriceProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Add the provider to the oracle.
+	o.priceProviders[state.Provider.Name()] = state
+
+	// Add the provider name to the message here since we want these to ignore log sampling limits
+	o.logger.Info(
+		fmt.Sprintf("created %s provider state", state.Provider.Name()),
+		zap.String("provider", state.Provider.Name()),
+		zap.Int("num_tickers", len(state.Provider.GetIDs())),
+	)
+	return nil
+}
+
+// newPriceProvider creates a new price provider for the given provider configuration, without adding it
+// to the oracle.
+func (o *OracleImpl) newPriceProvider(ctx context.Context, cfg config.ProviderConfig) (ProviderState, error) {
 	// Create the provider market map. This creates the tickers the provider is configured to
 	// support.
 	tickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
 	if err != nil {
-		return fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
+		return ProviderState{}, fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
 	}
 
 	// Select the query handler based on the provider's configuration.
@@ -59,7 +89,7 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 	case cfg.API.Enabled:
 		queryHandler, err := o.createAPIQueryHandler(ctx, cfg)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
 		}
 
 		provider, err = types.NewPriceProvider(
@@ -71,12 +101,12 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
 		}
 	case cfg.WebSocket.Enabled:
 		queryHandler, err := o.createWebSocketQueryHandler(ctx, cfg)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
 		}
 
 		provider, err = types.NewPriceProvider(
@@ -88,27 +118,16 @@ func (o *OracleImpl) createPriceProvider(ctx context.Context, cfg config.Provide
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
+			return ProviderState{}, fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
 		}
 	default:
-		return fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
+		return ProviderState{}, fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
 	}
 
-	state := ProviderState{
+	return ProviderState{
 		Provider: provider,
 		Cfg:      cfg,
-	}
-
-	// Add the provider to the oracle.
-	o.priceProviders[provider.Name()] = state
-
-	// Add the provider name to the message here since we want these to ignore log sampling limits
-	o.logger.Info(
-		fmt.Sprintf("created %s provider state", provider.Name()),
-		zap.String("provider", provider.Name()),
-		zap.Int("num_tickers", len(provider.GetIDs())),
-	)
-	return nil
+	}, nil
 }
 
 // createAPIQueryHandler creates a new API query handler for the given provider configuration.
@@ -136,9 +155,9 @@ func (o *OracleImpl) createWebSocketQueryHandler(
 }
 
 // createMarketMapProvider creates a new market map provider for the given provider configuration.
-func (o *OracleImpl) createMarketMapProvider(cfg config.ProviderConfig) error {
+func (o *OracleImpl) createMarketMapProvider(cfg config.ProviderConfig) (*mmclienttypes.MarketMapProvider, error) {
 	if o.marketMapperFactory == nil {
-		return fmt.Errorf("cannot create market map provider; market map factory is not set")
+		return nil, fmt.Errorf("cannot create market map provider; market map factory is not set")
 	}
 
 	mapper, err := o.marketMapperFactory(
@@ -148,13 +167,49 @@ func (o *OracleImpl) createMarketMapProvider(cfg config.ProviderConfig) error {
 		cfg,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to create market map provider (%s): %w", cfg.Name, err)
+		return nil, fmt.Errorf("failed to create market map provider (%s): %w", cfg.Name, err)
 	}
 
-	o.mmProvider = mapper
 	o.logger.Info(
 		"created market map provider",
 		zap.String("provider", mapper.Name()),
 	)
-	return nil
+	return mapper, nil
+}
+
+// orderMarketMapProviders orders the given market map providers, indexed by name, from the highest to the
+// lowest priority as configured by the market map sources. The sources may only be omitted if there is at
+// most one market map provider.
+func (o *OracleImpl) orderMarketMapProviders(
+	providers map[string]*mmclienttypes.MarketMapProvider,
+) ([]*mmclienttypes.MarketMapProvider, error) {
+	sources := o.cfg.MarketMap.Sources
+	if len(sources) == 0 {
+		if len(providers) > 1 {
+			return nil, fmt.Errorf("market map sources must be configured to use more than one market map provider")
+		}
+
+		ordered := make([]*mmclienttypes.MarketMapProvider, 0, len(providers))
+		for _, provider := range providers {
+			ordered = append(ordered, provider)
+		}
+
+		return ordered, nil
+	}
+
+	if len(sources) != len(providers) {
+		return nil, fmt.Errorf("expected %d market map sources; got %d", len(providers), len(sources))
+	}
+
+	ordered := make([]*mmclienttypes.MarketMapProvider, len(sources))
+	for i, source := range sources {
+		provider, ok := providers[source]
+		if !ok {
+			return nil, fmt.Errorf("market map source %s is not a market map provider", source)
+		}
+
+		ordered[i] = provider
+	}
+
+	return ordered, nil
 }
@@ -15,6 +15,7 @@ import (
 	oraclefactory "github.com/skip-mev/connect/v2/providers/factories/oracle"
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 	"github.com/skip-mev/connect/v2/providers/websockets/okx"
+	mmclienttypes "github.com/skip-mev/connect/v2/service/clients/marketmap/types"
 )
 
 var (
@@ -222,8 +223,81 @@ func TestInit(t *testing.T) {
 		err = o.Init(context.TODO())
 		require.NoError(t, err)
 
-		mapper := o.GetMarketMapProvider()
-		require.NotNil(t, mapper)
+		mappers := o.GetMarketMapProviders()
+		require.Len(t, mappers, 1)
+	})
+
+	t.Run("errors when multiple market map providers have no sources", func(t *testing.T) {
+		cfg := copyConfig(oracleCfgWithMockMapper)
+		cfg.Providers[mapperCfg.Name] = mapperCfg
+
+		_, mockMapper := createTestMarketMapProvider(t, nil)
+		_, mapper := createNamedTestMarketMapProvider(t, mapperCfg.Name, nil)
+		orc, err := oracle.New(
+			cfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMapperFactory(multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+				mockMapperCfg.Name: mockMapper,
+				mapperCfg.Name:     mapper,
+			})),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+
+		require.Error(t, o.Init(context.TODO()))
+	})
+
+	t.Run("errors when a market map source is not a market map provider", func(t *testing.T) {
+		cfg := copyConfig(oracleCfgWithMockMapper)
+		cfg.MarketMap.Sources = []string{mockMapperCfg.Name, coinbase.Name}
+
+		_, mockMapper := createTestMarketMapProvider(t, nil)
+		orc, err := oracle.New(
+			cfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMapperFactory(multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+				mockMapperCfg.Name: mockMapper,
+			})),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+
+		require.Error(t, o.Init(context.TODO()))
+	})
+
+	t.Run("orders multiple market map providers by their sources", func(t *testing.T) {
+		cfg := copyConfig(oracleCfgWithMockMapper)
+		cfg.Providers[mapperCfg.Name] = mapperCfg
+		cfg.MarketMap.Sources = []string{mapperCfg.Name, mockMapperCfg.Name}
+
+		_, mockMapper := createTestMarketMapProvider(t, nil)
+		_, mapper := createNamedTestMarketMapProvider(t, mapperCfg.Name, nil)
+		orc, err := oracle.New(
+			cfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMapperFactory(multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+				mockMapperCfg.Name: mockMapper,
+				mapperCfg.Name:     mapper,
+			})),
+		)
+		require.NoError(t, err)
+		o := orc.(*oracle.OracleImpl)
+
+		require.NoError(t, o.Init(context.TODO()))
+
+		mappers := o.GetMarketMapProviders()
+		require.Len(t, mappers, 2)
+		require.Equal(t, mapperCfg.Name, mappers[0].Name())
+		require.Equal(t, mockMapperCfg.Name, mappers[1].Name())
 	})
 
 	t.Run("errors when the market map factory is not set", func(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -15,9 +16,12 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetPriceDetails() types.PriceDetails
 	GetMarketMap() mmtypes.MarketMap
+	SubscribePrices(ctx context.Context) <-chan time.Time
 	Start(ctx context.Context) error
 	Stop()
+	UpdateConfig(cfg config.OracleConfig) error
 }
 
 // PriceAggregator is an interface for aggregating prices from multiple providers. Implementations of PriceAggregator
@@ -32,6 +36,20 @@ type PriceAggregator interface {
 	Reset()
 }
 
+// PriceDetailsAggregator is an optional extension of the PriceAggregator interface for aggregators that
+// retain the provenance of each aggregated price, i.e. the raw provider prices, their timestamps and the
+// conversions applied to them.
+//
+//go:generate mockery --name PriceDetailsAggregator
+type PriceDetailsAggregator interface {
+	PriceAggregator
+
+	// SetProviderResults sets the raw results (including stale results) most recently returned by the provider.
+	SetProviderResults(provider string, results types.ResolvedPrices)
+	// GetPriceDetails returns the provenance of the most recently aggregated prices.
+	GetPriceDetails() types.PriceDetails
+}
+
 // generalProvider is an interface for a provider that implements the base provider.
 type generalProvider interface {
 	// Start starts the provider.
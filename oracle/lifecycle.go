@@ -40,15 +40,17 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 		}
 	}
 
-	// Start the market map provider.
-	if o.mmProvider != nil {
-		o.logger.Info("starting marketmap provider")
-
-		o.wg.Add(1)
-		go func() {
-			defer o.wg.Done()
-			o.execProviderFn(ctx, o.mmProvider)
-		}()
+	// Start the market map providers.
+	if len(o.mmProviders) > 0 {
+		for _, mmProvider := range o.mmProviders {
+			o.logger.Info("starting marketmap provider", zap.String("provider", mmProvider.Name()))
+
+			o.wg.Add(1)
+			go func() {
+				defer o.wg.Done()
+				o.execProviderFn(ctx, mmProvider)
+			}()
+		}
 
 		o.wg.Add(1)
 		go func() {
@@ -107,8 +107,8 @@ func TestStart(t *testing.T) {
 		state := o.GetProviderState()
 		require.Equal(t, len(state), len(oracleCfgWithMapper.Providers)-1)
 
-		mapper := o.GetMarketMapProvider()
-		require.NotNil(t, mapper)
+		mappers := o.GetMarketMapProviders()
+		require.Len(t, mappers, 1)
 
 		// Stop the oracle.
 		o.Stop()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"strings"
 	"time"
@@ -59,39 +60,34 @@ func (o *OracleImpl) IsMarketMapValidUpdated(resp *mmtypes.MarketMapResponse) (m
 }
 
 // listenForMarketMapUpdates is a goroutine that listens for market map updates and
-// updates the orchestrated providers with the new market map. This method assumes a market map provider is present,
-// so callers of this method must nil check the provider first.
+// updates the orchestrated providers with the new market map. The market maps of all market map
+// providers are merged by priority, along with the local market map override. This method assumes
+// at least one market map provider is present, so callers of this method must check the providers first.
 func (o *OracleImpl) listenForMarketMapUpdates(ctx context.Context) {
-	mmProvider := o.mmProvider
-	ids := mmProvider.GetIDs()
-	if len(ids) != 1 {
-		o.logger.Error("market map provider can only be responsible for one chain", zap.Any("ids", ids))
-		return
+	// Poll the market map providers at the rate of the most frequently updated provider.
+	interval := o.mmProviders[0].GetAPIConfig().Interval
+	sources := make([]string, len(o.mmProviders))
+	for i, mmProvider := range o.mmProviders {
+		interval = min(interval, mmProvider.GetAPIConfig().Interval)
+		sources[i] = mmProvider.Name()
 	}
 
-	apiCfg := mmProvider.GetAPIConfig()
-	ticker := time.NewTicker(apiCfg.Interval)
-	chain := ids[0]
-	o.logger.Info("listening for market map updates", zap.String("chain", chain.String()))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	o.logger.Info("listening for market map updates", zap.Strings("sources", sources))
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			// Fetch the latest market map.
-			response := mmProvider.GetData()
-			if response == nil {
-				o.logger.Info("market map provider returned nil response")
-				continue
-			}
-
-			result, ok := response[chain]
+			// Fetch and merge the latest market maps.
+			response, ok := o.fetchMarketMap()
 			if !ok {
-				o.logger.Debug("market map provider response missing chain", zap.Any("chain", chain))
 				continue
 			}
 
-			newMarketMap, isUpdated, err := o.IsMarketMapValidUpdated(result.Value)
+			newMarketMap, isUpdated, err := o.IsMarketMapValidUpdated(response)
 			if err != nil {
 				o.logger.Error("failed to check new market map", zap.Error(err))
 				continue
@@ -107,7 +103,7 @@ func (o *OracleImpl) listenForMarketMapUpdates(ctx context.Context) {
 				continue
 			}
 
-			o.lastUpdated = result.Value.GetLastUpdated()
+			o.lastUpdated = response.GetLastUpdated()
 
 			// Write the market map to the configured path.
 			if err := o.WriteMarketMap(); err != nil {
@@ -120,6 +116,114 @@ func (o *OracleImpl) listenForMarketMapUpdates(ctx context.Context) {
 	}
 }
 
+// fetchMarketMap returns the latest market maps of all market map providers, merged by priority along
+// with the local market map override. The market map of each chain is reduced to its valid subset before
+// it is merged, and the merged market map must pass validation. The last updated height is that of the
+// highest priority chain. This returns false if the highest priority provider, i.e. the first of the market
+// map sources, has not returned a valid market map yet, so that the markets of lower priority providers are
+// not applied before they can be overridden. It also returns false if the merged market map is invalid.
+func (o *OracleImpl) fetchMarketMap() (*mmtypes.MarketMapResponse, bool) {
+	var (
+		marketMaps  = []mmtypes.MarketMap{o.marketMapOverride}
+		lastUpdated uint64
+		found       bool
+	)
+	for i, mmProvider := range o.mmProviders {
+		// do not merge the lower priority market maps until the highest priority provider has a result
+		if i > 0 && !found {
+			o.logger.Info(
+				"skipping market map merge until the highest priority provider returns a market map",
+				zap.String("provider", o.mmProviders[0].Name()),
+			)
+			return nil, false
+		}
+
+		response := mmProvider.GetData()
+		if response == nil {
+			o.logger.Info("market map provider returned nil response", zap.String("provider", mmProvider.Name()))
+			continue
+		}
+
+		for _, chain := range mmProvider.GetIDs() {
+			result, ok := response[chain]
+			if !ok || result.Value == nil {
+				o.logger.Debug(
+					"market map provider response missing chain",
+					zap.String("provider", mmProvider.Name()),
+					zap.Any("chain", chain),
+				)
+				continue
+			}
+
+			validSubset, err := result.Value.MarketMap.GetValidSubset()
+			if err != nil {
+				o.logger.Error(
+					"failed to validate market map",
+					zap.String("provider", mmProvider.Name()),
+					zap.Any("chain", chain),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			if !found {
+				lastUpdated = result.Value.GetLastUpdated()
+				found = true
+			}
+			marketMaps = append(marketMaps, validSubset)
+		}
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	// the merged market map may be invalid even though each market map is valid, in which case it is reduced to
+	// its valid subset
+	merged := MergeMarketMaps(marketMaps...)
+	if err := merged.ValidateBasic(); err != nil {
+		o.logger.Warn("merged market map is invalid", zap.Error(err))
+
+		validSubset, err := merged.GetValidSubset()
+		if err != nil {
+			o.logger.Error("failed to validate merged market map", zap.Error(err))
+			return nil, false
+		}
+
+		var droppedMarkets []string
+		for t := range merged.Markets {
+			if _, in := validSubset.Markets[t]; !in {
+				droppedMarkets = append(droppedMarkets, t)
+			}
+		}
+		o.logger.Info("markets dropped from invalid merged market map", zap.String("markets", strings.Join(droppedMarkets, " ")))
+
+		merged = validSubset
+	}
+
+	return &mmtypes.MarketMapResponse{
+		MarketMap:   merged,
+		LastUpdated: lastUpdated,
+	}, true
+}
+
+// MergeMarketMaps merges the given market maps, which are ordered from the highest to the lowest priority.
+// A market that is defined by several market maps is taken from the market map with the highest priority
+// in its entirety, i.e. its ticker and provider configs are not merged. Markets that are only defined by a
+// single market map are always included. Note that the merged market map may not be valid, e.g. if a market
+// is normalized by a market that was overridden and disabled.
+func MergeMarketMaps(marketMaps ...mmtypes.MarketMap) mmtypes.MarketMap {
+	merged := mmtypes.MarketMap{
+		Markets: make(map[string]mmtypes.Market),
+	}
+
+	for i := len(marketMaps) - 1; i >= 0; i-- {
+		maps.Copy(merged.Markets, marketMaps[i].Markets)
+	}
+
+	return merged
+}
+
 // WriteMarketMap writes the oracle's market map to the configured path.
 func (o *OracleImpl) WriteMarketMap() error {
 	if len(o.writeTo) == 0 {
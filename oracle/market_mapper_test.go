@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	oraclefactory "github.com/skip-mev/connect/v2/providers/factories/oracle"
 	"github.com/skip-mev/connect/v2/providers/providertest"
@@ -110,6 +111,17 @@ var (
 		btcusdt.Ticker.String(): btcusdt,
 		usdtusd.Ticker.String(): usdtusd,
 	}
+
+	// btcusdt with a different provider, used to override btcusdt.
+	btcusdtOverride = mmtypes.Market{
+		Ticker: btcusdt.Ticker,
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{
+				Name:           "okx",
+				OffChainTicker: "BTC-USDT",
+			},
+		},
+	}
 )
 
 func TestListenForMarketMapUpdates(t *testing.T) {
@@ -435,6 +447,267 @@ func TestListenForMarketMapUpdates(t *testing.T) {
 	})
 }
 
+func TestListenForMergedMarketMapUpdates(t *testing.T) {
+	// mockMarketMapProvider returns a market map provider that always returns the given market map.
+	mockMarketMapProvider := func(t *testing.T, name string, marketMap mmtypes.MarketMap) *mmclienttypes.MarketMapProvider {
+		t.Helper()
+
+		chains := []mmclienttypes.Chain{{ChainID: name}}
+		handler, provider := createNamedTestMarketMapProvider(t, name, chains)
+		handler.On("CreateURL", mock.Anything).Return("", nil).Maybe()
+
+		resolved := make(mmclienttypes.ResolvedMarketMap)
+		resolved[chains[0]] = mmclienttypes.NewMarketMapResult(&mmtypes.MarketMapResponse{MarketMap: marketMap}, time.Now())
+		handler.On("ParseResponse", mock.Anything, mock.Anything).Return(mmclienttypes.NewMarketMapResponse(resolved, nil)).Maybe()
+
+		return provider
+	}
+
+	// mapperCfg returns a market map provider config with the given name.
+	mapperCfg := func(name string) config.ProviderConfig {
+		cfg := mockMapperCfg
+		cfg.Name = name
+		cfg.API.Name = name
+		return cfg
+	}
+
+	startOracle := func(t *testing.T, o oracle.Oracle) {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			err := o.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Start() should have returned context.Canceled error")
+			}
+		}()
+		t.Cleanup(func() {
+			cancel()
+			o.Stop()
+		})
+	}
+
+	t.Run("merges the market maps of multiple providers by priority", func(t *testing.T) {
+		cfg := copyConfig(oracleCfgWithOnlyMockMapper)
+		cfg.Providers = map[string]config.ProviderConfig{
+			"primary":   mapperCfg("primary"),
+			"secondary": mapperCfg("secondary"),
+		}
+		cfg.MarketMap.Sources = []string{"primary", "secondary"}
+
+		factory := multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+			"primary": mockMarketMapProvider(t, "primary", mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btcusdtOverride.Ticker.String(): btcusdtOverride,
+				},
+			}),
+			"secondary": mockMarketMapProvider(t, "secondary", mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btcusdt.Ticker.String(): btcusdt,
+					usdtusd.Ticker.String(): usdtusd,
+				},
+			}),
+		})
+
+		o, err := oracle.New(
+			cfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithMarketMapperFactory(factory),
+		)
+		require.NoError(t, err)
+		startOracle(t, o)
+
+		expected := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcusdtOverride.Ticker.String(): btcusdtOverride,
+				usdtusd.Ticker.String():         usdtusd,
+			},
+		}
+		require.Eventually(t, func() bool {
+			return expected.Equal(o.GetMarketMap())
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("does not merge the market maps until the highest priority provider returns a market map", func(t *testing.T) {
+		cfg := copyConfig(oracleCfgWithOnlyMockMapper)
+		cfg.Providers = map[string]config.ProviderConfig{
+			"primary":   mapperCfg("primary"),
+			"secondary": mapperCfg("secondary"),
+		}
+		cfg.MarketMap.Sources = []string{"primary", "secondary"}
+
+		// the primary provider fails to fetch its market map
+		handler, primary := createNamedTestMarketMapProvider(t, "primary", []mmclienttypes.Chain{{ChainID: "primary"}})
+		handler.On("CreateURL", mock.Anything).Return("", fmt.Errorf("failed to create url")).Maybe()
+
+		factory := multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+			"primary": primary,
+			"secondary": mockMarketMapProvider(t, "secondary", mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btcusdt.Ticker.String(): btcusdt,
+					usdtusd.Ticker.String(): usdtusd,
+				},
+			}),
+		})
+
+		o, err := oracle.New(
+			cfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithMarketMapperFactory(factory),
+		)
+		require.NoError(t, err)
+		current := o.GetMarketMap()
+		startOracle(t, o)
+
+		// Wait for the oracle to start.
+		time.Sleep(2000 * time.Millisecond)
+
+		// The market map of the secondary provider should not have been applied.
+		require.Equal(t, current, o.GetMarketMap())
+	})
+
+	t.Run("local market map overrides the markets of the providers", func(t *testing.T) {
+		factory := multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+			mockMapperCfg.Name: mockMarketMapProvider(t, mockMapperCfg.Name, mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btcusdt.Ticker.String(): btcusdt,
+					usdtusd.Ticker.String(): usdtusd,
+				},
+			}),
+		})
+
+		override := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcusdtOverride.Ticker.String(): btcusdtOverride,
+				usdcusd.Ticker.String():         usdcusd,
+			},
+		}
+
+		o, err := oracle.New(
+			oracleCfgWithOnlyMockMapper,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithMarketMapperFactory(factory),
+			oracle.WithMarketMap(override),
+			oracle.WithMarketMapOverride(override),
+		)
+		require.NoError(t, err)
+		startOracle(t, o)
+
+		// the override takes precedence, and its local-only markets are retained.
+		expected := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcusdtOverride.Ticker.String(): btcusdtOverride,
+				usdtusd.Ticker.String():         usdtusd,
+				usdcusd.Ticker.String():         usdcusd,
+			},
+		}
+		require.Eventually(t, func() bool {
+			return expected.Equal(o.GetMarketMap())
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("drops the invalid markets of the merged market map", func(t *testing.T) {
+		enabledUsdcusd := usdcusd
+		enabledUsdcusd.Ticker.Enabled = true
+		enabledEthusdt := ethusdt
+		enabledEthusdt.Ticker.Enabled = true
+
+		factory := multiMarketMapperFactory(map[string]*mmclienttypes.MarketMapProvider{
+			mockMapperCfg.Name: mockMarketMapProvider(t, mockMapperCfg.Name, mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					enabledUsdcusd.Ticker.String(): enabledUsdcusd,
+					enabledEthusdt.Ticker.String(): enabledEthusdt,
+				},
+			}),
+		})
+
+		// disabling usdcusd locally is invalid, since it is used to normalize the enabled ethusdt.
+		override := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				usdcusd.Ticker.String(): usdcusd,
+			},
+		}
+
+		o, err := oracle.New(
+			oracleCfgWithOnlyMockMapper,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithMarketMapperFactory(factory),
+			oracle.WithMarketMap(mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					enabledUsdcusd.Ticker.String(): enabledUsdcusd,
+				},
+			}),
+			oracle.WithMarketMapOverride(override),
+		)
+		require.NoError(t, err)
+		startOracle(t, o)
+
+		// ethusdt is dropped, since its only provider config is normalized by the disabled usdcusd.
+		require.Eventually(t, func() bool {
+			return override.Equal(o.GetMarketMap())
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
+
+func TestMergeMarketMaps(t *testing.T) {
+	tests := []struct {
+		name       string
+		marketMaps []mmtypes.MarketMap
+		want       mmtypes.MarketMap
+	}{
+		{
+			name: "no market maps",
+			want: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{},
+			},
+		},
+		{
+			name: "markets defined by a single market map are included",
+			marketMaps: []mmtypes.MarketMap{
+				{Markets: map[string]mmtypes.Market{btcusdt.Ticker.String(): btcusdt}},
+				{Markets: map[string]mmtypes.Market{usdtusd.Ticker.String(): usdtusd}},
+				{},
+			},
+			want: mmtypes.MarketMap{
+				Markets: marketsMapValidSubset,
+			},
+		},
+		{
+			name: "markets are taken from the market map with the highest priority",
+			marketMaps: []mmtypes.MarketMap{
+				{Markets: map[string]mmtypes.Market{btcusdtOverride.Ticker.String(): btcusdtOverride}},
+				{Markets: marketsMapValidSubset},
+			},
+			want: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btcusdtOverride.Ticker.String(): btcusdtOverride,
+					usdtusd.Ticker.String():         usdtusd,
+				},
+			},
+		},
+		{
+			name: "lower priority market maps do not override",
+			marketMaps: []mmtypes.MarketMap{
+				{Markets: marketsMapValidSubset},
+				{Markets: map[string]mmtypes.Market{btcusdtOverride.Ticker.String(): btcusdtOverride}},
+			},
+			want: mmtypes.MarketMap{
+				Markets: marketsMapValidSubset,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, oracle.MergeMarketMaps(tt.marketMaps...))
+		})
+	}
+}
+
 func TestOracleImpl_IsMarketMapValidUpdated(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -108,6 +108,14 @@ func (d *dynamicMetrics) AddProviderCountForMarket(pairID string, count int) {
 	d.impl.AddProviderCountForMarket(pairID, count)
 }
 
+func (d *dynamicMetrics) AddOutlierRejection(providerName, pairID string) {
+	d.impl.AddOutlierRejection(providerName, pairID)
+}
+
+func (d *dynamicMetrics) AddQuorumFailure(pairID, reason string) {
+	d.impl.AddQuorumFailure(pairID, reason)
+}
+
 func (d *dynamicMetrics) SetConnectBuildInfo() {
 	d.impl.SetConnectBuildInfo()
 }
@@ -17,6 +17,8 @@ const (
 	ProviderLabel = "provider"
 	// PairIDLabel is the currency pair for which the metric applies.
 	PairIDLabel = "id"
+	// ReasonLabel is a label for the reason of a failure.
+	ReasonLabel = "reason"
 	// DecimalsLabel is the number of decimal points associated with the price.
 	DecimalsLabel = "decimals"
 	// OracleSubsystem is a subsystem shared by all metrics exposed by this package.
@@ -32,6 +34,8 @@ const (
 	AggregatePricesMetricName  = "aggregated_price"
 	ProviderTickMetricName     = "health_check_provider_updates_total"
 	ProviderCountMetricName    = "health_check_market_providers"
+	OutlierRejectionMetricName = "health_check_provider_outliers_total"
+	QuorumFailureMetricName    = "health_check_market_quorum_failures_total"
 	ConnectBuildInfoMetricName = "connect_build_info"
 )
 
@@ -62,6 +66,14 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(pairID string, count int)
 
+	// AddOutlierRejection increments the number of times a provider's price was rejected
+	// as an outlier before being aggregated for the given pairID.
+	AddOutlierRejection(providerName, pairID string)
+
+	// AddQuorumFailure increments the number of times a price could not be aggregated for the
+	// given pairID because its provider prices did not satisfy the market's quorum, by reason.
+	AddQuorumFailure(pairID, reason string)
+
 	// SetConnectBuildInfo sets the build information for the Connect binary.
 	SetConnectBuildInfo()
 
@@ -80,6 +92,8 @@ type OracleMetricsImpl struct {
 	promAggregatePrices   *prometheus.GaugeVec
 	promProviderTick      *prometheus.CounterVec
 	promProviderCount     *prometheus.GaugeVec
+	promOutlierRejections *prometheus.CounterVec
+	promQuorumFailures    *prometheus.CounterVec
 	promConnectBuildInfo  *prometheus.GaugeVec
 	statsdClient          statsd.ClientInterface
 	nodeIdentifier        string
@@ -155,6 +169,16 @@ func NewMetrics(statsdClient statsd.ClientInterface, nodeIdentifier string) Metr
 		Name:      ProviderCountMetricName,
 		Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 	}, []string{PairIDLabel})
+	ret.promOutlierRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      OutlierRejectionMetricName,
+		Help:      "Number of times a provider's price was rejected as an outlier for a given market.",
+	}, []string{ProviderLabel, PairIDLabel})
+	ret.promQuorumFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: OracleSubsystem,
+		Name:      QuorumFailureMetricName,
+		Help:      "Number of times a price could not be aggregated because the market's quorum was not met, by reason.",
+	}, []string{PairIDLabel, ReasonLabel})
 	ret.promConnectBuildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: OracleSubsystem,
 		Name:      ConnectBuildInfoMetricName,
@@ -167,6 +191,8 @@ func NewMetrics(statsdClient statsd.ClientInterface, nodeIdentifier string) Metr
 	prometheus.MustRegister(ret.promAggregatePrices)
 	prometheus.MustRegister(ret.promProviderTick)
 	prometheus.MustRegister(ret.promProviderCount)
+	prometheus.MustRegister(ret.promOutlierRejections)
+	prometheus.MustRegister(ret.promQuorumFailures)
 	prometheus.MustRegister(ret.promConnectBuildInfo)
 
 	return &ret
@@ -205,6 +231,14 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {}
 // to calculate the final price for a given market.
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {}
 
+// AddOutlierRejection increments the number of times a provider's price was rejected
+// as an outlier before being aggregated for the given pairID.
+func (m *noOpOracleMetrics) AddOutlierRejection(string, string) {}
+
+// AddQuorumFailure increments the number of times a price could not be aggregated for the
+// given pairID because its provider prices did not satisfy the market's quorum, by reason.
+func (m *noOpOracleMetrics) AddQuorumFailure(string, string) {}
+
 // SetConnectBuildInfo sets the build information for the Connect binary.
 func (m *noOpOracleMetrics) SetConnectBuildInfo() {}
 
@@ -286,6 +320,32 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	m.statsdClient.Gauge(metricName, float64(count), []string{}, 1)
 }
 
+// AddOutlierRejection increments the number of times a provider's price was rejected
+// as an outlier before being aggregated for the given pairID.
+func (m *OracleMetricsImpl) AddOutlierRejection(providerName, pairID string) {
+	m.promOutlierRejections.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Add(1)
+
+	metricName := strings.Join([]string{OutlierRejectionMetricName, m.nodeIdentifier, strings.ToLower(providerName), strings.ToLower(pairID)}, ".")
+	m.statsdClient.Incr(metricName, []string{}, 1)
+}
+
+// AddQuorumFailure increments the number of times a price could not be aggregated for the
+// given pairID because its provider prices did not satisfy the market's quorum, by reason.
+func (m *OracleMetricsImpl) AddQuorumFailure(pairID, reason string) {
+	m.promQuorumFailures.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+		ReasonLabel: reason,
+	},
+	).Add(1)
+
+	metricName := strings.Join([]string{QuorumFailureMetricName, m.nodeIdentifier, strings.ToLower(pairID), reason}, ".")
+	m.statsdClient.Incr(metricName, []string{}, 1)
+}
+
 // MissingPrices updates the list of missing prices for the given tick.
 func (m *OracleMetricsImpl) MissingPrices(pairIDs []string) {
 	m.missingPricesMtx.Lock()
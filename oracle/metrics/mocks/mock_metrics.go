@@ -17,6 +17,40 @@ func (_m *Metrics) EXPECT() *Metrics_Expecter {
 	return &Metrics_Expecter{mock: &_m.Mock}
 }
 
+// AddOutlierRejection provides a mock function with given fields: providerName, pairID
+func (_m *Metrics) AddOutlierRejection(providerName string, pairID string) {
+	_m.Called(providerName, pairID)
+}
+
+// Metrics_AddOutlierRejection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddOutlierRejection'
+type Metrics_AddOutlierRejection_Call struct {
+	*mock.Call
+}
+
+// AddOutlierRejection is a helper method to define mock.On call
+//   - providerName string
+//   - pairID string
+func (_e *Metrics_Expecter) AddOutlierRejection(providerName interface{}, pairID interface{}) *Metrics_AddOutlierRejection_Call {
+	return &Metrics_AddOutlierRejection_Call{Call: _e.mock.On("AddOutlierRejection", providerName, pairID)}
+}
+
+func (_c *Metrics_AddOutlierRejection_Call) Run(run func(providerName string, pairID string)) *Metrics_AddOutlierRejection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddOutlierRejection_Call) Return() *Metrics_AddOutlierRejection_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddOutlierRejection_Call) RunAndReturn(run func(string, string)) *Metrics_AddOutlierRejection_Call {
+	_c.Run(run)
+	return _c
+}
+
 // AddProviderCountForMarket provides a mock function with given fields: pairID, count
 func (_m *Metrics) AddProviderCountForMarket(pairID string, count int) {
 	_m.Called(pairID, count)
@@ -86,6 +120,40 @@ func (_c *Metrics_AddProviderTick_Call) RunAndReturn(run func(string, string, bo
 	return _c
 }
 
+// AddQuorumFailure provides a mock function with given fields: pairID, reason
+func (_m *Metrics) AddQuorumFailure(pairID string, reason string) {
+	_m.Called(pairID, reason)
+}
+
+// Metrics_AddQuorumFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddQuorumFailure'
+type Metrics_AddQuorumFailure_Call struct {
+	*mock.Call
+}
+
+// AddQuorumFailure is a helper method to define mock.On call
+//   - pairID string
+//   - reason string
+func (_e *Metrics_Expecter) AddQuorumFailure(pairID interface{}, reason interface{}) *Metrics_AddQuorumFailure_Call {
+	return &Metrics_AddQuorumFailure_Call{Call: _e.mock.On("AddQuorumFailure", pairID, reason)}
+}
+
+func (_c *Metrics_AddQuorumFailure_Call) Run(run func(pairID string, reason string)) *Metrics_AddQuorumFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddQuorumFailure_Call) Return() *Metrics_AddQuorumFailure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddQuorumFailure_Call) RunAndReturn(run func(string, string)) *Metrics_AddQuorumFailure_Call {
+	_c.Run(run)
+	return _c
+}
+
 // AddTick provides a mock function with no fields
 func (_m *Metrics) AddTick() {
 	_m.Called()
@@ -0,0 +1,300 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	big "math/big"
+
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	mock "github.com/stretchr/testify/mock"
+
+	providerstypes "github.com/skip-mev/connect/v2/providers/types"
+
+	types "github.com/skip-mev/connect/v2/oracle/types"
+)
+
+// PriceDetailsAggregator is an autogenerated mock type for the PriceDetailsAggregator type
+type PriceDetailsAggregator struct {
+	mock.Mock
+}
+
+type PriceDetailsAggregator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PriceDetailsAggregator) EXPECT() *PriceDetailsAggregator_Expecter {
+	return &PriceDetailsAggregator_Expecter{mock: &_m.Mock}
+}
+
+// AggregatePrices provides a mock function with no fields
+func (_m *PriceDetailsAggregator) AggregatePrices() {
+	_m.Called()
+}
+
+// PriceDetailsAggregator_AggregatePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AggregatePrices'
+type PriceDetailsAggregator_AggregatePrices_Call struct {
+	*mock.Call
+}
+
+// AggregatePrices is a helper method to define mock.On call
+func (_e *PriceDetailsAggregator_Expecter) AggregatePrices() *PriceDetailsAggregator_AggregatePrices_Call {
+	return &PriceDetailsAggregator_AggregatePrices_Call{Call: _e.mock.On("AggregatePrices")}
+}
+
+func (_c *PriceDetailsAggregator_AggregatePrices_Call) Run(run func()) *PriceDetailsAggregator_AggregatePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_AggregatePrices_Call) Return() *PriceDetailsAggregator_AggregatePrices_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_AggregatePrices_Call) RunAndReturn(run func()) *PriceDetailsAggregator_AggregatePrices_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetPriceDetails provides a mock function with no fields
+func (_m *PriceDetailsAggregator) GetPriceDetails() map[string]types.PriceDetail {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDetails")
+	}
+
+	var r0 map[string]types.PriceDetail
+	if rf, ok := ret.Get(0).(func() map[string]types.PriceDetail); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.PriceDetail)
+		}
+	}
+
+	return r0
+}
+
+// PriceDetailsAggregator_GetPriceDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceDetails'
+type PriceDetailsAggregator_GetPriceDetails_Call struct {
+	*mock.Call
+}
+
+// GetPriceDetails is a helper method to define mock.On call
+func (_e *PriceDetailsAggregator_Expecter) GetPriceDetails() *PriceDetailsAggregator_GetPriceDetails_Call {
+	return &PriceDetailsAggregator_GetPriceDetails_Call{Call: _e.mock.On("GetPriceDetails")}
+}
+
+func (_c *PriceDetailsAggregator_GetPriceDetails_Call) Run(run func()) *PriceDetailsAggregator_GetPriceDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_GetPriceDetails_Call) Return(_a0 map[string]types.PriceDetail) *PriceDetailsAggregator_GetPriceDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_GetPriceDetails_Call) RunAndReturn(run func() map[string]types.PriceDetail) *PriceDetailsAggregator_GetPriceDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrices provides a mock function with no fields
+func (_m *PriceDetailsAggregator) GetPrices() map[string]*big.Float {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrices")
+	}
+
+	var r0 map[string]*big.Float
+	if rf, ok := ret.Get(0).(func() map[string]*big.Float); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*big.Float)
+		}
+	}
+
+	return r0
+}
+
+// PriceDetailsAggregator_GetPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrices'
+type PriceDetailsAggregator_GetPrices_Call struct {
+	*mock.Call
+}
+
+// GetPrices is a helper method to define mock.On call
+func (_e *PriceDetailsAggregator_Expecter) GetPrices() *PriceDetailsAggregator_GetPrices_Call {
+	return &PriceDetailsAggregator_GetPrices_Call{Call: _e.mock.On("GetPrices")}
+}
+
+func (_c *PriceDetailsAggregator_GetPrices_Call) Run(run func()) *PriceDetailsAggregator_GetPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_GetPrices_Call) Return(_a0 map[string]*big.Float) *PriceDetailsAggregator_GetPrices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_GetPrices_Call) RunAndReturn(run func() map[string]*big.Float) *PriceDetailsAggregator_GetPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with no fields
+func (_m *PriceDetailsAggregator) Reset() {
+	_m.Called()
+}
+
+// PriceDetailsAggregator_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type PriceDetailsAggregator_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *PriceDetailsAggregator_Expecter) Reset() *PriceDetailsAggregator_Reset_Call {
+	return &PriceDetailsAggregator_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *PriceDetailsAggregator_Reset_Call) Run(run func()) *PriceDetailsAggregator_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_Reset_Call) Return() *PriceDetailsAggregator_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_Reset_Call) RunAndReturn(run func()) *PriceDetailsAggregator_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetProviderPrices provides a mock function with given fields: provider, prices
+func (_m *PriceDetailsAggregator) SetProviderPrices(provider string, prices map[string]*big.Float) {
+	_m.Called(provider, prices)
+}
+
+// PriceDetailsAggregator_SetProviderPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProviderPrices'
+type PriceDetailsAggregator_SetProviderPrices_Call struct {
+	*mock.Call
+}
+
+// SetProviderPrices is a helper method to define mock.On call
+//   - provider string
+//   - prices map[string]*big.Float
+func (_e *PriceDetailsAggregator_Expecter) SetProviderPrices(provider interface{}, prices interface{}) *PriceDetailsAggregator_SetProviderPrices_Call {
+	return &PriceDetailsAggregator_SetProviderPrices_Call{Call: _e.mock.On("SetProviderPrices", provider, prices)}
+}
+
+func (_c *PriceDetailsAggregator_SetProviderPrices_Call) Run(run func(provider string, prices map[string]*big.Float)) *PriceDetailsAggregator_SetProviderPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]*big.Float))
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_SetProviderPrices_Call) Return() *PriceDetailsAggregator_SetProviderPrices_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_SetProviderPrices_Call) RunAndReturn(run func(string, map[string]*big.Float)) *PriceDetailsAggregator_SetProviderPrices_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetProviderResults provides a mock function with given fields: provider, results
+func (_m *PriceDetailsAggregator) SetProviderResults(provider string, results map[types.ProviderTicker]providerstypes.ResolvedResult[*big.Float]) {
+	_m.Called(provider, results)
+}
+
+// PriceDetailsAggregator_SetProviderResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProviderResults'
+type PriceDetailsAggregator_SetProviderResults_Call struct {
+	*mock.Call
+}
+
+// SetProviderResults is a helper method to define mock.On call
+//   - provider string
+//   - results map[types.ProviderTicker]providerstypes.ResolvedResult[*big.Float]
+func (_e *PriceDetailsAggregator_Expecter) SetProviderResults(provider interface{}, results interface{}) *PriceDetailsAggregator_SetProviderResults_Call {
+	return &PriceDetailsAggregator_SetProviderResults_Call{Call: _e.mock.On("SetProviderResults", provider, results)}
+}
+
+func (_c *PriceDetailsAggregator_SetProviderResults_Call) Run(run func(provider string, results map[types.ProviderTicker]providerstypes.ResolvedResult[*big.Float])) *PriceDetailsAggregator_SetProviderResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[types.ProviderTicker]providerstypes.ResolvedResult[*big.Float]))
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_SetProviderResults_Call) Return() *PriceDetailsAggregator_SetProviderResults_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_SetProviderResults_Call) RunAndReturn(run func(string, map[types.ProviderTicker]providerstypes.ResolvedResult[*big.Float])) *PriceDetailsAggregator_SetProviderResults_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateMarketMap provides a mock function with given fields: _a0
+func (_m *PriceDetailsAggregator) UpdateMarketMap(_a0 marketmaptypes.MarketMap) {
+	_m.Called(_a0)
+}
+
+// PriceDetailsAggregator_UpdateMarketMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMarketMap'
+type PriceDetailsAggregator_UpdateMarketMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMarketMap is a helper method to define mock.On call
+//   - _a0 marketmaptypes.MarketMap
+func (_e *PriceDetailsAggregator_Expecter) UpdateMarketMap(_a0 interface{}) *PriceDetailsAggregator_UpdateMarketMap_Call {
+	return &PriceDetailsAggregator_UpdateMarketMap_Call{Call: _e.mock.On("UpdateMarketMap", _a0)}
+}
+
+func (_c *PriceDetailsAggregator_UpdateMarketMap_Call) Run(run func(_a0 marketmaptypes.MarketMap)) *PriceDetailsAggregator_UpdateMarketMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(marketmaptypes.MarketMap))
+	})
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_UpdateMarketMap_Call) Return() *PriceDetailsAggregator_UpdateMarketMap_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceDetailsAggregator_UpdateMarketMap_Call) RunAndReturn(run func(marketmaptypes.MarketMap)) *PriceDetailsAggregator_UpdateMarketMap_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewPriceDetailsAggregator creates a new instance of PriceDetailsAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceDetailsAggregator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceDetailsAggregator {
+	mock := &PriceDetailsAggregator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,8 +6,12 @@ import (
 	context "context"
 	big "math/big"
 
+	config "github.com/skip-mev/connect/v2/oracle/config"
+
 	mock "github.com/stretchr/testify/mock"
 
+	oracletypes "github.com/skip-mev/connect/v2/oracle/types"
+
 	time "time"
 
 	types "github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -116,6 +120,53 @@ func (_c *Oracle_GetMarketMap_Call) RunAndReturn(run func() types.MarketMap) *Or
 	return _c
 }
 
+// GetPriceDetails provides a mock function with no fields
+func (_m *Oracle) GetPriceDetails() map[string]oracletypes.PriceDetail {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDetails")
+	}
+
+	var r0 map[string]oracletypes.PriceDetail
+	if rf, ok := ret.Get(0).(func() map[string]oracletypes.PriceDetail); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]oracletypes.PriceDetail)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_GetPriceDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceDetails'
+type Oracle_GetPriceDetails_Call struct {
+	*mock.Call
+}
+
+// GetPriceDetails is a helper method to define mock.On call
+func (_e *Oracle_Expecter) GetPriceDetails() *Oracle_GetPriceDetails_Call {
+	return &Oracle_GetPriceDetails_Call{Call: _e.mock.On("GetPriceDetails")}
+}
+
+func (_c *Oracle_GetPriceDetails_Call) Run(run func()) *Oracle_GetPriceDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Oracle_GetPriceDetails_Call) Return(_a0 map[string]oracletypes.PriceDetail) *Oracle_GetPriceDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_GetPriceDetails_Call) RunAndReturn(run func() map[string]oracletypes.PriceDetail) *Oracle_GetPriceDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrices provides a mock function with no fields
 func (_m *Oracle) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -286,6 +337,100 @@ func (_c *Oracle_Stop_Call) RunAndReturn(run func()) *Oracle_Stop_Call {
 	return _c
 }
 
+// SubscribePrices provides a mock function with given fields: ctx
+func (_m *Oracle) SubscribePrices(ctx context.Context) <-chan time.Time {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePrices")
+	}
+
+	var r0 <-chan time.Time
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan time.Time); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan time.Time)
+		}
+	}
+
+	return r0
+}
+
+// Oracle_SubscribePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePrices'
+type Oracle_SubscribePrices_Call struct {
+	*mock.Call
+}
+
+// SubscribePrices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Oracle_Expecter) SubscribePrices(ctx interface{}) *Oracle_SubscribePrices_Call {
+	return &Oracle_SubscribePrices_Call{Call: _e.mock.On("SubscribePrices", ctx)}
+}
+
+func (_c *Oracle_SubscribePrices_Call) Run(run func(ctx context.Context)) *Oracle_SubscribePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Oracle_SubscribePrices_Call) Return(_a0 <-chan time.Time) *Oracle_SubscribePrices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_SubscribePrices_Call) RunAndReturn(run func(context.Context) <-chan time.Time) *Oracle_SubscribePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: cfg
+func (_m *Oracle) UpdateConfig(cfg config.OracleConfig) error {
+	ret := _m.Called(cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(config.OracleConfig) error); ok {
+		r0 = rf(cfg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Oracle_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type Oracle_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - cfg config.OracleConfig
+func (_e *Oracle_Expecter) UpdateConfig(cfg interface{}) *Oracle_UpdateConfig_Call {
+	return &Oracle_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", cfg)}
+}
+
+func (_c *Oracle_UpdateConfig_Call) Run(run func(cfg config.OracleConfig)) *Oracle_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(config.OracleConfig))
+	})
+	return _c
+}
+
+func (_c *Oracle_UpdateConfig_Call) Return(_a0 error) *Oracle_UpdateConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Oracle_UpdateConfig_Call) RunAndReturn(run func(config.OracleConfig) error) *Oracle_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
@@ -34,6 +34,19 @@ func WithMarketMap(marketMap mmtypes.MarketMap) Option {
 	}
 }
 
+// WithMarketMapOverride sets a local market map for the oracle whose markets take precedence over those
+// fetched by the market map providers. Markets that are only defined in the override are added to the
+// oracle's market map. Note that this is optional.
+func WithMarketMapOverride(marketMap mmtypes.MarketMap) Option {
+	return func(m *OracleImpl) {
+		if err := marketMap.ValidateBasic(); err != nil {
+			panic(err)
+		}
+
+		m.marketMapOverride = marketMap
+	}
+}
+
 // WithLastUpdated sets the last updated for the oracle.
 func WithLastUpdated(lastUpdated uint64) Option {
 	return func(m *OracleImpl) {
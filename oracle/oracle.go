@@ -40,13 +40,20 @@ type OracleImpl struct { //nolint:revive
 	//
 	// priceProviders is a map of all price providers that the oracle is using.
 	priceProviders map[string]ProviderState
-	// mmProvider is the market map provider. Specifically this provider is responsible
-	// for making requests for the latest market map data.
-	mmProvider *mmclienttypes.MarketMapProvider
+	// mmProviders are the market map providers, ordered from the highest to the lowest priority.
+	// Specifically these providers are responsible for making requests for the latest market map
+	// data, which is merged into the oracle's market map.
+	mmProviders []*mmclienttypes.MarketMapProvider
 	// aggregator is the price aggregator.
 	aggregator PriceAggregator
 	// lastPriceSync is the last time the oracle successfully updated its prices.
 	lastPriceSync time.Time
+	// subMut guards the price subscribers.
+	subMut sync.Mutex
+	// subscribers are the channels notified each time the oracle completes a price aggregation round.
+	subscribers map[uint64]chan time.Time
+	// nextSubID is the identifier assigned to the next price subscriber.
+	nextSubID uint64
 
 	// -------------------Oracle Configuration Fields-------------------//
 	//
@@ -54,6 +61,9 @@ type OracleImpl struct { //nolint:revive
 	cfg config.OracleConfig
 	// marketMap is the market map that the oracle is using.
 	marketMap mmtypes.MarketMap
+	// marketMapOverride is a local market map whose markets take precedence over those of all market
+	// map providers. Markets that are only defined locally are added to the oracle's market map.
+	marketMapOverride mmtypes.MarketMap
 	// lastUpdated is the field in the marketmap module tracking the last block at which an update was posted
 	lastUpdated uint64
 	// writeTo is a path to write the market map to.
@@ -85,9 +95,8 @@ type OracleImpl struct { //nolint:revive
 type ProviderState struct {
 	// Provider is the price provider implementation.
 	Provider *types.PriceProvider
-	// Cfg is the provider configuration.
-	//
-	// TODO: Deprecate this once we have synchronous configuration updates.
+	// Cfg is the provider configuration. This is diffed against the provider's new configuration
+	// when the oracle's configuration is updated.
 	Cfg config.ProviderConfig
 }
 
@@ -108,6 +117,7 @@ func New(
 		cfg:             cfg,
 		aggregator:      aggregator,
 		priceProviders:  make(map[string]ProviderState), // this will be initialized via the Init method.
+		subscribers:     make(map[uint64]chan time.Time),
 		logger:          zap.NewNop(),
 		wsMetrics:       wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
 		apiMetrics:      apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
@@ -138,8 +148,9 @@ func (o *OracleImpl) GetMarketMap() mmtypes.MarketMap {
 	return o.marketMap
 }
 
-func (o *OracleImpl) GetMarketMapProvider() *mmclienttypes.MarketMapProvider {
-	return o.mmProvider
+// GetMarketMapProviders returns the market map providers, ordered from the highest to the lowest priority.
+func (o *OracleImpl) GetMarketMapProviders() []*mmclienttypes.MarketMapProvider {
+	return o.mmProviders
 }
 
 func (o *OracleImpl) GetLastSyncTime() time.Time {
@@ -151,3 +162,13 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+// GetPriceDetails returns the provenance of the most recently aggregated prices. This is empty if the
+// oracle's aggregator does not retain price details.
+func (o *OracleImpl) GetPriceDetails() types.PriceDetails {
+	if aggregator, ok := o.aggregator.(PriceDetailsAggregator); ok {
+		return aggregator.GetPriceDetails()
+	}
+
+	return make(types.PriceDetails)
+}
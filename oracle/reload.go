@@ -0,0 +1,249 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+	mmclienttypes "github.com/skip-mev/connect/v2/service/clients/marketmap/types"
+)
+
+// UpdateConfig updates the configuration of a running oracle without restarting it. The new configuration
+// is diffed against the running one, and only the price providers whose configuration changed are
+// restarted, so that the connections of all other providers are retained. Specifically,
+//
+//  1. Price providers that were removed from the configuration are stopped.
+//  2. Price providers that were added to the configuration are created and started.
+//  3. Price providers whose configuration changed are given new query handlers and configuration via
+//     Provider.Update, or are re-created if they switched between the API and WebSocket handlers.
+//
+// The update is atomic: all new providers and query handlers are created before any running provider is
+// stopped or updated, and if any of them cannot be created an error is returned and the running providers
+// are left unchanged.
+//
+// The max price age is updated in place. Changes to any other field of the configuration, including the
+// market map providers and their sources, are ignored and only take effect once the oracle is restarted.
+func (o *OracleImpl) UpdateConfig(cfg config.OracleConfig) error {
+	if err := cfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid oracle config: %w", err)
+	}
+
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	if o.mainCtx == nil {
+		return fmt.Errorf("cannot update the config of an oracle that has not been started")
+	}
+
+	o.warnOnRestartRequired(cfg)
+
+	// Create the new providers and query handlers of all price providers whose configuration changed,
+	// before any running provider is touched.
+	updates := make(map[string]priceProviderUpdate)
+	for name, providerCfg := range cfg.Providers {
+		if providerCfg.Type != types.ConfigType {
+			continue
+		}
+
+		state, ok := o.priceProviders[name]
+		if ok && reflect.DeepEqual(state.Cfg, providerCfg) {
+			continue
+		}
+
+		var (
+			update priceProviderUpdate
+			err    error
+		)
+		if ok && providerType(providerCfg) == state.Provider.Type() {
+			update, err = o.newPriceProviderConfigUpdate(state, providerCfg)
+		} else {
+			update, err = o.newPriceProviderReplacement(providerCfg)
+		}
+		if err != nil {
+			return err
+		}
+
+		updates[name] = update
+	}
+
+	// Stop all price providers that are no longer configured.
+	for name, state := range o.priceProviders {
+		if providerCfg, ok := cfg.Providers[name]; ok && providerCfg.Type == types.ConfigType {
+			continue
+		}
+
+		o.logger.Info("removing price provider", zap.String("provider", name))
+		state.Provider.Stop()
+		delete(o.priceProviders, name)
+	}
+
+	// Apply the changes to the price providers whose configuration changed.
+	for name, update := range updates {
+		if update.replace {
+			if state, ok := o.priceProviders[name]; ok {
+				o.logger.Info("re-creating price provider", zap.String("provider", name))
+				state.Provider.Stop()
+			} else {
+				o.logger.Info("adding price provider", zap.String("provider", name))
+			}
+		} else {
+			o.logger.Info("updating price provider config", zap.String("provider", name))
+		}
+
+		state, err := o.UpdateProviderState(update.tickers, update.state, update.opts...)
+		if err != nil {
+			return err
+		}
+
+		o.priceProviders[name] = state
+	}
+
+	// Retain the running market map providers' configuration, as it can only be changed on restart.
+	providers := make(map[string]config.ProviderConfig, len(cfg.Providers))
+	for name, providerCfg := range cfg.Providers {
+		if providerCfg.Type != mmclienttypes.ConfigType {
+			providers[name] = providerCfg
+		}
+	}
+	for name, providerCfg := range o.cfg.Providers {
+		if providerCfg.Type == mmclienttypes.ConfigType {
+			providers[name] = providerCfg
+		}
+	}
+
+	o.cfg.Providers = providers
+	o.cfg.MaxPriceAge = cfg.MaxPriceAge
+
+	o.logger.Info("updated oracle config", zap.Int("num_price_providers", len(o.priceProviders)))
+	return nil
+}
+
+// priceProviderUpdate is a change to a price provider that has been created, but not yet applied to the
+// oracle.
+type priceProviderUpdate struct {
+	// state is the provider state once the update is applied.
+	state ProviderState
+	// tickers are the tickers the provider supports in the current market map.
+	tickers []types.ProviderTicker
+	// opts are the options the provider is updated with.
+	opts []base.UpdateOption[types.ProviderTicker, *big.Float]
+	// replace is whether state contains a new provider that replaces the running one, if any.
+	replace bool
+}
+
+// newPriceProviderReplacement creates a new price provider for the given configuration, which is started
+// once the update is applied.
+func (o *OracleImpl) newPriceProviderReplacement(cfg config.ProviderConfig) (priceProviderUpdate, error) {
+	state, err := o.newPriceProvider(o.mainCtx, cfg)
+	if err != nil {
+		return priceProviderUpdate{}, fmt.Errorf("failed to create %s provider: %w", cfg.Name, err)
+	}
+
+	providerTickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
+	if err != nil {
+		return priceProviderUpdate{}, fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
+	}
+
+	return priceProviderUpdate{
+		state:   state,
+		tickers: providerTickers,
+		replace: true,
+	}, nil
+}
+
+// newPriceProviderConfigUpdate creates the query handler and configuration a running price provider is
+// updated with, which restarts its fetch loop once the update is applied. The provider's configuration must
+// use the same type of query handler as the running provider.
+func (o *OracleImpl) newPriceProviderConfigUpdate(state ProviderState, cfg config.ProviderConfig) (priceProviderUpdate, error) {
+	var opts []base.UpdateOption[types.ProviderTicker, *big.Float]
+	switch state.Provider.Type() {
+	case providertypes.API:
+		queryHandler, err := o.createAPIQueryHandler(o.mainCtx, cfg)
+		if err != nil {
+			return priceProviderUpdate{}, fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
+		}
+
+		opts = append(
+			opts,
+			base.WithNewAPIHandler(queryHandler),
+			base.WithNewAPIConfig[types.ProviderTicker, *big.Float](cfg.API),
+		)
+	case providertypes.WebSockets:
+		queryHandler, err := o.createWebSocketQueryHandler(o.mainCtx, cfg)
+		if err != nil {
+			return priceProviderUpdate{}, fmt.Errorf("failed to create %s's web socket query handler: %w", cfg.Name, err)
+		}
+
+		opts = append(
+			opts,
+			base.WithNewWebSocketHandler(queryHandler),
+			base.WithNewWebSocketConfig[types.ProviderTicker, *big.Float](cfg.WebSocket),
+		)
+	default:
+		return priceProviderUpdate{}, fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
+	}
+
+	providerTickers, err := types.ProviderTickersFromMarketMap(cfg.Name, o.marketMap)
+	if err != nil {
+		return priceProviderUpdate{}, fmt.Errorf("failed to create %s's provider market map: %w", cfg.Name, err)
+	}
+
+	state.Cfg = cfg
+	return priceProviderUpdate{
+		state:   state,
+		tickers: providerTickers,
+		opts:    opts,
+	}, nil
+}
+
+// warnOnRestartRequired logs the fields of the given configuration that differ from the running
+// configuration but cannot be updated without restarting the oracle.
+func (o *OracleImpl) warnOnRestartRequired(cfg config.OracleConfig) {
+	var fields []string
+	if cfg.UpdateInterval != o.cfg.UpdateInterval {
+		fields = append(fields, "updateInterval")
+	}
+	if !reflect.DeepEqual(cfg.Metrics, o.cfg.Metrics) {
+		fields = append(fields, "metrics")
+	}
+	if !reflect.DeepEqual(cfg.Aggregation, o.cfg.Aggregation) {
+		fields = append(fields, "aggregation")
+	}
+	if !reflect.DeepEqual(cfg.MarketMap, o.cfg.MarketMap) {
+		fields = append(fields, "marketMap")
+	}
+	if cfg.Host != o.cfg.Host || cfg.Port != o.cfg.Port {
+		fields = append(fields, "host/port")
+	}
+	for name, providerCfg := range cfg.Providers {
+		if providerCfg.Type != mmclienttypes.ConfigType {
+			continue
+		}
+
+		if !reflect.DeepEqual(providerCfg, o.cfg.Providers[name]) {
+			fields = append(fields, fmt.Sprintf("providers.%s", name))
+		}
+	}
+
+	if len(fields) > 0 {
+		o.logger.Warn("ignoring oracle config changes that require a restart", zap.Strings("fields", fields))
+	}
+}
+
+// providerType returns the type of query handler the given provider configuration uses.
+func providerType(cfg config.ProviderConfig) providertypes.ProviderType {
+	switch {
+	case cfg.API.Enabled:
+		return providertypes.API
+	case cfg.WebSocket.Enabled:
+		return providertypes.WebSockets
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,170 @@
+package oracle_test
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	oraclefactory "github.com/skip-mev/connect/v2/providers/factories/oracle"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+	"github.com/skip-mev/connect/v2/providers/websockets/okx"
+)
+
+func TestUpdateConfig(t *testing.T) {
+	newOracle := func(t *testing.T, cfg config.OracleConfig) *oracle.OracleImpl {
+		t.Helper()
+
+		cfg.Providers = maps.Clone(cfg.Providers)
+		orc, err := oracle.New(
+			cfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMap(marketMap),
+		)
+		require.NoError(t, err)
+
+		return orc.(*oracle.OracleImpl)
+	}
+
+	startOracle := func(t *testing.T, o *oracle.OracleImpl) {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			_ = o.Start(ctx)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			o.Stop()
+		})
+
+		// wait for all providers that support a market to be started
+		require.Eventually(t, func() bool {
+			running := 0
+			for _, state := range o.GetProviderState() {
+				if len(state.Provider.GetIDs()) == 0 {
+					continue
+				}
+				if !state.Provider.IsRunning() {
+					return false
+				}
+				running++
+			}
+
+			return running > 0
+		}, 5*time.Second, 100*time.Millisecond)
+	}
+
+	t.Run("fails if the oracle has not been started", func(t *testing.T) {
+		o := newOracle(t, oracleCfg)
+		require.Error(t, o.UpdateConfig(oracleCfg))
+	})
+
+	t.Run("fails on an invalid config", func(t *testing.T) {
+		o := newOracle(t, oracleCfg)
+		startOracle(t, o)
+
+		cfg := oracleCfg
+		cfg.MaxPriceAge = 0
+		require.Error(t, o.UpdateConfig(cfg))
+		require.Len(t, o.GetProviderState(), len(oracleCfg.Providers))
+	})
+
+	t.Run("only restarts the providers whose config changed", func(t *testing.T) {
+		o := newOracle(t, oracleCfg)
+		startOracle(t, o)
+
+		before := maps.Clone(o.GetProviderState())
+
+		// remove okx, and change the interval of coinbase
+		cfg := oracleCfg
+		cfg.Providers = maps.Clone(oracleCfg.Providers)
+		delete(cfg.Providers, okx.Name)
+
+		coinbaseCfg := cfg.Providers[coinbase.Name]
+		coinbaseCfg.API.Interval = 2 * coinbase.DefaultAPIConfig.Interval
+		cfg.Providers[coinbase.Name] = coinbaseCfg
+
+		cfg.MaxPriceAge = time.Minute
+		require.NoError(t, o.UpdateConfig(cfg))
+
+		after := o.GetProviderState()
+		require.Len(t, after, 2)
+
+		// binance is unchanged
+		require.Same(t, before[binance.Name].Provider, after[binance.Name].Provider)
+		require.Equal(t, oracleCfg.Providers[binance.Name], after[binance.Name].Cfg)
+
+		// coinbase is updated in place
+		require.Same(t, before[coinbase.Name].Provider, after[coinbase.Name].Provider)
+		require.Equal(t, coinbaseCfg, after[coinbase.Name].Cfg)
+		require.Equal(t, coinbaseCfg.API, after[coinbase.Name].Provider.GetAPIConfig())
+
+		// okx is stopped
+		_, ok := after[okx.Name]
+		require.False(t, ok)
+		require.Eventually(t, func() bool {
+			return !before[okx.Name].Provider.IsRunning()
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("creates providers that were added to the config", func(t *testing.T) {
+		cfg := oracleCfg
+		cfg.Providers = map[string]config.ProviderConfig{
+			binance.Name:  oracleCfg.Providers[binance.Name],
+			coinbase.Name: oracleCfg.Providers[coinbase.Name],
+		}
+
+		o := newOracle(t, cfg)
+		startOracle(t, o)
+		require.Len(t, o.GetProviderState(), 2)
+
+		require.NoError(t, o.UpdateConfig(oracleCfg))
+
+		after := o.GetProviderState()
+		require.Len(t, after, len(oracleCfg.Providers))
+		require.Equal(t, providertypes.WebSockets, after[okx.Name].Provider.Type())
+		require.Equal(t, oracleCfg.Providers[okx.Name], after[okx.Name].Cfg)
+		require.Eventually(t, after[okx.Name].Provider.IsRunning, 5*time.Second, 100*time.Millisecond)
+	})
+	t.Run("leaves the providers unchanged if a provider cannot be created", func(t *testing.T) {
+		o := newOracle(t, oracleCfg)
+		startOracle(t, o)
+
+		before := maps.Clone(o.GetProviderState())
+
+		// remove okx and change the interval of coinbase, but add a provider the factory does not support
+		cfg := oracleCfg
+		cfg.Providers = maps.Clone(oracleCfg.Providers)
+		delete(cfg.Providers, okx.Name)
+
+		coinbaseCfg := cfg.Providers[coinbase.Name]
+		coinbaseCfg.API.Interval = 2 * coinbase.DefaultAPIConfig.Interval
+		cfg.Providers[coinbase.Name] = coinbaseCfg
+
+		unsupportedCfg := cfg.Providers[binance.Name]
+		unsupportedCfg.Name = "unsupported"
+		unsupportedCfg.API.Name = "unsupported"
+		cfg.Providers[unsupportedCfg.Name] = unsupportedCfg
+
+		require.Error(t, o.UpdateConfig(cfg))
+
+		after := o.GetProviderState()
+		require.Len(t, after, len(before))
+		for name, state := range before {
+			require.Same(t, state.Provider, after[name].Provider)
+			require.Equal(t, state.Cfg, after[name].Cfg)
+		}
+		require.Equal(t, oracleCfg.Providers[coinbase.Name].API, after[coinbase.Name].Provider.GetAPIConfig())
+		require.True(t, after[okx.Name].Provider.IsRunning())
+	})
+}
@@ -0,0 +1,51 @@
+package oracle
+
+import (
+	"context"
+	"time"
+)
+
+// SubscribePrices returns a channel that receives the oracle's last sync time each time the
+// oracle completes a price aggregation round. Notifications are coalesced, i.e. a slow subscriber
+// only observes the most recent round once it is ready to receive. The subscription is removed,
+// and the channel is closed, once the given context is cancelled.
+func (o *OracleImpl) SubscribePrices(ctx context.Context) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+
+	o.subMut.Lock()
+	id := o.nextSubID
+	o.nextSubID++
+	o.subscribers[id] = ch
+	o.subMut.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		o.subMut.Lock()
+		delete(o.subscribers, id)
+		close(ch)
+		o.subMut.Unlock()
+	}()
+
+	return ch
+}
+
+// notifySubscribers notifies all price subscribers that a new aggregation round has completed.
+// This method never blocks on a subscriber.
+func (o *OracleImpl) notifySubscribers(ts time.Time) {
+	o.subMut.Lock()
+	defer o.subMut.Unlock()
+
+	for _, ch := range o.subscribers {
+		// drop any pending notification so the subscriber sees the latest round.
+		select {
+		case <-ch:
+		default:
+		}
+
+		select {
+		case ch <- ts:
+		default:
+		}
+	}
+}
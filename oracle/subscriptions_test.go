@@ -0,0 +1,61 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle"
+	oraclefactory "github.com/skip-mev/connect/v2/providers/factories/oracle"
+)
+
+func TestSubscribePrices(t *testing.T) {
+	orc, err := oracle.New(
+		oracleCfg,
+		noOpPriceAggregator{},
+		oracle.WithLogger(logger),
+		oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+		oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		oracle.WithMarketMap(marketMap),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		err := orc.Start(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Start() should have returned context.Canceled error")
+		}
+	}()
+
+	subCtx, subCancel := context.WithCancel(context.Background())
+	updates := orc.SubscribePrices(subCtx)
+
+	// each aggregation round notifies the subscriber with the last sync time
+	for i := 0; i < 2; i++ {
+		select {
+		case ts, ok := <-updates:
+			require.True(t, ok)
+			require.False(t, ts.IsZero())
+		case <-time.After(3 * oracleCfg.UpdateInterval):
+			t.Fatal("timed out waiting for price update")
+		}
+	}
+
+	// cancelling the subscription closes the channel
+	subCancel()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-updates:
+			return !ok
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	orc.Stop()
+}
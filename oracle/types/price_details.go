@@ -0,0 +1,62 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+type (
+	// PriceDetails is a type alias for a map of ticker -> price details.
+	PriceDetails = map[string]PriceDetail
+
+	// PriceDetail describes how the aggregated price of a single ticker was derived.
+	PriceDetail struct {
+		// Decimals is the number of decimals the scaled price is reported with.
+		Decimals uint64
+		// MinProviderCount is the minimum number of converted provider prices required
+		// to aggregate a price.
+		MinProviderCount uint64
+		// Providers contains the provenance of each provider price configured for the ticker.
+		Providers []ProviderPriceDetail
+		// Price is the unscaled aggregate of the converted provider prices. This is nil if
+		// a price could not be aggregated.
+		Price *big.Float
+		// ScaledPrice is the aggregated price scaled by the ticker's decimals. This is nil
+		// if a price could not be aggregated.
+		ScaledPrice *big.Float
+		// Dispersion is the median absolute deviation of the converted provider prices that were
+		// aggregated, in unscaled units. This is nil if a price could not be aggregated.
+		Dispersion *big.Float
+		// ScaledDispersion is the dispersion scaled by the ticker's decimals. This is nil if a price
+		// could not be aggregated.
+		ScaledDispersion *big.Float
+	}
+
+	// ProviderPriceDetail describes a single provider's contribution to an aggregated price.
+	ProviderPriceDetail struct {
+		// Provider is the name of the provider.
+		Provider string
+		// OffChainTicker is the provider's representation of the ticker.
+		OffChainTicker string
+		// Price is the raw price reported by the provider. This is nil if the provider did
+		// not report a price.
+		Price *big.Float
+		// Invert is whether the raw price is inverted before being converted.
+		Invert bool
+		// NormalizeByPair is the pair whose index price the raw price is converted by, if any.
+		NormalizeByPair *pkgtypes.CurrencyPair
+		// NormalizeByPrice is the index price of the normalize by pair used in the conversion.
+		NormalizeByPrice *big.Float
+		// ConvertedPrice is the raw price after inversion and conversion. This is nil if the
+		// price could not be converted.
+		ConvertedPrice *big.Float
+		// Timestamp is the time at which the provider reported the raw price.
+		Timestamp time.Time
+		// Stale is whether the raw price was excluded for being older than the max price age.
+		Stale bool
+		// Error is the reason the price was excluded from aggregation, if any.
+		Error string
+	}
+)
@@ -51,12 +51,19 @@ func (o *OracleImpl) UpdateMarketMap(marketMap mmtypes.MarketMap) error {
 }
 
 // UpdateProviderState updates the provider's state based on the market map. Specifically,
-// this will update the provider's query handler and the provider's market map.
-func (o *OracleImpl) UpdateProviderState(providerTickers []types.ProviderTicker, state ProviderState) (ProviderState, error) {
+// this will update the provider's query handler and the provider's market map. Any additional
+// update options, e.g. a new query handler or configuration, are applied alongside the market map.
+func (o *OracleImpl) UpdateProviderState(
+	providerTickers []types.ProviderTicker,
+	state ProviderState,
+	opts ...base.UpdateOption[types.ProviderTicker, *big.Float],
+) (ProviderState, error) {
 	provider := state.Provider
 
 	o.logger.Info("updating provider state", zap.String("provider_state", provider.Name()))
-	provider.Update(base.WithNewIDs[types.ProviderTicker, *big.Float](providerTickers))
+	provider.Update(append([]base.UpdateOption[types.ProviderTicker, *big.Float]{
+		base.WithNewIDs[types.ProviderTicker, *big.Float](providerTickers),
+	}, opts...)...)
 
 	switch {
 	case len(providerTickers) == 0:
@@ -99,7 +106,11 @@ func (o *OracleImpl) fetchAllPrices() {
 
 	// Compute aggregated prices and update the oracle.
 	o.aggregator.AggregatePrices()
-	o.setLastSyncTime(time.Now().UTC())
+	syncTime := time.Now().UTC()
+	o.setLastSyncTime(syncTime)
+
+	// notify any price subscribers of the new round
+	o.notifySubscribers(syncTime)
 
 	// update the last sync time
 	o.metrics.AddTick()
@@ -177,6 +188,11 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.Int("prices", len(prices)),
 	)
 	o.aggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+
+	// retain the raw results so that the provenance of each price can be reported
+	if aggregator, ok := o.aggregator.(PriceDetailsAggregator); ok {
+		aggregator.SetProviderResults(provider.Name(), prices)
+	}
 }
 
 func (o *OracleImpl) setLastSyncTime(t time.Time) {
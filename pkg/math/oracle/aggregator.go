@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -8,15 +9,17 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/connect/v2/oracle"
+	"github.com/skip-mev/connect/v2/oracle/config"
 	oraclemetrics "github.com/skip-mev/connect/v2/oracle/metrics"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/pkg/math"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
-var _ oracle.PriceAggregator = &IndexPriceAggregator{}
+var _ oracle.PriceDetailsAggregator = &IndexPriceAggregator{}
 
-// IndexPriceAggregator is an aggregator that calculates the median price for each ticker,
+// IndexPriceAggregator is an aggregator that calculates the aggregated (by default median) price for each ticker,
 // resolved from a predefined set of conversion markets. A conversion market is a set of
 // markets that can be used to convert the prices of a set of tickers to a common ticker.
 // These are defined in the market map configuration.
@@ -26,7 +29,7 @@ type IndexPriceAggregator struct {
 	cfg     mmtypes.MarketMap
 	metrics oraclemetrics.Metrics
 
-	// indexPrices cache the median prices for each ticker. These are unscaled prices.
+	// indexPrices cache the aggregated prices for each ticker. These are unscaled prices.
 	indexPrices types.Prices
 	// scaledPrices cache the scaled prices for each ticker. These are the prices that can be
 	// consumed by consumers.
@@ -34,6 +37,18 @@ type IndexPriceAggregator struct {
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// providerResults cache the raw results for each provider, including results that were
+	// excluded for being stale. These are indexed by provider -> offChainTicker -> result.
+	providerResults map[string]map[string]providertypes.ResolvedResult[*big.Float]
+	// priceDetails cache the provenance of each aggregated price.
+	priceDetails types.PriceDetails
+
+	// aggregationCfg is the oracle's aggregation configuration.
+	aggregationCfg config.AggregationConfig
+	// aggregations cache the outlier filter and aggregation strategy of each market, indexed by ticker.
+	aggregations map[string]MarketAggregation
+	// quorums cache the quorum of each market, indexed by ticker.
+	quorums map[string]MarketQuorum
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -41,6 +56,7 @@ func NewIndexPriceAggregator(
 	logger *zap.Logger,
 	cfg mmtypes.MarketMap,
 	metrics oraclemetrics.Metrics,
+	opts ...Option,
 ) (*IndexPriceAggregator, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
@@ -51,31 +67,47 @@ func NewIndexPriceAggregator(
 		metrics = oraclemetrics.NewNopMetrics()
 	}
 
-	return &IndexPriceAggregator{
-		logger:         logger.With(zap.String("process", "index_price_aggregator")),
-		cfg:            cfg,
-		metrics:        metrics,
-		indexPrices:    make(types.Prices),
-		scaledPrices:   make(types.Prices),
-		providerPrices: make(map[string]types.Prices),
-	}, nil
+	m := &IndexPriceAggregator{
+		logger:          logger.With(zap.String("process", "index_price_aggregator")),
+		cfg:             cfg,
+		metrics:         metrics,
+		indexPrices:     make(types.Prices),
+		scaledPrices:    make(types.Prices),
+		providerPrices:  make(map[string]types.Prices),
+		providerResults: make(map[string]map[string]providertypes.ResolvedResult[*big.Float]),
+		priceDetails:    make(types.PriceDetails),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if err := m.aggregationCfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	m.aggregations = m.resolveAggregations(cfg)
+	m.quorums = m.resolveQuorums(cfg)
+
+	return m, nil
 }
 
 // AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
 // aggregation function aggregates the prices seen by each provider by first converting each price to a
-// common ticker and then calculating the median of the converted prices. Prices are converted either
+// common ticker and then aggregating the converted prices using the market's aggregation strategy (the
+// median by default). Prices are converted either
 //
 //  1. Directly from the base ticker to the target ticker. i.e. I have BTC/USD and I want BTC/USD.
 //  2. Using the index price of an asset. i.e. I have BTC/USDT and I want BTC/USD. I can convert
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
-// The index price cache contains the previously calculated median prices.
+// The index price cache contains the previously aggregated prices.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	indexPrices := make(types.Prices)
 	scaledPrices := make(types.Prices)
+	priceDetails := make(types.PriceDetails)
 
 	var missingPrices []string
 
@@ -89,37 +121,87 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
+		aggregation := m.aggregationFor(ticker)
+		convertedPrices := m.CalculateProviderPrices(market)
+
+		// Record the provenance of the price, regardless of whether it can be aggregated.
+		detail := m.CalculatePriceDetail(market)
+
+		// Reject any outliers before aggregating so that a single bad source cannot move the price.
+		convertedPrices, rejectedPrices := aggregation.OutlierFilter.Filter(convertedPrices)
+		for _, rejected := range rejectedPrices {
+			m.logger.Debug(
+				"rejected outlier price",
+				zap.String("target_ticker", ticker),
+				zap.String("price", rejected.Price.String()),
+				zap.Any("provider", rejected.Config.Name),
+				zap.String("off_chain_ticker", rejected.Config.OffChainTicker),
+			)
+
+			m.metrics.AddOutlierRejection(rejected.Config.Name, target.String())
+			markOutlier(&detail, rejected.Config)
+		}
+
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
+		priceDetails[target.String()] = detail
 
-		// We need to have at least the minimum number of providers to calculate the median.
-		if len(convertedPrices) < int(target.MinProviderCount) { //nolint:gosec
+		// The converted prices must satisfy the market's quorum, i.e. at least the minimum number of
+		// providers and any additional requirements on their sources, to aggregate a price.
+		if err := m.quorumFor(ticker, market).Check(convertedPrices); err != nil {
 			missingPrices = append(missingPrices, ticker)
+
+			var quorumErr QuorumError
+			if errors.As(err, &quorumErr) {
+				m.metrics.AddQuorumFailure(target.String(), quorumErr.Reason)
+			}
+
 			m.logger.Debug(
-				"insufficient amount of converted prices",
+				"converted prices do not satisfy quorum",
 				zap.String("target_ticker", ticker),
 				zap.Int("num_converted_prices", len(convertedPrices)),
-				zap.Any("converted_prices", convertedPrices),
+				zap.Any("converted_prices", toBigFloats(convertedPrices)),
 				zap.Int("min_provider_count", int(target.MinProviderCount)), //nolint:gosec
+				zap.Error(err),
 			)
 
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Aggregate the converted prices using the market's strategy. By default, this takes the
+		// median of the converted prices.
+		price, err := aggregation.Strategy.Aggregate(convertedPrices)
+		if err != nil || price == nil {
+			missingPrices = append(missingPrices, ticker)
+			m.logger.Debug(
+				"failed to aggregate converted prices",
+				zap.String("target_ticker", ticker),
+				zap.Error(err),
+			)
+
+			continue
+		}
 		indexPrices[target.String()] = new(big.Float).Copy(price)
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
 
+		detail.Price = indexPrices[target.String()]
+		detail.ScaledPrice = scaledPrices[target.String()]
+
+		// Publish the dispersion of the converted prices alongside the price so that consumers can
+		// gauge how much the sources agree.
+		if dispersion := CalculateDispersion(convertedPrices); dispersion != nil {
+			detail.Dispersion = dispersion
+			detail.ScaledDispersion = math.ScaleBigFloat(new(big.Float).Copy(dispersion), target.Decimals)
+		}
+		priceDetails[target.String()] = detail
+
 		m.logger.Debug(
-			"calculated median price",
+			"calculated aggregated price",
 			zap.String("target_ticker", ticker),
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
 			zap.String("scaled_price", scaledPrices[target.String()].String()),
-			zap.Any("converted_prices", convertedPrices),
+			zap.Any("converted_prices", toBigFloats(convertedPrices)),
 		)
 		floatPrice, _ := price.Float64()
 		m.metrics.AddTickerTick(target.String())
@@ -128,13 +210,14 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 
 	// Update the aggregated data. These prices are going to be used as the index prices the
 	// next time we calculate prices.
-	m.logger.Debug("calculated median prices for price feeds", zap.Int("num_prices", len(indexPrices)))
+	m.logger.Debug("calculated aggregated prices for price feeds", zap.Int("num_prices", len(indexPrices)))
 	m.metrics.MissingPrices(missingPrices)
 	if len(missingPrices) > 0 {
 		m.logger.Info("failed to calculate prices for price feeds", zap.Strings("missing_prices", missingPrices))
 	}
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.priceDetails = priceDetails
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -143,6 +226,15 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
 ) []*big.Float {
+	return toBigFloats(m.CalculateProviderPrices(market))
+}
+
+// CalculateProviderPrices calculates the converted prices for a given set of paths and target ticker,
+// along with the provider configuration that produced each price. The prices utilized are the prices
+// most recently seen by the providers. Each price is within a MaxPriceAge window so is safe to use.
+func (m *IndexPriceAggregator) CalculateProviderPrices(
+	market mmtypes.Market,
+) []ProviderPrice {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -153,7 +245,7 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		return nil
 	}
 
-	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	convertedPrices := make([]ProviderPrice, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
 		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
@@ -169,7 +261,7 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			continue
 		}
 
-		convertedPrices = append(convertedPrices, adjustedPrice)
+		convertedPrices = append(convertedPrices, ProviderPrice{Config: cfg, Price: adjustedPrice})
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
@@ -211,7 +303,7 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 	if err != nil {
 		return nil, err
 	}
-	
+
 	// Make sure that the price is adjusted by the market price.
 	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
 }
@@ -0,0 +1,75 @@
+package oracle
+
+import (
+	"github.com/skip-mev/connect/v2/oracle/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// ErrStalePrice is the reason reported for provider prices that were excluded for being older
+// than the oracle's max price age.
+const ErrStalePrice = "price is older than the max price age"
+
+// CalculatePriceDetail returns the provenance of the given market's price, i.e. for every provider
+// configured for the market, the raw price, the conversion applied to it, and the reason it was
+// excluded from aggregation, if any. The aggregated price is not set on the returned detail.
+func (m *IndexPriceAggregator) CalculatePriceDetail(
+	market mmtypes.Market,
+) types.PriceDetail {
+	detail := types.PriceDetail{
+		Decimals:         market.Ticker.Decimals,
+		MinProviderCount: market.Ticker.MinProviderCount,
+		Providers:        make([]types.ProviderPriceDetail, 0, len(market.ProviderConfigs)),
+	}
+
+	for _, cfg := range market.ProviderConfigs {
+		providerDetail := types.ProviderPriceDetail{
+			Provider:        cfg.Name,
+			OffChainTicker:  cfg.OffChainTicker,
+			Invert:          cfg.Invert,
+			NormalizeByPair: cfg.NormalizeByPair,
+		}
+
+		// The raw result is only available if the oracle reported it. Otherwise, fall back
+		// to the (time filtered) provider price.
+		result, hasResult := m.providerResults[cfg.Name][cfg.OffChainTicker]
+		price, hasPrice := m.providerPrices[cfg.Name][cfg.OffChainTicker]
+		switch {
+		case hasResult:
+			providerDetail.Price = result.Value
+			providerDetail.Timestamp = result.Timestamp
+			providerDetail.Stale = !hasPrice
+		case hasPrice:
+			providerDetail.Price = price
+		}
+
+		if cfg.NormalizeByPair != nil {
+			if indexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair); err == nil {
+				providerDetail.NormalizeByPrice = indexPrice
+			}
+		}
+
+		convertedPrice, err := m.CalculateAdjustedPrice(cfg)
+		switch {
+		case providerDetail.Stale:
+			providerDetail.Error = ErrStalePrice
+		case err != nil:
+			providerDetail.Error = err.Error()
+		default:
+			providerDetail.ConvertedPrice = convertedPrice
+		}
+
+		detail.Providers = append(detail.Providers, providerDetail)
+	}
+
+	return detail
+}
+
+// markOutlier marks the price of the given provider config as rejected by the market's outlier filter.
+func markOutlier(detail *types.PriceDetail, cfg mmtypes.ProviderConfig) {
+	for i, provider := range detail.Providers {
+		if provider.Provider == cfg.Name && provider.OffChainTicker == cfg.OffChainTicker && provider.Error == "" {
+			detail.Providers[i].Error = ErrOutlierPrice
+			return
+		}
+	}
+}
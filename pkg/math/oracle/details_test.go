@@ -0,0 +1,109 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/metrics"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	"github.com/skip-mev/connect/v2/providers/apis/binance"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+func TestGetPriceDetails(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	stale := now.Add(-time.Hour)
+
+	// coinbase reports BTC/USD and BTC/USDT, binance reports a stale BTC/USDT price that was
+	// filtered out by the oracle.
+	m.SetProviderPrices(coinbase.Name, types.Prices{
+		"BTC-USD":  big.NewFloat(70_000),
+		"BTC-USDT": big.NewFloat(70_000),
+	})
+	m.SetProviderResults(coinbase.Name, types.ResolvedPrices{
+		types.NewProviderTicker("BTC-USD", "{}"):  providertypes.NewResult(big.NewFloat(70_000), now),
+		types.NewProviderTicker("BTC-USDT", "{}"): providertypes.NewResult(big.NewFloat(70_000), now),
+	})
+	m.SetProviderPrices(binance.Name, types.Prices{})
+	m.SetProviderResults(binance.Name, types.ResolvedPrices{
+		types.NewProviderTicker("BTCUSDT", "{}"): providertypes.NewResult(big.NewFloat(60_000), stale),
+	})
+	m.SetIndexPrices(types.Prices{
+		USDT_USD.String(): big.NewFloat(1.1),
+	})
+
+	m.AggregatePrices()
+
+	details := m.GetPriceDetails()
+	detail, ok := details[BTC_USD.String()]
+	require.True(t, ok)
+	require.Equal(t, BTC_USD.Decimals, detail.Decimals)
+	require.Equal(t, BTC_USD.MinProviderCount, detail.MinProviderCount)
+	require.Len(t, detail.Providers, len(marketmap.Markets[BTC_USD.String()].ProviderConfigs))
+
+	// only two converted prices are available, which is below the min provider count
+	require.Nil(t, detail.Price)
+	require.Nil(t, detail.ScaledPrice)
+
+	byTicker := make(map[string]types.ProviderPriceDetail)
+	for _, provider := range detail.Providers {
+		byTicker[provider.Provider+"/"+provider.OffChainTicker] = provider
+	}
+
+	direct := byTicker[coinbase.Name+"/BTC-USD"]
+	require.Equal(t, big.NewFloat(70_000), direct.Price)
+	require.Equal(t, big.NewFloat(70_000), direct.ConvertedPrice)
+	require.Equal(t, now, direct.Timestamp)
+	require.False(t, direct.Stale)
+	require.Empty(t, direct.Error)
+
+	normalized := byTicker[coinbase.Name+"/BTC-USDT"]
+	require.Equal(t, usdtusdCP, *normalized.NormalizeByPair)
+	require.Equal(t, big.NewFloat(1.1), normalized.NormalizeByPrice)
+	require.Equal(t, big.NewFloat(77_000).SetPrec(36), normalized.ConvertedPrice.SetPrec(36))
+	require.Empty(t, normalized.Error)
+
+	excluded := byTicker[binance.Name+"/BTCUSDT"]
+	require.Equal(t, big.NewFloat(60_000), excluded.Price)
+	require.Equal(t, stale, excluded.Timestamp)
+	require.True(t, excluded.Stale)
+	require.Equal(t, oracle.ErrStalePrice, excluded.Error)
+	require.Nil(t, excluded.ConvertedPrice)
+
+	// resetting the aggregator clears the raw results, but the details of the last round remain
+	m.Reset()
+	require.Len(t, m.GetPriceDetails(), len(details))
+}
+
+func TestGetPriceDetailsAggregatedPrice(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	m.SetProviderPrices(coinbase.Name, types.Prices{
+		"USDT-USD": big.NewFloat(1.1),
+	})
+	m.SetProviderPrices(binance.Name, types.Prices{
+		"USDTUSD": big.NewFloat(1.2),
+	})
+
+	m.AggregatePrices()
+
+	detail, ok := m.GetPriceDetails()[USDT_USD.String()]
+	require.True(t, ok)
+	require.Equal(t, big.NewFloat(1.15).SetPrec(36), detail.Price.SetPrec(36))
+	require.NotNil(t, detail.ScaledPrice)
+
+	for _, provider := range detail.Providers {
+		// no raw results were reported, so the timestamps are unknown
+		require.True(t, provider.Timestamp.IsZero())
+		require.False(t, provider.Stale)
+	}
+}
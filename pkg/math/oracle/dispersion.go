@@ -0,0 +1,24 @@
+package oracle
+
+import (
+	"math/big"
+
+	"github.com/skip-mev/connect/v2/pkg/math"
+)
+
+// CalculateDispersion returns the dispersion of the given converted prices, measured as the median
+// absolute deviation (MAD) of the prices from their median. The dispersion is reported in the same
+// (unscaled) units as the prices. Nil is returned if there are no prices.
+func CalculateDispersion(prices []ProviderPrice) *big.Float {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	median := math.CalculateMedian(toBigFloats(prices))
+	deviations := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		deviations[i] = absDiff(price.Price, median)
+	}
+
+	return math.CalculateMedian(deviations)
+}
@@ -0,0 +1,62 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+)
+
+func TestCalculateDispersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		prices   []oracle.ProviderPrice
+		expected *big.Float
+	}{
+		{
+			name:     "no prices",
+			prices:   nil,
+			expected: nil,
+		},
+		{
+			name:     "single price has no dispersion",
+			prices:   providerPrices(100),
+			expected: big.NewFloat(0),
+		},
+		{
+			name:     "identical prices have no dispersion",
+			prices:   providerPrices(100, 100, 100),
+			expected: big.NewFloat(0),
+		},
+		{
+			name:     "odd number of prices",
+			prices:   providerPrices(99, 100, 102),
+			expected: big.NewFloat(1),
+		},
+		{
+			name:     "even number of prices",
+			prices:   providerPrices(100, 101, 103, 110),
+			expected: big.NewFloat(1.5),
+		},
+		{
+			name:     "a single outlier does not move the dispersion",
+			prices:   providerPrices(100, 101, 99, 1000),
+			expected: big.NewFloat(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispersion := oracle.CalculateDispersion(tc.prices)
+			if tc.expected == nil {
+				require.Nil(t, dispersion)
+				return
+			}
+
+			require.NotNil(t, dispersion)
+			require.Zero(t, tc.expected.Cmp(dispersion), "expected %s, got %s", tc.expected, dispersion)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package oracle
+
+import (
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+// Option is a functional option for the index price aggregator.
+type Option func(*IndexPriceAggregator)
+
+// WithAggregationConfig sets the aggregation config used to select the aggregation strategy of
+// each market.
+func WithAggregationConfig(cfg config.AggregationConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		m.aggregationCfg = cfg
+	}
+}
@@ -0,0 +1,137 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/math"
+)
+
+const (
+	// DefaultOutlierFilterMinPrices is the default minimum number of converted prices required to
+	// apply an outlier filter. An outlier cannot be distinguished amongst fewer prices.
+	DefaultOutlierFilterMinPrices = 3
+
+	// ErrOutlierPrice is the reason reported for provider prices that were rejected by the
+	// market's outlier filter.
+	ErrOutlierPrice = "price was rejected as an outlier"
+)
+
+// OutlierFilter rejects converted provider prices that deviate too far from the rest of a market's
+// prices, before they are aggregated. Implementations must not modify or reorder the given prices.
+type OutlierFilter interface {
+	// Filter partitions the given prices into the accepted and rejected prices.
+	Filter(prices []ProviderPrice) (accepted, rejected []ProviderPrice)
+}
+
+// NewOutlierFilter returns the outlier filter selected by the given config.
+func NewOutlierFilter(cfg config.OutlierFilterConfig) (OutlierFilter, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	minPrices := cfg.MinPrices
+	if minPrices == 0 {
+		minPrices = DefaultOutlierFilterMinPrices
+	}
+
+	switch cfg.Method {
+	case "":
+		return NoOutlierFilter{}, nil
+	case config.MADOutlierFilter:
+		return MADOutlierFilter{Threshold: cfg.Threshold, MinPrices: minPrices}, nil
+	case config.MaxDeviationOutlierFilter:
+		return MaxDeviationOutlierFilter{MaxDeviation: cfg.Threshold, MinPrices: minPrices}, nil
+	default:
+		return nil, fmt.Errorf("unknown outlier filter: %s", cfg.Method)
+	}
+}
+
+// NoOutlierFilter accepts every price.
+type NoOutlierFilter struct{}
+
+// Filter implements the OutlierFilter interface.
+func (NoOutlierFilter) Filter(prices []ProviderPrice) ([]ProviderPrice, []ProviderPrice) {
+	return prices, nil
+}
+
+// MADOutlierFilter rejects prices whose absolute distance from the median exceeds Threshold times the
+// median absolute deviation (MAD) of the prices. If the MAD is zero, i.e. more than half of the prices
+// are identical, no price is rejected.
+type MADOutlierFilter struct {
+	// Threshold is the number of median absolute deviations a price may be from the median.
+	Threshold float64
+	// MinPrices is the minimum number of prices required to apply the filter.
+	MinPrices int
+}
+
+// Filter implements the OutlierFilter interface.
+func (f MADOutlierFilter) Filter(prices []ProviderPrice) ([]ProviderPrice, []ProviderPrice) {
+	if len(prices) < f.MinPrices {
+		return prices, nil
+	}
+
+	median := math.CalculateMedian(toBigFloats(prices))
+	deviations := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		deviations[i] = absDiff(price.Price, median)
+	}
+
+	mad := math.CalculateMedian(deviations)
+	if mad.Sign() == 0 {
+		return prices, nil
+	}
+
+	limit := new(big.Float).Mul(mad, big.NewFloat(f.Threshold))
+	return partition(prices, func(price *big.Float) bool {
+		return absDiff(price, median).Cmp(limit) <= 0
+	})
+}
+
+// MaxDeviationOutlierFilter rejects prices whose relative deviation from the median exceeds MaxDeviation.
+type MaxDeviationOutlierFilter struct {
+	// MaxDeviation is the maximum relative deviation from the median, e.g. 0.05 for 5%.
+	MaxDeviation float64
+	// MinPrices is the minimum number of prices required to apply the filter.
+	MinPrices int
+}
+
+// Filter implements the OutlierFilter interface.
+func (f MaxDeviationOutlierFilter) Filter(prices []ProviderPrice) ([]ProviderPrice, []ProviderPrice) {
+	if len(prices) < f.MinPrices {
+		return prices, nil
+	}
+
+	median := math.CalculateMedian(toBigFloats(prices))
+	if median.Sign() == 0 {
+		return prices, nil
+	}
+
+	limit := new(big.Float).Mul(new(big.Float).Abs(median), big.NewFloat(f.MaxDeviation))
+	return partition(prices, func(price *big.Float) bool {
+		return absDiff(price, median).Cmp(limit) <= 0
+	})
+}
+
+// partition splits the given prices into those that are accepted by the given function and those that
+// are not, retaining the order of the prices.
+func partition(prices []ProviderPrice, accept func(*big.Float) bool) ([]ProviderPrice, []ProviderPrice) {
+	accepted := make([]ProviderPrice, 0, len(prices))
+	var rejected []ProviderPrice
+	for _, price := range prices {
+		if accept(price.Price) {
+			accepted = append(accepted, price)
+		} else {
+			rejected = append(rejected, price)
+		}
+	}
+
+	return accepted, rejected
+}
+
+// absDiff returns |a - b|.
+func absDiff(a, b *big.Float) *big.Float {
+	diff := new(big.Float).Sub(a, b)
+	return diff.Abs(diff)
+}
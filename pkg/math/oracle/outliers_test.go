@@ -0,0 +1,247 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	metricmocks "github.com/skip-mev/connect/v2/oracle/metrics/mocks"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestNewOutlierFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      config.OutlierFilterConfig
+		expected oracle.OutlierFilter
+		err      bool
+	}{
+		{
+			name:     "no method disables filtering",
+			cfg:      config.OutlierFilterConfig{},
+			expected: oracle.NoOutlierFilter{},
+		},
+		{
+			name:     "mad with default min prices",
+			cfg:      config.OutlierFilterConfig{Method: config.MADOutlierFilter, Threshold: 3},
+			expected: oracle.MADOutlierFilter{Threshold: 3, MinPrices: oracle.DefaultOutlierFilterMinPrices},
+		},
+		{
+			name:     "max deviation",
+			cfg:      config.OutlierFilterConfig{Method: config.MaxDeviationOutlierFilter, Threshold: 0.05, MinPrices: 4},
+			expected: oracle.MaxDeviationOutlierFilter{MaxDeviation: 0.05, MinPrices: 4},
+		},
+		{
+			name: "unknown method",
+			cfg:  config.OutlierFilterConfig{Method: "zscore", Threshold: 3},
+			err:  true,
+		},
+		{
+			name: "non-positive threshold",
+			cfg:  config.OutlierFilterConfig{Method: config.MADOutlierFilter},
+			err:  true,
+		},
+		{
+			name: "min prices below three",
+			cfg:  config.OutlierFilterConfig{Method: config.MADOutlierFilter, Threshold: 3, MinPrices: 2},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := oracle.NewOutlierFilter(tc.cfg)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, filter)
+		})
+	}
+}
+
+func TestOutlierFilters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   oracle.OutlierFilter
+		prices   []oracle.ProviderPrice
+		accepted []float64
+		rejected []float64
+	}{
+		{
+			name:     "mad rejects a price far from the median",
+			filter:   oracle.MADOutlierFilter{Threshold: 3, MinPrices: 3},
+			prices:   providerPrices(100, 101, 99, 150),
+			accepted: []float64{100, 101, 99},
+			rejected: []float64{150},
+		},
+		{
+			name:     "mad accepts prices within the threshold",
+			filter:   oracle.MADOutlierFilter{Threshold: 3, MinPrices: 3},
+			prices:   providerPrices(100, 101, 99, 102),
+			accepted: []float64{100, 101, 99, 102},
+		},
+		{
+			name:     "mad accepts every price if the deviation is zero",
+			filter:   oracle.MADOutlierFilter{Threshold: 3, MinPrices: 3},
+			prices:   providerPrices(100, 100, 150),
+			accepted: []float64{100, 100, 150},
+		},
+		{
+			name:     "mad is not applied below the min prices",
+			filter:   oracle.MADOutlierFilter{Threshold: 3, MinPrices: 5},
+			prices:   providerPrices(100, 101, 99, 150),
+			accepted: []float64{100, 101, 99, 150},
+		},
+		{
+			name:     "max deviation rejects a price far from the median",
+			filter:   oracle.MaxDeviationOutlierFilter{MaxDeviation: 0.05, MinPrices: 3},
+			prices:   providerPrices(100, 100, 150),
+			accepted: []float64{100, 100},
+			rejected: []float64{150},
+		},
+		{
+			name:     "max deviation accepts prices within the deviation",
+			filter:   oracle.MaxDeviationOutlierFilter{MaxDeviation: 0.05, MinPrices: 3},
+			prices:   providerPrices(100, 104, 96),
+			accepted: []float64{100, 104, 96},
+		},
+		{
+			name:     "max deviation is not applied below the min prices",
+			filter:   oracle.MaxDeviationOutlierFilter{MaxDeviation: 0.05, MinPrices: 3},
+			prices:   providerPrices(100, 150),
+			accepted: []float64{100, 150},
+		},
+		{
+			name:     "no filter accepts every price",
+			filter:   oracle.NoOutlierFilter{},
+			prices:   providerPrices(100, 1000),
+			accepted: []float64{100, 1000},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accepted, rejected := tc.filter.Filter(tc.prices)
+			requirePrices(t, tc.accepted, accepted)
+			requirePrices(t, tc.rejected, rejected)
+		})
+	}
+}
+
+func TestAggregatePricesWithOutlierFilter(t *testing.T) {
+	ticker := mmtypes.Ticker{
+		CurrencyPair:     pkgtypes.NewCurrencyPair("ABC", "USD"),
+		Decimals:         8,
+		MinProviderCount: 3,
+		Enabled:          true,
+	}
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: "a", OffChainTicker: "ABC-USD"},
+					{Name: "b", OffChainTicker: "ABC-USD"},
+					{Name: "c", OffChainTicker: "ABC-USD"},
+					{Name: "d", OffChainTicker: "ABC-USD"},
+				},
+			},
+		},
+	}
+
+	newAggregator := func(t *testing.T, metrics *metricmocks.Metrics) *oracle.IndexPriceAggregator {
+		t.Helper()
+
+		agg, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketMap,
+			metrics,
+			oracle.WithAggregationConfig(config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					Strategy: config.TrimmedMeanStrategy,
+					OutlierFilter: config.OutlierFilterConfig{
+						Method:    config.MaxDeviationOutlierFilter,
+						Threshold: 0.1,
+					},
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		return agg
+	}
+
+	expectMetrics := func(metrics *metricmocks.Metrics) {
+		metrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metrics.On("AddTickerTick", mock.Anything).Maybe()
+		metrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metrics.On("MissingPrices", mock.Anything).Maybe()
+	}
+
+	t.Run("outliers are excluded from the aggregated price", func(t *testing.T) {
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("AddOutlierRejection", "d", ticker.String()).Once()
+		metrics.On("AddProviderCountForMarket", ticker.String(), 3).Once()
+		expectMetrics(metrics)
+
+		agg := newAggregator(t, metrics)
+		for name, price := range map[string]float64{"a": 100, "b": 101, "c": 102, "d": 1000} {
+			agg.SetProviderPrices(name, types.Prices{"ABC-USD": big.NewFloat(price)})
+		}
+		agg.AggregatePrices()
+
+		price := agg.GetIndexPrices()[ticker.String()]
+		require.Zero(t, big.NewFloat(101).Cmp(price), "expected 101, got %s", price)
+
+		detail := agg.GetPriceDetails()[ticker.String()]
+		require.Len(t, detail.Providers, 4)
+		for _, provider := range detail.Providers {
+			if provider.Provider == "d" {
+				require.Equal(t, oracle.ErrOutlierPrice, provider.Error)
+				require.NotNil(t, provider.ConvertedPrice)
+			} else {
+				require.Empty(t, provider.Error)
+			}
+		}
+
+		// the dispersion only reflects the prices that were aggregated
+		require.Zero(t, big.NewFloat(1).Cmp(detail.Dispersion), "expected 1, got %s", detail.Dispersion)
+		require.Zero(t, big.NewFloat(1e8).Cmp(detail.ScaledDispersion), "expected 1e8, got %s", detail.ScaledDispersion)
+	})
+
+	t.Run("rejected outliers count against the min provider count", func(t *testing.T) {
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("AddOutlierRejection", "c", ticker.String()).Once()
+		metrics.On("AddProviderCountForMarket", ticker.String(), 2).Once()
+		metrics.On("AddQuorumFailure", ticker.String(), oracle.QuorumReasonMinProviderCount).Once()
+		expectMetrics(metrics)
+
+		agg := newAggregator(t, metrics)
+		for name, price := range map[string]float64{"a": 100, "b": 101, "c": 1000} {
+			agg.SetProviderPrices(name, types.Prices{"ABC-USD": big.NewFloat(price)})
+		}
+		agg.AggregatePrices()
+
+		_, ok := agg.GetIndexPrices()[ticker.String()]
+		require.False(t, ok)
+	})
+}
+
+func requirePrices(t *testing.T, expected []float64, actual []oracle.ProviderPrice) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i, price := range expected {
+		require.Zero(t, big.NewFloat(price).Cmp(actual[i].Price), "expected %f, got %s", price, actual[i].Price)
+	}
+}
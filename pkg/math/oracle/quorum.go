@@ -0,0 +1,158 @@
+package oracle
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+const (
+	// QuorumReasonMinProviderCount is the reason reported when a market has fewer converted prices than its
+	// ticker's MinProviderCount.
+	QuorumReasonMinProviderCount = "min_provider_count"
+	// QuorumReasonMinVenues is the reason reported when a market's prices come from too few distinct venues.
+	QuorumReasonMinVenues = "min_venues"
+	// QuorumReasonMinDirectSources is the reason reported when a market has too few prices that are not
+	// normalized by another market.
+	QuorumReasonMinDirectSources = "min_direct_sources"
+	// QuorumReasonMaxVenueShare is the reason reported when too large a share of a market's prices comes
+	// from a single venue.
+	QuorumReasonMaxVenueShare = "max_venue_share"
+)
+
+// QuorumError is returned when a market's converted prices do not satisfy its quorum. Reason is one of the
+// QuorumReason values.
+type QuorumError struct {
+	Reason string
+	Msg    string
+}
+
+// Error implements the error interface.
+func (e QuorumError) Error() string {
+	return fmt.Sprintf("quorum not met (%s): %s", e.Reason, e.Msg)
+}
+
+// MarketQuorum is the set of requirements a market's converted prices must satisfy to be aggregated. It is
+// composed of the ticker's MinProviderCount and the optional quorum of the ticker's metadata.
+type MarketQuorum struct {
+	// MinProviderCount is the minimum number of converted prices.
+	MinProviderCount uint64
+	// Quorum contains the additional requirements on the sources of the prices.
+	tickermetadata.Quorum
+}
+
+// Check returns a QuorumError if the given prices do not satisfy the quorum. The checks are performed in the
+// order: provider count, distinct venues, direct sources and venue share.
+func (q MarketQuorum) Check(prices []ProviderPrice) error {
+	if uint64(len(prices)) < q.MinProviderCount {
+		return QuorumError{
+			Reason: QuorumReasonMinProviderCount,
+			Msg:    fmt.Sprintf("got %d prices; need %d", len(prices), q.MinProviderCount),
+		}
+	}
+
+	venues := make(map[string]int)
+	direct := uint64(0)
+	for _, price := range prices {
+		venues[ProviderVenue(price.Config)]++
+		if price.Config.NormalizeByPair == nil {
+			direct++
+		}
+	}
+
+	if uint64(len(venues)) < q.MinVenues {
+		return QuorumError{
+			Reason: QuorumReasonMinVenues,
+			Msg:    fmt.Sprintf("got prices from %d venues; need %d", len(venues), q.MinVenues),
+		}
+	}
+
+	if direct < q.MinDirectSources {
+		return QuorumError{
+			Reason: QuorumReasonMinDirectSources,
+			Msg:    fmt.Sprintf("got %d direct prices; need %d", direct, q.MinDirectSources),
+		}
+	}
+
+	if q.MaxVenueShare > 0 && len(prices) > 0 {
+		for venue, count := range venues {
+			if share := float64(count) / float64(len(prices)); share > q.MaxVenueShare {
+				return QuorumError{
+					Reason: QuorumReasonMaxVenueShare,
+					Msg:    fmt.Sprintf("venue %s has a share of %.2f; max %.2f", venue, share, q.MaxVenueShare),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ProviderVenue returns the venue of the given provider config. This is the venue set in the provider
+// config's metadata if any, and otherwise the provider name up to its `_api` or `_ws` suffix, so that e.g.
+// `binance_api` and `binance_ws` are both attributed to `binance`.
+func ProviderVenue(cfg mmtypes.ProviderConfig) string {
+	if cfg.Metadata_JSON != "" {
+		if metadata, err := tickermetadata.ProviderVenueFromJSONString(cfg.Metadata_JSON); err == nil && metadata.Venue != "" {
+			return metadata.Venue
+		}
+	}
+
+	for _, suffix := range []string{"_api", "_ws"} {
+		if i := strings.LastIndex(cfg.Name, suffix); i > 0 {
+			return cfg.Name[:i]
+		}
+	}
+
+	return cfg.Name
+}
+
+// resolveQuorums returns the quorum of each market in the given market map. Markets whose metadata does not
+// configure a valid quorum only require their ticker's MinProviderCount.
+func (m *IndexPriceAggregator) resolveQuorums(marketMap mmtypes.MarketMap) map[string]MarketQuorum {
+	quorums := make(map[string]MarketQuorum, len(marketMap.Markets))
+	for ticker, market := range marketMap.Markets {
+		quorum := MarketQuorum{MinProviderCount: market.Ticker.MinProviderCount}
+
+		if market.Ticker.Metadata_JSON != "" {
+			metadata, err := tickermetadata.QuorumMetadataFromJSONString(market.Ticker.Metadata_JSON)
+			switch {
+			case err != nil:
+				m.logger.Debug(
+					"failed to parse ticker metadata; using min provider count as quorum",
+					zap.String("ticker", ticker),
+					zap.Error(err),
+				)
+			case metadata.Quorum != nil:
+				if err := metadata.Quorum.ValidateBasic(); err != nil {
+					m.logger.Error(
+						"invalid quorum; using min provider count as quorum",
+						zap.String("ticker", ticker),
+						zap.Error(err),
+					)
+					break
+				}
+
+				quorum.Quorum = *metadata.Quorum
+			}
+		}
+
+		quorums[ticker] = quorum
+	}
+
+	return quorums
+}
+
+// quorumFor returns the quorum of the given market. This defaults to the market's MinProviderCount if the
+// market has no resolved quorum.
+func (m *IndexPriceAggregator) quorumFor(ticker string, market mmtypes.Market) MarketQuorum {
+	if quorum, ok := m.quorums[ticker]; ok {
+		return quorum
+	}
+
+	return MarketQuorum{MinProviderCount: market.Ticker.MinProviderCount}
+}
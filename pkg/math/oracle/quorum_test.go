@@ -0,0 +1,184 @@
+package oracle_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	metricmocks "github.com/skip-mev/connect/v2/oracle/metrics/mocks"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func TestProviderVenue(t *testing.T) {
+	testCases := []struct {
+		cfg      mmtypes.ProviderConfig
+		expected string
+	}{
+		{mmtypes.ProviderConfig{Name: "binance_api"}, "binance"},
+		{mmtypes.ProviderConfig{Name: "binance_ws"}, "binance"},
+		{mmtypes.ProviderConfig{Name: "crypto_dot_com_ws"}, "crypto_dot_com"},
+		{mmtypes.ProviderConfig{Name: "uniswapv3_api-ethereum"}, "uniswapv3"},
+		{mmtypes.ProviderConfig{Name: "custom"}, "custom"},
+		{mmtypes.ProviderConfig{Name: "custom", Metadata_JSON: `{"venue":"binance"}`}, "binance"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cfg.Name, func(t *testing.T) {
+			require.Equal(t, tc.expected, oracle.ProviderVenue(tc.cfg))
+		})
+	}
+}
+
+func TestMarketQuorumCheck(t *testing.T) {
+	usdtusd := pkgtypes.NewCurrencyPair("USDT", "USD")
+	price := func(name string, normalized bool) oracle.ProviderPrice {
+		cfg := mmtypes.ProviderConfig{Name: name, OffChainTicker: "BTC-USD"}
+		if normalized {
+			cfg.NormalizeByPair = &usdtusd
+		}
+		return oracle.ProviderPrice{Config: cfg, Price: big.NewFloat(1)}
+	}
+
+	testCases := []struct {
+		name   string
+		quorum oracle.MarketQuorum
+		prices []oracle.ProviderPrice
+		reason string
+	}{
+		{
+			name:   "min provider count is met",
+			quorum: oracle.MarketQuorum{MinProviderCount: 2},
+			prices: []oracle.ProviderPrice{price("binance_api", false), price("binance_ws", false)},
+		},
+		{
+			name:   "min provider count is not met",
+			quorum: oracle.MarketQuorum{MinProviderCount: 3},
+			prices: []oracle.ProviderPrice{price("binance_api", false), price("binance_ws", false)},
+			reason: oracle.QuorumReasonMinProviderCount,
+		},
+		{
+			name: "two feeds from one venue do not satisfy min venues",
+			quorum: oracle.MarketQuorum{
+				MinProviderCount: 2,
+				Quorum:           tickermetadata.Quorum{MinVenues: 2},
+			},
+			prices: []oracle.ProviderPrice{price("binance_api", false), price("binance_ws", false)},
+			reason: oracle.QuorumReasonMinVenues,
+		},
+		{
+			name: "min venues is met",
+			quorum: oracle.MarketQuorum{
+				MinProviderCount: 2,
+				Quorum:           tickermetadata.Quorum{MinVenues: 2},
+			},
+			prices: []oracle.ProviderPrice{price("binance_api", false), price("okx_ws", false)},
+		},
+		{
+			name: "min direct sources is not met",
+			quorum: oracle.MarketQuorum{
+				Quorum: tickermetadata.Quorum{MinDirectSources: 1},
+			},
+			prices: []oracle.ProviderPrice{price("binance_api", true), price("okx_ws", true)},
+			reason: oracle.QuorumReasonMinDirectSources,
+		},
+		{
+			name: "max venue share is exceeded",
+			quorum: oracle.MarketQuorum{
+				Quorum: tickermetadata.Quorum{MaxVenueShare: 0.5},
+			},
+			prices: []oracle.ProviderPrice{price("binance_api", false), price("binance_ws", false), price("okx_ws", false)},
+			reason: oracle.QuorumReasonMaxVenueShare,
+		},
+		{
+			name: "max venue share is met",
+			quorum: oracle.MarketQuorum{
+				Quorum: tickermetadata.Quorum{MaxVenueShare: 0.5},
+			},
+			prices: []oracle.ProviderPrice{price("binance_api", false), price("okx_ws", false)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quorum.Check(tc.prices)
+			if tc.reason == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var quorumErr oracle.QuorumError
+			require.True(t, errors.As(err, &quorumErr))
+			require.Equal(t, tc.reason, quorumErr.Reason)
+		})
+	}
+}
+
+func TestAggregatePricesWithQuorum(t *testing.T) {
+	ticker := mmtypes.Ticker{
+		CurrencyPair:     pkgtypes.NewCurrencyPair("ABC", "USD"),
+		Decimals:         8,
+		MinProviderCount: 2,
+		Enabled:          true,
+		Metadata_JSON:    `{"quorum":{"min_venues":2}}`,
+	}
+	marketMap := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: "binance_api", OffChainTicker: "ABCUSD"},
+					{Name: "binance_ws", OffChainTicker: "ABCUSD"},
+					{Name: "okx_ws", OffChainTicker: "ABC-USD"},
+				},
+			},
+		},
+	}
+
+	expectMetrics := func(metrics *metricmocks.Metrics) {
+		metrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Maybe()
+		metrics.On("AddTickerTick", mock.Anything).Maybe()
+		metrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metrics.On("MissingPrices", mock.Anything).Maybe()
+	}
+
+	t.Run("prices from a single venue do not satisfy the quorum", func(t *testing.T) {
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("AddQuorumFailure", ticker.String(), oracle.QuorumReasonMinVenues).Once()
+		expectMetrics(metrics)
+
+		agg, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics)
+		require.NoError(t, err)
+
+		agg.SetProviderPrices("binance_api", types.Prices{"ABCUSD": big.NewFloat(100)})
+		agg.SetProviderPrices("binance_ws", types.Prices{"ABCUSD": big.NewFloat(101)})
+		agg.AggregatePrices()
+
+		_, ok := agg.GetIndexPrices()[ticker.String()]
+		require.False(t, ok)
+	})
+
+	t.Run("prices from distinct venues satisfy the quorum", func(t *testing.T) {
+		metrics := metricmocks.NewMetrics(t)
+		expectMetrics(metrics)
+
+		agg, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics)
+		require.NoError(t, err)
+
+		agg.SetProviderPrices("binance_api", types.Prices{"ABCUSD": big.NewFloat(100)})
+		agg.SetProviderPrices("okx_ws", types.Prices{"ABC-USD": big.NewFloat(102)})
+		agg.AggregatePrices()
+
+		price, ok := agg.GetIndexPrices()[ticker.String()]
+		require.True(t, ok)
+		require.Zero(t, big.NewFloat(101).Cmp(price), "expected 101, got %s", price)
+	})
+}
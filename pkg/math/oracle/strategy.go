@@ -0,0 +1,276 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/math"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+// ProviderPrice is a provider price that has been converted to the target ticker of a market,
+// along with the provider configuration that produced it.
+type ProviderPrice struct {
+	// Config is the provider configuration that produced the price.
+	Config mmtypes.ProviderConfig
+	// Price is the converted price.
+	Price *big.Float
+}
+
+// AggregationStrategy aggregates the converted provider prices of a market into a single price.
+// Implementations may assume that the given prices are non-empty, but must not retain them.
+type AggregationStrategy interface {
+	// Aggregate returns the aggregated price of the given converted provider prices.
+	Aggregate(prices []ProviderPrice) (*big.Float, error)
+}
+
+// NewAggregationStrategy returns the aggregation strategy selected by the given config.
+func NewAggregationStrategy(cfg config.AggregationStrategyConfig) (AggregationStrategy, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Strategy {
+	case "", config.MedianStrategy:
+		return MedianStrategy{}, nil
+	case config.TrimmedMeanStrategy:
+		return TrimmedMeanStrategy{TrimFraction: cfg.TrimFraction}, nil
+	case config.LiquidityWeightedMedianStrategy:
+		return WeightedMedianStrategy{Weight: ProviderLiquidity}, nil
+	case config.ProviderWeightedMedianStrategy:
+		return WeightedMedianStrategy{Weight: ProviderWeight}, nil
+	default:
+		return nil, fmt.Errorf("unknown aggregation strategy: %s", cfg.Strategy)
+	}
+}
+
+// MedianStrategy aggregates prices via their median. This takes the average of the middle two
+// prices if the number of prices is even.
+type MedianStrategy struct{}
+
+// Aggregate implements the AggregationStrategy interface.
+func (MedianStrategy) Aggregate(prices []ProviderPrice) (*big.Float, error) {
+	return math.CalculateMedian(toBigFloats(prices)), nil
+}
+
+// TrimmedMeanStrategy aggregates prices via their mean, after discarding the given fraction of the
+// lowest and highest prices. If no prices remain after trimming, the median is returned.
+type TrimmedMeanStrategy struct {
+	// TrimFraction is the fraction of prices that is discarded from each end.
+	TrimFraction float64
+}
+
+// Aggregate implements the AggregationStrategy interface.
+func (s TrimmedMeanStrategy) Aggregate(prices []ProviderPrice) (*big.Float, error) {
+	values := toBigFloats(prices)
+	math.SortBigFloats(values)
+
+	trim := int(float64(len(values)) * s.TrimFraction)
+	trimmed := values[trim : len(values)-trim]
+	if len(trimmed) == 0 {
+		return math.CalculateMedian(values), nil
+	}
+
+	sum := new(big.Float)
+	for _, value := range trimmed {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(trimmed)))), nil
+}
+
+// WeightedMedianStrategy aggregates prices via their weighted median, i.e. the lowest price at which
+// the cumulative weight of all lower prices reaches half of the total weight. Prices with a non-positive
+// weight are ignored. If no price has a positive weight, the (unweighted) median is returned.
+type WeightedMedianStrategy struct {
+	// Weight returns the weight of the price produced by the given provider configuration.
+	Weight func(cfg mmtypes.ProviderConfig) (float64, error)
+}
+
+// Aggregate implements the AggregationStrategy interface.
+func (s WeightedMedianStrategy) Aggregate(prices []ProviderPrice) (*big.Float, error) {
+	type weightedPrice struct {
+		price  *big.Float
+		weight float64
+	}
+
+	var (
+		weighted    = make([]weightedPrice, 0, len(prices))
+		totalWeight float64
+	)
+	for _, price := range prices {
+		weight, err := s.Weight(price.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine weight of %s price: %w", price.Config.Name, err)
+		}
+
+		if weight <= 0 {
+			continue
+		}
+
+		weighted = append(weighted, weightedPrice{price: price.Price, weight: weight})
+		totalWeight += weight
+	}
+
+	if len(weighted) == 0 {
+		return MedianStrategy{}.Aggregate(prices)
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].price.Cmp(weighted[j].price) < 0
+	})
+
+	middle := totalWeight / 2
+	sum := 0.0
+	for _, price := range weighted {
+		sum += price.weight
+		if sum >= middle {
+			return price.price, nil
+		}
+	}
+
+	// This is unreachable given a positive total weight, but guards against rounding.
+	return weighted[len(weighted)-1].price, nil
+}
+
+// ProviderWeight returns the weight declared in the metadata of the given provider configuration. This
+// defaults to 1 if no weight is declared.
+func ProviderWeight(cfg mmtypes.ProviderConfig) (float64, error) {
+	if cfg.Metadata_JSON == "" {
+		return 1, nil
+	}
+
+	metadata, err := tickermetadata.ProviderWeightFromJSONString(cfg.Metadata_JSON)
+	if err != nil {
+		return 0, err
+	}
+
+	if metadata.Weight == nil {
+		return 1, nil
+	}
+
+	return *metadata.Weight, nil
+}
+
+// ProviderLiquidity returns the liquidity declared in the metadata of the given provider configuration.
+// This defaults to 0 if no liquidity is declared.
+func ProviderLiquidity(cfg mmtypes.ProviderConfig) (float64, error) {
+	if cfg.Metadata_JSON == "" {
+		return 0, nil
+	}
+
+	metadata, err := tickermetadata.ProviderWeightFromJSONString(cfg.Metadata_JSON)
+	if err != nil {
+		return 0, err
+	}
+
+	return metadata.Liquidity, nil
+}
+
+// MarketAggregation is the resolved aggregation configuration of a single market.
+type MarketAggregation struct {
+	// OutlierFilter is applied to the market's converted prices before they are aggregated.
+	OutlierFilter OutlierFilter
+	// Strategy aggregates the market's accepted converted prices into a single price.
+	Strategy AggregationStrategy
+}
+
+// NewMarketAggregation returns the market aggregation selected by the given config.
+func NewMarketAggregation(cfg config.AggregationStrategyConfig) (MarketAggregation, error) {
+	strategy, err := NewAggregationStrategy(cfg)
+	if err != nil {
+		return MarketAggregation{}, err
+	}
+
+	filter, err := NewOutlierFilter(cfg.OutlierFilter)
+	if err != nil {
+		return MarketAggregation{}, err
+	}
+
+	return MarketAggregation{OutlierFilter: filter, Strategy: strategy}, nil
+}
+
+// resolveAggregations returns the aggregation of each market in the given market map. Precedence is
+// given to the per-market overrides of the aggregation config, then to the aggregation field of the
+// ticker's metadata, and finally to the config's default strategy. The ticker's metadata only
+// overrides the fields it sets on top of the default. Markets whose metadata selects an invalid
+// strategy or outlier filter fall back to the default.
+func (m *IndexPriceAggregator) resolveAggregations(marketMap mmtypes.MarketMap) map[string]MarketAggregation {
+	aggregations := make(map[string]MarketAggregation, len(marketMap.Markets))
+	for ticker, market := range marketMap.Markets {
+		cfg := m.aggregationCfg.Default
+		if override, ok := m.aggregationCfg.Markets[ticker]; ok {
+			cfg = override
+		} else if market.Ticker.Metadata_JSON != "" {
+			metadata, err := tickermetadata.AggregationMetadataFromJSONString(market.Ticker.Metadata_JSON)
+			switch {
+			case err != nil:
+				m.logger.Debug(
+					"failed to parse ticker metadata; using default aggregation strategy",
+					zap.String("ticker", ticker),
+					zap.Error(err),
+				)
+			case metadata.Aggregation != nil:
+				// Only the fields set by the metadata are overwritten, so that the default outlier
+				// filter still applies to markets that only select a strategy, and the default strategy
+				// still applies to markets that only select an outlier filter.
+				if metadata.Aggregation.Strategy != "" {
+					cfg.Strategy = metadata.Aggregation.Strategy
+				}
+
+				if metadata.Aggregation.TrimFraction != 0 {
+					cfg.TrimFraction = metadata.Aggregation.TrimFraction
+				}
+
+				if filter := metadata.Aggregation.OutlierFilter; filter != nil {
+					cfg.OutlierFilter = config.OutlierFilterConfig{
+						Method:    filter.Method,
+						Threshold: filter.Threshold,
+						MinPrices: filter.MinPrices,
+					}
+				}
+			}
+		}
+
+		aggregation, err := NewMarketAggregation(cfg)
+		if err != nil {
+			m.logger.Error(
+				"invalid aggregation strategy; using default aggregation strategy",
+				zap.String("ticker", ticker),
+				zap.Error(err),
+			)
+
+			// The default strategy is validated on construction.
+			aggregation, _ = NewMarketAggregation(m.aggregationCfg.Default)
+		}
+
+		aggregations[ticker] = aggregation
+	}
+
+	return aggregations
+}
+
+// aggregationFor returns the aggregation of the given ticker. This defaults to the unfiltered median
+// if the ticker has no resolved aggregation.
+func (m *IndexPriceAggregator) aggregationFor(ticker string) MarketAggregation {
+	if aggregation, ok := m.aggregations[ticker]; ok && aggregation.Strategy != nil && aggregation.OutlierFilter != nil {
+		return aggregation
+	}
+
+	return MarketAggregation{OutlierFilter: NoOutlierFilter{}, Strategy: MedianStrategy{}}
+}
+
+// toBigFloats returns the prices of the given provider prices.
+func toBigFloats(prices []ProviderPrice) []*big.Float {
+	values := make([]*big.Float, len(prices))
+	for i, price := range prices {
+		values[i] = price.Price
+	}
+
+	return values
+}
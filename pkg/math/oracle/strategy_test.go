@@ -0,0 +1,363 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func providerPrices(prices ...float64) []oracle.ProviderPrice {
+	out := make([]oracle.ProviderPrice, len(prices))
+	for i, price := range prices {
+		out[i] = oracle.ProviderPrice{Price: big.NewFloat(price)}
+	}
+	return out
+}
+
+func weightedProviderPrice(price float64, metadata string) oracle.ProviderPrice {
+	return oracle.ProviderPrice{
+		Config: mmtypes.ProviderConfig{Name: "test", Metadata_JSON: metadata},
+		Price:  big.NewFloat(price),
+	}
+}
+
+func TestNewAggregationStrategy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      config.AggregationStrategyConfig
+		expected oracle.AggregationStrategy
+		err      bool
+	}{
+		{
+			name:     "empty strategy defaults to the median",
+			cfg:      config.AggregationStrategyConfig{},
+			expected: oracle.MedianStrategy{},
+		},
+		{
+			name:     "median",
+			cfg:      config.AggregationStrategyConfig{Strategy: config.MedianStrategy},
+			expected: oracle.MedianStrategy{},
+		},
+		{
+			name:     "trimmed mean",
+			cfg:      config.AggregationStrategyConfig{Strategy: config.TrimmedMeanStrategy, TrimFraction: 0.25},
+			expected: oracle.TrimmedMeanStrategy{TrimFraction: 0.25},
+		},
+		{
+			name: "unknown strategy",
+			cfg:  config.AggregationStrategyConfig{Strategy: "mode"},
+			err:  true,
+		},
+		{
+			name: "invalid trim fraction",
+			cfg:  config.AggregationStrategyConfig{Strategy: config.TrimmedMeanStrategy, TrimFraction: 0.6},
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, err := oracle.NewAggregationStrategy(tc.cfg)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, strategy)
+		})
+	}
+
+	t.Run("weighted medians", func(t *testing.T) {
+		strategy, err := oracle.NewAggregationStrategy(config.AggregationStrategyConfig{
+			Strategy: config.LiquidityWeightedMedianStrategy,
+		})
+		require.NoError(t, err)
+		require.IsType(t, oracle.WeightedMedianStrategy{}, strategy)
+
+		strategy, err = oracle.NewAggregationStrategy(config.AggregationStrategyConfig{
+			Strategy: config.ProviderWeightedMedianStrategy,
+		})
+		require.NoError(t, err)
+		require.IsType(t, oracle.WeightedMedianStrategy{}, strategy)
+	})
+}
+
+func TestTrimmedMeanStrategy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fraction float64
+		prices   []oracle.ProviderPrice
+		expected *big.Float
+	}{
+		{
+			name:     "no trimming takes the mean",
+			fraction: 0,
+			prices:   providerPrices(1, 2, 6),
+			expected: big.NewFloat(3),
+		},
+		{
+			name:     "trims the lowest and highest prices",
+			fraction: 0.2,
+			prices:   providerPrices(100, 1, 2, 3, 4),
+			expected: big.NewFloat(3),
+		},
+		{
+			name:     "fraction smaller than a single price does not trim",
+			fraction: 0.2,
+			prices:   providerPrices(1, 2, 3, 6),
+			expected: big.NewFloat(3),
+		},
+		{
+			name:     "single price",
+			fraction: 0.4,
+			prices:   providerPrices(5),
+			expected: big.NewFloat(5),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := oracle.TrimmedMeanStrategy{TrimFraction: tc.fraction}.Aggregate(tc.prices)
+			require.NoError(t, err)
+			require.Zero(t, tc.expected.Cmp(price), "expected %s, got %s", tc.expected, price)
+		})
+	}
+}
+
+func TestWeightedMedianStrategy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		weight   func(mmtypes.ProviderConfig) (float64, error)
+		prices   []oracle.ProviderPrice
+		expected *big.Float
+		err      bool
+	}{
+		{
+			name:   "provider weights default to one",
+			weight: oracle.ProviderWeight,
+			prices: []oracle.ProviderPrice{
+				weightedProviderPrice(1, ""),
+				weightedProviderPrice(2, ""),
+				weightedProviderPrice(3, ""),
+			},
+			expected: big.NewFloat(2),
+		},
+		{
+			name:   "provider weights skew the median",
+			weight: oracle.ProviderWeight,
+			prices: []oracle.ProviderPrice{
+				weightedProviderPrice(1, `{"weight": 5}`),
+				weightedProviderPrice(2, ""),
+				weightedProviderPrice(3, ""),
+			},
+			expected: big.NewFloat(1),
+		},
+		{
+			name:   "zero weights are ignored",
+			weight: oracle.ProviderWeight,
+			prices: []oracle.ProviderPrice{
+				weightedProviderPrice(1, `{"weight": 0}`),
+				weightedProviderPrice(2, `{"weight": 0}`),
+				weightedProviderPrice(3, ""),
+			},
+			expected: big.NewFloat(3),
+		},
+		{
+			name:   "liquidity skews the median",
+			weight: oracle.ProviderLiquidity,
+			prices: []oracle.ProviderPrice{
+				weightedProviderPrice(1, `{"liquidity": 100}`),
+				weightedProviderPrice(2, `{"liquidity": 100}`),
+				weightedProviderPrice(3, `{"liquidity": 1000}`),
+			},
+			expected: big.NewFloat(3),
+		},
+		{
+			name:   "no liquidity falls back to the median",
+			weight: oracle.ProviderLiquidity,
+			prices: []oracle.ProviderPrice{
+				weightedProviderPrice(1, ""),
+				weightedProviderPrice(2, ""),
+				weightedProviderPrice(4, ""),
+			},
+			expected: big.NewFloat(2),
+		},
+		{
+			name:   "invalid metadata",
+			weight: oracle.ProviderWeight,
+			prices: []oracle.ProviderPrice{
+				weightedProviderPrice(1, "invalid"),
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := oracle.WeightedMedianStrategy{Weight: tc.weight}.Aggregate(tc.prices)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Zero(t, tc.expected.Cmp(price), "expected %s, got %s", tc.expected, price)
+		})
+	}
+}
+
+func TestAggregatePricesWithStrategies(t *testing.T) {
+	ticker := mmtypes.Ticker{
+		CurrencyPair:     pkgtypes.NewCurrencyPair("ABC", "USD"),
+		Decimals:         8,
+		MinProviderCount: 1,
+		Enabled:          true,
+	}
+
+	newMarketMap := func(metadata string) mmtypes.MarketMap {
+		tk := ticker
+		tk.Metadata_JSON = metadata
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				tk.String(): {
+					Ticker: tk,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: "a", OffChainTicker: "ABC-USD"},
+						{Name: "b", OffChainTicker: "ABC-USD"},
+						{Name: "c", OffChainTicker: "ABC-USD"},
+						{Name: "d", OffChainTicker: "ABC-USD"},
+						{Name: "e", OffChainTicker: "ABC-USD"},
+					},
+				},
+			},
+		}
+	}
+
+	setPrices := func(agg *oracle.IndexPriceAggregator) {
+		for name, price := range map[string]float64{"a": 1, "b": 2, "c": 3, "d": 4, "e": 100} {
+			agg.SetProviderPrices(name, types.Prices{"ABC-USD": big.NewFloat(price)})
+		}
+	}
+
+	mean := config.AggregationStrategyConfig{Strategy: config.TrimmedMeanStrategy}
+
+	testCases := []struct {
+		name     string
+		metadata string
+		cfg      config.AggregationConfig
+		expected *big.Float
+	}{
+		{
+			name:     "defaults to the median",
+			expected: big.NewFloat(3),
+		},
+		{
+			name:     "config default is used",
+			cfg:      config.AggregationConfig{Default: mean},
+			expected: big.NewFloat(22),
+		},
+		{
+			name:     "ticker metadata takes precedence over the config default",
+			metadata: `{"aggregation": {"strategy": "trimmed_mean", "trim_fraction": 0.2}}`,
+			cfg:      config.AggregationConfig{Default: mean},
+			expected: big.NewFloat(3),
+		},
+		{
+			name:     "ticker metadata strategy keeps the default outlier filter",
+			metadata: `{"aggregation": {"strategy": "trimmed_mean"}}`,
+			cfg: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					OutlierFilter: config.OutlierFilterConfig{
+						Method:    config.MaxDeviationOutlierFilter,
+						Threshold: 1,
+					},
+				},
+			},
+			expected: big.NewFloat(2.5),
+		},
+		{
+			name:     "ticker metadata outlier filter takes precedence over the default outlier filter",
+			metadata: `{"aggregation": {"strategy": "trimmed_mean", "outlier_filter": {"method": "max_deviation", "threshold": 50}}}`,
+			cfg: config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{
+					OutlierFilter: config.OutlierFilterConfig{
+						Method:    config.MaxDeviationOutlierFilter,
+						Threshold: 1,
+					},
+				},
+			},
+			expected: big.NewFloat(22),
+		},
+		{
+			name:     "ticker metadata outlier filter keeps the default strategy",
+			metadata: `{"aggregation": {"outlier_filter": {"method": "max_deviation", "threshold": 50}}}`,
+			cfg:      config.AggregationConfig{Default: mean},
+			expected: big.NewFloat(22),
+		},
+		{
+			name:     "market override takes precedence over ticker metadata",
+			metadata: `{"aggregation": {"strategy": "median"}}`,
+			cfg: config.AggregationConfig{
+				Markets: map[string]config.AggregationStrategyConfig{ticker.String(): mean},
+			},
+			expected: big.NewFloat(22),
+		},
+		{
+			name:     "invalid ticker metadata falls back to the config default",
+			metadata: `{"aggregation": {"strategy": "mode"}}`,
+			cfg:      config.AggregationConfig{Default: mean},
+			expected: big.NewFloat(22),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			agg, err := oracle.NewIndexPriceAggregator(
+				logger,
+				newMarketMap(tc.metadata),
+				nil,
+				oracle.WithAggregationConfig(tc.cfg),
+			)
+			require.NoError(t, err)
+
+			setPrices(agg)
+			agg.AggregatePrices()
+
+			price, ok := agg.GetIndexPrices()[ticker.String()]
+			require.True(t, ok)
+			require.Zero(t, tc.expected.Cmp(price), "expected %s, got %s", tc.expected, price)
+		})
+	}
+
+	t.Run("strategies are re-resolved on market map updates", func(t *testing.T) {
+		agg, err := oracle.NewIndexPriceAggregator(logger, newMarketMap(""), nil)
+		require.NoError(t, err)
+
+		agg.UpdateMarketMap(newMarketMap(`{"aggregation": {"strategy": "trimmed_mean"}}`))
+		setPrices(agg)
+		agg.AggregatePrices()
+
+		price := agg.GetIndexPrices()[ticker.String()]
+		require.Zero(t, big.NewFloat(22).Cmp(price), "expected 22, got %s", price)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := oracle.NewIndexPriceAggregator(
+			logger,
+			newMarketMap(""),
+			nil,
+			oracle.WithAggregationConfig(config.AggregationConfig{
+				Default: config.AggregationStrategyConfig{Strategy: "mode"},
+			}),
+		)
+		require.Error(t, err)
+	})
+}
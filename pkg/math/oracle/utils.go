@@ -7,6 +7,7 @@ import (
 
 	"github.com/skip-mev/connect/v2/oracle/types"
 	pkgtypes "github.com/skip-mev/connect/v2/pkg/types"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 )
 
@@ -80,6 +81,8 @@ func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) {
 	defer m.mtx.Unlock()
 
 	m.cfg = marketMap
+	m.aggregations = m.resolveAggregations(marketMap)
+	m.quorums = m.resolveQuorums(marketMap)
 }
 
 // GetMarketMap returns the market map for the oracle.
@@ -102,12 +105,28 @@ func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Pri
 	m.providerPrices[provider] = data
 }
 
+// SetProviderResults updates the data aggregator with the raw results returned by the given provider,
+// including results that were excluded for being stale. These are only used to report the provenance
+// of each aggregated price.
+func (m *IndexPriceAggregator) SetProviderResults(provider string, results types.ResolvedPrices) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cache := make(map[string]providertypes.ResolvedResult[*big.Float], len(results))
+	for ticker, result := range results {
+		cache[ticker.GetOffChainTicker()] = result
+	}
+
+	m.providerResults[provider] = cache
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *IndexPriceAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerResults = make(map[string]map[string]providertypes.ResolvedResult[*big.Float])
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -122,3 +141,14 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetPriceDetails returns the provenance of the most recently aggregated prices.
+func (m *IndexPriceAggregator) GetPriceDetails() types.PriceDetails {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.PriceDetails)
+	maps.Copy(cpy, m.priceDetails)
+
+	return cpy
+}
@@ -26,7 +26,8 @@ var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
 
 // PriceFetcher is the Uniswap V3 price fetcher. This fetcher is responsible for
 // querying Uniswap V3 pool contracts and returning the price of a given ticker. The price is
-// derived from the slot 0 data of the pool contract.
+// derived from the slot 0 data of the pool contract, or from the tick cumulatives returned by the
+// observe method of the pool contract if the pool is configured with a TWAP window.
 //
 // To read more about how the price is calculated, see the Uniswap V3 documentation
 // https://blog.uniswap.org/uniswap-v3-math-primer.
@@ -40,8 +41,8 @@ type PriceFetcher struct {
 
 	// client is the EVM client implementation. This is used to interact with the ethereum network.
 	client ethmulticlient.EVMClient
-	// abi is the uniswap v3 pool abi. This is used to pack the slot0 call to the pool contract
-	// and parse the result.
+	// abi is the uniswap v3 pool abi. This is used to pack the slot0 and observe calls to the pool
+	// contract and parse the results.
 	abi *abi.ABI
 	// payload is the packed slot0 call to the pool contract. Since the slot0 payload is the same
 	// for all pools, we can reuse this payload for all pools.
@@ -145,7 +146,8 @@ func NewPriceFetcherWithClient(
 // Fetch returns the price of a given set of tickers. This fetch utilizes the batch call to lower
 // overhead of making individual RPC calls for each ticker. The fetcher will query the Uniswap V3
 // pool contract for the price of the pool. The price is derived from the slot 0 data of the pool
-// contract, specifically the sqrtPriceX96 value.
+// contract, specifically the sqrtPriceX96 value, or from the arithmetic mean tick over the TWAP
+// window of the pool if configured.
 func (u *PriceFetcher) Fetch(
 	ctx context.Context,
 	tickers []types.ProviderTicker,
@@ -177,6 +179,23 @@ func (u *PriceFetcher) Fetch(
 			)
 		}
 
+		payload, err := u.payloadForPool(pool)
+		if err != nil {
+			u.logger.Debug(
+				"failed to pack call for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			return types.NewPriceResponseWithErr(
+				tickers,
+				providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to pack call: %w", err),
+					providertypes.ErrorUnknown,
+				),
+			)
+		}
+
 		// Create a batch element for the ticker and pool.
 		var result string
 		batchElems[i] = rpc.BatchElem{
@@ -184,7 +203,7 @@ func (u *PriceFetcher) Fetch(
 			Args: []interface{}{
 				map[string]interface{}{
 					"to":   common.HexToAddress(pool.Address),
-					"data": hexutil.Bytes(u.payload), // slot0 call to the pool contract.
+					"data": hexutil.Bytes(payload), // slot0 or observe call to the pool contract.
 				},
 				"latest", // latest signifies the latest block.
 			},
@@ -232,11 +251,11 @@ func (u *PriceFetcher) Fetch(
 			continue
 		}
 
-		// Parse the sqrtPriceX96 from the result.
-		sqrtPriceX96, err := u.ParseSqrtPriceX96(result.Result)
+		// Parse the raw, unscaled price from the result.
+		price, err := u.parsePrice(pools[i], result.Result)
 		if err != nil {
 			u.logger.Debug(
-				"failed to parse sqrt price x96",
+				"failed to parse price",
 				zap.String("ticker", ticker.String()),
 				zap.Error(err),
 			)
@@ -251,9 +270,6 @@ func (u *PriceFetcher) Fetch(
 			continue
 		}
 
-		// Convert the sqrtPriceX96 to a price. This is the raw, unscaled price.
-		price := ConvertSquareRootX96Price(sqrtPriceX96)
-
 		// Scale the price to the respective token decimals.
 		scaledPrice := ScalePrice(pools[i], price)
 		resolved[ticker] = types.NewPriceResult(scaledPrice, time.Now().UTC())
@@ -263,6 +279,36 @@ func (u *PriceFetcher) Fetch(
 	return types.NewPriceResponse(resolved, unResolved)
 }
 
+// payloadForPool returns the call to the pool contract for the given pool. This is the observe call
+// over the TWAP window of the pool if configured, and the slot0 call otherwise.
+func (u *PriceFetcher) payloadForPool(pool PoolConfig) ([]byte, error) {
+	if pool.TWAPWindow == 0 {
+		return u.payload, nil
+	}
+
+	// The tick cumulatives are observed at the start and at the end of the window.
+	return u.abi.Pack(ObserveMethod, []uint32{pool.TWAPWindow, 0})
+}
+
+// parsePrice parses the raw, unscaled price of the given pool from the result of the batch call.
+func (u *PriceFetcher) parsePrice(pool PoolConfig, result interface{}) (*big.Float, error) {
+	if pool.TWAPWindow == 0 {
+		sqrtPriceX96, err := u.ParseSqrtPriceX96(result)
+		if err != nil {
+			return nil, err
+		}
+
+		return ConvertSquareRootX96Price(sqrtPriceX96), nil
+	}
+
+	tick, err := u.ParseArithmeticMeanTick(result, pool.TWAPWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertTickPrice(tick), nil
+}
+
 // GetPool returns the uniswap pool for the given ticker. This will unmarshal the metadata
 // and validate the pool config which contains all required information to query the EVM.
 func (u *PriceFetcher) GetPool(
@@ -288,6 +334,48 @@ func (u *PriceFetcher) GetPool(
 func (u *PriceFetcher) ParseSqrtPriceX96(
 	result interface{},
 ) (*big.Int, error) {
+	bz, err := decodeResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := u.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	// Parse the sqrtPriceX96 from the result.
+	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return sqrtPriceX96, nil
+}
+
+// ParseArithmeticMeanTick parses the tick cumulatives from the result of the batch call to the
+// observe method, and returns the arithmetic mean tick over the given window.
+func (u *PriceFetcher) ParseArithmeticMeanTick(
+	result interface{},
+	window uint32,
+) (int64, error) {
+	bz, err := decodeResult(result)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := u.abi.Methods[ObserveMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	// Parse the tick cumulatives at the start and at the end of the window from the result.
+	tickCumulatives := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	if len(tickCumulatives) != 2 {
+		return 0, fmt.Errorf("expected 2 tick cumulatives, got %d", len(tickCumulatives))
+	}
+
+	return ArithmeticMeanTick(tickCumulatives[0], tickCumulatives[1], window)
+}
+
+// decodeResult decodes the hex-encoded result of a batch call.
+func decodeResult(result interface{}) ([]byte, error) {
 	r, ok := result.(*string)
 	if !ok {
 		return nil, fmt.Errorf("expected result to be a string, got %T", result)
@@ -302,12 +390,5 @@ func (u *PriceFetcher) ParseSqrtPriceX96(
 		return nil, fmt.Errorf("failed to decode hex result: %w", err)
 	}
 
-	out, err := u.abi.Methods[ContractMethod].Outputs.UnpackValues(bz)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unpack values: %w", err)
-	}
-
-	// Parse the sqrtPriceX96 from the result.
-	sqrtPriceX96 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-	return sqrtPriceX96, nil
+	return bz, nil
 }
@@ -6,7 +6,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -134,6 +136,59 @@ func TestFetch(t *testing.T) {
 				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
 			},
 		},
+		{
+			name: "weth/usdc twap result",
+			tickers: []types.ProviderTicker{
+				wethusdcTWAPTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+					elems, ok := args.Get(1).([]rpc.BatchElem)
+					require.True(t, ok)
+					require.Len(t, elems, 1)
+
+					// The pool is observed over the twap window.
+					call, ok := elems[0].Args[0].(map[string]interface{})
+					require.True(t, ok)
+					require.Equal(t, hexutil.Bytes(observeCallData(t, wethusdcTWAPCfg.TWAPWindow)), call["data"])
+
+					result, ok := elems[0].Result.(*string)
+					require.True(t, ok)
+					*result = createObserveResponse(t, 1_000_000, 1_000_000+195263*1800)
+				})
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					wethusdcTWAPTicker: {
+						Value: big.NewFloat(3313.291436045142965518732174288282),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "twap batch request returns a slot0 result",
+			tickers: []types.ProviderTicker{
+				wethusdcTWAPTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				batchErrors := []error{
+					nil,
+				}
+				responses := []string{
+					"0x0000000000000000000000000000000000000000000000000000000000000001",
+				}
+				return createEVMClientWithResponse(t, nil, responses, batchErrors)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					wethusdcTWAPTicker: {},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -247,6 +302,42 @@ func TestParseSqrtPriceX96(t *testing.T) {
 	})
 }
 
+func TestParseArithmeticMeanTick(t *testing.T) {
+	fetcher := createPriceFetcher(t)
+
+	t.Run("result does not map to a string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseArithmeticMeanTick(42, 1800)
+		require.Error(t, err)
+	})
+
+	t.Run("result is a nil string pointer", func(t *testing.T) {
+		_, err := fetcher.ParseArithmeticMeanTick((*string)(nil), 1800)
+		require.Error(t, err)
+	})
+
+	t.Run("result cannot be unpacked by the uniswap abi", func(t *testing.T) {
+		result := new(string)
+		*result = "0x1234"
+		_, err := fetcher.ParseArithmeticMeanTick(result, 1800)
+		require.Error(t, err)
+	})
+
+	t.Run("result does not have two tick cumulatives", func(t *testing.T) {
+		result := new(string)
+		*result = createObserveResponse(t, 1, 2, 3)
+		_, err := fetcher.ParseArithmeticMeanTick(result, 1800)
+		require.Error(t, err)
+	})
+
+	t.Run("result for a negative mean tick", func(t *testing.T) {
+		result := new(string)
+		*result = createObserveResponse(t, -1_000_000, -1_000_000-276324*600-1)
+		tick, err := fetcher.ParseArithmeticMeanTick(result, 600)
+		require.NoError(t, err)
+		require.Equal(t, int64(-276325), tick)
+	})
+}
+
 func TestNewPriceFetcher(t *testing.T) {
 	ctx := context.TODO()
 
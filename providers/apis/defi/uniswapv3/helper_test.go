@@ -1,8 +1,10 @@
 package uniswapv3_test
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -12,6 +14,7 @@ import (
 	"github.com/skip-mev/connect/v2/providers/apis/defi/ethmulticlient"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/ethmulticlient/mocks"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/uniswapv3"
+	uniswappool "github.com/skip-mev/connect/v2/providers/apis/defi/uniswapv3/pool"
 )
 
 var (
@@ -25,8 +28,17 @@ var (
 		Invert:        true,
 	}
 
+	wethusdcTWAPCfg = uniswapv3.PoolConfig{
+		Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+		BaseDecimals:  18,
+		QuoteDecimals: 6,
+		Invert:        true,
+		TWAPWindow:    1800,
+	}
+
 	// Tickers used for testing.
-	wethusdcTicker = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+	wethusdcTicker     = types.NewProviderTicker("WETH/USDC", wethusdcCfg.MustToJSON())
+	wethusdcTWAPTicker = types.NewProviderTicker("WETH/USDC", wethusdcTWAPCfg.MustToJSON())
 )
 
 func createPriceFetcher(
@@ -91,3 +103,43 @@ func createEVMClientWithResponse(
 
 	return c
 }
+
+// createObserveResponse returns the hex-encoded result of a call to the observe method of a pool
+// with the given tick cumulatives.
+func createObserveResponse(
+	t *testing.T,
+	tickCumulatives ...int64,
+) string {
+	t.Helper()
+
+	abi, err := uniswappool.UniswapMetaData.GetAbi()
+	require.NoError(t, err)
+
+	ticks := make([]*big.Int, len(tickCumulatives))
+	liquidities := make([]*big.Int, len(tickCumulatives))
+	for i, tick := range tickCumulatives {
+		ticks[i] = big.NewInt(tick)
+		liquidities[i] = big.NewInt(0)
+	}
+
+	bz, err := abi.Methods[uniswapv3.ObserveMethod].Outputs.Pack(ticks, liquidities)
+	require.NoError(t, err)
+
+	return hexutil.Encode(bz)
+}
+
+// observeCallData returns the packed call to the observe method of a pool over the given window.
+func observeCallData(
+	t *testing.T,
+	window uint32,
+) []byte {
+	t.Helper()
+
+	abi, err := uniswappool.UniswapMetaData.GetAbi()
+	require.NoError(t, err)
+
+	bz, err := abi.Pack(uniswapv3.ObserveMethod, []uint32{window, 0})
+	require.NoError(t, err)
+
+	return bz
+}
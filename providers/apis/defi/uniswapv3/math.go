@@ -1,6 +1,7 @@
 package uniswapv3
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/skip-mev/connect/v2/pkg/math"
@@ -47,3 +48,72 @@ func ScalePrice(
 	}
 	return new(big.Float).Mul(price, erc20ScalingFactor)
 }
+
+// tickBase is the base of the Uniswap V3 tick price, i.e. the price of a pool at a given tick is
+// 1.0001^tick.
+var tickBase, _ = new(big.Float).SetPrec(tickPricePrecision).SetString("1.0001")
+
+// tickPricePrecision is the precision, in bits, used to compute the price of a tick.
+const tickPricePrecision = 256
+
+const (
+	// MinTick is the minimum tick of a Uniswap V3 pool, i.e. the tick of the lowest price 1.0001^-887272.
+	MinTick = -887272
+	// MaxTick is the maximum tick of a Uniswap V3 pool, i.e. the tick of the highest price 1.0001^887272.
+	MaxTick = 887272
+)
+
+// ArithmeticMeanTick returns the time-weighted arithmetic mean tick of a pool over the given window,
+// from the tick cumulatives observed at the start and at the end of the window. The mean tick is
+// rounded towards negative infinity, as is done by the Uniswap V3 oracle library:
+//
+// https://github.com/Uniswap/v3-periphery/blob/main/contracts/libraries/OracleLibrary.sol.
+//
+// An error is returned if the mean tick is outside of [MinTick, MaxTick], which no pool can report.
+func ArithmeticMeanTick(
+	startTickCumulative *big.Int,
+	endTickCumulative *big.Int,
+	window uint32,
+) (int64, error) {
+	if window == 0 {
+		return 0, fmt.Errorf("window must be strictly positive")
+	}
+
+	delta := new(big.Int).Sub(endTickCumulative, startTickCumulative)
+
+	// Div rounds towards negative infinity for a positive divisor.
+	tick := new(big.Int).Div(delta, new(big.Int).SetUint64(uint64(window)))
+	if !tick.IsInt64() || tick.Int64() < MinTick || tick.Int64() > MaxTick {
+		return 0, fmt.Errorf("mean tick %s is out of range [%d, %d]", tick, MinTick, MaxTick)
+	}
+
+	return tick.Int64(), nil
+}
+
+// ConvertTickPrice converts a tick to a price. Note that this price is not scaled to the token
+// decimals. This calculation is equivalent to:
+//
+// price = 1.0001 ^ tick.
+func ConvertTickPrice(
+	tick int64,
+) *big.Float {
+	exponent := tick
+	if exponent < 0 {
+		exponent = -exponent
+	}
+
+	// Exponentiation by squaring.
+	price := new(big.Float).SetPrec(tickPricePrecision).SetInt64(1)
+	base := new(big.Float).Copy(tickBase)
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			price.Mul(price, base)
+		}
+		base.Mul(base, base)
+	}
+
+	if tick < 0 {
+		return new(big.Float).SetPrec(tickPricePrecision).Quo(big.NewFloat(1), price)
+	}
+	return price
+}
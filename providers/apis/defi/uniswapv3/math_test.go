@@ -110,3 +110,123 @@ func TestScalePrice(t *testing.T) {
 		})
 	}
 }
+
+func TestArithmeticMeanTick(t *testing.T) {
+	testCases := []struct {
+		name     string
+		start    int64
+		end      int64
+		window   uint32
+		expected int64
+		err      bool
+	}{
+		{
+			name:   "window of 0",
+			start:  0,
+			end:    100,
+			window: 0,
+			err:    true,
+		},
+		{
+			name:     "constant tick",
+			start:    1_000_000,
+			end:      1_000_000 + 195263*1800,
+			window:   1800,
+			expected: 195263,
+		},
+		{
+			name:     "positive mean tick is rounded down",
+			start:    0,
+			end:      15,
+			window:   10,
+			expected: 1,
+		},
+		{
+			name:     "negative mean tick is rounded towards negative infinity",
+			start:    0,
+			end:      -15,
+			window:   10,
+			expected: -2,
+		},
+		{
+			name:     "max tick",
+			start:    0,
+			end:      uniswapv3.MaxTick * 10,
+			window:   10,
+			expected: uniswapv3.MaxTick,
+		},
+		{
+			name:     "min tick",
+			start:    0,
+			end:      uniswapv3.MinTick * 10,
+			window:   10,
+			expected: uniswapv3.MinTick,
+		},
+		{
+			name:   "mean tick above the max tick",
+			start:  0,
+			end:    (uniswapv3.MaxTick + 1) * 10,
+			window: 10,
+			err:    true,
+		},
+		{
+			name:   "mean tick below the min tick",
+			start:  0,
+			end:    (uniswapv3.MinTick - 1) * 10,
+			window: 10,
+			err:    true,
+		},
+		{
+			name:     "negative mean tick with no remainder",
+			start:    100,
+			end:      80,
+			window:   10,
+			expected: -2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tick, err := uniswapv3.ArithmeticMeanTick(big.NewInt(tc.start), big.NewInt(tc.end), tc.window)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tick)
+		})
+	}
+}
+
+func TestConvertTickPrice(t *testing.T) {
+	t.Run("should be 1 when the tick is 0", func(t *testing.T) {
+		expected := big.NewFloat(1).SetPrec(40)
+		actual := uniswapv3.ConvertTickPrice(0).SetPrec(40)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("positive tick", func(t *testing.T) {
+		expected := big.NewFloat(1.0001).SetPrec(40)
+		actual := uniswapv3.ConvertTickPrice(1).SetPrec(40)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("negative tick", func(t *testing.T) {
+		expected := big.NewFloat(1 / 1.0001).SetPrec(40)
+		actual := uniswapv3.ConvertTickPrice(-1).SetPrec(40)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("matches the sqrtPriceX96 of the same tick for weth/usdc", func(t *testing.T) {
+		// slot 0 of the weth/usdc mainnet pool at tick 195263.
+		val, converted := big.NewInt(1).SetString("1376449308836404920403933324640256", 10)
+		require.True(t, converted)
+
+		sqrtPrice, _ := uniswapv3.ConvertSquareRootX96Price(val).Float64()
+		tickPrice, _ := uniswapv3.ConvertTickPrice(195263).Float64()
+		nextTickPrice, _ := uniswapv3.ConvertTickPrice(195264).Float64()
+		require.LessOrEqual(t, tickPrice, sqrtPrice)
+		require.Less(t, sqrtPrice, nextTickPrice)
+	})
+}
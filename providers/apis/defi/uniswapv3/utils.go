@@ -22,6 +22,10 @@ const (
 	// ContractMethod is the contract method to call for the Uniswap V3 API.
 	ContractMethod = "slot0"
 
+	// ObserveMethod is the contract method to call for the Uniswap V3 API when the pool is priced
+	// with a time-weighted average price.
+	ObserveMethod = "observe"
+
 	// ETH_URL is the URL for the Uniswap V3 API. This uses a free public RPC provider on Ethereum Mainnet.
 	ETH_URL = "https://eth.public-rpc.com/"
 
@@ -60,8 +64,18 @@ type PoolConfig struct {
 	// pools as the price is derived based on the sorted order of the ERC20 addresses of the tokens
 	// in the pool.
 	Invert bool `json:"invert"`
+	// TWAPWindow is the window, in seconds, over which the time-weighted average price of the pool
+	// is derived from the tick cumulatives returned by the observe method of the pool contract. If
+	// zero, the pool is priced from the instantaneous sqrtPriceX96 in slot 0, which can be
+	// manipulated within a single block. Note that the pool must store enough observations to
+	// cover the window.
+	TWAPWindow uint32 `json:"twap_window,omitempty"`
 }
 
+// MaxTWAPWindow is the maximum TWAP window, in seconds, of a pool. Longer windows produce prices that
+// lag the market too much to be useful, and require more observations than most pools store.
+const MaxTWAPWindow uint32 = 24 * 60 * 60
+
 // ValidateBasic validates the pool configuration.
 func (pc *PoolConfig) ValidateBasic() error {
 	if !common.IsHexAddress(pc.Address) {
@@ -76,6 +90,10 @@ func (pc *PoolConfig) ValidateBasic() error {
 		return fmt.Errorf("quote decimals must be non-negative")
 	}
 
+	if pc.TWAPWindow > MaxTWAPWindow {
+		return fmt.Errorf("twap window must be at most %d seconds", MaxTWAPWindow)
+	}
+
 	return nil
 }
 
@@ -48,6 +48,31 @@ func TestPoolConfig(t *testing.T) {
 		}
 		require.NoError(t, cfg.ValidateBasic())
 	})
+
+	t.Run("twap window above the maximum", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{
+			Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+			BaseDecimals:  18,
+			QuoteDecimals: 18,
+			TWAPWindow:    uniswapv3.MaxTWAPWindow + 1,
+		}
+		require.Error(t, cfg.ValidateBasic())
+
+		cfg.TWAPWindow = uniswapv3.MaxTWAPWindow
+		require.NoError(t, cfg.ValidateBasic())
+	})
+
+	t.Run("twap window is omitted from the json when unset", func(t *testing.T) {
+		cfg := uniswapv3.PoolConfig{
+			Address:       "0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+			BaseDecimals:  18,
+			QuoteDecimals: 6,
+		}
+		require.NotContains(t, cfg.MustToJSON(), "twap_window")
+
+		cfg.TWAPWindow = 1800
+		require.Contains(t, cfg.MustToJSON(), `"twap_window":1800`)
+	})
 }
 
 func TestIsValidProviderName(t *testing.T) {
@@ -0,0 +1,310 @@
+package genericjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base/jsonpath"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for any REST API that returns JSON. The URL
+// and the parsing of the response are configured entirely through the provider's config, and the
+// metadata_JSON of each ticker.
+type APIHandler struct {
+	// api is the config for the API.
+	api config.APIConfig
+	// cfg is the parsing config of the API.
+	cfg config.GenericJSONConfig
+	// results selects the results in the response.
+	results jsonpath.Selector
+	// tickerPath selects the ticker within each result, if set.
+	tickerPath jsonpath.Selector
+
+	mut sync.Mutex
+	// selectors caches the parsed price and timestamp selectors of each off-chain ticker.
+	selectors map[string]tickerSelectors
+}
+
+// tickerSelectors are the parsed price and timestamp selectors of a ticker.
+type tickerSelectors struct {
+	// json is the metadata of the ticker the selectors were parsed from.
+	json string
+	// price selects the price of the ticker.
+	price jsonpath.Selector
+	// timestamp selects the timestamp of the ticker, if set.
+	timestamp jsonpath.Selector
+}
+
+// NewAPIHandler returns a new generic JSON PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if !strings.HasPrefix(api.Name, Name) {
+		return nil, fmt.Errorf("expected api config name with prefix %s, got %s", Name, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", api.Name, err)
+	}
+
+	if api.GenericJSON == nil {
+		return nil, fmt.Errorf("generic json config for %s cannot be empty", api.Name)
+	}
+
+	cfg := *api.GenericJSON
+	if len(cfg.TickerSeparator) == 0 {
+		cfg.TickerSeparator = DefaultTickerSeparator
+	}
+
+	url := api.Endpoints[0].URL
+	if strings.Contains(url, TickerPlaceholder) && strings.Contains(url, TickersPlaceholder) {
+		return nil, fmt.Errorf("url for %s cannot contain both %s and %s", api.Name, TickerPlaceholder, TickersPlaceholder)
+	}
+
+	// validate the selectors; the price and timestamp selectors are parsed and cached per ticker.
+	results, err := jsonpath.ParseSelector(cfg.ResultsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerPath, err := jsonpath.ParseSelector(cfg.TickerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range []string{cfg.PricePath, cfg.TimestampPath} {
+		if _, err := jsonpath.ParseTickerSelector(path, "ticker"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &APIHandler{
+		api:        api,
+		cfg:        cfg,
+		results:    results,
+		tickerPath: tickerPath,
+		selectors:  make(map[string]tickerSelectors),
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch data from the API for the given tickers. The {ticker}
+// placeholder of the URL is replaced with the single ticker queried, and the {tickers} placeholder with the
+// joined tickers of the batch. A URL without placeholders is used as is.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	url := h.api.Endpoints[0].URL
+
+	switch {
+	case strings.Contains(url, TickerPlaceholder):
+		if len(tickers) != 1 {
+			return "", fmt.Errorf("expected 1 ticker, got %d", len(tickers))
+		}
+
+		return strings.ReplaceAll(url, TickerPlaceholder, tickers[0].GetOffChainTicker()), nil
+	case strings.Contains(url, TickersPlaceholder):
+		if len(tickers) == 0 {
+			return "", fmt.Errorf("empty url created. invalid or no ticker were provided")
+		}
+
+		offChainTickers := make([]string, len(tickers))
+		for i, ticker := range tickers {
+			offChainTickers[i] = ticker.GetOffChainTicker()
+		}
+
+		return strings.ReplaceAll(url, TickersPlaceholder, strings.Join(offChainTickers, h.cfg.TickerSeparator)), nil
+	default:
+		return url, nil
+	}
+}
+
+// ParseResponse parses the response from the API using the configured selectors. Tickers for which no
+// price can be selected are returned as unresolved.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+		now        = time.Now().UTC()
+	)
+
+	results, err := h.results.Select(body)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(fmt.Errorf("failed to select results: %w", err), providertypes.ErrorInvalidResponse),
+		)
+	}
+
+	for _, ticker := range tickers {
+		result, err := h.selectResult(ticker, results)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorNoResponse),
+			}
+			continue
+		}
+
+		price, err := h.parseResult(ticker, result, now)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			}
+			continue
+		}
+
+		resolved[ticker] = price
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
+
+// selectResult selects the result of the given ticker from the results of the response.
+func (h *APIHandler) selectResult(ticker types.ProviderTicker, results interface{}) (interface{}, error) {
+	offChainTicker := ticker.GetOffChainTicker()
+
+	// a response for a single ticker contains only its result, and selectors containing the ticker
+	// select each ticker's price from the shared results
+	if strings.Contains(h.api.Endpoints[0].URL, TickerPlaceholder) || strings.Contains(h.cfg.PricePath, TickerPlaceholder) {
+		return results, nil
+	}
+
+	// results keyed by ticker
+	if len(h.tickerPath) == 0 {
+		obj, ok := results.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected results keyed by ticker, got %T", results)
+		}
+
+		result, ok := obj[offChainTicker]
+		if !ok {
+			return nil, fmt.Errorf("no result for ticker %s", offChainTicker)
+		}
+
+		return result, nil
+	}
+
+	// otherwise, find the result whose ticker matches
+	var candidates []interface{}
+	switch v := results.(type) {
+	case []interface{}:
+		candidates = v
+	case map[string]interface{}:
+		for _, result := range v {
+			candidates = append(candidates, result)
+		}
+	default:
+		return nil, fmt.Errorf("expected array or object of results, got %T", results)
+	}
+
+	for _, candidate := range candidates {
+		value, err := h.tickerPath.Select(candidate)
+		if err != nil {
+			continue
+		}
+
+		if s, ok := value.(string); ok && s == offChainTicker {
+			return candidate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no result for ticker %s", offChainTicker)
+}
+
+// parseResult parses the price and timestamp of the given ticker from its result.
+func (h *APIHandler) parseResult(ticker types.ProviderTicker, result interface{}, now time.Time) (providertypes.ResolvedResult[*big.Float], error) {
+	selectors, err := h.getSelectors(ticker)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	value, err := selectors.price.Select(result)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("failed to select price: %w", err)
+	}
+
+	price, err := jsonpath.ParsePrice(value)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	timestamp := now
+	if selectors.timestamp != nil {
+		value, err := selectors.timestamp.Select(result)
+		if err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("failed to select timestamp: %w", err)
+		}
+
+		if timestamp, err = jsonpath.ParseTimestamp(value, h.cfg.TimestampFormat); err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, err
+		}
+	}
+
+	return types.NewPriceResult(price, timestamp), nil
+}
+
+// getSelectors returns the price and timestamp selectors of the given ticker. The selectors are parsed
+// once per off-chain ticker, and re-parsed only if the metadata of the ticker changes.
+func (h *APIHandler) getSelectors(ticker types.ProviderTicker) (tickerSelectors, error) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	offChainTicker := ticker.GetOffChainTicker()
+	if selectors, ok := h.selectors[offChainTicker]; ok && selectors.json == ticker.GetJSON() {
+		return selectors, nil
+	}
+
+	md, err := jsonpath.TickerMetadataFromJSONString(ticker.GetJSON())
+	if err != nil {
+		return tickerSelectors{}, err
+	}
+
+	pricePath, timestampPath := h.cfg.PricePath, h.cfg.TimestampPath
+	if len(md.PricePath) > 0 {
+		pricePath = md.PricePath
+	}
+	if len(md.TimestampPath) > 0 {
+		timestampPath = md.TimestampPath
+	}
+
+	selectors := tickerSelectors{json: ticker.GetJSON()}
+	if selectors.price, err = jsonpath.ParseTickerSelector(pricePath, offChainTicker); err != nil {
+		return tickerSelectors{}, err
+	}
+
+	if len(timestampPath) > 0 {
+		if selectors.timestamp, err = jsonpath.ParseTickerSelector(timestampPath, offChainTicker); err != nil {
+			return tickerSelectors{}, err
+		}
+	}
+
+	h.selectors[offChainTicker] = selectors
+	return selectors, nil
+}
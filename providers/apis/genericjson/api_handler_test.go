@@ -0,0 +1,383 @@
+package genericjson_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/apis/genericjson"
+	"github.com/skip-mev/connect/v2/providers/base/testutils"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+var (
+	btcusd = types.DefaultProviderTicker{
+		OffChainTicker: "BTC-USD",
+	}
+	ethusd = types.DefaultProviderTicker{
+		OffChainTicker: "ETH-USD",
+	}
+	ethusdOverride = types.DefaultProviderTicker{
+		OffChainTicker: "ETH-USD",
+		JSON:           `{"price_path": "quote.mid"}`,
+	}
+)
+
+func apiConfig(url string, cfg config.GenericJSONConfig) config.APIConfig {
+	return config.APIConfig{
+		Name:             genericjson.Name,
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          500 * time.Millisecond,
+		Interval:         time.Second,
+		ReconnectTimeout: 2 * time.Second,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: url}},
+		GenericJSON:      &cfg,
+	}
+}
+
+func TestNewAPIHandler(t *testing.T) {
+	testCases := []struct {
+		name        string
+		api         func() config.APIConfig
+		expectedErr bool
+	}{
+		{
+			name: "valid",
+			api: func() config.APIConfig {
+				return apiConfig("https://api.example.com/price/{ticker}", config.GenericJSONConfig{PricePath: "price"})
+			},
+		},
+		{
+			name: "valid with suffixed name",
+			api: func() config.APIConfig {
+				api := apiConfig("https://api.example.com/price/{ticker}", config.GenericJSONConfig{PricePath: "price"})
+				api.Name = genericjson.Name + "_venue"
+				return api
+			},
+		},
+		{
+			name: "invalid name",
+			api: func() config.APIConfig {
+				api := apiConfig("https://api.example.com/price/{ticker}", config.GenericJSONConfig{PricePath: "price"})
+				api.Name = "venue"
+				return api
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing generic json config",
+			api: func() config.APIConfig {
+				api := apiConfig("https://api.example.com/price/{ticker}", config.GenericJSONConfig{})
+				api.GenericJSON = nil
+				return api
+			},
+			expectedErr: true,
+		},
+		{
+			name: "both placeholders",
+			api: func() config.APIConfig {
+				return apiConfig("https://api.example.com/{ticker}?symbols={tickers}", config.GenericJSONConfig{PricePath: "price"})
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid selector",
+			api: func() config.APIConfig {
+				return apiConfig("https://api.example.com/prices", config.GenericJSONConfig{PricePath: "data[x]"})
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := genericjson.NewAPIHandler(tc.api())
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		endpoint    string
+		separator   string
+		cps         []types.ProviderTicker
+		url         string
+		expectedErr bool
+	}{
+		{
+			name:     "single ticker",
+			endpoint: "https://api.example.com/price/{ticker}",
+			cps:      []types.ProviderTicker{btcusd},
+			url:      "https://api.example.com/price/BTC-USD",
+		},
+		{
+			name:        "single ticker with multiple tickers",
+			endpoint:    "https://api.example.com/price/{ticker}",
+			cps:         []types.ProviderTicker{btcusd, ethusd},
+			expectedErr: true,
+		},
+		{
+			name:     "batched tickers",
+			endpoint: "https://api.example.com/prices?symbols={tickers}",
+			cps:      []types.ProviderTicker{btcusd, ethusd},
+			url:      "https://api.example.com/prices?symbols=BTC-USD,ETH-USD",
+		},
+		{
+			name:      "batched tickers with separator",
+			endpoint:  "https://api.example.com/prices?symbols={tickers}",
+			separator: "|",
+			cps:       []types.ProviderTicker{btcusd, ethusd},
+			url:       "https://api.example.com/prices?symbols=BTC-USD|ETH-USD",
+		},
+		{
+			name:        "batched tickers with no tickers",
+			endpoint:    "https://api.example.com/prices?symbols={tickers}",
+			cps:         []types.ProviderTicker{},
+			expectedErr: true,
+		},
+		{
+			name:     "no placeholder",
+			endpoint: "https://api.example.com/prices",
+			cps:      []types.ProviderTicker{btcusd, ethusd},
+			url:      "https://api.example.com/prices",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := genericjson.NewAPIHandler(apiConfig(tc.endpoint, config.GenericJSONConfig{
+				PricePath:       "price",
+				TickerSeparator: tc.separator,
+			}))
+			require.NoError(t, err)
+
+			url, err := h.CreateURL(tc.cps)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.url, url)
+			}
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		endpoint string
+		cfg      config.GenericJSONConfig
+		cps      []types.ProviderTicker
+		response *http.Response
+		expected types.PriceResponse
+		// timestamp is the expected timestamp of the resolved prices. If zero, the time of parsing is expected.
+		timestamp time.Time
+	}{
+		{
+			name:     "single ticker",
+			endpoint: "https://api.example.com/price/{ticker}",
+			cfg: config.GenericJSONConfig{
+				ResultsPath: "data",
+				PricePath:   "amount",
+			},
+			cps: []types.ProviderTicker{btcusd},
+			response: testutils.CreateResponseFromJSON(
+				`{"data": {"amount": "65000.5", "currency": "USD"}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {Value: big.NewFloat(65000.5)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name:     "results keyed by ticker",
+			endpoint: "https://api.example.com/prices?symbols={tickers}",
+			cfg: config.GenericJSONConfig{
+				PricePath: "last",
+			},
+			cps: []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`{"BTC-USD": {"last": 65000}, "ETH-USD": {"last": "3500.25"}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {Value: big.NewFloat(65000)},
+					ethusd: {Value: big.NewFloat(3500.25)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name:     "array of results with timestamps",
+			endpoint: "https://api.example.com/tickers",
+			cfg: config.GenericJSONConfig{
+				ResultsPath:     "$.result.list",
+				TickerPath:      "symbol",
+				PricePath:       "prices[0]",
+				TimestampPath:   "ts",
+				TimestampFormat: config.TimestampFormatUnixMilli,
+			},
+			cps: []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`{"result": {"list": [
+					{"symbol": "ETH-USD", "prices": [3500.25, 3499], "ts": 1700000000000},
+					{"symbol": "BTC-USD", "prices": [65000, 64999], "ts": 1700000000000}
+				]}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {Value: big.NewFloat(65000)},
+					ethusd: {Value: big.NewFloat(3500.25)},
+				},
+				types.UnResolvedPrices{},
+			),
+			timestamp: time.UnixMilli(1700000000000).UTC(),
+		},
+		{
+			name:     "selector with ticker placeholder and rfc3339 timestamp",
+			endpoint: "https://api.example.com/prices",
+			cfg: config.GenericJSONConfig{
+				PricePath:       "prices.{ticker}",
+				TimestampPath:   "updated",
+				TimestampFormat: config.TimestampFormatRFC3339,
+			},
+			cps: []types.ProviderTicker{btcusd},
+			response: testutils.CreateResponseFromJSON(
+				`{"prices": {"BTC-USD": "65000"}, "updated": "2023-11-14T22:13:20Z"}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {Value: big.NewFloat(65000)},
+				},
+				types.UnResolvedPrices{},
+			),
+			timestamp: time.Unix(1700000000, 0).UTC(),
+		},
+		{
+			name:     "ticker metadata overrides the price selector",
+			endpoint: "https://api.example.com/prices?symbols={tickers}",
+			cfg: config.GenericJSONConfig{
+				PricePath: "last",
+			},
+			cps: []types.ProviderTicker{btcusd, ethusdOverride},
+			response: testutils.CreateResponseFromJSON(
+				`{"BTC-USD": {"last": 65000}, "ETH-USD": {"quote": {"mid": 3500.25}}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd:         {Value: big.NewFloat(65000)},
+					ethusdOverride: {Value: big.NewFloat(3500.25)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name:     "missing and unparsable tickers are unresolved",
+			endpoint: "https://api.example.com/prices?symbols={tickers}",
+			cfg: config.GenericJSONConfig{
+				PricePath: "last",
+			},
+			cps: []types.ProviderTicker{btcusd, ethusd},
+			response: testutils.CreateResponseFromJSON(
+				`{"BTC-USD": {"last": "$65000"}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("bad format"), providertypes.ErrorFailedToParsePrice),
+					},
+					ethusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+					},
+				},
+			),
+		},
+		{
+			name:     "unable to parse json",
+			endpoint: "https://api.example.com/price/{ticker}",
+			cfg: config.GenericJSONConfig{
+				PricePath: "price",
+			},
+			cps: []types.ProviderTicker{btcusd},
+			response: testutils.CreateResponseFromJSON(
+				`toms obvious but not minimal language`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("bad format"), providertypes.ErrorFailedToDecode),
+					},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := genericjson.NewAPIHandler(apiConfig(tc.endpoint, tc.cfg))
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.cps, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.expected.Resolved))
+			require.Len(t, resp.UnResolved, len(tc.expected.UnResolved))
+
+			for cp, result := range tc.expected.Resolved {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+				if tc.timestamp.IsZero() {
+					require.True(t, r.Timestamp.After(now))
+				} else {
+					require.True(t, tc.timestamp.Equal(r.Timestamp))
+				}
+			}
+
+			for cp, result := range tc.expected.UnResolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Equal(t, result.Code(), resp.UnResolved[cp].Code())
+			}
+		})
+	}
+}
+
+func TestParseResponseTickerMetadataUpdate(t *testing.T) {
+	h, err := genericjson.NewAPIHandler(apiConfig(
+		"https://api.example.com/prices",
+		config.GenericJSONConfig{PricePath: "last"},
+	))
+	require.NoError(t, err)
+
+	body := `{"ETH-USD": {"last": "3000", "quote": {"mid": "3001"}}}`
+
+	// the selectors of the ticker are parsed on the first response and reused on the next.
+	for i := 0; i < 2; i++ {
+		resp := h.ParseResponse([]types.ProviderTicker{ethusd}, testutils.CreateResponseFromJSON(body))
+		require.Contains(t, resp.Resolved, ethusd)
+		require.Equal(t, big.NewFloat(3000).SetPrec(18), resp.Resolved[ethusd].Value.SetPrec(18))
+	}
+
+	// the selectors are re-parsed once the metadata of the ticker changes.
+	resp := h.ParseResponse([]types.ProviderTicker{ethusdOverride}, testutils.CreateResponseFromJSON(body))
+	require.Contains(t, resp.Resolved, ethusdOverride)
+	require.Equal(t, big.NewFloat(3001).SetPrec(18), resp.Resolved[ethusdOverride].Value.SetPrec(18))
+}
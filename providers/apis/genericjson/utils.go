@@ -0,0 +1,21 @@
+package genericjson
+
+import (
+	"github.com/skip-mev/connect/v2/providers/base/jsonpath"
+)
+
+const (
+	// Name is the name of the generic JSON provider. Multiple generic JSON providers can be configured
+	// by suffixing the name, e.g. generic_json_venue.
+	Name = "generic_json"
+
+	// TickerPlaceholder is replaced by the off-chain ticker in URLs that query a single ticker, and in
+	// selectors.
+	TickerPlaceholder = jsonpath.TickerPlaceholder
+
+	// TickersPlaceholder is replaced by the joined off-chain tickers in URLs that query a batch of tickers.
+	TickersPlaceholder = "{tickers}"
+
+	// DefaultTickerSeparator is the separator used to join the tickers of a batch.
+	DefaultTickerSeparator = ","
+)
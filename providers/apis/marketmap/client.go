@@ -29,7 +29,7 @@ func NewGRPCClient(
 	api config.APIConfig,
 	apiMetrics metrics.APIMetrics,
 ) (mmtypes.QueryClient, error) {
-	if api.Name != Name {
+	if !IsMarketMapAPIName(api.Name) {
 		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
 	}
 
@@ -98,3 +98,24 @@ func (c *MarketMapClient) MarketMap(
 	c.apiMetrics.AddRPCStatusCode(c.api.Name, metrics.RedactedURL, metrics.RPCCodeOK)
 	return
 }
+
+// LastUpdated wraps the MarketMapClient's query with additional metrics.
+func (c *MarketMapClient) LastUpdated(
+	ctx context.Context,
+	req *mmtypes.LastUpdatedRequest,
+	_ ...grpc.CallOption,
+) (resp *mmtypes.LastUpdatedResponse, err error) {
+	start := time.Now()
+	defer func() {
+		c.apiMetrics.ObserveProviderResponseLatency(c.api.Name, metrics.RedactedURL, time.Since(start))
+	}()
+
+	resp, err = c.QueryClient.LastUpdated(ctx, req)
+	if err != nil {
+		c.apiMetrics.AddRPCStatusCode(c.api.Name, metrics.RedactedURL, metrics.RPCCodeError)
+		return
+	}
+
+	c.apiMetrics.AddRPCStatusCode(c.api.Name, metrics.RedactedURL, metrics.RPCCodeOK)
+	return
+}
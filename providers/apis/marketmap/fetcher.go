@@ -39,7 +39,7 @@ func NewMarketMapFetcher(
 		return nil, fmt.Errorf("invalid api config: %w", err)
 	}
 
-	if api.Name != Name {
+	if !IsMarketMapAPIName(api.Name) {
 		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
 	}
 
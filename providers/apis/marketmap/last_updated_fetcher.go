@@ -0,0 +1,120 @@
+package marketmap
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
+	"github.com/skip-mev/connect/v2/service/clients/marketmap/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// LastUpdatedMarketMapFetcher is an alternative x/marketmap fetcher that only fetches the full market map
+// when it changes. Each fetch queries the height at which the x/marketmap module was last updated, which
+// is bumped by every change to the market map (including changes applied at the end of a block, such as
+// pending markets and executed proposals). The full market map is only queried if the height changed since
+// the last full query, or if the cached market map is older than MaxCachedMarketMapAge. If the height
+// cannot be queried, e.g. after the node disconnected, the fetcher falls back to polling the full market map.
+type LastUpdatedMarketMapFetcher struct { //nolint
+	logger *zap.Logger
+
+	// client is the QueryClient implementation. This is used to query the last updated height.
+	client mmtypes.QueryClient
+	// fetcher is used to query the full market map.
+	fetcher *MarketMapFetcher
+
+	// mut guards the cached market map.
+	mut sync.Mutex
+	// cached is the market map returned by the last full query.
+	cached *mmtypes.MarketMapResponse
+	// cachedAt is the time of the last full query.
+	cachedAt time.Time
+}
+
+// NewLastUpdatedMarketMapFetcher returns a new LastUpdatedMarketMap fetcher with the standard grpc client.
+func NewLastUpdatedMarketMapFetcher(
+	logger *zap.Logger,
+	api config.APIConfig,
+	metrics metrics.APIMetrics,
+) (*LastUpdatedMarketMapFetcher, error) {
+	fetcher, err := NewMarketMapFetcher(logger, api, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LastUpdatedMarketMapFetcher{
+		logger:  logger.With(zap.String("fetcher", LastUpdatedName)),
+		client:  fetcher.client,
+		fetcher: fetcher,
+	}, nil
+}
+
+// NewLastUpdatedMarketMapFetcherWithClient returns a new LastUpdatedMarketMap fetcher.
+func NewLastUpdatedMarketMapFetcherWithClient(
+	logger *zap.Logger,
+	client mmtypes.QueryClient,
+) (*LastUpdatedMarketMapFetcher, error) {
+	fetcher, err := NewMarketMapFetcherWithClient(logger, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LastUpdatedMarketMapFetcher{
+		logger:  logger.With(zap.String("fetcher", LastUpdatedName)),
+		client:  client,
+		fetcher: fetcher,
+	}, nil
+}
+
+// Fetch returns the latest market map data from the x/marketmap module. The cached market map is returned
+// if the module has not been updated since it was fetched. It expects only a single chain ID since the
+// current implementation assumes a single connection to one chain.
+func (f *LastUpdatedMarketMapFetcher) Fetch(
+	ctx context.Context,
+	chains []types.Chain,
+) types.MarketMapResponse {
+	if len(chains) != 1 {
+		return f.fetcher.Fetch(ctx, chains)
+	}
+
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	// Query the height at which the x/marketmap module was last updated.
+	lastUpdated, err := f.client.LastUpdated(ctx, &mmtypes.LastUpdatedRequest{})
+	switch {
+	case err != nil || lastUpdated == nil:
+		f.logger.Info(
+			"failed to query last updated height; falling back to querying the full market map",
+			zap.Error(err),
+		)
+	case f.cached == nil:
+		f.logger.Debug("no cached market map; querying the full market map")
+	case f.cached.LastUpdated != lastUpdated.LastUpdated:
+		f.logger.Info(
+			"market map module was updated; querying the full market map",
+			zap.Uint64("cached_last_updated", f.cached.LastUpdated),
+			zap.Uint64("last_updated", lastUpdated.LastUpdated),
+		)
+	case time.Since(f.cachedAt) >= MaxCachedMarketMapAge:
+		f.logger.Debug("cached market map is stale; querying the full market map")
+	default:
+		f.logger.Debug("market map module was not updated; returning the cached market map")
+
+		resolved := make(types.ResolvedMarketMap)
+		resolved[chains[0]] = types.NewMarketMapResult(f.cached, time.Now())
+		return types.NewMarketMapResponse(resolved, nil)
+	}
+
+	response := f.fetcher.Fetch(ctx, chains)
+	if result, ok := response.Resolved[chains[0]]; ok {
+		f.cached = result.Value
+		f.cachedAt = time.Now()
+	}
+
+	return response
+}
@@ -0,0 +1,94 @@
+package marketmap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/providers/apis/marketmap"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/mocks"
+)
+
+func TestLastUpdatedFetch(t *testing.T) {
+	marketMapResponse := func(lastUpdated uint64) *mmtypes.MarketMapResponse {
+		return &mmtypes.MarketMapResponse{
+			MarketMap:   goodMarketMap,
+			ChainId:     chains[0].ChainID,
+			LastUpdated: lastUpdated,
+		}
+	}
+
+	t.Run("returns the cached market map if the module was not updated", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 10}, nil).Twice()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(marketMapResponse(10), nil).Once()
+
+		fetcher, err := marketmap.NewLastUpdatedMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		for range 2 {
+			resp := fetcher.Fetch(context.TODO(), chains[:1])
+			require.Len(t, resp.Resolved, 1)
+			require.Equal(t, marketMapResponse(10), resp.Resolved[chains[0]].Value)
+		}
+	})
+
+	t.Run("fetches the market map if the module was updated", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 10}, nil).Once()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(marketMapResponse(10), nil).Once()
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 11}, nil).Once()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(marketMapResponse(11), nil).Once()
+
+		fetcher, err := marketmap.NewLastUpdatedMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		resp := fetcher.Fetch(context.TODO(), chains[:1])
+		require.Equal(t, marketMapResponse(10), resp.Resolved[chains[0]].Value)
+
+		resp = fetcher.Fetch(context.TODO(), chains[:1])
+		require.Equal(t, marketMapResponse(11), resp.Resolved[chains[0]].Value)
+	})
+
+	t.Run("fetches the market map if the last updated height cannot be queried", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("could not make request")).Twice()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(marketMapResponse(10), nil).Twice()
+
+		fetcher, err := marketmap.NewLastUpdatedMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		for range 2 {
+			resp := fetcher.Fetch(context.TODO(), chains[:1])
+			require.Equal(t, marketMapResponse(10), resp.Resolved[chains[0]].Value)
+		}
+	})
+
+	t.Run("does not cache a market map that could not be fetched", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		c.On("LastUpdated", mock.Anything, mock.Anything).Return(&mmtypes.LastUpdatedResponse{LastUpdated: 10}, nil).Twice()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("could not make request")).Once()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(marketMapResponse(10), nil).Once()
+
+		fetcher, err := marketmap.NewLastUpdatedMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		resp := fetcher.Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.UnResolved, 1)
+
+		resp = fetcher.Fetch(context.TODO(), chains[:1])
+		require.Equal(t, marketMapResponse(10), resp.Resolved[chains[0]].Value)
+	})
+
+	t.Run("errors when too many chains are inputted", func(t *testing.T) {
+		fetcher, err := marketmap.NewLastUpdatedMarketMapFetcherWithClient(logger, mocks.NewQueryClient(t))
+		require.NoError(t, err)
+
+		resp := fetcher.Fetch(context.TODO(), chains)
+		require.Len(t, resp.UnResolved, len(chains))
+	})
+}
@@ -0,0 +1,188 @@
+package marketmap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+)
+
+// DefaultMaxClockDrift is the default maximum amount of time the time of a header may be ahead of the
+// local clock.
+const DefaultMaxClockDrift = 10 * time.Second
+
+// LightClientVerifier is a lightweight CometBFT light client. It is initialized from a trusted header,
+// and verifies the latest header of the chain against the last header it trusts using skipping
+// verification, i.e. a header is trusted if validators holding at least 1/3 of the voting power of the
+// trusted validator set signed it. If the validator set changed too much for that, intermediate headers
+// are verified by bisection. Only the last trusted light block is kept, in memory.
+type LightClientVerifier struct {
+	mut sync.Mutex
+
+	// provider is used to fetch the light blocks to verify.
+	provider provider.Provider
+	// cfg is the light client configuration.
+	cfg config.LightClientConfig
+	// trusted is the last trusted light block. It is nil until the verifier is initialized from the
+	// trusted header in the config.
+	trusted *cmttypes.LightBlock
+}
+
+// NewLightClientVerifier returns a new light client verifier that fetches light blocks from the given
+// provider. The trusted header is fetched once the first header is verified.
+func NewLightClientVerifier(
+	provider provider.Provider,
+	cfg config.LightClientConfig,
+) (*LightClientVerifier, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("light block provider is required")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid light client config: %w", err)
+	}
+
+	if provider.ChainID() != cfg.ChainID {
+		return nil, fmt.Errorf("expected light block provider for chain %s, got %s", cfg.ChainID, provider.ChainID())
+	}
+
+	if cfg.MaxClockDrift == 0 {
+		cfg.MaxClockDrift = DefaultMaxClockDrift
+	}
+
+	return &LightClientVerifier{
+		provider: provider,
+		cfg:      cfg,
+	}, nil
+}
+
+// VerifyLatest fetches the latest light block of the chain, verifies it, and returns it.
+func (v *LightClientVerifier) VerifyLatest(ctx context.Context, now time.Time) (*cmttypes.LightBlock, error) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	if v.trusted == nil {
+		if err := v.initialize(ctx, now); err != nil {
+			return nil, fmt.Errorf("failed to initialize light client from trusted header: %w", err)
+		}
+	}
+
+	latest, err := v.provider.LightBlock(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest light block: %w", err)
+	}
+
+	switch {
+	case latest.Height < v.trusted.Height:
+		return nil, fmt.Errorf(
+			"latest height %d is lower than the trusted height %d; the node may be lagging",
+			latest.Height,
+			v.trusted.Height,
+		)
+	case latest.Height == v.trusted.Height:
+		if !bytes.Equal(latest.Hash(), v.trusted.Hash()) {
+			return nil, fmt.Errorf("latest header at height %d does not match the trusted header", latest.Height)
+		}
+	default:
+		if err := v.verifySkipping(ctx, latest, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if v.cfg.MaxBlockAge > 0 && now.Sub(v.trusted.Time) > v.cfg.MaxBlockAge {
+		return nil, fmt.Errorf(
+			"latest header at height %d is older than %s; the node may be lagging",
+			v.trusted.Height,
+			v.cfg.MaxBlockAge,
+		)
+	}
+
+	return v.trusted, nil
+}
+
+// initialize fetches the trusted header from the provider, and checks it against the trusted hash.
+func (v *LightClientVerifier) initialize(ctx context.Context, now time.Time) error {
+	trusted, err := v.provider.LightBlock(ctx, v.cfg.TrustHeight)
+	if err != nil {
+		return fmt.Errorf("failed to fetch light block at height %d: %w", v.cfg.TrustHeight, err)
+	}
+
+	if err := trusted.ValidateBasic(v.cfg.ChainID); err != nil {
+		return err
+	}
+
+	// the hash was validated by the config.
+	trustHash, _ := v.cfg.TrustHashBytes()
+	if !bytes.Equal(trusted.Hash(), trustHash) {
+		return fmt.Errorf("expected header hash %X, got %X", trustHash, trusted.Hash())
+	}
+
+	if light.HeaderExpired(trusted.SignedHeader, v.cfg.TrustPeriod, now) {
+		return fmt.Errorf("trusted header at height %d is older than the trust period", trusted.Height)
+	}
+
+	if err := trusted.ValidatorSet.VerifyCommitLight(
+		v.cfg.ChainID,
+		trusted.Commit.BlockID,
+		trusted.Height,
+		trusted.Commit,
+	); err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+
+	v.trusted = trusted
+	return nil
+}
+
+// verifySkipping verifies the given light block against the trusted light block. If the trusted validator
+// set cannot be used to verify the light block, the light block halfway between both is verified first.
+func (v *LightClientVerifier) verifySkipping(ctx context.Context, target *cmttypes.LightBlock, now time.Time) error {
+	pending := []*cmttypes.LightBlock{target}
+	for len(pending) > 0 {
+		next := pending[len(pending)-1]
+
+		err := light.Verify(
+			v.trusted.SignedHeader,
+			v.trusted.ValidatorSet,
+			next.SignedHeader,
+			next.ValidatorSet,
+			v.cfg.TrustPeriod,
+			now,
+			v.cfg.MaxClockDrift,
+			light.DefaultTrustLevel,
+		)
+
+		var errValSet light.ErrNewValSetCantBeTrusted
+		switch {
+		case err == nil:
+			v.trusted = next
+			pending = pending[:len(pending)-1]
+		case errors.As(err, &errValSet):
+			// Adjacent headers are verified against the next validators hash of the trusted header, so the
+			// pivot is always strictly between both heights.
+			height := (v.trusted.Height + next.Height) / 2
+			pivot, err := v.provider.LightBlock(ctx, height)
+			if err != nil {
+				return fmt.Errorf("failed to fetch light block at height %d: %w", height, err)
+			}
+
+			if pivot.Height != height {
+				return fmt.Errorf("expected light block at height %d, got %d", height, pivot.Height)
+			}
+
+			pending = append(pending, pivot)
+		default:
+			return fmt.Errorf("failed to verify header at height %d: %w", next.Height, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package marketmap_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/light/provider/mock"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/providers/apis/marketmap"
+)
+
+const testChainID = "test-chain"
+
+// testValidators is a validator set with the private keys of its validators.
+type testValidators struct {
+	privVals []cmttypes.PrivValidator
+	set      *cmttypes.ValidatorSet
+}
+
+func newTestValidators() testValidators {
+	privVal := cmttypes.NewMockPV()
+
+	return testValidators{
+		privVals: []cmttypes.PrivValidator{privVal},
+		set:      cmttypes.NewValidatorSet([]*cmttypes.Validator{privVal.ExtractIntoValidator(10)}),
+	}
+}
+
+// testChain serves the light blocks of a chain through a mock light block provider.
+type testChain struct {
+	provider *mock.Mock
+	start    time.Time
+}
+
+func newTestChain() *testChain {
+	return &testChain{
+		provider: mock.New(testChainID, map[int64]*cmttypes.SignedHeader{}, map[int64]*cmttypes.ValidatorSet{}),
+		start:    time.Now().Add(-time.Hour),
+	}
+}
+
+// addBlock adds a light block at the given height to the chain, signed by the given validators.
+func (c *testChain) addBlock(
+	t *testing.T,
+	height int64,
+	appHash []byte,
+	vals testValidators,
+	nextVals testValidators,
+) *cmttypes.LightBlock {
+	t.Helper()
+
+	proposer, err := vals.privVals[0].GetPubKey()
+	require.NoError(t, err)
+
+	blockTime := c.start.Add(time.Duration(height) * time.Second)
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            testChainID,
+		Height:             height,
+		Time:               blockTime,
+		ValidatorsHash:     vals.set.Hash(),
+		NextValidatorsHash: nextVals.set.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    proposer.Address(),
+	}
+
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := cmttypes.NewVoteSet(testChainID, height, 0, cmtproto.PrecommitType, vals.set)
+	commit, err := cmttypes.MakeExtCommit(blockID, height, 0, voteSet, vals.privVals, blockTime, false)
+	require.NoError(t, err)
+
+	lightBlock := &cmttypes.LightBlock{
+		SignedHeader: &cmttypes.SignedHeader{Header: header, Commit: commit.ToCommit()},
+		ValidatorSet: vals.set,
+	}
+	c.provider.AddLightBlock(lightBlock)
+
+	return lightBlock
+}
+
+// lightClientConfig returns a light client config that trusts the given light block.
+func lightClientConfig(trusted *cmttypes.LightBlock) config.LightClientConfig {
+	return config.LightClientConfig{
+		ChainID:     testChainID,
+		TrustHeight: trusted.Height,
+		TrustHash:   trusted.Hash().String(),
+		TrustPeriod: 24 * time.Hour,
+	}
+}
+
+func TestLightClientVerifier(t *testing.T) {
+	t.Run("verifies the latest header", func(t *testing.T) {
+		vals := newTestValidators()
+		chain := newTestChain()
+		trusted := chain.addBlock(t, 1, nil, vals, vals)
+		chain.addBlock(t, 2, nil, vals, vals)
+		latest := chain.addBlock(t, 3, nil, vals, vals)
+
+		verifier, err := marketmap.NewLightClientVerifier(chain.provider, lightClientConfig(trusted))
+		require.NoError(t, err)
+
+		lightBlock, err := verifier.VerifyLatest(context.TODO(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, latest.Hash(), lightBlock.Hash())
+
+		// the latest header is unchanged
+		lightBlock, err = verifier.VerifyLatest(context.TODO(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, latest.Hash(), lightBlock.Hash())
+
+		latest = chain.addBlock(t, 5, nil, vals, vals)
+		lightBlock, err = verifier.VerifyLatest(context.TODO(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, latest.Hash(), lightBlock.Hash())
+	})
+
+	t.Run("verifies the latest header after the validator set changed", func(t *testing.T) {
+		vals, nextVals := newTestValidators(), newTestValidators()
+		chain := newTestChain()
+		trusted := chain.addBlock(t, 1, nil, vals, vals)
+		chain.addBlock(t, 2, nil, vals, nextVals)
+		chain.addBlock(t, 3, nil, nextVals, nextVals)
+		chain.addBlock(t, 4, nil, nextVals, nextVals)
+		latest := chain.addBlock(t, 5, nil, nextVals, nextVals)
+
+		verifier, err := marketmap.NewLightClientVerifier(chain.provider, lightClientConfig(trusted))
+		require.NoError(t, err)
+
+		lightBlock, err := verifier.VerifyLatest(context.TODO(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, latest.Hash(), lightBlock.Hash())
+	})
+
+	t.Run("fails if the trusted header does not match the trust hash", func(t *testing.T) {
+		vals := newTestValidators()
+		chain := newTestChain()
+		chain.addBlock(t, 1, nil, vals, vals)
+		other := newTestChain().addBlock(t, 1, []byte("other"), vals, vals)
+
+		verifier, err := marketmap.NewLightClientVerifier(chain.provider, lightClientConfig(other))
+		require.NoError(t, err)
+
+		_, err = verifier.VerifyLatest(context.TODO(), time.Now())
+		require.ErrorContains(t, err, "expected header hash")
+	})
+
+	t.Run("fails if the latest header is signed by another validator set", func(t *testing.T) {
+		vals := newTestValidators()
+		chain := newTestChain()
+		trusted := chain.addBlock(t, 1, nil, vals, vals)
+
+		verifier, err := marketmap.NewLightClientVerifier(chain.provider, lightClientConfig(trusted))
+		require.NoError(t, err)
+
+		_, err = verifier.VerifyLatest(context.TODO(), time.Now())
+		require.NoError(t, err)
+
+		// the header of a fork, signed by validators that are not trusted
+		forkVals := newTestValidators()
+		chain.addBlock(t, 5, nil, forkVals, forkVals)
+
+		_, err = verifier.VerifyLatest(context.TODO(), time.Now())
+		require.Error(t, err)
+	})
+
+	t.Run("fails if the latest header is too old", func(t *testing.T) {
+		vals := newTestValidators()
+		chain := newTestChain()
+		trusted := chain.addBlock(t, 1, nil, vals, vals)
+		chain.addBlock(t, 2, nil, vals, vals)
+
+		cfg := lightClientConfig(trusted)
+		cfg.MaxBlockAge = time.Minute
+
+		verifier, err := marketmap.NewLightClientVerifier(chain.provider, cfg)
+		require.NoError(t, err)
+
+		_, err = verifier.VerifyLatest(context.TODO(), time.Now())
+		require.ErrorContains(t, err, "may be lagging")
+	})
+
+	t.Run("fails if the provider is for another chain", func(t *testing.T) {
+		vals := newTestValidators()
+		chain := newTestChain()
+		trusted := chain.addBlock(t, 1, nil, vals, vals)
+
+		cfg := lightClientConfig(trusted)
+		cfg.ChainID = "other-chain"
+
+		_, err := marketmap.NewLightClientVerifier(chain.provider, cfg)
+		require.Error(t, err)
+	})
+}
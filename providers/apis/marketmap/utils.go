@@ -1,6 +1,7 @@
 package marketmap
 
 import (
+	"strings"
 	"time"
 
 	"github.com/skip-mev/connect/v2/oracle/config"
@@ -9,8 +10,27 @@ import (
 const (
 	// Name is the name of the MarketMap provider.
 	Name = "marketmap_api"
+
+	// LastUpdatedName is the name of the MarketMap provider that only fetches the full market map
+	// when the x/marketmap module's last updated height changes.
+	LastUpdatedName = "marketmap_last_updated_api"
+
+	// VerifiedName is the name of the MarketMap provider that verifies the market map it fetches from the
+	// x/marketmap store using a light client.
+	VerifiedName = "marketmap_verified_api"
+
+	// MaxCachedMarketMapAge is the maximum age of the market map cached by the LastUpdated and verified
+	// MarketMap providers, after which the full market map is fetched regardless of the last updated height.
+	MaxCachedMarketMapAge = 5 * time.Minute
 )
 
+// IsMarketMapAPIName returns true if the given API name refers to an x/marketmap provider. The market
+// maps of additional chains can be followed via providers whose names are prefixed by the MarketMap
+// provider's name, e.g. marketmap_api_secondary.
+func IsMarketMapAPIName(name string) bool {
+	return name == Name || name == LastUpdatedName || strings.HasPrefix(name, Name+"_")
+}
+
 // DefaultAPIConfig returns the default configuration for the MarketMap API.
 var DefaultAPIConfig = config.APIConfig{
 	Name:             Name,
@@ -22,3 +42,17 @@ var DefaultAPIConfig = config.APIConfig{
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: "localhost:9090"}},
 }
+
+// DefaultLastUpdatedAPIConfig returns the default configuration for the LastUpdated MarketMap API. Since
+// the full market map is only fetched when it changes, the last updated height is polled more frequently
+// than the MarketMap API polls the full market map.
+var DefaultLastUpdatedAPIConfig = config.APIConfig{
+	Name:             LastUpdatedName,
+	Atomic:           true,
+	Enabled:          true,
+	Timeout:          20 * time.Second,
+	Interval:         time.Second,
+	ReconnectTimeout: 2000 * time.Millisecond,
+	MaxQueries:       1,
+	Endpoints:        []config.Endpoint{{URL: "localhost:9090"}},
+}
@@ -0,0 +1,364 @@
+package marketmap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	lighthttp "github.com/cometbft/cometbft/light/provider/http"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	ics23 "github.com/cosmos/ics23/go"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+	"github.com/skip-mev/connect/v2/service/clients/marketmap/types"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// StoreQueryPath is the ABCI query path used to query keys of the x/marketmap store.
+var StoreQueryPath = fmt.Sprintf("/store/%s/key", mmtypes.StoreKey)
+
+// StoreQueryClient is the subset of the CometBFT RPC client used to query the x/marketmap store.
+type StoreQueryClient interface {
+	ABCIQueryWithOptions(
+		ctx context.Context,
+		path string,
+		data cmtbytes.HexBytes,
+		opts rpcclient.ABCIQueryOptions,
+	) (*coretypes.ResultABCIQuery, error)
+}
+
+// VerifiedMarketMapFetcher is an alternative x/marketmap fetcher that does not trust the node it queries.
+// The market map is read directly from the x/marketmap store through ABCI queries, and every query result
+// is verified with its Merkle proof against the app hash of a header that was verified by a light client.
+//
+// The markets are read by walking the keys of the markets collection: each query proves that a key does
+// not exist, and the proof contains the next key of the store and its value. This proves both the content
+// of each market, and that no market was omitted. Since this requires a query per market, the full market
+// map is only read if the last updated height of the module changed since it was last read, or if the
+// cached market map is older than MaxCachedMarketMapAge.
+type VerifiedMarketMapFetcher struct { //nolint
+	logger *zap.Logger
+
+	// client is used to query the x/marketmap store.
+	client StoreQueryClient
+	// verifier is used to verify the latest header of the chain.
+	verifier *LightClientVerifier
+	// proofRuntime is used to verify the proofs of the store queries.
+	proofRuntime *merkle.ProofRuntime
+
+	// mut guards the cached market map.
+	mut sync.Mutex
+	// cached is the market map returned by the last full query.
+	cached *mmtypes.MarketMapResponse
+	// cachedAt is the time of the last full query.
+	cachedAt time.Time
+}
+
+// NewVerifiedMarketMapFetcher returns a new verified MarketMap fetcher that queries the CometBFT RPC
+// endpoint of the given config.
+func NewVerifiedMarketMapFetcher(
+	logger *zap.Logger,
+	api config.APIConfig,
+) (*VerifiedMarketMapFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if api.Name != VerifiedName {
+		return nil, fmt.Errorf("invalid api name; expected %s, got %s", VerifiedName, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api is not enabled")
+	}
+
+	if api.LightClient == nil {
+		return nil, fmt.Errorf("light client config is required")
+	}
+
+	client, err := rpchttp.NewWithClient(api.Endpoints[0].URL, "/websocket", &http.Client{
+		Timeout: api.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+
+	verifier, err := NewLightClientVerifier(lighthttp.NewWithClient(api.LightClient.ChainID, client), *api.LightClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVerifiedMarketMapFetcherWithClients(logger, client, verifier)
+}
+
+// NewVerifiedMarketMapFetcherWithClients returns a new verified MarketMap fetcher.
+func NewVerifiedMarketMapFetcherWithClients(
+	logger *zap.Logger,
+	client StoreQueryClient,
+	verifier *LightClientVerifier,
+) (*VerifiedMarketMapFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	if verifier == nil {
+		return nil, fmt.Errorf("verifier is required")
+	}
+
+	return &VerifiedMarketMapFetcher{
+		logger:       logger.With(zap.String("fetcher", VerifiedName)),
+		client:       client,
+		verifier:     verifier,
+		proofRuntime: rootmulti.DefaultProofRuntime(),
+	}, nil
+}
+
+// Fetch returns the latest market map data from the x/marketmap module, verified against the latest
+// header of the chain. It expects only a single chain ID since the current implementation assumes a
+// single connection to one chain.
+func (f *VerifiedMarketMapFetcher) Fetch(
+	ctx context.Context,
+	chains []types.Chain,
+) types.MarketMapResponse {
+	if len(chains) != 1 {
+		f.logger.Info("expected one chain, got multiple chains", zap.Any("chains", chains))
+		return types.NewMarketMapResponseWithErr(
+			chains,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("expected one chain, got %d", len(chains)),
+				providertypes.ErrorInvalidAPIChains,
+			),
+		)
+	}
+
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	resp, err := f.fetchVerified(ctx)
+	if err != nil {
+		f.logger.Error("failed to fetch verified market map", zap.Error(err))
+		return types.NewMarketMapResponseWithErr(
+			chains,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("failed to fetch verified market map: %w", err),
+				providertypes.ErrorInvalidResponse,
+			),
+		)
+	}
+
+	resolved := make(types.ResolvedMarketMap)
+	resolved[chains[0]] = types.NewMarketMapResult(resp, time.Now())
+
+	return types.NewMarketMapResponse(resolved, nil)
+}
+
+// fetchVerified verifies the latest header of the chain, and returns the market map at the preceding
+// height, whose app hash is committed to by the header.
+func (f *VerifiedMarketMapFetcher) fetchVerified(ctx context.Context) (*mmtypes.MarketMapResponse, error) {
+	lightBlock, err := f.verifier.VerifyLatest(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify latest header: %w", err)
+	}
+
+	if lightBlock.Height < 2 {
+		return nil, fmt.Errorf("expected a header at height 2 or greater, got %d", lightBlock.Height)
+	}
+
+	height := lightBlock.Height - 1
+	appHash := lightBlock.AppHash
+
+	value, err := f.queryMembership(ctx, height, appHash, mmtypes.LastUpdatedPrefix.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	lastUpdated, err := mmtypes.LastUpdatedCodec.Decode(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode last updated height: %w", err)
+	}
+
+	if f.cached != nil && f.cached.LastUpdated == lastUpdated && time.Since(f.cachedAt) < MaxCachedMarketMapAge {
+		f.logger.Debug(
+			"market map module was not updated; returning the cached market map",
+			zap.Int64("height", height),
+		)
+		return f.cached, nil
+	}
+
+	markets, err := f.queryMarkets(ctx, height, appHash)
+	if err != nil {
+		return nil, err
+	}
+
+	f.cached = &mmtypes.MarketMapResponse{
+		MarketMap:   mmtypes.MarketMap{Markets: markets},
+		LastUpdated: lastUpdated,
+		ChainId:     lightBlock.ChainID,
+	}
+	f.cachedAt = time.Now()
+
+	f.logger.Info(
+		"successfully fetched verified market map data from module",
+		zap.Int64("height", height),
+		zap.Uint64("last_updated", lastUpdated),
+		zap.Int("num_markets", len(markets)),
+	)
+
+	return f.cached, nil
+}
+
+// queryMarkets walks the keys of the markets collection in order, and returns all markets.
+func (f *VerifiedMarketMapFetcher) queryMarkets(
+	ctx context.Context,
+	height int64,
+	appHash []byte,
+) (map[string]mmtypes.Market, error) {
+	prefix := mmtypes.MarketsPrefix.Bytes()
+	markets := make(map[string]mmtypes.Market)
+
+	// The prefix itself is never a key, so its non-existence proof contains the first market. Similarly,
+	// the key of a market followed by a zero byte is never a key, so its non-existence proof contains the
+	// next market.
+	key := prefix
+	for {
+		proof, err := f.queryNonMembership(ctx, height, appHash, key)
+		if err != nil {
+			return nil, err
+		}
+
+		next := proof.Right
+		if next == nil || !bytes.HasPrefix(next.Key, prefix) {
+			return markets, nil
+		}
+
+		var market mmtypes.Market
+		if err := market.Unmarshal(next.Value); err != nil {
+			return nil, fmt.Errorf("failed to decode market %X: %w", next.Key, err)
+		}
+
+		ticker := string(next.Key[len(prefix):])
+		if market.Ticker.String() != ticker {
+			return nil, fmt.Errorf("market %s is stored under ticker %s", market.Ticker.String(), ticker)
+		}
+
+		markets[ticker] = market
+		key = append(bytes.Clone(next.Key), 0)
+	}
+}
+
+// queryMembership queries the given key of the x/marketmap store at the given height, verifies that it
+// exists against the app hash, and returns its value.
+func (f *VerifiedMarketMapFetcher) queryMembership(
+	ctx context.Context,
+	height int64,
+	appHash []byte,
+	key []byte,
+) ([]byte, error) {
+	resp, err := f.queryKey(ctx, height, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Value) == 0 {
+		return nil, fmt.Errorf("key %X does not exist at height %d", key, height)
+	}
+
+	if err := f.proofRuntime.VerifyValue(resp.ProofOps, appHash, keyPath(key), resp.Value); err != nil {
+		return nil, fmt.Errorf("failed to verify proof of key %X: %w", key, err)
+	}
+
+	return resp.Value, nil
+}
+
+// queryNonMembership queries the given key of the x/marketmap store at the given height, verifies that it
+// does not exist against the app hash, and returns the proof of its non-existence.
+func (f *VerifiedMarketMapFetcher) queryNonMembership(
+	ctx context.Context,
+	height int64,
+	appHash []byte,
+	key []byte,
+) (*ics23.NonExistenceProof, error) {
+	resp, err := f.queryKey(ctx, height, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Value) != 0 {
+		return nil, fmt.Errorf("unexpected key %X at height %d", key, height)
+	}
+
+	if err := f.proofRuntime.VerifyAbsence(resp.ProofOps, appHash, keyPath(key)); err != nil {
+		return nil, fmt.Errorf("failed to verify proof of absence of key %X: %w", key, err)
+	}
+
+	// The proof was verified, so the first operation is the store's commitment proof.
+	ops, err := f.proofRuntime.DecodeProof(resp.ProofOps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode proof of absence of key %X: %w", key, err)
+	}
+
+	op, ok := ops[0].(storetypes.CommitmentOp)
+	if !ok || op.Proof.GetNonexist() == nil {
+		return nil, fmt.Errorf("expected a non-existence proof for key %X", key)
+	}
+
+	return op.Proof.GetNonexist(), nil
+}
+
+// queryKey queries the given key of the x/marketmap store at the given height, including its proof.
+func (f *VerifiedMarketMapFetcher) queryKey(
+	ctx context.Context,
+	height int64,
+	key []byte,
+) (*abci.ResponseQuery, error) {
+	result, err := f.client.ABCIQueryWithOptions(ctx, StoreQueryPath, key, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query key %X: %w", key, err)
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return nil, fmt.Errorf("query of key %X failed with code %d: %s", key, resp.Code, resp.Log)
+	}
+
+	if resp.Height != height {
+		return nil, fmt.Errorf("expected query result at height %d, got %d", height, resp.Height)
+	}
+
+	if resp.ProofOps == nil {
+		return nil, fmt.Errorf("query of key %X did not return a proof", key)
+	}
+
+	return &resp, nil
+}
+
+// keyPath returns the merkle key path of the given key of the x/marketmap store.
+func keyPath(key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(mmtypes.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
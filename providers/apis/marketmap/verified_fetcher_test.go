@@ -0,0 +1,234 @@
+package marketmap_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/apis/coinbase"
+	"github.com/skip-mev/connect/v2/providers/apis/marketmap"
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// storeQueryClient serves ABCI store queries from a multi store, like a CometBFT RPC endpoint.
+type storeQueryClient struct {
+	store storetypes.Queryable
+	// queries is the number of queries served.
+	queries int
+	// tamper optionally modifies the key that is queried.
+	tamper func(key []byte) []byte
+}
+
+func (c *storeQueryClient) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	data cmtbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	c.queries++
+	if c.tamper != nil {
+		data = c.tamper(data)
+	}
+
+	resp, err := c.store.Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{
+		Response: abci.ResponseQuery{
+			Key:      resp.Key,
+			Value:    resp.Value,
+			ProofOps: resp.ProofOps,
+			Height:   resp.Height,
+		},
+	}, nil
+}
+
+// verifiedTestEnv is an x/marketmap store whose commits are included in the headers of a test chain.
+type verifiedTestEnv struct {
+	ctx    sdk.Context
+	cms    storetypes.CommitMultiStore
+	keeper *keeper.Keeper
+	vals   testValidators
+	chain  *testChain
+	client *storeQueryClient
+	// trusted is the first light block of the chain.
+	trusted *cmttypes.LightBlock
+}
+
+func newVerifiedTestEnv(t *testing.T) *verifiedTestEnv {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(mmtypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_mm"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	env := &verifiedTestEnv{
+		ctx:    testCtx.Ctx,
+		cms:    testCtx.CMS,
+		keeper: keeper.NewKeeper(runtime.NewKVStoreService(key), encCfg.Codec, sdk.AccAddress("authority")),
+		vals:   newTestValidators(),
+		chain:  newTestChain(),
+	}
+	env.client = &storeQueryClient{store: testCtx.CMS.(storetypes.Queryable)}
+	env.trusted = env.chain.addBlock(t, 1, nil, env.vals, env.vals)
+
+	return env
+}
+
+// commit commits the store at the given height, and adds the header that includes the commit to the
+// chain.
+func (env *verifiedTestEnv) commit(t *testing.T, height int64) {
+	t.Helper()
+
+	commitID := env.cms.Commit()
+	require.Equal(t, height, commitID.Version)
+
+	env.chain.addBlock(t, height+1, commitID.Hash, env.vals, env.vals)
+}
+
+func (env *verifiedTestEnv) newFetcher(t *testing.T) *marketmap.VerifiedMarketMapFetcher {
+	t.Helper()
+
+	verifier, err := marketmap.NewLightClientVerifier(env.chain.provider, lightClientConfig(env.trusted))
+	require.NoError(t, err)
+
+	fetcher, err := marketmap.NewVerifiedMarketMapFetcherWithClients(logger, env.client, verifier)
+	require.NoError(t, err)
+
+	return fetcher
+}
+
+func newTestMarket(base string) mmtypes.Market {
+	return mmtypes.Market{
+		Ticker: mmtypes.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair(base, "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []mmtypes.ProviderConfig{
+			{
+				Name:           coinbase.Name,
+				OffChainTicker: base + "-USD",
+			},
+		},
+	}
+}
+
+func TestVerifiedFetch(t *testing.T) {
+	btc, eth, sol := newTestMarket("BTC"), newTestMarket("ETH"), newTestMarket("SOL")
+
+	t.Run("returns the verified market map", func(t *testing.T) {
+		env := newVerifiedTestEnv(t)
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, btc))
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, eth))
+		require.NoError(t, env.keeper.SetLastUpdated(env.ctx, 1))
+		env.commit(t, 1)
+
+		resp := env.newFetcher(t).Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.Resolved, 1)
+		require.Equal(t, &mmtypes.MarketMapResponse{
+			MarketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					btc.Ticker.String(): btc,
+					eth.Ticker.String(): eth,
+				},
+			},
+			LastUpdated: 1,
+			ChainId:     testChainID,
+		}, resp.Resolved[chains[0]].Value)
+	})
+
+	t.Run("only fetches the markets if the module was updated", func(t *testing.T) {
+		env := newVerifiedTestEnv(t)
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, btc))
+		require.NoError(t, env.keeper.SetLastUpdated(env.ctx, 1))
+		env.commit(t, 1)
+
+		fetcher := env.newFetcher(t)
+		resp := fetcher.Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.Resolved[chains[0]].Value.MarketMap.Markets, 1)
+
+		// the module is unchanged, so only the last updated height is queried
+		env.commit(t, 2)
+		env.client.queries = 0
+
+		resp = fetcher.Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.Resolved[chains[0]].Value.MarketMap.Markets, 1)
+		require.Equal(t, 1, env.client.queries)
+
+		// the module is updated, so the markets are queried
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, sol))
+		require.NoError(t, env.keeper.SetLastUpdated(env.ctx, 3))
+		env.commit(t, 3)
+
+		resp = fetcher.Fetch(context.TODO(), chains[:1])
+		require.Equal(t, uint64(3), resp.Resolved[chains[0]].Value.LastUpdated)
+		require.Equal(t, map[string]mmtypes.Market{
+			btc.Ticker.String(): btc,
+			sol.Ticker.String(): sol,
+		}, resp.Resolved[chains[0]].Value.MarketMap.Markets)
+	})
+
+	t.Run("fails if the store does not match the app hash of the header", func(t *testing.T) {
+		env := newVerifiedTestEnv(t)
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, btc))
+		require.NoError(t, env.keeper.SetLastUpdated(env.ctx, 1))
+		env.cms.Commit()
+
+		// the header commits to another app hash
+		env.chain.addBlock(t, 2, []byte("malicious"), env.vals, env.vals)
+
+		resp := env.newFetcher(t).Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.UnResolved, 1)
+	})
+
+	t.Run("fails if the node omits a market", func(t *testing.T) {
+		env := newVerifiedTestEnv(t)
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, btc))
+		require.NoError(t, env.keeper.CreateMarket(env.ctx, eth))
+		require.NoError(t, env.keeper.SetLastUpdated(env.ctx, 1))
+		env.commit(t, 1)
+
+		// answer the query for the first market with the proof for the second market
+		prefix := mmtypes.MarketsPrefix.Bytes()
+		env.client.tamper = func(key []byte) []byte {
+			if bytes.Equal(key, prefix) {
+				return append(append(bytes.Clone(prefix), btc.Ticker.String()...), 0)
+			}
+			return key
+		}
+
+		resp := env.newFetcher(t).Fetch(context.TODO(), chains[:1])
+		require.Len(t, resp.UnResolved, 1)
+	})
+
+	t.Run("errors when too many chains are inputted", func(t *testing.T) {
+		env := newVerifiedTestEnv(t)
+
+		resp := env.newFetcher(t).Fetch(context.TODO(), chains)
+		require.Len(t, resp.UnResolved, len(chains))
+	})
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// endpointHealthWeight is the weight of the most recent request in the health score of an endpoint.
+	endpointHealthWeight = 0.2
+
+	// MinEndpointHealth is the health score below which an endpoint is considered unhealthy. Unhealthy
+	// endpoints are only queried if no healthy endpoint is available, or once the reconnect timeout has
+	// elapsed since their last failure.
+	MinEndpointHealth = 0.5
+)
+
+// endpointHealth tracks the recent errors and latency of requests to an endpoint.
+type endpointHealth struct {
+	mtx sync.Mutex
+
+	// successRate is the exponentially weighted moving average of successful requests.
+	successRate float64
+
+	// latency is the exponentially weighted moving average of the request latency.
+	latency time.Duration
+
+	// lastFailure is the time of the last failed request.
+	lastFailure time.Time
+}
+
+// newEndpointHealth returns the health of an endpoint that has not been queried yet.
+func newEndpointHealth() *endpointHealth {
+	return &endpointHealth{
+		successRate: 1,
+	}
+}
+
+// record records the outcome and latency of a request to the endpoint.
+func (h *endpointHealth) record(success bool, latency time.Duration, now time.Time) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	outcome := 0.0
+	if success {
+		outcome = 1
+	} else {
+		h.lastFailure = now
+	}
+
+	h.successRate = (1-endpointHealthWeight)*h.successRate + endpointHealthWeight*outcome
+	h.latency = time.Duration((1-endpointHealthWeight)*float64(h.latency) + endpointHealthWeight*float64(latency))
+}
+
+// score returns the health score of the endpoint between 0 and 1. The score is the success rate of recent
+// requests, reduced by up to half as their latency approaches the request timeout.
+func (h *endpointHealth) score(timeout time.Duration) float64 {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	slowness := 1.0
+	if timeout > 0 && h.latency < timeout {
+		slowness = float64(h.latency) / float64(timeout)
+	}
+
+	return h.successRate * (1 - slowness/2)
+}
+
+// available returns true if the endpoint is healthy, or if the given reconnect timeout has elapsed since
+// its last failure.
+func (h *endpointHealth) available(timeout, reconnectTimeout time.Duration, now time.Time) bool {
+	if h.score(timeout) >= MinEndpointHealth {
+		return true
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	return now.Sub(h.lastFailure) >= reconnectTimeout
+}
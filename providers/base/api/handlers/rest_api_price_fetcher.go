@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -14,14 +16,32 @@ import (
 	providertypes "github.com/skip-mev/connect/v2/providers/types"
 )
 
+// RestAPIEndpoint pairs the RequestHandler and APIDataHandler used to query a single endpoint of a REST API.
+// The APIDataHandler is expected to create URLs for the endpoint, and the RequestHandler to apply the
+// endpoint's authentication.
+type RestAPIEndpoint[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	// RequestHandler is responsible for making outgoing HTTP requests to the endpoint.
+	RequestHandler RequestHandler
+
+	// APIDataHandler is responsible for creating URLs for the endpoint and parsing its responses.
+	APIDataHandler APIDataHandler[K, V]
+}
+
 // RestAPIFetcher handles the logic of fetching prices from a REST API. This implementation
 // depends on an APIDataHandler to handle the creation of URLs / parsing the API response.
+// If multiple endpoints are configured, the fetcher selects an endpoint per request based on
+// the configured selection strategy and the health of each endpoint, and fails over to the
+// next endpoint if a request fails. The configured timeout bounds the request across all
+// endpoints.
 type RestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
-	// requestHandler is responsible for making outgoing HTTP requests with a given URL.
-	requestHandler RequestHandler
+	// endpoints are the endpoints that the fetcher can query.
+	endpoints []RestAPIEndpoint[K, V]
+
+	// health is the health of each endpoint. This is only tracked if multiple endpoints are configured.
+	health []*endpointHealth
 
-	// apiDataHandler is responsible for creating URLs and parsing the API response.
-	apiDataHandler APIDataHandler[K, V]
+	// next is the index of the endpoint that the next request starts at when using round-robin selection.
+	next atomic.Uint64
 
 	// metrics is responsible for tracking metrics related to the API.
 	metrics metrics.APIMetrics
@@ -34,13 +54,33 @@ type RestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue]
 	logger *zap.Logger
 }
 
-// NewRestAPIFetcher creates a new RestAPIFetcher.
+// NewRestAPIFetcher creates a new RestAPIFetcher that queries a single endpoint.
 func NewRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue](
 	requestHandler RequestHandler,
 	apiDataHandler APIDataHandler[K, V],
 	metrics metrics.APIMetrics,
 	config config.APIConfig,
 	logger *zap.Logger,
+) (*RestAPIFetcher[K, V], error) {
+	return NewRestAPIFetcherWithEndpoints(
+		[]RestAPIEndpoint[K, V]{
+			{
+				RequestHandler: requestHandler,
+				APIDataHandler: apiDataHandler,
+			},
+		},
+		metrics,
+		config,
+		logger,
+	)
+}
+
+// NewRestAPIFetcherWithEndpoints creates a new RestAPIFetcher that fails over between the given endpoints.
+func NewRestAPIFetcherWithEndpoints[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	endpoints []RestAPIEndpoint[K, V],
+	metrics metrics.APIMetrics,
+	config config.APIConfig,
+	logger *zap.Logger,
 ) (*RestAPIFetcher[K, V], error) {
 	if err := config.ValidateBasic(); err != nil {
 		return nil, err
@@ -50,29 +90,47 @@ func NewRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValu
 		return nil, fmt.Errorf("api is disabled")
 	}
 
-	if requestHandler == nil {
-		return nil, fmt.Errorf("request handler is nil")
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints provided")
 	}
 
-	if apiDataHandler == nil {
-		return nil, fmt.Errorf("api data handler is nil")
+	for _, endpoint := range endpoints {
+		if endpoint.RequestHandler == nil {
+			return nil, fmt.Errorf("request handler is nil")
+		}
+
+		if endpoint.APIDataHandler == nil {
+			return nil, fmt.Errorf("api data handler is nil")
+		}
 	}
 
 	if metrics == nil {
 		return nil, fmt.Errorf("metrics is nil")
 	}
 
+	var health []*endpointHealth
+	if len(endpoints) > 1 {
+		health = make([]*endpointHealth, len(endpoints))
+		for i := range health {
+			health[i] = newEndpointHealth()
+		}
+	}
+
 	return &RestAPIFetcher[K, V]{
-		requestHandler: requestHandler,
-		apiDataHandler: apiDataHandler,
-		metrics:        metrics,
-		config:         config,
-		logger:         logger.With(zap.String("fetcher", config.Name)),
+		endpoints: endpoints,
+		health:    health,
+		metrics:   metrics,
+		config:    config,
+		logger:    logger.With(zap.String("fetcher", config.Name)),
 	}, nil
 }
 
 // Fetch is used to fetch the corresponding IDs from the API. This method blocks until the
-// response is received from the API, parsed, and returned.
+// response is received from the API, parsed, and returned. If a request to an endpoint fails,
+// the request is retried against the remaining endpoints. The first endpoint is given the full
+// timeout, and the time left after a failed attempt is split evenly across the endpoints that have
+// yet to be queried, so that failing over does not extend the request beyond the configured timeout.
+// If an endpoint timeout is configured, each attempt is additionally bounded by it.
 func (pf *RestAPIFetcher[K, V]) Fetch(
 	ctx context.Context,
 	ids []K,
@@ -83,8 +141,59 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		pf.metrics.ObserveProviderResponseLatency(pf.config.Name, metrics.RedactedURL, time.Since(start))
 	}()
 
+	ctx, cancel := context.WithTimeout(ctx, pf.config.Timeout)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	order := pf.endpointOrder()
+
+	var response providertypes.GetResponse[K, V]
+	for attempt, index := range order {
+		var retry bool
+		response, retry = pf.fetchFromEndpoint(ctx, index, ids, pf.attemptTimeout(deadline, attempt, len(order)))
+		if !retry || attempt == len(order)-1 || ctx.Err() != nil {
+			break
+		}
+
+		pf.logger.Warn(
+			"request to endpoint failed; failing over to next endpoint",
+			zap.String("endpoint", metrics.RedactedEndpointURL(index)),
+			zap.String("next_endpoint", metrics.RedactedEndpointURL(order[attempt+1])),
+		)
+		pf.metrics.AddEndpointFailover(pf.config.Name, metrics.RedactedEndpointURL(index))
+	}
+
+	return response
+}
+
+// attemptTimeout returns the timeout of the given attempt out of the given number of attempts. The first
+// attempt is given all of the time before the deadline, and each failover attempt an even share of the
+// time left for the remaining attempts. Each attempt is bounded by the configured endpoint timeout, if any.
+func (pf *RestAPIFetcher[K, V]) attemptTimeout(deadline time.Time, attempt, attempts int) time.Duration {
+	timeout := time.Until(deadline)
+	if attempt > 0 {
+		timeout /= time.Duration(attempts - attempt)
+	}
+
+	if pf.config.EndpointTimeout > 0 {
+		timeout = min(timeout, pf.config.EndpointTimeout)
+	}
+
+	return timeout
+}
+
+// fetchFromEndpoint fetches the given IDs from the endpoint at the given index within the given timeout. It
+// returns the response, and whether the request failed in a way that may succeed against another endpoint.
+func (pf *RestAPIFetcher[K, V]) fetchFromEndpoint(
+	ctx context.Context,
+	index int,
+	ids []K,
+	timeout time.Duration,
+) (_ providertypes.GetResponse[K, V], retry bool) {
+	endpoint := pf.endpoints[index]
+
 	// Create the URL for the request.
-	url, err := pf.apiDataHandler.CreateURL(ids)
+	url, err := endpoint.APIDataHandler.CreateURL(ids)
 	if err != nil {
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -92,19 +201,25 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 				errors.ErrCreateURLWithErr(err),
 				providertypes.ErrorUnableToCreateURL,
 			),
-		)
+		), false
 	}
 
 	pf.logger.Debug("created url", zap.String("url", url))
 
 	// Make the request.
-	apiCtx, cancel := context.WithTimeout(ctx, pf.config.Timeout)
+	apiCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	pf.logger.Debug("making request", zap.String("url", url))
 
+	// Record the health of the endpoint once the request completes.
+	start := time.Now()
+	defer func() {
+		pf.recordHealth(index, !retry, time.Since(start))
+	}()
+
 	// Record the status code in the metrics.
-	resp, err := pf.requestHandler.Do(apiCtx, url)
+	resp, err := endpoint.RequestHandler.Do(apiCtx, url)
 	pf.metrics.AddHTTPStatusCode(pf.config.Name, resp)
 	if err != nil {
 		status := providertypes.ErrorUnknown
@@ -124,7 +239,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 				errors.ErrDoRequestWithErr(err),
 				status,
 			),
-		)
+		), true
 	}
 	defer resp.Body.Close()
 
@@ -141,6 +256,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 				providertypes.ErrorRateLimitExceeded,
 			),
 		)
+		retry = true
 	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
 		response = providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -149,8 +265,9 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 				providertypes.ErrorCode(resp.StatusCode),
 			),
 		)
+		retry = true
 	default:
-		response = pf.apiDataHandler.ParseResponse(ids, resp)
+		response = endpoint.APIDataHandler.ParseResponse(ids, resp)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -161,5 +278,49 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		)
 	}
 
-	return response
+	return response, retry
+}
+
+// endpointOrder returns the order in which the endpoints are queried for a request. Available endpoints
+// are ordered first, starting at the primary endpoint or, with round-robin selection, at the next endpoint
+// in the rotation. Unavailable endpoints follow, ordered by their health score.
+func (pf *RestAPIFetcher[K, V]) endpointOrder() []int {
+	if len(pf.endpoints) == 1 {
+		return []int{0}
+	}
+
+	start := 0
+	if pf.config.EndpointSelection == config.EndpointSelectionRoundRobin {
+		start = int((pf.next.Add(1) - 1) % uint64(len(pf.endpoints)))
+	}
+
+	now := time.Now()
+	order := make([]int, 0, len(pf.endpoints))
+	unavailable := make([]int, 0)
+	for i := range pf.endpoints {
+		index := (start + i) % len(pf.endpoints)
+		if pf.health[index].available(pf.config.Timeout, pf.config.ReconnectTimeout, now) {
+			order = append(order, index)
+		} else {
+			unavailable = append(unavailable, index)
+		}
+	}
+
+	sort.SliceStable(unavailable, func(i, j int) bool {
+		return pf.health[unavailable[i]].score(pf.config.Timeout) > pf.health[unavailable[j]].score(pf.config.Timeout)
+	})
+
+	return append(order, unavailable...)
+}
+
+// recordHealth records the outcome of a request to the endpoint at the given index, and reports the
+// endpoint's health score. Health is only tracked if multiple endpoints are configured.
+func (pf *RestAPIFetcher[K, V]) recordHealth(index int, success bool, latency time.Duration) {
+	if pf.health == nil {
+		return
+	}
+
+	pf.health[index].record(success, latency, time.Now())
+	pf.metrics.ObserveEndpointResponseLatency(pf.config.Name, metrics.RedactedEndpointURL(index), latency)
+	pf.metrics.SetEndpointHealth(pf.config.Name, metrics.RedactedEndpointURL(index), pf.health[index].score(pf.config.Timeout))
 }
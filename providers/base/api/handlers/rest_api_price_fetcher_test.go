@@ -0,0 +1,222 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/providers/base/api/handlers"
+	"github.com/skip-mev/connect/v2/providers/base/api/handlers/mocks"
+	"github.com/skip-mev/connect/v2/providers/base/api/metrics"
+	metricmocks "github.com/skip-mev/connect/v2/providers/base/api/metrics/mocks"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+var multiEndpointCfg = config.APIConfig{
+	Enabled:          true,
+	Timeout:          500 * time.Millisecond,
+	Interval:         250 * time.Millisecond,
+	ReconnectTimeout: time.Hour,
+	MaxQueries:       1,
+	Atomic:           true,
+	Endpoints:        []config.Endpoint{{URL: "http://primary.org"}, {URL: "http://backup.org"}},
+	Name:             "handler1",
+}
+
+// newTestEndpoint returns a RestAPIEndpoint whose requests return the given status code, or an error if
+// the status code is zero.
+func newTestEndpoint(
+	t *testing.T,
+	url string,
+	statusCode int,
+) handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int] {
+	t.Helper()
+
+	requestHandler := mocks.NewRequestHandler(t)
+	if statusCode == 0 {
+		requestHandler.On("Do", mock.Anything, url).Return(nil, fmt.Errorf("connection refused")).Maybe()
+	} else {
+		requestHandler.On("Do", mock.Anything, url).Return(&http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil).Maybe()
+	}
+
+	apiDataHandler := mocks.NewAPIDataHandler[connecttypes.CurrencyPair, *big.Int](t)
+	apiDataHandler.On("CreateURL", mock.Anything).Return(url, nil).Maybe()
+	apiDataHandler.On("ParseResponse", mock.Anything, mock.Anything).Return(
+		providertypes.NewGetResponse(
+			map[connecttypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+				btcusd: {Value: big.NewInt(1)},
+			},
+			nil,
+		),
+	).Maybe()
+
+	return handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{
+		RequestHandler: requestHandler,
+		APIDataHandler: apiDataHandler,
+	}
+}
+
+func TestRestAPIFetcherFailover(t *testing.T) {
+	ids := []connecttypes.CurrencyPair{btcusd}
+
+	t.Run("fails over to the backup endpoint on errors", func(t *testing.T) {
+		for _, statusCode := range []int{0, http.StatusTooManyRequests, http.StatusInternalServerError} {
+			fetcher, err := handlers.NewRestAPIFetcherWithEndpoints(
+				[]handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{
+					newTestEndpoint(t, "http://primary.org", statusCode),
+					newTestEndpoint(t, "http://backup.org", http.StatusOK),
+				},
+				metrics.NewNopAPIMetrics(),
+				multiEndpointCfg,
+				logger,
+			)
+			require.NoError(t, err)
+
+			resp := fetcher.Fetch(context.Background(), ids)
+			require.Len(t, resp.Resolved, 1, statusCode)
+			require.Empty(t, resp.UnResolved, statusCode)
+		}
+	})
+
+	t.Run("returns the last error if all endpoints fail", func(t *testing.T) {
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints(
+			[]handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{
+				newTestEndpoint(t, "http://primary.org", http.StatusServiceUnavailable),
+				newTestEndpoint(t, "http://backup.org", http.StatusTooManyRequests),
+			},
+			metrics.NewNopAPIMetrics(),
+			multiEndpointCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		resp := fetcher.Fetch(context.Background(), ids)
+		require.Empty(t, resp.Resolved)
+		require.Equal(t, providertypes.ErrorRateLimitExceeded, resp.UnResolved[btcusd].Code())
+	})
+
+	t.Run("unhealthy primary endpoints are skipped", func(t *testing.T) {
+		primary := newTestEndpoint(t, "http://primary.org", http.StatusInternalServerError)
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints(
+			[]handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{
+				primary,
+				newTestEndpoint(t, "http://backup.org", http.StatusOK),
+			},
+			metrics.NewNopAPIMetrics(),
+			multiEndpointCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			resp := fetcher.Fetch(context.Background(), ids)
+			require.Len(t, resp.Resolved, 1)
+		}
+
+		// four consecutive failures bring the primary below the minimum health, after which it is
+		// not queried until the reconnect timeout elapses
+		primary.RequestHandler.(*mocks.RequestHandler).AssertNumberOfCalls(t, "Do", 4)
+	})
+
+	t.Run("round robin rotates across endpoints", func(t *testing.T) {
+		roundRobinCfg := multiEndpointCfg
+		roundRobinCfg.EndpointSelection = config.EndpointSelectionRoundRobin
+
+		primary := newTestEndpoint(t, "http://primary.org", http.StatusOK)
+		backup := newTestEndpoint(t, "http://backup.org", http.StatusOK)
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints(
+			[]handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{primary, backup},
+			metrics.NewNopAPIMetrics(),
+			roundRobinCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 4; i++ {
+			resp := fetcher.Fetch(context.Background(), ids)
+			require.Len(t, resp.Resolved, 1)
+		}
+
+		primary.RequestHandler.(*mocks.RequestHandler).AssertNumberOfCalls(t, "Do", 2)
+		backup.RequestHandler.(*mocks.RequestHandler).AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("a slow primary endpoint is given the full timeout", func(t *testing.T) {
+		primary := newTestEndpoint(t, "http://primary.org", http.StatusOK)
+		slow := mocks.NewRequestHandler(t)
+		slow.On("Do", mock.Anything, "http://primary.org").Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil).After(multiEndpointCfg.Timeout * 3 / 5)
+		primary.RequestHandler = slow
+
+		backup := newTestEndpoint(t, "http://backup.org", http.StatusOK)
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints(
+			[]handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{primary, backup},
+			metrics.NewNopAPIMetrics(),
+			multiEndpointCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		resp := fetcher.Fetch(context.Background(), ids)
+		require.Len(t, resp.Resolved, 1)
+
+		// the primary responded within the timeout, so the backup is not queried
+		backup.RequestHandler.(*mocks.RequestHandler).AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a hanging endpoint does not extend the request beyond the timeout", func(t *testing.T) {
+		primary := newTestEndpoint(t, "http://primary.org", http.StatusOK)
+		hanging := mocks.NewRequestHandler(t)
+		hanging.On("Do", mock.Anything, "http://primary.org").Return(nil, context.DeadlineExceeded).Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		})
+		primary.RequestHandler = hanging
+
+		// the endpoint timeout leaves half of the timeout for the backup
+		endpointTimeoutCfg := multiEndpointCfg
+		endpointTimeoutCfg.EndpointTimeout = multiEndpointCfg.Timeout / 2
+
+		apiMetrics := metricmocks.NewAPIMetrics(t)
+		apiMetrics.On("AddHTTPStatusCode", multiEndpointCfg.Name, mock.Anything)
+		apiMetrics.On("ObserveProviderResponseLatency", multiEndpointCfg.Name, metrics.RedactedURL, mock.Anything).Once()
+		apiMetrics.On("ObserveEndpointResponseLatency", multiEndpointCfg.Name, metrics.RedactedEndpointURL(0), mock.Anything).Once()
+		apiMetrics.On("ObserveEndpointResponseLatency", multiEndpointCfg.Name, metrics.RedactedEndpointURL(1), mock.Anything).Once()
+		apiMetrics.On("SetEndpointHealth", multiEndpointCfg.Name, mock.Anything, mock.Anything)
+		apiMetrics.On("AddEndpointFailover", multiEndpointCfg.Name, metrics.RedactedEndpointURL(0)).Once()
+
+		fetcher, err := handlers.NewRestAPIFetcherWithEndpoints(
+			[]handlers.RestAPIEndpoint[connecttypes.CurrencyPair, *big.Int]{
+				primary,
+				newTestEndpoint(t, "http://backup.org", http.StatusOK),
+			},
+			apiMetrics,
+			endpointTimeoutCfg,
+			logger,
+		)
+		require.NoError(t, err)
+
+		start := time.Now()
+		resp := fetcher.Fetch(context.Background(), ids)
+		require.Len(t, resp.Resolved, 1)
+
+		// the primary endpoint is given the endpoint timeout, leaving the rest for the backup
+		elapsed := time.Since(start)
+		require.GreaterOrEqual(t, elapsed, multiEndpointCfg.Timeout/2)
+		require.Less(t, elapsed, multiEndpointCfg.Timeout)
+	})
+}
@@ -36,6 +36,18 @@ type APIMetrics interface {
 	// within a single interval. Note that if the provider is not atomic, this will be the
 	// time it took for all the requests to complete.
 	ObserveProviderResponseLatency(providerName, endpoint string, duration time.Duration)
+
+	// ObserveEndpointResponseLatency records the time it took for a single request to an endpoint of a
+	// provider with multiple endpoints to complete.
+	ObserveEndpointResponseLatency(providerName, endpoint string, duration time.Duration)
+
+	// SetEndpointHealth sets the health score, between 0 and 1, of an endpoint of a provider. The score is
+	// derived from the recent errors and latency of requests to the endpoint.
+	SetEndpointHealth(providerName, endpoint string, score float64)
+
+	// AddEndpointFailover increments the number of times a request to an endpoint of a provider failed and
+	// was retried against another endpoint.
+	AddEndpointFailover(providerName, endpoint string)
 }
 
 // APIMetricsImpl contains metrics exposed by this package.
@@ -51,6 +63,15 @@ type APIMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	apiResponseTimePerProvider *prometheus.HistogramVec
+
+	// Histogram paginated by provider and endpoint, measuring the latency of single requests to an endpoint.
+	apiEndpointResponseTimePerProvider *prometheus.HistogramVec
+
+	// Health score of each endpoint of a provider.
+	apiEndpointHealthPerProvider *prometheus.GaugeVec
+
+	// Number of failovers from each endpoint of a provider.
+	apiEndpointFailoversPerProvider *prometheus.CounterVec
 }
 
 // NewAPIMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -85,6 +106,22 @@ func NewAPIMetrics() APIMetrics {
 			Help:      "Response time per API provider. URL may be redacted but will correspond to indices in the oracle config.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
+		apiEndpointResponseTimePerProvider: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_endpoint_response_latency",
+			Help:      "Response time of single requests to each API provider endpoint. URL may be redacted but will correspond to indices in the oracle config.",
+			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
+		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
+		apiEndpointHealthPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_endpoint_health",
+			Help:      "Health score (0 to 1) of each API provider endpoint, derived from recent errors and latency. URL may be redacted but will correspond to indices in the oracle config.",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
+		apiEndpointFailoversPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_endpoint_failovers",
+			Help:      "Number of failed API provider requests to an endpoint that were retried against another endpoint. URL may be redacted but will correspond to indices in the oracle config.",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
 	}
 
 	// register the above metrics
@@ -92,6 +129,9 @@ func NewAPIMetrics() APIMetrics {
 	prometheus.MustRegister(m.apiHTTPStatusCodePerProvider)
 	prometheus.MustRegister(m.apiRPCStatusCodePerProvider)
 	prometheus.MustRegister(m.apiResponseTimePerProvider)
+	prometheus.MustRegister(m.apiEndpointResponseTimePerProvider)
+	prometheus.MustRegister(m.apiEndpointHealthPerProvider)
+	prometheus.MustRegister(m.apiEndpointFailoversPerProvider)
 
 	return m
 }
@@ -107,6 +147,9 @@ func (m *noOpAPIMetricsImpl) AddProviderResponse(_ string, _ string, _ providert
 func (m *noOpAPIMetricsImpl) AddHTTPStatusCode(_ string, _ *http.Response)                      {}
 func (m *noOpAPIMetricsImpl) AddRPCStatusCode(_, _ string, _ RPCCode)                           {}
 func (m *noOpAPIMetricsImpl) ObserveProviderResponseLatency(_, _ string, _ time.Duration)       {}
+func (m *noOpAPIMetricsImpl) ObserveEndpointResponseLatency(_, _ string, _ time.Duration)       {}
+func (m *noOpAPIMetricsImpl) SetEndpointHealth(_, _ string, _ float64)                          {}
+func (m *noOpAPIMetricsImpl) AddEndpointFailover(_, _ string)                                   {}
 
 // AddProviderResponse increments the number of requests by provider and status.
 func (m *APIMetricsImpl) AddProviderResponse(providerName string, id string, err providertypes.ErrorCode) {
@@ -172,3 +215,27 @@ func (m *APIMetricsImpl) ObserveProviderResponseLatency(providerName, endpoint s
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// ObserveEndpointResponseLatency records the time it took for a single request to an endpoint of a provider.
+func (m *APIMetricsImpl) ObserveEndpointResponseLatency(providerName, endpoint string, duration time.Duration) {
+	m.apiEndpointResponseTimePerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+		EndpointLabel:                 endpoint,
+	}).Observe(float64(duration.Milliseconds()))
+}
+
+// SetEndpointHealth sets the health score of an endpoint of a provider.
+func (m *APIMetricsImpl) SetEndpointHealth(providerName, endpoint string, score float64) {
+	m.apiEndpointHealthPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+		EndpointLabel:                 endpoint,
+	}).Set(score)
+}
+
+// AddEndpointFailover increments the number of failovers from an endpoint of a provider.
+func (m *APIMetricsImpl) AddEndpointFailover(providerName, endpoint string) {
+	m.apiEndpointFailoversPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+		EndpointLabel:                 endpoint,
+	}).Add(1)
+}
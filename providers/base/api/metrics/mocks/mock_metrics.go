@@ -5,9 +5,8 @@ package mocks
 import (
 	http "net/http"
 
-	mock "github.com/stretchr/testify/mock"
-
 	metrics "github.com/skip-mev/connect/v2/providers/base/api/metrics"
+	mock "github.com/stretchr/testify/mock"
 
 	time "time"
 
@@ -27,6 +26,40 @@ func (_m *APIMetrics) EXPECT() *APIMetrics_Expecter {
 	return &APIMetrics_Expecter{mock: &_m.Mock}
 }
 
+// AddEndpointFailover provides a mock function with given fields: providerName, endpoint
+func (_m *APIMetrics) AddEndpointFailover(providerName string, endpoint string) {
+	_m.Called(providerName, endpoint)
+}
+
+// APIMetrics_AddEndpointFailover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEndpointFailover'
+type APIMetrics_AddEndpointFailover_Call struct {
+	*mock.Call
+}
+
+// AddEndpointFailover is a helper method to define mock.On call
+//   - providerName string
+//   - endpoint string
+func (_e *APIMetrics_Expecter) AddEndpointFailover(providerName interface{}, endpoint interface{}) *APIMetrics_AddEndpointFailover_Call {
+	return &APIMetrics_AddEndpointFailover_Call{Call: _e.mock.On("AddEndpointFailover", providerName, endpoint)}
+}
+
+func (_c *APIMetrics_AddEndpointFailover_Call) Run(run func(providerName string, endpoint string)) *APIMetrics_AddEndpointFailover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIMetrics_AddEndpointFailover_Call) Return() *APIMetrics_AddEndpointFailover_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *APIMetrics_AddEndpointFailover_Call) RunAndReturn(run func(string, string)) *APIMetrics_AddEndpointFailover_Call {
+	_c.Run(run)
+	return _c
+}
+
 // AddHTTPStatusCode provides a mock function with given fields: providerName, resp
 func (_m *APIMetrics) AddHTTPStatusCode(providerName string, resp *http.Response) {
 	_m.Called(providerName, resp)
@@ -131,6 +164,41 @@ func (_c *APIMetrics_AddRPCStatusCode_Call) RunAndReturn(run func(string, string
 	return _c
 }
 
+// ObserveEndpointResponseLatency provides a mock function with given fields: providerName, endpoint, duration
+func (_m *APIMetrics) ObserveEndpointResponseLatency(providerName string, endpoint string, duration time.Duration) {
+	_m.Called(providerName, endpoint, duration)
+}
+
+// APIMetrics_ObserveEndpointResponseLatency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveEndpointResponseLatency'
+type APIMetrics_ObserveEndpointResponseLatency_Call struct {
+	*mock.Call
+}
+
+// ObserveEndpointResponseLatency is a helper method to define mock.On call
+//   - providerName string
+//   - endpoint string
+//   - duration time.Duration
+func (_e *APIMetrics_Expecter) ObserveEndpointResponseLatency(providerName interface{}, endpoint interface{}, duration interface{}) *APIMetrics_ObserveEndpointResponseLatency_Call {
+	return &APIMetrics_ObserveEndpointResponseLatency_Call{Call: _e.mock.On("ObserveEndpointResponseLatency", providerName, endpoint, duration)}
+}
+
+func (_c *APIMetrics_ObserveEndpointResponseLatency_Call) Run(run func(providerName string, endpoint string, duration time.Duration)) *APIMetrics_ObserveEndpointResponseLatency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *APIMetrics_ObserveEndpointResponseLatency_Call) Return() *APIMetrics_ObserveEndpointResponseLatency_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *APIMetrics_ObserveEndpointResponseLatency_Call) RunAndReturn(run func(string, string, time.Duration)) *APIMetrics_ObserveEndpointResponseLatency_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ObserveProviderResponseLatency provides a mock function with given fields: providerName, endpoint, duration
 func (_m *APIMetrics) ObserveProviderResponseLatency(providerName string, endpoint string, duration time.Duration) {
 	_m.Called(providerName, endpoint, duration)
@@ -166,6 +234,41 @@ func (_c *APIMetrics_ObserveProviderResponseLatency_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SetEndpointHealth provides a mock function with given fields: providerName, endpoint, score
+func (_m *APIMetrics) SetEndpointHealth(providerName string, endpoint string, score float64) {
+	_m.Called(providerName, endpoint, score)
+}
+
+// APIMetrics_SetEndpointHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEndpointHealth'
+type APIMetrics_SetEndpointHealth_Call struct {
+	*mock.Call
+}
+
+// SetEndpointHealth is a helper method to define mock.On call
+//   - providerName string
+//   - endpoint string
+//   - score float64
+func (_e *APIMetrics_Expecter) SetEndpointHealth(providerName interface{}, endpoint interface{}, score interface{}) *APIMetrics_SetEndpointHealth_Call {
+	return &APIMetrics_SetEndpointHealth_Call{Call: _e.mock.On("SetEndpointHealth", providerName, endpoint, score)}
+}
+
+func (_c *APIMetrics_SetEndpointHealth_Call) Run(run func(providerName string, endpoint string, score float64)) *APIMetrics_SetEndpointHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *APIMetrics_SetEndpointHealth_Call) Return() *APIMetrics_SetEndpointHealth_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *APIMetrics_SetEndpointHealth_Call) RunAndReturn(run func(string, string, float64)) *APIMetrics_SetEndpointHealth_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewAPIMetrics creates a new instance of APIMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAPIMetrics(t interface {
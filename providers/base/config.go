@@ -37,6 +37,26 @@ func WithNewWebSocketHandler[K providertypes.ResponseKey, V providertypes.Respon
 	}
 }
 
+// WithNewAPIConfig returns an option that sets the new API configuration of the provider. The provider
+// must already be an API provider.
+func WithNewAPIConfig[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	cfg config.APIConfig,
+) UpdateOption[K, V] {
+	return func(p *Provider[K, V]) {
+		p.setAPIConfig(cfg)
+	}
+}
+
+// WithNewWebSocketConfig returns an option that sets the new WebSocket configuration of the provider. The
+// provider must already be a WebSocket provider.
+func WithNewWebSocketConfig[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	cfg config.WebSocketConfig,
+) UpdateOption[K, V] {
+	return func(p *Provider[K, V]) {
+		p.setWebSocketConfig(cfg)
+	}
+}
+
 // Update updates the provider with the given options.
 func (p *Provider[K, V]) Update(opts ...UpdateOption[K, V]) {
 	p.logger.Debug("updating provider")
@@ -86,13 +106,13 @@ func (p *Provider[K, V]) setAPIHandler(apiHandler apihandler.APIQueryHandler[K,
 
 // GetAPIHandler returns the API handler that the provider will use to fetch data.
 func (p *Provider[K, V]) GetAPIHandler() apihandler.APIQueryHandler[K, V] {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	if p.Type() != providertypes.API {
 		panic("cannot get api handler for non-api provider")
 	}
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	return p.api
 }
 
@@ -111,17 +131,54 @@ func (p *Provider[K, V]) setWebSocketHandler(wsHandler wshandlers.WebSocketQuery
 
 // GetWebSocketHandler returns the WebSocket handler that the provider will use to fetch data.
 func (p *Provider[K, V]) GetWebSocketHandler() wshandlers.WebSocketQueryHandler[K, V] {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	if p.Type() != providertypes.WebSockets {
 		panic("cannot get websocket handler for non-websocket provider")
 	}
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	return p.ws
 }
 
+// setAPIConfig sets the API configuration for the provider.
+func (p *Provider[K, V]) setAPIConfig(cfg config.APIConfig) {
+	if p.Type() != providertypes.API || !cfg.Enabled {
+		panic("cannot set api config for non-api provider")
+	}
+
+	p.mu.Lock()
+	p.apiCfg = cfg
+	p.mu.Unlock()
+
+	p.logger.Debug("set api config")
+}
+
 // GetAPIConfig returns the API configuration for the provider.
 func (p *Provider[K, V]) GetAPIConfig() config.APIConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	return p.apiCfg
 }
+
+// setWebSocketConfig sets the WebSocket configuration for the provider.
+func (p *Provider[K, V]) setWebSocketConfig(cfg config.WebSocketConfig) {
+	if p.Type() != providertypes.WebSockets || !cfg.Enabled {
+		panic("cannot set websocket config for non-websocket provider")
+	}
+
+	p.mu.Lock()
+	p.wsCfg = cfg
+	p.mu.Unlock()
+
+	p.logger.Debug("set websocket config")
+}
+
+// GetWebSocketConfig returns the WebSocket configuration for the provider.
+func (p *Provider[K, V]) GetWebSocketConfig() config.WebSocketConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.wsCfg
+}
@@ -239,4 +239,41 @@ func TestConfigUpdater(t *testing.T) {
 		provider.Stop()
 		require.Eventually(t, func() bool { return !provider.IsRunning() }, 2*time.Second, 100*time.Millisecond)
 	})
+
+	t.Run("update the api and websocket configs", func(t *testing.T) {
+		pairs := []connecttypes.CurrencyPair{btcusd}
+		apiHandler := testutils.CreateAPIQueryHandlerWithGetResponses[connecttypes.CurrencyPair, *big.Int](
+			t,
+			logger,
+			nil,
+			200*time.Millisecond,
+		)
+
+		provider, err := base.NewProvider[connecttypes.CurrencyPair, *big.Int](
+			base.WithName[connecttypes.CurrencyPair, *big.Int](apiCfg.Name),
+			base.WithAPIQueryHandler[connecttypes.CurrencyPair, *big.Int](apiHandler),
+			base.WithAPIConfig[connecttypes.CurrencyPair, *big.Int](apiCfg),
+			base.WithLogger[connecttypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[connecttypes.CurrencyPair, *big.Int](pairs),
+		)
+		require.NoError(t, err)
+
+		updatedCfg := apiCfg
+		updatedCfg.Interval = 2 * apiCfg.Interval
+		provider.Update(base.WithNewAPIConfig[connecttypes.CurrencyPair, *big.Int](updatedCfg))
+		require.Equal(t, updatedCfg, provider.GetAPIConfig())
+
+		// The config of a provider cannot be switched to a different type of handler.
+		require.Panics(t, func() {
+			provider.Update(base.WithNewWebSocketConfig[connecttypes.CurrencyPair, *big.Int](wsCfg))
+		})
+
+		// The config cannot be disabled.
+		disabledCfg := apiCfg
+		disabledCfg.Enabled = false
+		require.Panics(t, func() {
+			provider.Update(base.WithNewAPIConfig[connecttypes.CurrencyPair, *big.Int](disabledCfg))
+		})
+		require.Equal(t, updatedCfg, provider.GetAPIConfig())
+	})
 }
@@ -53,7 +53,7 @@ func (p *Provider[K, V]) startAPI(ctx context.Context) error {
 
 				// If the API query handler returns, then the connection was closed. Wait for
 				// a bit before trying to reconnect.
-				time.Sleep(p.apiCfg.ReconnectTimeout)
+				time.Sleep(p.GetAPIConfig().ReconnectTimeout)
 			}
 
 			p.logger.Debug(
@@ -74,7 +74,7 @@ func (p *Provider[K, V]) startAPI(ctx context.Context) error {
 // connections.
 func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 	var (
-		maxSubsPerConn = p.wsCfg.MaxSubscriptionsPerConnection
+		maxSubsPerConn = p.GetWebSocketConfig().MaxSubscriptionsPerConnection
 		subTasks       = make([][]K, 0)
 		wg             = errgroup.Group{}
 	)
@@ -121,7 +121,7 @@ func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K) func()
 
 					// If the websocket query handler returns, then the connection was closed. Wait for
 					// a bit before trying to reconnect.
-					time.Sleep(p.wsCfg.ReconnectionTimeout)
+					time.Sleep(p.GetWebSocketConfig().ReconnectionTimeout)
 				}
 
 				p.logger.Debug("starting websocket query handler", zap.Int("num_ids", len(subIDs)), zap.Any("ids", subIDs))
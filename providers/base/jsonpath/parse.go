@@ -0,0 +1,94 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/pkg/math"
+)
+
+// TickerMetadata is the optional metadata_JSON of a ticker, which overrides the selectors of a generic
+// JSON provider's config for the ticker.
+//
+//	{
+//	  "price_path": "data.last",
+//	  "timestamp_path": "data.ts"
+//	}
+type TickerMetadata struct {
+	// PricePath overrides the price selector of the provider for the ticker.
+	PricePath string `json:"price_path"`
+
+	// TimestampPath overrides the timestamp selector of the provider for the ticker.
+	TimestampPath string `json:"timestamp_path"`
+}
+
+// TickerMetadataFromJSONString parses the TickerMetadata of a ticker. An empty string yields empty metadata.
+func TickerMetadataFromJSONString(metadata string) (TickerMetadata, error) {
+	var md TickerMetadata
+	if len(metadata) == 0 {
+		return md, nil
+	}
+
+	if err := json.Unmarshal([]byte(metadata), &md); err != nil {
+		return md, fmt.Errorf("failed to unmarshal ticker metadata: %w", err)
+	}
+
+	return md, nil
+}
+
+// ParsePrice parses a price from a JSON number or string.
+func ParsePrice(value interface{}) (*big.Float, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("expected price to be a number or string, got %T", value)
+	}
+
+	price, err := math.Float64StringToBigFloat(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse price %q: %w", s, err)
+	}
+
+	if price.Sign() < 0 {
+		return nil, fmt.Errorf("price cannot be negative: %s", s)
+	}
+
+	return price, nil
+}
+
+// ParseTimestamp parses a timestamp from a JSON number or string in the given format.
+func ParseTimestamp(value interface{}, format string) (time.Time, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return time.Time{}, fmt.Errorf("expected timestamp to be a number or string, got %T", value)
+	}
+
+	if format == config.TimestampFormatRFC3339 {
+		return time.Parse(time.RFC3339, s)
+	}
+
+	// unix timestamps may be fractional
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse timestamp %q: %w", s, err)
+	}
+
+	if format == config.TimestampFormatUnixMilli {
+		return time.UnixMilli(int64(f)).UTC(), nil
+	}
+
+	return time.Unix(0, int64(f*float64(time.Second))).UTC(), nil
+}
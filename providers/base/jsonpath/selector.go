@@ -0,0 +1,99 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TickerPlaceholder is replaced by the off-chain ticker in selectors that are parsed for a ticker.
+const TickerPlaceholder = "{ticker}"
+
+// selectorStep is a single step of a selector, either an object key or an array index.
+type selectorStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// Selector is a parsed JSONPath-like selector, e.g. "data.tickers[0].last".
+type Selector []selectorStep
+
+// ParseSelector parses a JSONPath-like selector of dot-separated object keys and bracketed array indices.
+// A leading "$" or "$." is ignored, and an empty selector selects the root.
+func ParseSelector(path string) (Selector, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if len(path) == 0 {
+		return Selector{}, nil
+	}
+
+	var steps Selector
+	for _, part := range strings.Split(path, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+		if len(key) > 0 {
+			steps = append(steps, selectorStep{key: key})
+		} else if len(rest) == 0 {
+			return nil, fmt.Errorf("invalid selector %q: empty key", path)
+		}
+
+		// parse any array indices following the key, e.g. key[0][1]
+		for len(rest) > 0 {
+			index, remainder, ok := strings.Cut(rest, "]")
+			if !ok {
+				return nil, fmt.Errorf("invalid selector %q: unterminated index", path)
+			}
+
+			i, err := strconv.Atoi(index)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid selector %q: invalid index %q", path, index)
+			}
+			steps = append(steps, selectorStep{index: i, isIndex: true})
+
+			if len(remainder) == 0 {
+				break
+			}
+			if !strings.HasPrefix(remainder, "[") {
+				return nil, fmt.Errorf("invalid selector %q: unexpected %q", path, remainder)
+			}
+			rest = remainder[1:]
+		}
+	}
+
+	return steps, nil
+}
+
+// ParseTickerSelector parses a selector after replacing the {ticker} placeholder with the given ticker.
+func ParseTickerSelector(path, ticker string) (Selector, error) {
+	return ParseSelector(strings.ReplaceAll(path, TickerPlaceholder, ticker))
+}
+
+// Select returns the value selected from the given decoded JSON value.
+func (s Selector) Select(value interface{}) (interface{}, error) {
+	for _, step := range s {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if step.isIndex {
+				return nil, fmt.Errorf("cannot index object with [%d]", step.index)
+			}
+
+			next, ok := v[step.key]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found", step.key)
+			}
+			value = next
+		case []interface{}:
+			if !step.isIndex {
+				return nil, fmt.Errorf("cannot select key %q from array", step.key)
+			}
+
+			if step.index >= len(v) {
+				return nil, fmt.Errorf("index %d out of range", step.index)
+			}
+			value = v[step.index]
+		default:
+			return nil, fmt.Errorf("cannot select from %T", value)
+		}
+	}
+
+	return value, nil
+}
@@ -231,6 +231,9 @@ func (p *Provider[K, V]) GetData() map[K]providertypes.ResolvedResult[V] {
 
 // Type returns the type of data handler the provider uses.
 func (p *Provider[K, V]) Type() providertypes.ProviderType {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	switch {
 	case p.apiCfg.Enabled:
 		return providertypes.API
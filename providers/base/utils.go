@@ -16,7 +16,7 @@ func (p *Provider[K, V]) createResponseCh() error {
 		p.responseCh = make(chan providertypes.GetResponse[K, V], len(p.GetIDs()))
 	case p.Type() == providertypes.WebSockets:
 		// Otherwise, the buffer size is set to the max buffer size configured for the websocket.
-		p.responseCh = make(chan providertypes.GetResponse[K, V], p.wsCfg.MaxBufferSize)
+		p.responseCh = make(chan providertypes.GetResponse[K, V], p.GetWebSocketConfig().MaxBufferSize)
 	default:
 		return fmt.Errorf("no api or websocket configured")
 	}
@@ -3,10 +3,12 @@ package oracle
 import (
 	"context"
 	"fmt"
-	"go.uber.org/zap"
+	"math/big"
 	"net/http"
 	"strings"
 
+	"go.uber.org/zap"
+
 	"github.com/skip-mev/connect/v2/oracle/config"
 	"github.com/skip-mev/connect/v2/oracle/types"
 	"github.com/skip-mev/connect/v2/providers/apis/binance"
@@ -21,6 +23,7 @@ import (
 	"github.com/skip-mev/connect/v2/providers/apis/defi/raydium"
 	"github.com/skip-mev/connect/v2/providers/apis/defi/uniswapv3"
 	"github.com/skip-mev/connect/v2/providers/apis/geckoterminal"
+	"github.com/skip-mev/connect/v2/providers/apis/genericjson"
 	"github.com/skip-mev/connect/v2/providers/apis/kraken"
 	"github.com/skip-mev/connect/v2/providers/apis/polymarket"
 	apihandlers "github.com/skip-mev/connect/v2/providers/base/api/handlers"
@@ -56,38 +59,31 @@ func APIQueryHandlerFactory(
 	}
 
 	var (
-		apiPriceFetcher types.PriceAPIFetcher
-		apiDataHandler  types.PriceAPIDataHandler
-		headers         = make(map[string]string)
+		apiPriceFetcher   types.PriceAPIFetcher
+		apiDataHandler    types.PriceAPIDataHandler
+		newAPIDataHandler func(config.APIConfig) (types.PriceAPIDataHandler, error)
+		requestHandler    apihandlers.RequestHandler
 	)
 
-	// If the provider has an API key, add it to the headers.
-	if len(cfg.API.Endpoints) == 1 && cfg.API.Endpoints[0].Authentication.Enabled() {
-		headers[cfg.API.Endpoints[0].Authentication.APIKeyHeader] = cfg.API.Endpoints[0].Authentication.APIKey
-	}
-
-	requestHandler, err := apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
-	if err != nil {
-		return nil, err
-	}
-
 	switch providerName := cfg.Name; {
 	case providerName == binance.Name:
-		apiDataHandler, err = binance.NewAPIHandler(cfg.API)
+		newAPIDataHandler = binance.NewAPIHandler
 	case providerName == bitstamp.Name:
-		apiDataHandler, err = bitstamp.NewAPIHandler(cfg.API)
+		newAPIDataHandler = bitstamp.NewAPIHandler
 	case providerName == coinbaseapi.Name:
-		apiDataHandler, err = coinbaseapi.NewAPIHandler(cfg.API)
+		newAPIDataHandler = coinbaseapi.NewAPIHandler
 	case providerName == coingecko.Name:
-		apiDataHandler, err = coingecko.NewAPIHandler(cfg.API)
+		newAPIDataHandler = coingecko.NewAPIHandler
 	case providerName == coinmarketcap.Name:
-		apiDataHandler, err = coinmarketcap.NewAPIHandler(cfg.API)
+		newAPIDataHandler = coinmarketcap.NewAPIHandler
 	case providerName == geckoterminal.Name:
-		apiDataHandler, err = geckoterminal.NewAPIHandler(cfg.API)
+		newAPIDataHandler = geckoterminal.NewAPIHandler
 	case providerName == kraken.Name:
-		apiDataHandler, err = kraken.NewAPIHandler(cfg.API)
+		newAPIDataHandler = kraken.NewAPIHandler
 	case strings.HasPrefix(providerName, uniswapv3.BaseName):
 		apiPriceFetcher, err = uniswapv3.NewPriceFetcher(ctx, logger, metrics, cfg.API)
+	case strings.HasPrefix(providerName, genericjson.Name):
+		newAPIDataHandler = genericjson.NewAPIHandler
 	case providerName == static.Name:
 		apiDataHandler = static.NewAPIHandler()
 		requestHandler = static.NewStaticMockClient()
@@ -99,11 +95,11 @@ func APIQueryHandlerFactory(
 	case providerName == osmosis.Name:
 		apiPriceFetcher, err = osmosis.NewAPIPriceFetcher(logger, cfg.API, metrics)
 	case providerName == polymarket.Name:
-		apiDataHandler, err = polymarket.NewAPIHandler(cfg.API)
+		newAPIDataHandler = polymarket.NewAPIHandler
 	case providerName == curve.Name:
-		apiDataHandler, err = curve.NewAPIHandler(cfg.API)
+		newAPIDataHandler = curve.NewAPIHandler
 	case providerName == bitget.Name:
-		apiDataHandler, err = bitget.NewAPIHandler(cfg.API)
+		newAPIDataHandler = bitget.NewAPIHandler
 	case providerName == initia.Name:
 		apiPriceFetcher, err = initia.NewAPIPriceFetcher(logger, cfg.API, metrics)
 	default:
@@ -113,8 +109,25 @@ func APIQueryHandlerFactory(
 		return nil, err
 	}
 
-	// if no apiPriceFetcher has been created yet, create a default REST API price fetcher.
-	if apiPriceFetcher == nil {
+	switch {
+	case newAPIDataHandler != nil:
+		// create a REST API price fetcher that fails over between the configured endpoints.
+		endpoints, err := restAPIEndpoints(client, cfg.API, newAPIDataHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		apiPriceFetcher, err = apihandlers.NewRestAPIFetcherWithEndpoints(
+			endpoints,
+			metrics,
+			cfg.API,
+			logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+	case apiPriceFetcher == nil:
+		// create a default REST API price fetcher from the mock request handler.
 		apiPriceFetcher, err = apihandlers.NewRestAPIFetcher(
 			requestHandler,
 			apiDataHandler,
@@ -135,3 +148,43 @@ func APIQueryHandlerFactory(
 		metrics,
 	)
 }
+
+// restAPIEndpoints creates a RestAPIEndpoint for each endpoint in the given config. The data handler of each
+// endpoint is created from a copy of the config with the endpoint moved to the front, since data handlers
+// create URLs from the first configured endpoint. The request handler of each endpoint applies the
+// endpoint's authentication.
+func restAPIEndpoints(
+	client *http.Client,
+	cfg config.APIConfig,
+	newAPIDataHandler func(config.APIConfig) (types.PriceAPIDataHandler, error),
+) ([]apihandlers.RestAPIEndpoint[types.ProviderTicker, *big.Float], error) {
+	endpoints := make([]apihandlers.RestAPIEndpoint[types.ProviderTicker, *big.Float], len(cfg.Endpoints))
+	for i, endpoint := range cfg.Endpoints {
+		endpointCfg := cfg
+		endpointCfg.Endpoints = append([]config.Endpoint{endpoint}, cfg.Endpoints[:i]...)
+		endpointCfg.Endpoints = append(endpointCfg.Endpoints, cfg.Endpoints[i+1:]...)
+
+		apiDataHandler, err := newAPIDataHandler(endpointCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the endpoint has an API key, add it to the headers.
+		headers := make(map[string]string)
+		if endpoint.Authentication.Enabled() {
+			headers[endpoint.Authentication.APIKeyHeader] = endpoint.Authentication.APIKey
+		}
+
+		requestHandler, err := apihandlers.NewRequestHandlerImpl(client, apihandlers.WithHTTPHeaders(headers))
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints[i] = apihandlers.RestAPIEndpoint[types.ProviderTicker, *big.Float]{
+			RequestHandler: requestHandler,
+			APIDataHandler: apiDataHandler,
+		}
+	}
+
+	return endpoints, nil
+}
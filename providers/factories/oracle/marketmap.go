@@ -69,6 +69,19 @@ func MarketMapProviderFactory(
 			logger,
 		)
 		ids = []types.Chain{{ChainID: dydx.ChainID}}
+	case marketmap.LastUpdatedName:
+		marketMapFetcher, err = marketmap.NewLastUpdatedMarketMapFetcher(
+			logger,
+			cfg.API,
+			apiMetrics,
+		)
+		ids = []types.Chain{{ChainID: "local-node"}}
+	case marketmap.VerifiedName:
+		marketMapFetcher, err = marketmap.NewVerifiedMarketMapFetcher(
+			logger,
+			cfg.API,
+		)
+		ids = []types.Chain{{ChainID: "local-node"}}
 	default:
 		marketMapFetcher, err = marketmap.NewMarketMapFetcher(
 			logger,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -20,6 +21,7 @@ import (
 	coinbasews "github.com/skip-mev/connect/v2/providers/websockets/coinbase"
 	"github.com/skip-mev/connect/v2/providers/websockets/cryptodotcom"
 	"github.com/skip-mev/connect/v2/providers/websockets/gate"
+	"github.com/skip-mev/connect/v2/providers/websockets/genericws"
 	"github.com/skip-mev/connect/v2/providers/websockets/huobi"
 	"github.com/skip-mev/connect/v2/providers/websockets/kraken"
 	"github.com/skip-mev/connect/v2/providers/websockets/kucoin"
@@ -104,7 +106,12 @@ func WebSocketQueryHandlerFactory(
 	case bitget.Name:
 		wsDataHandler, err = bitget.NewWebSocketDataHandler(logger, cfg.WebSocket)
 	default:
-		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
+		// Generic websocket providers may be suffixed, e.g. generic_ws_venue.
+		if !strings.HasPrefix(cfg.Name, genericws.Name) {
+			return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
+		}
+
+		wsDataHandler, err = genericws.NewWebSocketDataHandler(logger, cfg.WebSocket)
 	}
 	if err != nil {
 		return nil, err
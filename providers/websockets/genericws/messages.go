@@ -0,0 +1,80 @@
+package genericws
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	connectmath "github.com/skip-mev/connect/v2/pkg/math"
+	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+)
+
+// NewSubscribeRequestMessages returns the subscribe messages for the given off-chain tickers, rendered
+// from the configured subscribe message template.
+func (h *WebSocketHandler) NewSubscribeRequestMessages(
+	instruments []string,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	numInstruments := len(instruments)
+	if numInstruments == 0 {
+		return nil, fmt.Errorf("instruments cannot be empty")
+	}
+
+	template := h.cfg.SubscribeMessage
+
+	// a message is sent per ticker
+	if strings.Contains(template, TickerPlaceholder) {
+		msgs := make([]handlers.WebsocketEncodedMessage, numInstruments)
+		for i, instrument := range instruments {
+			msgs[i] = []byte(strings.ReplaceAll(template, TickerPlaceholder, instrument))
+		}
+
+		return msgs, nil
+	}
+
+	// a message is sent per batch of tickers
+	numBatches := int(math.Ceil(float64(numInstruments) / float64(h.ws.MaxSubscriptionsPerBatch)))
+	msgs := make([]handlers.WebsocketEncodedMessage, numBatches)
+	for i := 0; i < numBatches; i++ {
+		start := i * h.ws.MaxSubscriptionsPerBatch
+		end := connectmath.Min((i+1)*h.ws.MaxSubscriptionsPerBatch, numInstruments)
+
+		quoted := make([]string, 0, end-start)
+		for _, instrument := range instruments[start:end] {
+			bz, err := json.Marshal(instrument)
+			if err != nil {
+				return nil, err
+			}
+			quoted = append(quoted, string(bz))
+		}
+
+		msgs[i] = []byte(strings.ReplaceAll(template, TickersPlaceholder, strings.Join(quoted, ",")))
+	}
+
+	return msgs, nil
+}
+
+// validateSubscribeMessage checks that the subscribe message template contains exactly one of the
+// ticker placeholders, and renders valid JSON.
+func validateSubscribeMessage(template string) error {
+	hasTicker := strings.Contains(template, TickerPlaceholder)
+	hasTickers := strings.Contains(template, TickersPlaceholder)
+
+	var rendered string
+	switch {
+	case hasTicker && hasTickers:
+		return fmt.Errorf("subscribe message cannot contain both %s and %s", TickerPlaceholder, TickersPlaceholder)
+	case hasTicker:
+		rendered = strings.ReplaceAll(template, TickerPlaceholder, "BTC-USD")
+	case hasTickers:
+		rendered = strings.ReplaceAll(template, TickersPlaceholder, `"BTC-USD","ETH-USD"`)
+	default:
+		return fmt.Errorf("subscribe message must contain %s or %s", TickerPlaceholder, TickersPlaceholder)
+	}
+
+	if !json.Valid([]byte(rendered)) {
+		return fmt.Errorf("subscribe message does not render valid json: %s", rendered)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package genericws
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base/jsonpath"
+	providertypes "github.com/skip-mev/connect/v2/providers/types"
+)
+
+// parsePriceMessage parses the prices of a price update. If a ticker path is configured, the ticker of
+// each result is selected and its price parsed. Otherwise, the price of every subscribed ticker is
+// selected from the results, and tickers without a price in the message are skipped.
+func (h *WebSocketHandler) parsePriceMessage(msg interface{}) (types.PriceResponse, error) {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+		now        = time.Now().UTC()
+	)
+
+	results, err := h.results.Select(msg)
+	if err != nil {
+		return types.NewPriceResponse(resolved, unresolved), fmt.Errorf("failed to select results: %w", err)
+	}
+
+	if len(h.tickerPath) == 0 {
+		for _, ticker := range h.tickers {
+			price, err := h.parseResult(ticker, results, now)
+			if err != nil {
+				continue
+			}
+			resolved[ticker] = price
+		}
+
+		return types.NewPriceResponse(resolved, unresolved), nil
+	}
+
+	candidates, ok := results.([]interface{})
+	if !ok {
+		candidates = []interface{}{results}
+	}
+
+	for _, result := range candidates {
+		value, err := h.tickerPath.Select(result)
+		if err != nil {
+			return types.NewPriceResponse(resolved, unresolved), fmt.Errorf("failed to select ticker: %w", err)
+		}
+
+		offChainTicker, ok := value.(string)
+		if !ok {
+			return types.NewPriceResponse(resolved, unresolved), fmt.Errorf("expected ticker to be a string, got %T", value)
+		}
+
+		ticker, ok := h.cache.FromOffChainTicker(offChainTicker)
+		if !ok {
+			return types.NewPriceResponse(resolved, unresolved), fmt.Errorf("got response for an unsupported market %s", offChainTicker)
+		}
+
+		price, err := h.parseResult(ticker, result, now)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			}
+			continue
+		}
+		resolved[ticker] = price
+	}
+
+	return types.NewPriceResponse(resolved, unresolved), nil
+}
+
+// parseResult parses the price and timestamp of the given ticker from its result.
+func (h *WebSocketHandler) parseResult(
+	ticker types.ProviderTicker,
+	result interface{},
+	now time.Time,
+) (providertypes.ResolvedResult[*big.Float], error) {
+	md, err := jsonpath.TickerMetadataFromJSONString(ticker.GetJSON())
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	pricePath, timestampPath := h.cfg.PricePath, h.cfg.TimestampPath
+	if len(md.PricePath) > 0 {
+		pricePath = md.PricePath
+	}
+	if len(md.TimestampPath) > 0 {
+		timestampPath = md.TimestampPath
+	}
+
+	priceSelector, err := jsonpath.ParseTickerSelector(pricePath, ticker.GetOffChainTicker())
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	value, err := priceSelector.Select(result)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("failed to select price: %w", err)
+	}
+
+	price, err := jsonpath.ParsePrice(value)
+	if err != nil {
+		return providertypes.ResolvedResult[*big.Float]{}, err
+	}
+
+	timestamp := now
+	if len(timestampPath) > 0 {
+		timestampSelector, err := jsonpath.ParseTickerSelector(timestampPath, ticker.GetOffChainTicker())
+		if err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, err
+		}
+
+		value, err := timestampSelector.Select(result)
+		if err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, fmt.Errorf("failed to select timestamp: %w", err)
+		}
+
+		if timestamp, err = jsonpath.ParseTimestamp(value, h.cfg.TimestampFormat); err != nil {
+			return providertypes.ResolvedResult[*big.Float]{}, err
+		}
+	}
+
+	return types.NewPriceResult(price, timestamp), nil
+}
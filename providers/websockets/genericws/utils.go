@@ -0,0 +1,15 @@
+package genericws
+
+const (
+	// Name is the name of the generic WebSocket provider. Multiple generic WebSocket providers can be
+	// configured by suffixing the name, e.g. generic_ws_venue.
+	Name = "generic_ws"
+
+	// TickerPlaceholder is replaced by the off-chain ticker in subscribe messages that subscribe to a single
+	// ticker, and in selectors.
+	TickerPlaceholder = "{ticker}"
+
+	// TickersPlaceholder is replaced by the comma-separated JSON strings of the off-chain tickers in
+	// subscribe messages that subscribe to a batch of tickers.
+	TickersPlaceholder = "{tickers}"
+)
@@ -0,0 +1,191 @@
+package genericws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base/jsonpath"
+	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+)
+
+var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
+
+// WebSocketHandler implements the WebSocketDataHandler interface. The messages sent to the data
+// provider and the parsing of the messages received are configured entirely through the provider's
+// config, which allows any WebSocket API that sends JSON to be supported without a dedicated handler.
+type WebSocketHandler struct {
+	logger *zap.Logger
+
+	// ws is the config for the websocket.
+	ws config.WebSocketConfig
+	// cfg is the message config of the websocket.
+	cfg config.GenericWebSocketConfig
+	// typePath selects the type of each message, if set.
+	typePath jsonpath.Selector
+	// results selects the results in each price update.
+	results jsonpath.Selector
+	// tickerPath selects the ticker within each result, if set.
+	tickerPath jsonpath.Selector
+	// priceTypes are the message types that carry price updates.
+	priceTypes map[string]struct{}
+	// cache maintains the latest set of tickers seen by the handler.
+	cache types.ProviderTickers
+	// tickers are the tickers subscribed to by the handler.
+	tickers []types.ProviderTicker
+}
+
+// NewWebSocketDataHandler returns a new generic PriceWebSocketDataHandler.
+func NewWebSocketDataHandler(
+	logger *zap.Logger,
+	ws config.WebSocketConfig,
+) (types.PriceWebSocketDataHandler, error) {
+	if !strings.HasPrefix(ws.Name, Name) {
+		return nil, fmt.Errorf("expected websocket config name with prefix %s, got %s", Name, ws.Name)
+	}
+
+	if !ws.Enabled {
+		return nil, fmt.Errorf("websocket config for %s is not enabled", ws.Name)
+	}
+
+	if err := ws.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid websocket config for %s: %w", ws.Name, err)
+	}
+
+	if ws.GenericWebSocket == nil {
+		return nil, fmt.Errorf("generic websocket config for %s cannot be empty", ws.Name)
+	}
+
+	cfg := *ws.GenericWebSocket
+	if err := validateSubscribeMessage(cfg.SubscribeMessage); err != nil {
+		return nil, fmt.Errorf("invalid generic websocket config for %s: %w", ws.Name, err)
+	}
+
+	// validate the selectors; selectors containing the ticker placeholder are re-parsed per ticker.
+	typePath, err := jsonpath.ParseSelector(cfg.TypePath)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := jsonpath.ParseSelector(cfg.ResultsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerPath, err := jsonpath.ParseSelector(cfg.TickerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range []string{cfg.PricePath, cfg.TimestampPath} {
+		if _, err := jsonpath.ParseTickerSelector(path, "ticker"); err != nil {
+			return nil, err
+		}
+	}
+
+	priceTypes := make(map[string]struct{}, len(cfg.PriceTypes))
+	for _, t := range cfg.PriceTypes {
+		priceTypes[t] = struct{}{}
+	}
+
+	return &WebSocketHandler{
+		logger:     logger,
+		ws:         ws,
+		cfg:        cfg,
+		typePath:   typePath,
+		results:    results,
+		tickerPath: tickerPath,
+		priceTypes: priceTypes,
+		cache:      types.NewProviderTickers(),
+	}, nil
+}
+
+// HandleMessage is used to handle a message received from the data provider. If a type path is
+// configured, the type of the message determines how it is handled:
+//
+//  1. Ping messages are answered with the configured pong message.
+//  2. Price messages are parsed into a price response.
+//  3. All other messages, e.g. subscription acknowledgements, are ignored.
+//
+// Otherwise, every message is parsed as a price update.
+func (h *WebSocketHandler) HandleMessage(
+	message []byte,
+) (types.PriceResponse, []handlers.WebsocketEncodedMessage, error) {
+	var (
+		resp types.PriceResponse
+		msg  interface{}
+	)
+
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	if err := decoder.Decode(&msg); err != nil {
+		return resp, nil, fmt.Errorf("failed to unmarshal message %w", err)
+	}
+
+	if len(h.typePath) > 0 {
+		value, err := h.typePath.Select(msg)
+		if err != nil {
+			h.logger.Debug("ignoring message without a type", zap.ByteString("message", message))
+			return resp, nil, nil
+		}
+
+		msgType := fmt.Sprint(value)
+		switch _, isPrice := h.priceTypes[msgType]; {
+		case len(h.cfg.PingType) > 0 && msgType == h.cfg.PingType:
+			h.logger.Debug("received ping message")
+			return resp, []handlers.WebsocketEncodedMessage{[]byte(h.cfg.PongMessage)}, nil
+		case !isPrice:
+			h.logger.Debug("ignoring message", zap.String("type", msgType))
+			return resp, nil, nil
+		}
+	}
+
+	resp, err := h.parsePriceMessage(msg)
+	return resp, nil, err
+}
+
+// CreateMessages is used to create the subscribe messages sent to the data provider for the given
+// tickers. This is called when the connection to the data provider is first established, and again on
+// every reconnect, so the subscribed tickers are replaced rather than extended.
+func (h *WebSocketHandler) CreateMessages(
+	tickers []types.ProviderTicker,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	instruments := make([]string, 0)
+
+	h.tickers = make([]types.ProviderTicker, 0, len(tickers))
+	for _, ticker := range tickers {
+		instruments = append(instruments, ticker.GetOffChainTicker())
+		h.cache.Add(ticker)
+		h.tickers = append(h.tickers, ticker)
+	}
+
+	return h.NewSubscribeRequestMessages(instruments)
+}
+
+// HeartBeatMessages returns the configured heartbeat message, if any.
+func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
+	if len(h.cfg.HeartbeatMessage) == 0 {
+		return nil, nil
+	}
+
+	return []handlers.WebsocketEncodedMessage{[]byte(h.cfg.HeartbeatMessage)}, nil
+}
+
+// Copy is used to create a copy of the WebSocketHandler.
+func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
+	return &WebSocketHandler{
+		logger:     h.logger,
+		ws:         h.ws,
+		cfg:        h.cfg,
+		typePath:   h.typePath,
+		results:    h.results,
+		tickerPath: h.tickerPath,
+		priceTypes: h.priceTypes,
+		cache:      types.NewProviderTickers(),
+	}
+}
@@ -0,0 +1,360 @@
+package genericws_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/connect/v2/oracle/config"
+	"github.com/skip-mev/connect/v2/oracle/types"
+	"github.com/skip-mev/connect/v2/providers/base/websocket/handlers"
+	"github.com/skip-mev/connect/v2/providers/websockets/genericws"
+)
+
+var (
+	btcusdt = types.DefaultProviderTicker{
+		OffChainTicker: "BTC-USDT",
+	}
+	ethusdt = types.DefaultProviderTicker{
+		OffChainTicker: "ETH-USDT",
+	}
+	mogusdt = types.DefaultProviderTicker{
+		OffChainTicker: "MOG-USDT",
+	}
+	logger = zap.NewExample()
+
+	// cfg is a config for a venue that sends ticker updates of the form
+	// {"channel": "ticker", "data": [{"s": "BTC-USDT", "p": "1", "t": 1700000000000}]}.
+	cfg = config.GenericWebSocketConfig{
+		SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+		HeartbeatMessage: `{"op": "ping"}`,
+		TypePath:         "channel",
+		PriceTypes:       []string{"ticker"},
+		PingType:         "ping",
+		PongMessage:      `{"op": "pong"}`,
+		ResultsPath:      "data",
+		TickerPath:       "s",
+		PricePath:        "p",
+		TimestampPath:    "t",
+		TimestampFormat:  config.TimestampFormatUnixMilli,
+	}
+)
+
+func wsConfig(cfg config.GenericWebSocketConfig) config.WebSocketConfig {
+	return config.WebSocketConfig{
+		Name:                     genericws.Name,
+		Enabled:                  true,
+		MaxBufferSize:            config.DefaultMaxBufferSize,
+		ReconnectionTimeout:      config.DefaultReconnectionTimeout,
+		Endpoints:                []config.Endpoint{{URL: "wss://ws.example.com"}},
+		HandshakeTimeout:         config.DefaultHandshakeTimeout,
+		ReadTimeout:              config.DefaultReadTimeout,
+		WriteTimeout:             config.DefaultWriteTimeout,
+		WriteInterval:            config.DefaultWriteInterval,
+		MaxReadErrorCount:        config.DefaultMaxReadErrorCount,
+		MaxSubscriptionsPerBatch: 2,
+		GenericWebSocket:         &cfg,
+	}
+}
+
+func TestNewWebSocketDataHandler(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ws     func() config.WebSocketConfig
+		expErr bool
+	}{
+		{
+			name: "valid",
+			ws:   func() config.WebSocketConfig { return wsConfig(cfg) },
+		},
+		{
+			name: "valid with suffixed name",
+			ws: func() config.WebSocketConfig {
+				ws := wsConfig(cfg)
+				ws.Name = genericws.Name + "_venue"
+				return ws
+			},
+		},
+		{
+			name: "missing generic websocket config",
+			ws: func() config.WebSocketConfig {
+				ws := wsConfig(cfg)
+				ws.GenericWebSocket = nil
+				return ws
+			},
+			expErr: true,
+		},
+		{
+			name: "subscribe message without placeholder",
+			ws: func() config.WebSocketConfig {
+				c := cfg
+				c.SubscribeMessage = `{"op": "subscribe"}`
+				return wsConfig(c)
+			},
+			expErr: true,
+		},
+		{
+			name: "subscribe message that does not render json",
+			ws: func() config.WebSocketConfig {
+				c := cfg
+				c.SubscribeMessage = `{"op": "subscribe", "args": ["{tickers}"]}`
+				return wsConfig(c)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid selector",
+			ws: func() config.WebSocketConfig {
+				c := cfg
+				c.PricePath = "p[x]"
+				return wsConfig(c)
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := genericws.NewWebSocketDataHandler(logger, tc.ws())
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandleMessage(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cfg           config.GenericWebSocketConfig
+		msg           string
+		resp          types.PriceResponse
+		updateMessage []handlers.WebsocketEncodedMessage
+		timestamp     time.Time
+		expErr        bool
+	}{
+		{
+			name:   "invalid message",
+			cfg:    cfg,
+			msg:    "invalid message",
+			resp:   types.NewPriceResponse(nil, nil),
+			expErr: true,
+		},
+		{
+			name: "ignores messages of other types",
+			cfg:  cfg,
+			msg:  `{"channel": "subscribed", "args": ["BTC-USDT"]}`,
+			resp: types.NewPriceResponse(nil, nil),
+		},
+		{
+			name: "ignores messages without a type",
+			cfg:  cfg,
+			msg:  `{"result": "ok"}`,
+			resp: types.NewPriceResponse(nil, nil),
+		},
+		{
+			name:          "responds to pings",
+			cfg:           cfg,
+			msg:           `{"channel": "ping"}`,
+			resp:          types.NewPriceResponse(nil, nil),
+			updateMessage: []handlers.WebsocketEncodedMessage{[]byte(`{"op": "pong"}`)},
+		},
+		{
+			name: "price update",
+			cfg:  cfg,
+			msg:  `{"channel": "ticker", "data": [{"s": "BTC-USDT", "p": "65000.5", "t": 1700000000000}, {"s": "ETH-USDT", "p": 3500, "t": 1700000000000}]}`,
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {Value: big.NewFloat(65000.5)},
+					ethusdt: {Value: big.NewFloat(3500)},
+				},
+				types.UnResolvedPrices{},
+			),
+			timestamp: time.UnixMilli(1700000000000).UTC(),
+		},
+		{
+			name: "price update with a single result",
+			cfg: func() config.GenericWebSocketConfig {
+				c := cfg
+				c.TimestampPath = ""
+				return c
+			}(),
+			msg: `{"channel": "ticker", "data": {"s": "BTC-USDT", "p": 65000.5}}`,
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {Value: big.NewFloat(65000.5)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "price update with a price that cannot be parsed",
+			cfg:  cfg,
+			msg:  `{"channel": "ticker", "data": [{"s": "BTC-USDT", "p": "$65000.5", "t": 1700000000000}]}`,
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusdt: {},
+				},
+			),
+		},
+		{
+			name:   "price update for an unsupported ticker",
+			cfg:    cfg,
+			msg:    `{"channel": "ticker", "data": [{"s": "SOL-USDT", "p": "150", "t": 1700000000000}]}`,
+			resp:   types.NewPriceResponse(nil, nil),
+			expErr: true,
+		},
+		{
+			name: "price update keyed by ticker",
+			cfg: config.GenericWebSocketConfig{
+				SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+				PricePath:        "prices.{ticker}",
+			},
+			msg: `{"prices": {"BTC-USDT": "65000.5"}}`,
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {Value: big.NewFloat(65000.5)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := genericws.NewWebSocketDataHandler(logger, wsConfig(tc.cfg))
+			require.NoError(t, err)
+
+			// Update the cache since it is assumed that CreateMessages is executed before anything else.
+			_, err = handler.CreateMessages([]types.ProviderTicker{btcusdt, ethusdt, mogusdt})
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp, updateMsg, err := handler.HandleMessage([]byte(tc.msg))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.updateMessage, updateMsg)
+
+			require.Equal(t, len(tc.resp.Resolved), len(resp.Resolved))
+			require.Equal(t, len(tc.resp.UnResolved), len(resp.UnResolved))
+
+			for cp, result := range tc.resp.Resolved {
+				require.Contains(t, resp.Resolved, cp)
+				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if tc.timestamp.IsZero() {
+					require.True(t, resp.Resolved[cp].Timestamp.After(now))
+				} else {
+					require.True(t, tc.timestamp.Equal(resp.Resolved[cp].Timestamp))
+				}
+			}
+
+			for cp := range tc.resp.UnResolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+			}
+		})
+	}
+}
+
+func TestCreateMessages(t *testing.T) {
+	testCases := []struct {
+		name             string
+		subscribeMessage string
+		cps              []types.ProviderTicker
+		expected         []string
+		expErr           bool
+	}{
+		{
+			name:             "no tickers",
+			subscribeMessage: cfg.SubscribeMessage,
+			cps:              []types.ProviderTicker{},
+			expErr:           true,
+		},
+		{
+			name:             "batched tickers",
+			subscribeMessage: cfg.SubscribeMessage,
+			cps:              []types.ProviderTicker{btcusdt, ethusdt, mogusdt},
+			expected: []string{
+				`{"op": "subscribe", "args": ["BTC-USDT","ETH-USDT"]}`,
+				`{"op": "subscribe", "args": ["MOG-USDT"]}`,
+			},
+		},
+		{
+			name:             "single ticker per message",
+			subscribeMessage: `{"op": "subscribe", "channel": "ticker.{ticker}"}`,
+			cps:              []types.ProviderTicker{btcusdt, ethusdt},
+			expected: []string{
+				`{"op": "subscribe", "channel": "ticker.BTC-USDT"}`,
+				`{"op": "subscribe", "channel": "ticker.ETH-USDT"}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := cfg
+			c.SubscribeMessage = tc.subscribeMessage
+
+			handler, err := genericws.NewWebSocketDataHandler(logger, wsConfig(c))
+			require.NoError(t, err)
+
+			msgs, err := handler.CreateMessages(tc.cps)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, msgs, len(tc.expected))
+			for i, msg := range msgs {
+				require.Equal(t, tc.expected[i], string(msg))
+			}
+		})
+	}
+}
+
+func TestCreateMessagesReplacesSubscribedTickers(t *testing.T) {
+	handler, err := genericws.NewWebSocketDataHandler(logger, wsConfig(config.GenericWebSocketConfig{
+		SubscribeMessage: `{"op": "subscribe", "args": [{tickers}]}`,
+		PricePath:        "prices.{ticker}",
+	}))
+	require.NoError(t, err)
+
+	// messages are created again on every reconnect
+	_, err = handler.CreateMessages([]types.ProviderTicker{btcusdt})
+	require.NoError(t, err)
+	_, err = handler.CreateMessages([]types.ProviderTicker{ethusdt})
+	require.NoError(t, err)
+
+	resp, _, err := handler.HandleMessage([]byte(`{"prices": {"BTC-USDT": "65000.5", "ETH-USDT": "3500"}}`))
+	require.NoError(t, err)
+	require.Len(t, resp.Resolved, 1)
+	require.Contains(t, resp.Resolved, ethusdt)
+}
+
+func TestHeartBeatMessages(t *testing.T) {
+	handler, err := genericws.NewWebSocketDataHandler(logger, wsConfig(cfg))
+	require.NoError(t, err)
+
+	msgs, err := handler.HeartBeatMessages()
+	require.NoError(t, err)
+	require.Equal(t, []handlers.WebsocketEncodedMessage{[]byte(`{"op": "ping"}`)}, msgs)
+
+	c := cfg
+	c.HeartbeatMessage = ""
+	handler, err = genericws.NewWebSocketDataHandler(logger, wsConfig(c))
+	require.NoError(t, err)
+
+	msgs, err = handler.HeartBeatMessages()
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+}
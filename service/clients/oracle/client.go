@@ -220,6 +220,51 @@ func (c *GRPCClient) Prices(
 	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
 }
 
+// PriceDetails returns the provenance of the latest prices from the remote oracle service. This method blocks for the
+// timeout duration configured on the client, otherwise it returns the response from the remote oracle.
+func (c *GRPCClient) PriceDetails(
+	ctx context.Context,
+	req *types.QueryPriceDetailsRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryPriceDetailsResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.PriceDetails(ctx, req, grpc.WaitForReady(true))
+}
+
+// StreamPrices opens a price stream against the remote oracle service. Unlike the unary methods, the stream is
+// not bound by the client's timeout; it lives until the given context is cancelled or the stream errors.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.StreamPrices(ctx, req, grpc.WaitForReady(true))
+}
+
 func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapRequest, _ ...grpc.CallOption) (res *types.QueryMarketMapResponse, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
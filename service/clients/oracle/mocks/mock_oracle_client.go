@@ -99,6 +99,80 @@ func (_c *OracleClient_MarketMap_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// PriceDetails provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) PriceDetails(ctx context.Context, in *types.QueryPriceDetailsRequest, opts ...grpc.CallOption) (*types.QueryPriceDetailsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceDetails")
+	}
+
+	var r0 *types.QueryPriceDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) (*types.QueryPriceDetailsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) *types.QueryPriceDetailsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_PriceDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceDetails'
+type OracleClient_PriceDetails_Call struct {
+	*mock.Call
+}
+
+// PriceDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryPriceDetailsRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) PriceDetails(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_PriceDetails_Call {
+	return &OracleClient_PriceDetails_Call{Call: _e.mock.On("PriceDetails",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_PriceDetails_Call) Run(run func(ctx context.Context, in *types.QueryPriceDetailsRequest, opts ...grpc.CallOption)) *OracleClient_PriceDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryPriceDetailsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_PriceDetails_Call) Return(_a0 *types.QueryPriceDetailsResponse, _a1 error) *OracleClient_PriceDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_PriceDetails_Call) RunAndReturn(run func(context.Context, *types.QueryPriceDetailsRequest, ...grpc.CallOption) (*types.QueryPriceDetailsResponse, error)) *OracleClient_PriceDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Prices provides a mock function with given fields: ctx, in, opts
 func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest, opts ...grpc.CallOption) (*types.QueryPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -264,6 +338,80 @@ func (_c *OracleClient_Stop_Call) RunAndReturn(run func() error) *OracleClient_S
 	return _c
 }
 
+// StreamPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) StreamPrices(ctx context.Context, in *types.StreamPricesRequest, opts ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 types.Oracle_StreamPricesClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) types.Oracle_StreamPricesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Oracle_StreamPricesClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleClient_StreamPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPrices'
+type OracleClient_StreamPrices_Call struct {
+	*mock.Call
+}
+
+// StreamPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.StreamPricesRequest
+//   - opts ...grpc.CallOption
+func (_e *OracleClient_Expecter) StreamPrices(ctx interface{}, in interface{}, opts ...interface{}) *OracleClient_StreamPrices_Call {
+	return &OracleClient_StreamPrices_Call{Call: _e.mock.On("StreamPrices",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *OracleClient_StreamPrices_Call) Run(run func(ctx context.Context, in *types.StreamPricesRequest, opts ...grpc.CallOption)) *OracleClient_StreamPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.StreamPricesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OracleClient_StreamPrices_Call) Return(_a0 types.Oracle_StreamPricesClient, _a1 error) *OracleClient_StreamPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleClient_StreamPrices_Call) RunAndReturn(run func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)) *OracleClient_StreamPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function with given fields: ctx, in, opts
 func (_m *OracleClient) Version(ctx context.Context, in *types.QueryVersionRequest, opts ...grpc.CallOption) (*types.QueryVersionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,159 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+const (
+	// DefaultStreamBackoff is the default initial delay before re-establishing a failed price stream.
+	DefaultStreamBackoff = 250 * time.Millisecond
+	// DefaultStreamMaxBackoff is the default maximum delay before re-establishing a failed price stream.
+	DefaultStreamMaxBackoff = 10 * time.Second
+)
+
+// PriceStream maintains a price stream against a remote oracle service. If the stream fails, it is
+// re-established with an exponential backoff and resumed from the last delivered response, i.e. responses
+// that are not newer than the last delivered response are dropped. Consumers therefore observe a
+// monotonically advancing sequence of prices across reconnects.
+type PriceStream struct {
+	logger log.Logger
+
+	// client is the oracle client used to open the stream.
+	client types.OracleClient
+	// req is the request used to (re-)open the stream.
+	req *types.StreamPricesRequest
+	// backoff is the initial delay before re-establishing a failed stream.
+	backoff time.Duration
+	// maxBackoff is the maximum delay before re-establishing a failed stream.
+	maxBackoff time.Duration
+}
+
+// NewPriceStream returns a new price stream that uses the given client and request. The backoff
+// determines the initial delay before re-establishing a failed stream, and is doubled on each
+// consecutive failure up to maxBackoff.
+func NewPriceStream(
+	logger log.Logger,
+	client types.OracleClient,
+	req *types.StreamPricesRequest,
+	backoff time.Duration,
+	maxBackoff time.Duration,
+) (*PriceStream, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("oracle client cannot be nil")
+	}
+
+	if req == nil {
+		req = &types.StreamPricesRequest{}
+	}
+
+	if backoff <= 0 {
+		return nil, fmt.Errorf("backoff must be positive")
+	}
+
+	if maxBackoff < backoff {
+		return nil, fmt.Errorf("max backoff must be greater than or equal to backoff")
+	}
+
+	return &PriceStream{
+		logger:     logger.With("process", "price_stream"),
+		client:     client,
+		req:        req,
+		backoff:    backoff,
+		maxBackoff: maxBackoff,
+	}, nil
+}
+
+// Subscribe starts the price stream and returns a channel that receives each price response. The channel
+// is closed once the given context is cancelled.
+func (s *PriceStream) Subscribe(ctx context.Context) <-chan *types.QueryPricesResponse {
+	out := make(chan *types.QueryPricesResponse)
+
+	go func() {
+		defer close(out)
+
+		var (
+			last    time.Time
+			backoff = s.backoff
+		)
+
+		for {
+			received, err := s.stream(ctx, out, &last)
+			if ctx.Err() != nil {
+				s.logger.Info("price stream stopped")
+				return
+			}
+
+			// reset the backoff if the stream made progress before failing
+			if received {
+				backoff = s.backoff
+			}
+
+			s.logger.Error("price stream failed; reconnecting", "err", err, "backoff", backoff.String())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > s.maxBackoff {
+				backoff = s.maxBackoff
+			}
+		}
+	}()
+
+	return out
+}
+
+// stream opens a single price stream and forwards responses to out until the stream fails or
+// the context is cancelled. It returns whether any response was received on the stream.
+func (s *PriceStream) stream(
+	ctx context.Context,
+	out chan<- *types.QueryPricesResponse,
+	last *time.Time,
+) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := s.client.StreamPrices(ctx, s.req)
+	if err != nil {
+		return false, err
+	}
+
+	if stream == nil {
+		return false, fmt.Errorf("oracle client returned a nil stream")
+	}
+
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		// skip responses that were already delivered before a reconnect
+		if !resp.Timestamp.After(*last) {
+			s.logger.Debug("skipping stale price response", "timestamp", resp.Timestamp.String())
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return received, ctx.Err()
+		case out <- resp:
+			*last = resp.Timestamp
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/connect/v2/service/clients/oracle"
+	"github.com/skip-mev/connect/v2/service/clients/oracle/mocks"
+	"github.com/skip-mev/connect/v2/service/servers/oracle/types"
+)
+
+// fakePriceStream is a price stream that replays a fixed set of responses, followed by an error.
+type fakePriceStream struct {
+	grpc.ClientStream
+
+	resps []*types.QueryPricesResponse
+	err   error
+}
+
+func (s *fakePriceStream) Recv() (*types.QueryPricesResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, s.err
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
+
+func TestNewPriceStream(t *testing.T) {
+	testCases := []struct {
+		name       string
+		logger     log.Logger
+		client     types.OracleClient
+		backoff    time.Duration
+		maxBackoff time.Duration
+		err        bool
+	}{
+		{
+			name:       "valid",
+			logger:     log.NewNopLogger(),
+			client:     &oracle.NoOpClient{},
+			backoff:    oracle.DefaultStreamBackoff,
+			maxBackoff: oracle.DefaultStreamMaxBackoff,
+		},
+		{
+			name:       "nil logger",
+			client:     &oracle.NoOpClient{},
+			backoff:    oracle.DefaultStreamBackoff,
+			maxBackoff: oracle.DefaultStreamMaxBackoff,
+			err:        true,
+		},
+		{
+			name:       "nil client",
+			logger:     log.NewNopLogger(),
+			backoff:    oracle.DefaultStreamBackoff,
+			maxBackoff: oracle.DefaultStreamMaxBackoff,
+			err:        true,
+		},
+		{
+			name:       "non-positive backoff",
+			logger:     log.NewNopLogger(),
+			client:     &oracle.NoOpClient{},
+			maxBackoff: oracle.DefaultStreamMaxBackoff,
+			err:        true,
+		},
+		{
+			name:       "max backoff less than backoff",
+			logger:     log.NewNopLogger(),
+			client:     &oracle.NoOpClient{},
+			backoff:    time.Second,
+			maxBackoff: time.Millisecond,
+			err:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := oracle.NewPriceStream(tc.logger, tc.client, nil, tc.backoff, tc.maxBackoff)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceStreamResumes(t *testing.T) {
+	client := mocks.NewOracleClient(t)
+	req := &types.StreamPricesRequest{Tickers: []string{"BTC/USD"}}
+
+	t0 := time.Now().UTC()
+	t1 := t0.Add(time.Second)
+	t2 := t1.Add(time.Second)
+
+	resp := func(ts time.Time, price string) *types.QueryPricesResponse {
+		return &types.QueryPricesResponse{
+			Prices:    map[string]string{"BTC/USD": price},
+			Timestamp: ts,
+		}
+	}
+
+	// the first stream fails after two responses
+	client.On("StreamPrices", mock.Anything, req).Return(&fakePriceStream{
+		resps: []*types.QueryPricesResponse{resp(t0, "1"), resp(t1, "2")},
+		err:   io.EOF,
+	}, nil).Once()
+	// the first reconnect attempt fails
+	client.On("StreamPrices", mock.Anything, req).Return(nil, fmt.Errorf("unavailable")).Once()
+	// the second reconnect replays the last delivered response before a new one
+	client.On("StreamPrices", mock.Anything, req).Return(&fakePriceStream{
+		resps: []*types.QueryPricesResponse{resp(t1, "2"), resp(t2, "3")},
+		err:   io.EOF,
+	}, nil).Once()
+	client.On("StreamPrices", mock.Anything, req).Return(nil, fmt.Errorf("unavailable")).Maybe()
+
+	stream, err := oracle.NewPriceStream(log.NewNopLogger(), client, req, time.Millisecond, 5*time.Millisecond)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates := stream.Subscribe(ctx)
+
+	var prices []string
+	for len(prices) < 3 {
+		select {
+		case resp := <-updates:
+			prices = append(prices, resp.Prices["BTC/USD"])
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for price stream")
+		}
+	}
+	require.Equal(t, []string{"1", "2", "3"}, prices)
+
+	// the channel is closed once the context is cancelled
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-updates
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
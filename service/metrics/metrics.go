@@ -37,6 +37,14 @@ type Metrics interface {
 	// AddValidatorReportForTicker updates a counter per validator + status. This counter represents the number of times a validator
 	// for a ticker with a price, w/o a price, or w/ an absent.
 	AddValidatorReportForTicker(validator string, ticker connecttypes.CurrencyPair, status ReportStatus)
+
+	// ObserveValidatorPriceDeviation updates a gauge per validator with the deviation, in basis points, of the price they reported for
+	// a given ticker from the on-chain price, this is updated when vote extensions are verified
+	ObserveValidatorPriceDeviation(validator string, ticker connecttypes.CurrencyPair, deviationBps float64)
+
+	// AddValidatorDeviatingPrice updates a counter per validator + ticker. This counter represents the number of times a validator
+	// reported a price outside the allowed band around the on-chain price.
+	AddValidatorDeviatingPrice(validator string, ticker connecttypes.CurrencyPair)
 }
 
 type nopMetricsImpl struct{}
@@ -58,6 +66,12 @@ func (m *nopMetricsImpl) AddValidatorReportForTicker(_ string, _ connecttypes.Cu
 func (m *nopMetricsImpl) AddValidatorPriceForTicker(_ string, _ connecttypes.CurrencyPair, _ float64) {
 }
 
+func (m *nopMetricsImpl) ObserveValidatorPriceDeviation(_ string, _ connecttypes.CurrencyPair, _ float64) {
+}
+
+func (m *nopMetricsImpl) AddValidatorDeviatingPrice(_ string, _ connecttypes.CurrencyPair) {
+}
+
 func NewMetrics(chainID string) Metrics {
 	m := &metricsImpl{
 		oracleResponseLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
@@ -103,6 +117,16 @@ func NewMetrics(chainID string) Metrics {
 			Name:      "report_status_per_validator",
 			Help:      "The status of the report for a specific validator and ticker",
 		}, []string{ChainIDLabel, ValidatorLabel, TickerLabel, StatusLabel}),
+		priceDeviationPerValidator: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: AppNamespace,
+			Name:      "price_deviation_per_validator",
+			Help:      "The deviation (in basis points) of the price reported by a specific validator for a ticker from the on-chain price",
+		}, []string{ChainIDLabel, ValidatorLabel, TickerLabel}),
+		deviatingPricesPerValidator: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: AppNamespace,
+			Name:      "deviating_prices_per_validator",
+			Help:      "The number of prices reported by a specific validator for a ticker outside the allowed band around the on-chain price",
+		}, []string{ChainIDLabel, ValidatorLabel, TickerLabel}),
 	}
 
 	// register the above metrics
@@ -114,6 +138,8 @@ func NewMetrics(chainID string) Metrics {
 	prometheus.MustRegister(m.prices)
 	prometheus.MustRegister(m.reportsPerValidator)
 	prometheus.MustRegister(m.reportStatusPerValidator)
+	prometheus.MustRegister(m.priceDeviationPerValidator)
+	prometheus.MustRegister(m.deviatingPricesPerValidator)
 
 	m.chainID = chainID
 
@@ -121,15 +147,17 @@ func NewMetrics(chainID string) Metrics {
 }
 
 type metricsImpl struct {
-	oracleResponseLatency    *prometheus.HistogramVec
-	oracleResponseCounter    *prometheus.GaugeVec
-	reportsPerValidator      *prometheus.GaugeVec
-	reportStatusPerValidator *prometheus.GaugeVec
-	abciMethodLatency        *prometheus.HistogramVec
-	abciRequests             *prometheus.GaugeVec
-	messageSize              *prometheus.HistogramVec
-	prices                   *prometheus.GaugeVec
-	chainID                  string
+	oracleResponseLatency       *prometheus.HistogramVec
+	oracleResponseCounter       *prometheus.GaugeVec
+	reportsPerValidator         *prometheus.GaugeVec
+	reportStatusPerValidator    *prometheus.GaugeVec
+	priceDeviationPerValidator  *prometheus.GaugeVec
+	deviatingPricesPerValidator *prometheus.GaugeVec
+	abciMethodLatency           *prometheus.HistogramVec
+	abciRequests                *prometheus.GaugeVec
+	messageSize                 *prometheus.HistogramVec
+	prices                      *prometheus.GaugeVec
+	chainID                     string
 }
 
 func (m *metricsImpl) ObserveABCIMethodLatency(method ABCIMethod, duration time.Duration) {
@@ -191,6 +219,22 @@ func (m *metricsImpl) AddValidatorReportForTicker(validator string, ticker conne
 	}).Inc()
 }
 
+func (m *metricsImpl) ObserveValidatorPriceDeviation(validator string, ticker connecttypes.CurrencyPair, deviationBps float64) {
+	m.priceDeviationPerValidator.With(prometheus.Labels{
+		ChainIDLabel:   m.chainID,
+		ValidatorLabel: validator,
+		TickerLabel:    strings.ToLower(ticker.String()),
+	}).Set(deviationBps)
+}
+
+func (m *metricsImpl) AddValidatorDeviatingPrice(validator string, ticker connecttypes.CurrencyPair) {
+	m.deviatingPricesPerValidator.With(prometheus.Labels{
+		ChainIDLabel:   m.chainID,
+		ValidatorLabel: validator,
+		TickerLabel:    strings.ToLower(ticker.String()),
+	}).Inc()
+}
+
 // NewMetricsFromConfig returns a new Metrics implementation based on the config. The Metrics
 // returned is safe to be used in the client, and in the Oracle used by the PreBlocker.
 // If the metrics are not enabled, a nop implementation is returned.
@@ -3,9 +3,8 @@
 package mocks
 
 import (
-	mock "github.com/stretchr/testify/mock"
-
 	metrics "github.com/skip-mev/connect/v2/service/metrics"
+	mock "github.com/stretchr/testify/mock"
 
 	time "time"
 
@@ -92,6 +91,40 @@ func (_c *Metrics_AddOracleResponse_Call) RunAndReturn(run func(metrics.Labeller
 	return _c
 }
 
+// AddValidatorDeviatingPrice provides a mock function with given fields: validator, ticker
+func (_m *Metrics) AddValidatorDeviatingPrice(validator string, ticker types.CurrencyPair) {
+	_m.Called(validator, ticker)
+}
+
+// Metrics_AddValidatorDeviatingPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddValidatorDeviatingPrice'
+type Metrics_AddValidatorDeviatingPrice_Call struct {
+	*mock.Call
+}
+
+// AddValidatorDeviatingPrice is a helper method to define mock.On call
+//   - validator string
+//   - ticker types.CurrencyPair
+func (_e *Metrics_Expecter) AddValidatorDeviatingPrice(validator interface{}, ticker interface{}) *Metrics_AddValidatorDeviatingPrice_Call {
+	return &Metrics_AddValidatorDeviatingPrice_Call{Call: _e.mock.On("AddValidatorDeviatingPrice", validator, ticker)}
+}
+
+func (_c *Metrics_AddValidatorDeviatingPrice_Call) Run(run func(validator string, ticker types.CurrencyPair)) *Metrics_AddValidatorDeviatingPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(types.CurrencyPair))
+	})
+	return _c
+}
+
+func (_c *Metrics_AddValidatorDeviatingPrice_Call) Return() *Metrics_AddValidatorDeviatingPrice_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_AddValidatorDeviatingPrice_Call) RunAndReturn(run func(string, types.CurrencyPair)) *Metrics_AddValidatorDeviatingPrice_Call {
+	_c.Run(run)
+	return _c
+}
+
 // AddValidatorPriceForTicker provides a mock function with given fields: validator, ticker, price
 func (_m *Metrics) AddValidatorPriceForTicker(validator string, ticker types.CurrencyPair, price float64) {
 	_m.Called(validator, ticker, price)
@@ -297,6 +330,41 @@ func (_c *Metrics_ObservePriceForTicker_Call) RunAndReturn(run func(types.Curren
 	return _c
 }
 
+// ObserveValidatorPriceDeviation provides a mock function with given fields: validator, ticker, deviationBps
+func (_m *Metrics) ObserveValidatorPriceDeviation(validator string, ticker types.CurrencyPair, deviationBps float64) {
+	_m.Called(validator, ticker, deviationBps)
+}
+
+// Metrics_ObserveValidatorPriceDeviation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveValidatorPriceDeviation'
+type Metrics_ObserveValidatorPriceDeviation_Call struct {
+	*mock.Call
+}
+
+// ObserveValidatorPriceDeviation is a helper method to define mock.On call
+//   - validator string
+//   - ticker types.CurrencyPair
+//   - deviationBps float64
+func (_e *Metrics_Expecter) ObserveValidatorPriceDeviation(validator interface{}, ticker interface{}, deviationBps interface{}) *Metrics_ObserveValidatorPriceDeviation_Call {
+	return &Metrics_ObserveValidatorPriceDeviation_Call{Call: _e.mock.On("ObserveValidatorPriceDeviation", validator, ticker, deviationBps)}
+}
+
+func (_c *Metrics_ObserveValidatorPriceDeviation_Call) Run(run func(validator string, ticker types.CurrencyPair, deviationBps float64)) *Metrics_ObserveValidatorPriceDeviation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(types.CurrencyPair), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Metrics_ObserveValidatorPriceDeviation_Call) Return() *Metrics_ObserveValidatorPriceDeviation_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_ObserveValidatorPriceDeviation_Call) RunAndReturn(run func(string, types.CurrencyPair, float64)) *Metrics_ObserveValidatorPriceDeviation_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMetrics(t interface {
@@ -1,7 +1,10 @@
 package oracle
 
 import (
+	"math/big"
+
 	"github.com/skip-mev/connect/v2/oracle/types"
+	stypes "github.com/skip-mev/connect/v2/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +17,121 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+// ToReqPricesFromDetails returns the scaled price of each ticker in the oracle's price details. Tickers
+// without a price, i.e. whose price could not be aggregated, are omitted.
+func ToReqPricesFromDetails(details types.PriceDetails) map[string]string {
+	reqPrices := make(map[string]string, len(details))
+
+	for ticker, detail := range details {
+		if detail.ScaledPrice == nil {
+			continue
+		}
+
+		intPrice, _ := detail.ScaledPrice.Int(nil)
+		reqPrices[ticker] = intPrice.String()
+	}
+
+	return reqPrices
+}
+
+// ToReqDispersions returns the scaled dispersion of each ticker in the oracle's price details. Tickers
+// without a dispersion, i.e. whose price could not be aggregated, are omitted.
+func ToReqDispersions(details types.PriceDetails) map[string]string {
+	reqDispersions := make(map[string]string, len(details))
+
+	for ticker, detail := range details {
+		if detail.ScaledDispersion == nil {
+			continue
+		}
+
+		intDispersion, _ := detail.ScaledDispersion.Int(nil)
+		reqDispersions[ticker] = intDispersion.String()
+	}
+
+	return reqDispersions
+}
+
+// FilterReqPrices returns the subset of prices whose tickers are included in the given set of tickers. If no
+// tickers are given, all prices are returned.
+func FilterReqPrices(prices map[string]string, tickers []string) map[string]string {
+	if len(tickers) == 0 {
+		return prices
+	}
+
+	filtered := make(map[string]string, len(tickers))
+	for _, ticker := range tickers {
+		if price, ok := prices[ticker]; ok {
+			filtered[ticker] = price
+		}
+	}
+
+	return filtered
+}
+
+// ToReqPriceDetails converts the oracle's price details to their response representation. If tickers are
+// given, only the details of those tickers are returned.
+func ToReqPriceDetails(details types.PriceDetails, tickers []string) map[string]stypes.TickerPriceDetails {
+	if len(tickers) > 0 {
+		filtered := make(types.PriceDetails, len(tickers))
+		for _, ticker := range tickers {
+			if detail, ok := details[ticker]; ok {
+				filtered[ticker] = detail
+			}
+		}
+		details = filtered
+	}
+
+	reqDetails := make(map[string]stypes.TickerPriceDetails, len(details))
+	for ticker, detail := range details {
+		reqDetail := stypes.TickerPriceDetails{
+			Median:           floatString(detail.Price),
+			Decimals:         detail.Decimals,
+			MinProviderCount: detail.MinProviderCount,
+			Providers:        make([]stypes.ProviderPriceDetails, 0, len(detail.Providers)),
+		}
+
+		if detail.ScaledPrice != nil {
+			intPrice, _ := detail.ScaledPrice.Int(nil)
+			reqDetail.Price = intPrice.String()
+		}
+
+		if detail.ScaledDispersion != nil {
+			intDispersion, _ := detail.ScaledDispersion.Int(nil)
+			reqDetail.Dispersion = intDispersion.String()
+		}
+
+		for _, provider := range detail.Providers {
+			reqProvider := stypes.ProviderPriceDetails{
+				Name:             provider.Provider,
+				OffChainTicker:   provider.OffChainTicker,
+				RawPrice:         floatString(provider.Price),
+				Invert:           provider.Invert,
+				NormalizeByPrice: floatString(provider.NormalizeByPrice),
+				ConvertedPrice:   floatString(provider.ConvertedPrice),
+				Timestamp:        provider.Timestamp,
+				Stale:            provider.Stale,
+				Error:            provider.Error,
+			}
+
+			if provider.NormalizeByPair != nil {
+				reqProvider.NormalizeByPair = provider.NormalizeByPair.String()
+			}
+
+			reqDetail.Providers = append(reqDetail.Providers, reqProvider)
+		}
+
+		reqDetails[ticker] = reqDetail
+	}
+
+	return reqDetails
+}
+
+// floatString returns the decimal representation of the given float, or an empty string if it is nil.
+func floatString(f *big.Float) string {
+	if f == nil {
+		return ""
+	}
+
+	return f.Text('f', -1)
+}
@@ -82,6 +82,65 @@ func (_c *OracleService_MarketMap_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// PriceDetails provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) PriceDetails(_a0 context.Context, _a1 *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceDetails")
+	}
+
+	var r0 *types.QueryPriceDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest) *types.QueryPriceDetailsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceDetailsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleService_PriceDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceDetails'
+type OracleService_PriceDetails_Call struct {
+	*mock.Call
+}
+
+// PriceDetails is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *types.QueryPriceDetailsRequest
+func (_e *OracleService_Expecter) PriceDetails(_a0 interface{}, _a1 interface{}) *OracleService_PriceDetails_Call {
+	return &OracleService_PriceDetails_Call{Call: _e.mock.On("PriceDetails", _a0, _a1)}
+}
+
+func (_c *OracleService_PriceDetails_Call) Run(run func(_a0 context.Context, _a1 *types.QueryPriceDetailsRequest)) *OracleService_PriceDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.QueryPriceDetailsRequest))
+	})
+	return _c
+}
+
+func (_c *OracleService_PriceDetails_Call) Return(_a0 *types.QueryPriceDetailsResponse, _a1 error) *OracleService_PriceDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleService_PriceDetails_Call) RunAndReturn(run func(context.Context, *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error)) *OracleService_PriceDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Prices provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Prices(_a0 context.Context, _a1 *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -233,6 +292,53 @@ func (_c *OracleService_Stop_Call) RunAndReturn(run func(context.Context) error)
 	return _c
 }
 
+// StreamPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) StreamPrices(_a0 *types.StreamPricesRequest, _a1 types.Oracle_StreamPricesServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StreamPricesRequest, types.Oracle_StreamPricesServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleService_StreamPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPrices'
+type OracleService_StreamPrices_Call struct {
+	*mock.Call
+}
+
+// StreamPrices is a helper method to define mock.On call
+//   - _a0 *types.StreamPricesRequest
+//   - _a1 types.Oracle_StreamPricesServer
+func (_e *OracleService_Expecter) StreamPrices(_a0 interface{}, _a1 interface{}) *OracleService_StreamPrices_Call {
+	return &OracleService_StreamPrices_Call{Call: _e.mock.On("StreamPrices", _a0, _a1)}
+}
+
+func (_c *OracleService_StreamPrices_Call) Run(run func(_a0 *types.StreamPricesRequest, _a1 types.Oracle_StreamPricesServer)) *OracleService_StreamPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*types.StreamPricesRequest), args[1].(types.Oracle_StreamPricesServer))
+	})
+	return _c
+}
+
+func (_c *OracleService_StreamPrices_Call) Return(_a0 error) *OracleService_StreamPrices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleService_StreamPrices_Call) RunAndReturn(run func(*types.StreamPricesRequest, types.Oracle_StreamPricesServer) error) *OracleService_StreamPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Version(_a0 context.Context, _a1 *types.QueryVersionRequest) (*types.QueryVersionResponse, error) {
 	ret := _m.Called(_a0, _a1)
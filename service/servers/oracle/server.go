@@ -181,14 +181,55 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 
 	// run the request in a goroutine, to unblock server + ctx cancellation
 	go func() {
-		// get the prices
-		prices := os.o.GetPrices()
+		// get the prices and their dispersions
+		prices, dispersions := os.pricesSnapshot()
 
 		// get the latest timestamp of the latest update from the oracle
 		timestamp := os.o.GetLastSyncTime()
 
 		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
+			Prices:      prices,
+			Timestamp:   timestamp,
+			Version:     build.Build,
+			Dispersions: dispersions,
+		}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		return nil, context.Canceled
+	case resp := <-resCh:
+		return resp, nil
+	}
+}
+
+// PriceDetails returns the provenance of the oracle's latest prices. It defers to the ctx in the request, and errors
+// if the context is cancelled for any reason.
+func (os *OracleServer) PriceDetails(ctx context.Context, req *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for price details", zap.Strings("tickers", req.Tickers))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	resCh := make(chan *types.QueryPriceDetailsResponse)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		details := os.o.GetPriceDetails()
+		timestamp := os.o.GetLastSyncTime()
+
+		resCh <- &types.QueryPriceDetailsResponse{
+			Details:   ToReqPriceDetails(details, req.Tickers),
 			Timestamp: timestamp,
 			Version:   build.Build,
 		}
@@ -204,6 +245,78 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// StreamPrices streams the oracle's prices to the client. The latest prices are sent immediately (if the oracle
+// has synced at least once), and then again each time the oracle completes a price aggregation round. The stream
+// is closed when the client disconnects, or when the server is closed.
+func (os *OracleServer) StreamPrices(req *types.StreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Debug("received request to stream prices", zap.Strings("tickers", req.Tickers))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	ctx := stream.Context()
+	updates := os.o.SubscribePrices(ctx)
+
+	// send the latest prices so that the client does not have to wait for the next round
+	if timestamp := os.o.GetLastSyncTime(); !timestamp.IsZero() {
+		if err := stream.Send(os.streamResponse(req, timestamp)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Debug("price stream closed by client")
+			return ctx.Err()
+		case <-os.Done():
+			os.logger.Debug("price stream closed by server")
+			return ErrServerClosed
+		case timestamp, ok := <-updates:
+			if !ok {
+				return ctx.Err()
+			}
+
+			if err := stream.Send(os.streamResponse(req, timestamp)); err != nil {
+				os.logger.Debug("failed to send prices on stream", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// streamResponse builds a prices response for the given stream request.
+func (os *OracleServer) streamResponse(req *types.StreamPricesRequest, timestamp time.Time) *types.QueryPricesResponse {
+	prices, dispersions := os.pricesSnapshot()
+
+	return &types.QueryPricesResponse{
+		Prices:      FilterReqPrices(prices, req.Tickers),
+		Timestamp:   timestamp,
+		Version:     build.Build,
+		Dispersions: FilterReqPrices(dispersions, req.Tickers),
+	}
+}
+
+// pricesSnapshot returns the oracle's latest prices and their dispersions. Both are derived from a single read
+// of the oracle's price details, so that the dispersions always belong to the returned prices. If the oracle's
+// aggregator does not retain price details, the prices are read directly and no dispersions are returned.
+func (os *OracleServer) pricesSnapshot() (map[string]string, map[string]string) {
+	details := os.o.GetPriceDetails()
+	if len(details) == 0 {
+		return ToReqPrices(os.o.GetPrices()), make(map[string]string)
+	}
+
+	return ToReqPricesFromDetails(details), ToReqDispersions(details)
+}
+
 // MarketMap returns the current market map from the Oracle.
 func (os *OracleServer) MarketMap(_ context.Context, _ *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
 	mm := os.o.GetMarketMap()
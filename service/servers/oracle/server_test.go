@@ -128,6 +128,7 @@ func (s *ServerTestSuite) TestOracleServerTimeout() {
 	// set the mock oracle to delay GetPrices response (delay for absurd time)
 	s.mockOracle.EXPECT().IsRunning().Return(true)
 	s.mockOracle.On("GetPrices").Return(nil).After(delay)
+	s.mockOracle.On("GetPriceDetails").Return(nil).Maybe()
 
 	// call from client
 	_, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
@@ -155,9 +156,9 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 		Decimals: 8,
 	}
 
-	s.mockOracle.On("GetPrices").Return(types.Prices{
-		cp1.String(): big.NewFloat(100.1),
-		cp2.String(): big.NewFloat(200.1),
+	s.mockOracle.On("GetPriceDetails").Return(types.PriceDetails{
+		cp1.String(): {ScaledPrice: big.NewFloat(100.1), ScaledDispersion: big.NewFloat(5.5)},
+		cp2.String(): {ScaledPrice: big.NewFloat(200.1)},
 	})
 	ts := time.Now()
 	s.mockOracle.On("GetLastSyncTime").Return(ts)
@@ -169,6 +170,7 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	// check response
 	s.Require().Equal(resp.Prices[cp1.String()], big.NewInt(100).String())
 	s.Require().Equal(resp.Prices[cp2.String()], big.NewInt(200).String())
+	s.Require().Equal(map[string]string{cp1.String(): "5"}, resp.Dispersions)
 	// check timestamp
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
@@ -184,6 +186,142 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerPricesWithoutDetails() {
+	// an aggregator that does not retain price details returns no details
+	s.mockOracle.EXPECT().IsRunning().Return(true)
+	cp1 := connecttypes.NewCurrencyPair("BTC", "USD")
+
+	s.mockOracle.On("GetPriceDetails").Return(types.PriceDetails{})
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		cp1.String(): big.NewFloat(100.1),
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now())
+
+	resp, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{cp1.String(): "100"}, resp.Prices)
+	s.Require().Empty(resp.Dispersions)
+}
+
+func (s *ServerTestSuite) TestOracleServerPriceDetails() {
+	s.mockOracle.EXPECT().IsRunning().Return(true)
+	cp1 := connecttypes.NewCurrencyPair("BTC", "USD")
+	cp2 := connecttypes.NewCurrencyPair("ETH", "USD")
+	usdt := connecttypes.NewCurrencyPair("USDT", "USD")
+	ts := time.Now().UTC()
+
+	s.mockOracle.On("GetPriceDetails").Return(types.PriceDetails{
+		cp1.String(): {
+			Decimals:         2,
+			MinProviderCount: 1,
+			Price:            big.NewFloat(100.5),
+			ScaledPrice:      big.NewFloat(10050),
+			Providers: []types.ProviderPriceDetail{
+				{
+					Provider:         "coinbase_api",
+					OffChainTicker:   "BTC-USDT",
+					Price:            big.NewFloat(100),
+					NormalizeByPair:  &usdt,
+					NormalizeByPrice: big.NewFloat(1.005),
+					ConvertedPrice:   big.NewFloat(100.5),
+					Timestamp:        ts,
+				},
+				{
+					Provider:       "binance_api",
+					OffChainTicker: "BTCUSDT",
+					Price:          big.NewFloat(90),
+					Timestamp:      ts.Add(-time.Hour),
+					Stale:          true,
+					Error:          "stale",
+				},
+			},
+		},
+		cp2.String(): {
+			Decimals: 8,
+		},
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	resp, err := s.client.PriceDetails(context.Background(), &stypes.QueryPriceDetailsRequest{Tickers: []string{cp1.String()}})
+	s.Require().NoError(err)
+	s.Require().Equal(ts, resp.Timestamp)
+	s.Require().Len(resp.Details, 1)
+
+	detail := resp.Details[cp1.String()]
+	s.Require().Equal("10050", detail.Price)
+	s.Require().Equal("100.5", detail.Median)
+	s.Require().Equal(uint64(2), detail.Decimals)
+	s.Require().Len(detail.Providers, 2)
+
+	s.Require().Equal(stypes.ProviderPriceDetails{
+		Name:             "coinbase_api",
+		OffChainTicker:   "BTC-USDT",
+		RawPrice:         "100",
+		NormalizeByPair:  usdt.String(),
+		NormalizeByPrice: "1.005",
+		ConvertedPrice:   "100.5",
+		Timestamp:        ts,
+	}, detail.Providers[0])
+	s.Require().True(detail.Providers[1].Stale)
+	s.Require().Empty(detail.Providers[1].ConvertedPrice)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/connect/oracle/v2/price_details", localhost, s.port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"off_chain_ticker":"BTCUSDT"`)
+	s.Require().Contains(string(respBz), cp2.String())
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPrices() {
+	s.mockOracle.EXPECT().IsRunning().Return(true)
+	cp1 := connecttypes.NewCurrencyPair("BTC", "USD")
+	cp2 := connecttypes.NewCurrencyPair("ETH", "USD")
+
+	s.mockOracle.On("GetPriceDetails").Return(types.PriceDetails{
+		cp1.String(): {ScaledPrice: big.NewFloat(100.1), ScaledDispersion: big.NewFloat(1)},
+		cp2.String(): {ScaledPrice: big.NewFloat(200.1), ScaledDispersion: big.NewFloat(2)},
+	})
+	ts := time.Now().UTC()
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	// push a single aggregation round to the subscriber
+	updates := make(chan time.Time, 1)
+	updates <- ts.Add(time.Second)
+	s.mockOracle.On("SubscribePrices", mock.Anything).Return((<-chan time.Time)(updates))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{Tickers: []string{cp1.String()}})
+	s.Require().NoError(err)
+
+	// the latest prices are sent immediately
+	resp, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{cp1.String(): "100"}, resp.Prices)
+	s.Require().Equal(map[string]string{cp1.String(): "1"}, resp.Dispersions)
+	s.Require().Equal(ts, resp.Timestamp)
+
+	// followed by each aggregation round
+	resp, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{cp1.String(): "100"}, resp.Prices)
+	s.Require().Equal(ts.Add(time.Second), resp.Timestamp)
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesNotRunning() {
+	s.mockOracle.EXPECT().IsRunning().Return(false)
+
+	stream, err := s.client.StreamPrices(context.Background(), &stypes.StreamPricesRequest{})
+	s.Require().NoError(err)
+
+	_, err = stream.Recv()
+	s.Require().Equal(err.Error(), grpcErrPrefix+server.ErrOracleNotRunning.Error())
+}
+
 func (s *ServerTestSuite) TestOracleMarketMap() {
 	dummyMarketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
 		"foo": {
@@ -80,6 +80,11 @@ type QueryPricesResponse struct {
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	// Version defines the version of the oracle service that provided the prices.
 	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Dispersions defines the dispersion of the provider prices of each ticker,
+	// i.e. the median absolute deviation of the converted provider prices from
+	// their median, scaled by the ticker's decimals. Tickers without a price do
+	// not have a dispersion.
+	Dispersions map[string]string `protobuf:"bytes,4,rep,name=dispersions,proto3" json:"dispersions" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
@@ -136,6 +141,405 @@ func (m *QueryPricesResponse) GetVersion() string {
 	return ""
 }
 
+func (m *QueryPricesResponse) GetDispersions() map[string]string {
+	if m != nil {
+		return m.Dispersions
+	}
+	return nil
+}
+
+// QueryPriceDetailsRequest defines the request type for the PriceDetails
+// method.
+type QueryPriceDetailsRequest struct {
+	// Tickers defines an optional set of tickers to filter the price details by.
+	// If empty, price details for all tickers are returned.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *QueryPriceDetailsRequest) Reset()         { *m = QueryPriceDetailsRequest{} }
+func (m *QueryPriceDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceDetailsRequest) ProtoMessage()    {}
+func (*QueryPriceDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{2}
+}
+func (m *QueryPriceDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceDetailsRequest.Merge(m, src)
+}
+func (m *QueryPriceDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceDetailsRequest proto.InternalMessageInfo
+
+func (m *QueryPriceDetailsRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+// QueryPriceDetailsResponse defines the response type for the PriceDetails
+// method.
+type QueryPriceDetailsResponse struct {
+	// Details defines the price details indexed by ticker.
+	Details map[string]TickerPriceDetails `protobuf:"bytes,1,rep,name=details,proto3" json:"details" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Timestamp defines the timestamp of the prices.
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Version defines the version of the oracle service that provided the
+	// details.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryPriceDetailsResponse) Reset()         { *m = QueryPriceDetailsResponse{} }
+func (m *QueryPriceDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceDetailsResponse) ProtoMessage()    {}
+func (*QueryPriceDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{3}
+}
+func (m *QueryPriceDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceDetailsResponse.Merge(m, src)
+}
+func (m *QueryPriceDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceDetailsResponse) GetDetails() map[string]TickerPriceDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *QueryPriceDetailsResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *QueryPriceDetailsResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// TickerPriceDetails defines how the price of a single ticker was derived.
+type TickerPriceDetails struct {
+	// Price defines the aggregated price scaled by the ticker's decimals. This is
+	// empty if a price could not be aggregated.
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// Median defines the unscaled aggregate of the converted provider prices, as
+	// computed by the market's aggregation strategy (the median by default). This
+	// is empty if a price could not be aggregated.
+	Median string `protobuf:"bytes,2,opt,name=median,proto3" json:"median,omitempty"`
+	// Decimals defines the number of decimals the price is reported with.
+	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// MinProviderCount defines the minimum number of converted provider prices
+	// required to aggregate a price.
+	MinProviderCount uint64 `protobuf:"varint,4,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// Providers defines the provenance of each provider price configured for the
+	// ticker.
+	Providers []ProviderPriceDetails `protobuf:"bytes,5,rep,name=providers,proto3" json:"providers"`
+	// Dispersion defines the median absolute deviation of the converted provider
+	// prices from their median, scaled by the ticker's decimals. This is empty if
+	// a price could not be aggregated.
+	Dispersion string `protobuf:"bytes,6,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+}
+
+func (m *TickerPriceDetails) Reset()         { *m = TickerPriceDetails{} }
+func (m *TickerPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*TickerPriceDetails) ProtoMessage()    {}
+func (*TickerPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{4}
+}
+func (m *TickerPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickerPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickerPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickerPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickerPriceDetails.Merge(m, src)
+}
+func (m *TickerPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickerPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickerPriceDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickerPriceDetails proto.InternalMessageInfo
+
+func (m *TickerPriceDetails) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *TickerPriceDetails) GetMedian() string {
+	if m != nil {
+		return m.Median
+	}
+	return ""
+}
+
+func (m *TickerPriceDetails) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TickerPriceDetails) GetMinProviderCount() uint64 {
+	if m != nil {
+		return m.MinProviderCount
+	}
+	return 0
+}
+
+func (m *TickerPriceDetails) GetProviders() []ProviderPriceDetails {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *TickerPriceDetails) GetDispersion() string {
+	if m != nil {
+		return m.Dispersion
+	}
+	return ""
+}
+
+// ProviderPriceDetails defines a single provider's contribution to the price
+// of a ticker.
+type ProviderPriceDetails struct {
+	// Name defines the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// OffChainTicker defines the provider's representation of the ticker.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// RawPrice defines the price reported by the provider. This is empty if the
+	// provider did not report a price.
+	RawPrice string `protobuf:"bytes,3,opt,name=raw_price,json=rawPrice,proto3" json:"raw_price,omitempty"`
+	// Invert defines whether the raw price is inverted before conversion.
+	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizeByPair defines the pair whose index price the raw price is
+	// converted by, if any.
+	NormalizeByPair string `protobuf:"bytes,5,opt,name=normalize_by_pair,json=normalizeByPair,proto3" json:"normalize_by_pair,omitempty"`
+	// NormalizeByPrice defines the index price of the normalize by pair used in
+	// the conversion.
+	NormalizeByPrice string `protobuf:"bytes,6,opt,name=normalize_by_price,json=normalizeByPrice,proto3" json:"normalize_by_price,omitempty"`
+	// ConvertedPrice defines the raw price after inversion and conversion. This
+	// is empty if the price could not be converted.
+	ConvertedPrice string `protobuf:"bytes,7,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	// Timestamp defines the time at which the provider reported the raw price.
+	Timestamp time.Time `protobuf:"bytes,8,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Stale defines whether the raw price was excluded for being older than the
+	// oracle's max price age.
+	Stale bool `protobuf:"varint,9,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Error defines the reason the price was excluded from aggregation, if any.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ProviderPriceDetails) Reset()         { *m = ProviderPriceDetails{} }
+func (m *ProviderPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*ProviderPriceDetails) ProtoMessage()    {}
+func (*ProviderPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{5}
+}
+func (m *ProviderPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPriceDetails.Merge(m, src)
+}
+func (m *ProviderPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPriceDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPriceDetails proto.InternalMessageInfo
+
+func (m *ProviderPriceDetails) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetRawPrice() string {
+	if m != nil {
+		return m.RawPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
+func (m *ProviderPriceDetails) GetNormalizeByPair() string {
+	if m != nil {
+		return m.NormalizeByPair
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetNormalizeByPrice() string {
+	if m != nil {
+		return m.NormalizeByPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetConvertedPrice() string {
+	if m != nil {
+		return m.ConvertedPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ProviderPriceDetails) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *ProviderPriceDetails) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// StreamPricesRequest defines the request type for the StreamPrices method.
+type StreamPricesRequest struct {
+	// Tickers defines an optional set of tickers to filter the streamed prices
+	// by. If empty, prices for all tickers are streamed.
+	Tickers []string `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+}
+
+func (m *StreamPricesRequest) Reset()         { *m = StreamPricesRequest{} }
+func (m *StreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPricesRequest) ProtoMessage()    {}
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4d2eaa50661ccd, []int{6}
+}
+func (m *StreamPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPricesRequest.Merge(m, src)
+}
+func (m *StreamPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPricesRequest proto.InternalMessageInfo
+
+func (m *StreamPricesRequest) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
 // QueryMarketMapRequest defines the request type for the MarketMap method.
 type QueryMarketMapRequest struct {
 }
@@ -144,7 +548,7 @@ func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
 func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapRequest) ProtoMessage()    {}
 func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{2}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{7}
 }
 func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +587,7 @@ func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{}
 func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapResponse) ProtoMessage()    {}
 func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{3}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{8}
 }
 func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +631,7 @@ func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
 func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionRequest) ProtoMessage()    {}
 func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{4}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{9}
 }
 func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +670,7 @@ func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
 func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionResponse) ProtoMessage()    {}
 func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b4d2eaa50661ccd, []int{5}
+	return fileDescriptor_9b4d2eaa50661ccd, []int{10}
 }
 func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +709,14 @@ func (m *QueryVersionResponse) GetVersion() string {
 func init() {
 	proto.RegisterType((*QueryPricesRequest)(nil), "connect.service.v2.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "connect.service.v2.QueryPricesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "connect.service.v2.QueryPricesResponse.DispersionsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "connect.service.v2.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*QueryPriceDetailsRequest)(nil), "connect.service.v2.QueryPriceDetailsRequest")
+	proto.RegisterType((*QueryPriceDetailsResponse)(nil), "connect.service.v2.QueryPriceDetailsResponse")
+	proto.RegisterMapType((map[string]TickerPriceDetails)(nil), "connect.service.v2.QueryPriceDetailsResponse.DetailsEntry")
+	proto.RegisterType((*TickerPriceDetails)(nil), "connect.service.v2.TickerPriceDetails")
+	proto.RegisterType((*ProviderPriceDetails)(nil), "connect.service.v2.ProviderPriceDetails")
+	proto.RegisterType((*StreamPricesRequest)(nil), "connect.service.v2.StreamPricesRequest")
 	proto.RegisterType((*QueryMarketMapRequest)(nil), "connect.service.v2.QueryMarketMapRequest")
 	proto.RegisterType((*QueryMarketMapResponse)(nil), "connect.service.v2.QueryMarketMapResponse")
 	proto.RegisterType((*QueryVersionRequest)(nil), "connect.service.v2.QueryVersionRequest")
@@ -315,42 +726,69 @@ func init() {
 func init() { proto.RegisterFile("connect/service/v2/oracle.proto", fileDescriptor_9b4d2eaa50661ccd) }
 
 var fileDescriptor_9b4d2eaa50661ccd = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x5b, 0xe8, 0xa8, 0x7b, 0x41, 0xa6, 0x83, 0x2e, 0x54, 0x49, 0x17, 0x21, 0x28, 0x48,
-	0x38, 0x28, 0xbb, 0xf0, 0x43, 0xe2, 0x50, 0x89, 0xe3, 0x04, 0x8b, 0x00, 0x21, 0x2e, 0x93, 0x1b,
-	0x99, 0x10, 0xb5, 0x89, 0x4d, 0xec, 0x44, 0xaa, 0xc4, 0x01, 0x38, 0x71, 0x9c, 0x04, 0x7f, 0xd4,
-	0x8e, 0x93, 0xb8, 0x70, 0x02, 0xd4, 0xf2, 0x87, 0xa0, 0xd8, 0x4e, 0xb6, 0x8e, 0x4e, 0xdb, 0xa9,
-	0x7e, 0x7e, 0xdf, 0x7b, 0xef, 0xfb, 0xbe, 0xe7, 0x14, 0x3a, 0x21, 0x4b, 0x53, 0x1a, 0x4a, 0x4f,
-	0xd0, 0xac, 0x88, 0x43, 0xea, 0x15, 0xbe, 0xc7, 0x32, 0x12, 0xce, 0x28, 0xe6, 0x19, 0x93, 0x0c,
-	0x21, 0x03, 0xc0, 0x06, 0x80, 0x0b, 0xdf, 0xea, 0x45, 0x2c, 0x62, 0x2a, 0xed, 0x95, 0x27, 0x8d,
-	0xb4, 0x06, 0x11, 0x63, 0xd1, 0x8c, 0x7a, 0x84, 0xc7, 0x1e, 0x49, 0x53, 0x26, 0x89, 0x8c, 0x59,
-	0x2a, 0x4c, 0xd6, 0x31, 0x59, 0x15, 0x4d, 0xf2, 0x77, 0x9e, 0x8c, 0x13, 0x2a, 0x24, 0x49, 0xb8,
-	0x01, 0x6c, 0x85, 0x4c, 0x24, 0x4c, 0xec, 0xeb, 0xbe, 0x3a, 0x30, 0xa9, 0xed, 0x8a, 0x64, 0x42,
-	0xb2, 0x29, 0x95, 0x09, 0xe1, 0x25, 0x4d, 0x1d, 0x68, 0x88, 0xdb, 0x83, 0x68, 0x2f, 0xa7, 0xd9,
-	0xfc, 0x45, 0x16, 0x87, 0x54, 0x04, 0xf4, 0x43, 0x4e, 0x85, 0x74, 0x3f, 0x37, 0xe1, 0xb5, 0x95,
-	0x6b, 0xc1, 0x59, 0x2a, 0x28, 0xda, 0x83, 0x6d, 0xae, 0x6e, 0xfa, 0x60, 0xd8, 0x1a, 0x75, 0xfd,
-	0x1d, 0xfc, 0xbf, 0x4a, 0xbc, 0xa6, 0x10, 0xeb, 0xf0, 0x59, 0x2a, 0xb3, 0xf9, 0xf8, 0xd2, 0xe1,
-	0x2f, 0xa7, 0x11, 0x98, 0x46, 0x68, 0x0c, 0x3b, 0xb5, 0xa2, 0x7e, 0x73, 0x08, 0x46, 0x5d, 0xdf,
-	0xc2, 0x5a, 0x33, 0xae, 0x34, 0xe3, 0x97, 0x15, 0x62, 0x7c, 0xa5, 0x2c, 0x3e, 0xf8, 0xed, 0x80,
-	0xe0, 0xb8, 0x0c, 0xf5, 0xe1, 0x46, 0x41, 0x33, 0x11, 0xb3, 0xb4, 0xdf, 0x1a, 0x82, 0x51, 0x27,
-	0xa8, 0x42, 0xeb, 0x11, 0xec, 0x9e, 0x18, 0x8d, 0xae, 0xc2, 0xd6, 0x94, 0xce, 0xfb, 0x40, 0x81,
-	0xca, 0x23, 0xea, 0xc1, 0xcb, 0x05, 0x99, 0xe5, 0x54, 0x8d, 0xee, 0x04, 0x3a, 0x78, 0xdc, 0x7c,
-	0x08, 0xdc, 0x1b, 0x70, 0x53, 0x29, 0xd9, 0x55, 0x76, 0xed, 0x12, 0x5e, 0x99, 0xf3, 0x06, 0x5e,
-	0x3f, 0x9d, 0x30, 0xf6, 0x3c, 0x85, 0x50, 0x9b, 0xbb, 0x9f, 0x10, 0xae, 0xa6, 0x74, 0x7d, 0xa7,
-	0xb6, 0xa8, 0x5e, 0x42, 0x69, 0xd2, 0x71, 0x71, 0x27, 0xa9, 0x8e, 0xee, 0xa6, 0x71, 0xfd, 0xb5,
-	0x66, 0x5f, 0x0d, 0x7c, 0x00, 0x7b, 0xab, 0xd7, 0x66, 0xdc, 0x09, 0xd9, 0x60, 0x45, 0xb6, 0xff,
-	0xbd, 0x05, 0xdb, 0xcf, 0xd5, 0x6b, 0x44, 0x1f, 0x61, 0x5b, 0x3b, 0x80, 0x6e, 0x9f, 0xbb, 0x2c,
-	0x35, 0xce, 0xba, 0x73, 0xc1, 0xa5, 0xba, 0xdb, 0x5f, 0x7e, 0xfc, 0xfd, 0xd6, 0xbc, 0x89, 0xb6,
-	0xbc, 0xea, 0x9d, 0xe9, 0x2f, 0xa0, 0x7c, 0x64, 0x66, 0xbb, 0x5f, 0x01, 0xec, 0xd4, 0x52, 0xd1,
-	0xdd, 0x33, 0x3b, 0x9f, 0x36, 0xd9, 0xba, 0x77, 0x11, 0xa8, 0xe1, 0x71, 0x4b, 0xf1, 0xb0, 0xd1,
-	0x60, 0x0d, 0x8f, 0xda, 0x74, 0xf4, 0x09, 0xc0, 0x0d, 0xe3, 0x20, 0x3a, 0x5b, 0xe2, 0xaa, 0xf5,
-	0xd6, 0xe8, 0x7c, 0xa0, 0x21, 0xe1, 0x2a, 0x12, 0x03, 0x64, 0xad, 0x21, 0x61, 0xd6, 0x32, 0x7e,
-	0x75, 0xb8, 0xb0, 0xc1, 0xd1, 0xc2, 0x06, 0x7f, 0x16, 0x36, 0x38, 0x58, 0xda, 0x8d, 0xa3, 0xa5,
-	0xdd, 0xf8, 0xb9, 0xb4, 0x1b, 0x6f, 0x9f, 0x44, 0xb1, 0x7c, 0x9f, 0x4f, 0x70, 0xc8, 0x12, 0x4f,
-	0x4c, 0x63, 0x7e, 0x3f, 0xa1, 0x45, 0xdd, 0xa8, 0xf0, 0xeb, 0x7f, 0x99, 0xf2, 0x97, 0x66, 0xa2,
-	0xea, 0x2d, 0xe7, 0x9c, 0x8a, 0x49, 0x5b, 0x7d, 0x27, 0x3b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x88, 0x1b, 0xe4, 0x95, 0x94, 0x04, 0x00, 0x00,
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0xef, 0x26, 0xd9, 0xe4, 0x65, 0x45, 0xc3, 0x34, 0x2d, 0x5e, 0x77, 0x95, 0x6c, 0x2d,
+	0xd4, 0x86, 0xaa, 0xb5, 0xab, 0x94, 0x43, 0x29, 0xa8, 0x87, 0xb4, 0xdc, 0xa8, 0xd8, 0x86, 0x82,
+	0x50, 0x2f, 0x61, 0xe2, 0x4c, 0xd2, 0xd1, 0xc6, 0x1e, 0x33, 0xe3, 0xb8, 0x0a, 0xe2, 0x80, 0x38,
+	0x71, 0xe0, 0x50, 0x09, 0xf1, 0x09, 0xf8, 0x14, 0x88, 0x2f, 0xd0, 0x63, 0x25, 0x2e, 0x9c, 0x00,
+	0xed, 0xf2, 0x29, 0x10, 0x07, 0xe4, 0x99, 0xb1, 0x63, 0x6f, 0xbd, 0xdd, 0x14, 0x89, 0x53, 0xfc,
+	0xde, 0xbc, 0x3f, 0xbf, 0xf7, 0x7b, 0x6f, 0xde, 0x04, 0xba, 0x1e, 0x0b, 0x02, 0xe2, 0x45, 0xae,
+	0x20, 0x3c, 0xa6, 0x1e, 0x71, 0xe3, 0xbe, 0xcb, 0x38, 0xf6, 0xe6, 0xc4, 0x09, 0x39, 0x8b, 0x18,
+	0x42, 0xda, 0xc0, 0xd1, 0x06, 0x4e, 0xdc, 0xb7, 0xda, 0x33, 0x36, 0x63, 0xf2, 0xd8, 0x4d, 0xbe,
+	0x94, 0xa5, 0xb5, 0x37, 0x63, 0x6c, 0x36, 0x27, 0x2e, 0x0e, 0xa9, 0x8b, 0x83, 0x80, 0x45, 0x38,
+	0xa2, 0x2c, 0x10, 0xfa, 0xb4, 0xab, 0x4f, 0xa5, 0x34, 0x5e, 0x4c, 0xdd, 0x88, 0xfa, 0x44, 0x44,
+	0xd8, 0x0f, 0xb5, 0xc1, 0xae, 0xc7, 0x84, 0xcf, 0xc4, 0x48, 0xc5, 0x55, 0x82, 0x3e, 0xba, 0x9c,
+	0x82, 0xf4, 0x31, 0x3f, 0x24, 0x91, 0x8f, 0xc3, 0x04, 0xa6, 0x12, 0x94, 0x89, 0xdd, 0x06, 0xf4,
+	0x70, 0x41, 0xf8, 0xf2, 0x80, 0x53, 0x8f, 0x88, 0x21, 0xf9, 0x72, 0x41, 0x44, 0x64, 0xff, 0xb4,
+	0x05, 0xe7, 0x0b, 0x6a, 0x11, 0xb2, 0x40, 0x10, 0xf4, 0x10, 0x6a, 0xa1, 0xd4, 0x98, 0xc6, 0xfe,
+	0x56, 0xaf, 0xd9, 0xbf, 0xe5, 0xbc, 0x5c, 0xa5, 0x53, 0xe2, 0xe8, 0x28, 0xf1, 0xc3, 0x20, 0xe2,
+	0xcb, 0x41, 0xe5, 0xf9, 0xef, 0xdd, 0x8d, 0xa1, 0x0e, 0x84, 0x06, 0xd0, 0xc8, 0x2a, 0x32, 0x37,
+	0xf7, 0x8d, 0x5e, 0xb3, 0x6f, 0x39, 0xaa, 0x66, 0x27, 0xad, 0xd9, 0x79, 0x94, 0x5a, 0x0c, 0xea,
+	0x89, 0xf3, 0xb3, 0x3f, 0xba, 0xc6, 0x70, 0xe5, 0x86, 0x4c, 0xd8, 0x8e, 0x09, 0x17, 0x94, 0x05,
+	0xe6, 0xd6, 0xbe, 0xd1, 0x6b, 0x0c, 0x53, 0x11, 0x7d, 0x01, 0xcd, 0x09, 0x15, 0xa1, 0x92, 0x84,
+	0x59, 0x91, 0xa8, 0x6f, 0xaf, 0x8b, 0xfa, 0xfe, 0xca, 0x35, 0x0f, 0x3d, 0x1f, 0xd2, 0x7a, 0x0f,
+	0x9a, 0xb9, 0xe2, 0x50, 0x0b, 0xb6, 0x0e, 0xc9, 0xd2, 0x34, 0x24, 0x8c, 0xe4, 0x13, 0xb5, 0xa1,
+	0x1a, 0xe3, 0xf9, 0x82, 0xc8, 0xe2, 0x1a, 0x43, 0x25, 0xdc, 0xd9, 0xbc, 0x6d, 0x58, 0x77, 0xa1,
+	0x75, 0x32, 0xc3, 0xeb, 0xf8, 0xdb, 0xef, 0x82, 0xb9, 0x42, 0x7d, 0x9f, 0x44, 0x98, 0xce, 0xd3,
+	0x0e, 0x26, 0x94, 0x44, 0xd4, 0x3b, 0x24, 0x5c, 0xb5, 0xaa, 0x31, 0x4c, 0x45, 0xfb, 0xe7, 0x4d,
+	0xd8, 0x2d, 0x71, 0xd3, 0x1d, 0x7e, 0x0c, 0xdb, 0x13, 0xa5, 0xd2, 0x2d, 0xbe, 0xf3, 0x6a, 0xb2,
+	0x4e, 0xf8, 0x3b, 0x5a, 0xce, 0xd3, 0x95, 0x06, 0xfc, 0x7f, 0x5b, 0x6d, 0x8d, 0x61, 0x27, 0x9f,
+	0xbc, 0x84, 0xc9, 0x0f, 0xf2, 0x4c, 0x36, 0xfb, 0x57, 0xca, 0x2a, 0x7b, 0x24, 0x59, 0x2a, 0x94,
+	0x96, 0x63, 0xfc, 0x6f, 0x03, 0xd0, 0xcb, 0x16, 0x49, 0x8b, 0xe4, 0x34, 0xeb, 0x64, 0x4a, 0x40,
+	0x17, 0xa1, 0xe6, 0x93, 0x09, 0xc5, 0x81, 0xee, 0x9c, 0x96, 0x90, 0x05, 0xf5, 0x09, 0xf1, 0xa8,
+	0x8f, 0xe7, 0x42, 0xd6, 0x50, 0x19, 0x66, 0x32, 0xba, 0x0e, 0xc8, 0xa7, 0x41, 0x72, 0x97, 0x63,
+	0x3a, 0x21, 0x7c, 0xe4, 0xb1, 0x45, 0x10, 0x99, 0x15, 0x69, 0xd5, 0xf2, 0x69, 0x70, 0xa0, 0x0f,
+	0xee, 0x25, 0x7a, 0xf4, 0x11, 0x34, 0x52, 0x4b, 0x61, 0x56, 0x65, 0xbb, 0x7a, 0x65, 0x45, 0xa5,
+	0x5e, 0x79, 0xd0, 0xba, 0x39, 0xab, 0x00, 0xa8, 0x03, 0xb0, 0x1a, 0x6c, 0xb3, 0x26, 0x31, 0xe7,
+	0x34, 0xf6, 0x3f, 0x9b, 0xd0, 0x2e, 0x8b, 0x84, 0x10, 0x54, 0x02, 0xec, 0xa7, 0xd5, 0xcb, 0x6f,
+	0xd4, 0x83, 0x16, 0x9b, 0x4e, 0x47, 0xde, 0x13, 0x4c, 0x83, 0x91, 0x1a, 0x3d, 0x4d, 0xc3, 0x1b,
+	0x6c, 0x3a, 0xbd, 0x97, 0xa8, 0x15, 0x91, 0xe8, 0x12, 0x34, 0x38, 0x7e, 0x3a, 0x52, 0x04, 0xaa,
+	0x9e, 0xd6, 0x39, 0x7e, 0x7a, 0x90, 0x72, 0x48, 0x83, 0x98, 0x70, 0xc5, 0x41, 0x7d, 0xa8, 0x25,
+	0x74, 0x0d, 0xde, 0x0c, 0x18, 0xf7, 0xf1, 0x9c, 0x7e, 0x45, 0x46, 0xe3, 0xe5, 0x28, 0xc4, 0x94,
+	0x9b, 0x55, 0xe9, 0x7c, 0x2e, 0x3b, 0x18, 0x2c, 0x0f, 0x30, 0xe5, 0x09, 0xa7, 0x45, 0x5b, 0x99,
+	0x49, 0xd5, 0xd7, 0xca, 0x1b, 0xcb, 0x8c, 0x57, 0xe1, 0x9c, 0xc7, 0x64, 0x12, 0x32, 0xd1, 0xa6,
+	0xdb, 0x0a, 0x77, 0xa6, 0x56, 0x86, 0x85, 0x69, 0xae, 0xff, 0xb7, 0x69, 0x6e, 0x43, 0x55, 0x44,
+	0x78, 0x4e, 0xcc, 0x86, 0xac, 0x4e, 0x09, 0x89, 0x96, 0x70, 0xce, 0xb8, 0x09, 0x6a, 0x9c, 0xa4,
+	0x60, 0xbb, 0x70, 0xfe, 0x93, 0x88, 0x13, 0xec, 0x17, 0x56, 0xf5, 0x2b, 0x2e, 0xfa, 0x5b, 0x70,
+	0x41, 0xde, 0xd3, 0x07, 0x72, 0xdf, 0x3f, 0xc0, 0x61, 0xba, 0xdd, 0x3f, 0x87, 0x8b, 0x27, 0x0f,
+	0xf4, 0xed, 0xbf, 0x0b, 0xa0, 0x5e, 0x87, 0x91, 0x8f, 0x43, 0xd9, 0xcf, 0x66, 0xbf, 0x9b, 0x4d,
+	0x54, 0xf6, 0x8a, 0x24, 0x33, 0xb5, 0x72, 0x6e, 0xf8, 0xe9, 0xa7, 0x7d, 0x41, 0x3f, 0x1b, 0x9f,
+	0xa9, 0x91, 0x49, 0x13, 0xde, 0x84, 0x76, 0x51, 0xad, 0xd3, 0xe5, 0x2e, 0xb3, 0x51, 0xb8, 0xcc,
+	0xfd, 0x5f, 0xaa, 0x50, 0xfb, 0x58, 0x3e, 0xa7, 0xe8, 0x6b, 0xa8, 0xa9, 0x8a, 0xd1, 0x95, 0x33,
+	0xf7, 0xb6, 0x4c, 0x67, 0x5d, 0x5d, 0x73, 0xbf, 0xdb, 0x97, 0xbf, 0xfd, 0xf5, 0xaf, 0x1f, 0x36,
+	0x2f, 0xa1, 0x5d, 0x37, 0x7d, 0x28, 0xd5, 0x13, 0x9e, 0xbc, 0x92, 0xfa, 0x79, 0xfa, 0xce, 0x80,
+	0x46, 0x56, 0x2a, 0x7a, 0xe7, 0xd4, 0xc8, 0x27, 0x49, 0xb6, 0xae, 0xad, 0x63, 0xaa, 0x71, 0xbc,
+	0x2d, 0x71, 0x74, 0xd0, 0x5e, 0x09, 0x8e, 0x8c, 0x74, 0xf4, 0xa3, 0x01, 0x3b, 0x85, 0x7b, 0x77,
+	0x7d, 0xcd, 0xd5, 0xac, 0x00, 0xdd, 0x78, 0xad, 0x45, 0x6e, 0xf7, 0x24, 0x26, 0x1b, 0xed, 0x9f,
+	0xc6, 0xcd, 0x28, 0x5d, 0xeb, 0xdf, 0x1b, 0xb0, 0x93, 0x9f, 0x4c, 0x54, 0xca, 0x7f, 0xc9, 0xec,
+	0xae, 0xdf, 0xa8, 0x33, 0xc1, 0x08, 0x57, 0xc8, 0xf8, 0x37, 0x0d, 0xf4, 0x8d, 0x01, 0xdb, 0x7a,
+	0xd0, 0xd0, 0xe9, 0x09, 0x8a, 0x13, 0x6a, 0xf5, 0xce, 0x36, 0xd4, 0x50, 0x6c, 0x09, 0x65, 0x0f,
+	0x59, 0x25, 0x50, 0xf4, 0xf4, 0x0e, 0x3e, 0x7d, 0x7e, 0xd4, 0x31, 0x5e, 0x1c, 0x75, 0x8c, 0x3f,
+	0x8f, 0x3a, 0xc6, 0xb3, 0xe3, 0xce, 0xc6, 0x8b, 0xe3, 0xce, 0xc6, 0x6f, 0xc7, 0x9d, 0x8d, 0xc7,
+	0xef, 0xcf, 0x68, 0xf4, 0x64, 0x31, 0x76, 0x3c, 0xe6, 0xbb, 0xe2, 0x90, 0x86, 0x37, 0x7c, 0x12,
+	0x67, 0x81, 0xe2, 0x7e, 0xf6, 0x6f, 0x32, 0xf9, 0x25, 0x5c, 0xa4, 0xb1, 0xa3, 0x65, 0x48, 0xc4,
+	0xb8, 0x26, 0xd7, 0xca, 0xad, 0x7f, 0x07, 0x00, 0xcc, 0x8b, 0x12, 0x5a, 0x7c, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -370,6 +808,13 @@ type OracleClient interface {
 	// MarketMap defines a method for fetching the latest market map
 	// configuration.
 	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
+	// PriceDetails defines a method for fetching the provenance of the latest
+	// prices, i.e. every provider's raw price, its conversion, and whether it was
+	// included in the aggregated price.
+	PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error)
+	// StreamPrices defines a method for subscribing to the latest prices. A new
+	// response is sent each time the oracle completes a price aggregation round.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
 	// Version defines a method for fetching the current version of the oracle
 	// service.
 	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
@@ -401,6 +846,47 @@ func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest,
 	return out, nil
 }
 
+func (c *oracleClient) PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error) {
+	out := new(QueryPriceDetailsResponse)
+	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/PriceDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/connect.service.v2.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *oracleClient) Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error) {
 	out := new(QueryVersionResponse)
 	err := c.cc.Invoke(ctx, "/connect.service.v2.Oracle/Version", in, out, opts...)
@@ -417,6 +903,13 @@ type OracleServer interface {
 	// MarketMap defines a method for fetching the latest market map
 	// configuration.
 	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
+	// PriceDetails defines a method for fetching the provenance of the latest
+	// prices, i.e. every provider's raw price, its conversion, and whether it was
+	// included in the aggregated price.
+	PriceDetails(context.Context, *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error)
+	// StreamPrices defines a method for subscribing to the latest prices. A new
+	// response is sent each time the oracle completes a price aggregation round.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
 	// Version defines a method for fetching the current version of the oracle
 	// service.
 	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
@@ -432,6 +925,12 @@ func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRe
 func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
 }
+func (*UnimplementedOracleServer) PriceDetails(ctx context.Context, req *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDetails not implemented")
+}
+func (*UnimplementedOracleServer) StreamPrices(req *StreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
 func (*UnimplementedOracleServer) Version(ctx context.Context, req *QueryVersionRequest) (*QueryVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -476,29 +975,68 @@ func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Oracle_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVersionRequest)
+func _Oracle_PriceDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OracleServer).Version(ctx, in)
+		return srv.(OracleServer).PriceDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/connect.service.v2.Oracle/Version",
+		FullMethod: "/connect.service.v2.Oracle/PriceDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).Version(ctx, req.(*QueryVersionRequest))
+		return srv.(OracleServer).PriceDetails(ctx, req.(*QueryPriceDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Oracle_serviceDesc = _Oracle_serviceDesc
-var _Oracle_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.service.v2.Oracle",
-	HandlerType: (*OracleServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.service.v2.Oracle/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Version(ctx, req.(*QueryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Oracle_serviceDesc = _Oracle_serviceDesc
+var _Oracle_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.service.v2.Oracle",
+	HandlerType: (*OracleServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
@@ -507,12 +1045,22 @@ var _Oracle_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarketMap",
 			Handler:    _Oracle_MarketMap_Handler,
 		},
+		{
+			MethodName: "PriceDetails",
+			Handler:    _Oracle_PriceDetails_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Oracle_Version_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "connect/service/v2/oracle.proto",
 }
 
@@ -559,6 +1107,25 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Dispersions) > 0 {
+		for k := range m.Dispersions {
+			v := m.Dispersions[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -596,6 +1163,300 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Details) > 0 {
+		for k := range m.Details {
+			v := m.Details[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickerPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickerPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickerPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dispersion) > 0 {
+		i -= len(m.Dispersion)
+		copy(dAtA[i:], m.Dispersion)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Dispersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MinProviderCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinProviderCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Median) > 0 {
+		i -= len(m.Median)
+		copy(dAtA[i:], m.Median)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Median)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	if len(m.ConvertedPrice) > 0 {
+		i -= len(m.ConvertedPrice)
+		copy(dAtA[i:], m.ConvertedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConvertedPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NormalizeByPrice) > 0 {
+		i -= len(m.NormalizeByPrice)
+		copy(dAtA[i:], m.NormalizeByPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NormalizeByPrice)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NormalizeByPair) > 0 {
+		i -= len(m.NormalizeByPair)
+		copy(dAtA[i:], m.NormalizeByPair)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NormalizeByPair)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RawPrice) > 0 {
+		i -= len(m.RawPrice)
+		copy(dAtA[i:], m.RawPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RawPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -747,44 +1608,184 @@ func (m *QueryPricesResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if len(m.Dispersions) > 0 {
+		for k, v := range m.Dispersions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *QueryMarketMapRequest) Size() (n int) {
+func (m *QueryPriceDetailsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryMarketMapResponse) Size() (n int) {
+func (m *QueryPriceDetailsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MarketMap != nil {
-		l = m.MarketMap.Size()
+	if len(m.Details) > 0 {
+		for k, v := range m.Details {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Version)
+	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryVersionRequest) Size() (n int) {
+func (m *TickerPriceDetails) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Median)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovOracle(uint64(m.Decimals))
+	}
+	if m.MinProviderCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinProviderCount))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Dispersion)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderPriceDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.RawPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Invert {
+		n += 2
+	}
+	l = len(m.NormalizeByPair)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.NormalizeByPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ConvertedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		l = m.MarketMap.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Version)
@@ -1071,6 +2072,1106 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispersions == nil {
+				m.Dispersions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Dispersions[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = make(map[string]TickerPriceDetails)
+			}
+			var mapkey string
+			mapvalue := &TickerPriceDetails{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TickerPriceDetails{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Details[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickerPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickerPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickerPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Median = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderCount", wireType)
+			}
+			m.MinProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProviderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderPriceDetails{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dispersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
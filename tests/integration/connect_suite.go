@@ -21,6 +21,7 @@ import (
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
 
 	connectabci "github.com/skip-mev/connect/v2/abci/ve/types"
 	oracleconfig "github.com/skip-mev/connect/v2/oracle/config"
@@ -28,6 +29,7 @@ import (
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
 	"github.com/skip-mev/connect/v2/providers/apis/marketmap"
 	"github.com/skip-mev/connect/v2/providers/static"
+	mmclienttypes "github.com/skip-mev/connect/v2/service/clients/marketmap/types"
 	mmtypes "github.com/skip-mev/connect/v2/x/marketmap/types"
 	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
@@ -811,6 +813,73 @@ func (s *ConnectOracleIntegrationSuite) TestMultiplePriceFeeds() {
 	})
 }
 
+// TestVerifiedMarketMapFetcher checks that the verified market map fetcher, pointed at the RPC of the chain,
+// verifies the proofs of the x/marketmap store returned by the nodes and returns the market map of the chain.
+func (s *ConnectOracleIntegrationSuite) TestVerifiedMarketMapFetcher() {
+	ctx := context.Background()
+	chain := mmclienttypes.Chain{ChainID: s.chain.Config().ChainID}
+
+	// trust the latest header of the chain
+	client := s.chain.Nodes()[0].Client
+	block, err := client.Block(ctx, nil)
+	s.Require().NoError(err)
+
+	fetcher, err := marketmap.NewVerifiedMarketMapFetcher(zap.NewNop(), oracleconfig.APIConfig{
+		Name:             marketmap.VerifiedName,
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          20 * time.Second,
+		Interval:         10 * time.Second,
+		ReconnectTimeout: 2 * time.Second,
+		MaxQueries:       1,
+		Endpoints:        []oracleconfig.Endpoint{{URL: s.chain.GetHostRPCAddress()}},
+		LightClient: &oracleconfig.LightClientConfig{
+			ChainID:       chain.ChainID,
+			TrustHeight:   block.Block.Height,
+			TrustHash:     hex.EncodeToString(block.BlockID.Hash),
+			TrustPeriod:   time.Hour,
+			MaxClockDrift: 10 * time.Second,
+		},
+	})
+	s.Require().NoError(err)
+
+	// expectMarketMap waits for a block after the latest market map update, and checks that the verified
+	// market map is the market map of the chain.
+	expectMarketMap := func() {
+		expected, err := QueryMarketMap(s.chain)
+		s.Require().NoError(err)
+
+		// the market map is read at the height preceding the latest verified header
+		s.Require().NoError(WaitForHeight(s.chain, expected.LastUpdated+2, 30*time.Second))
+
+		resp := fetcher.Fetch(ctx, []mmclienttypes.Chain{chain})
+		s.Require().Empty(resp.UnResolved)
+		s.Require().Contains(resp.Resolved, chain)
+
+		got := resp.Resolved[chain].Value
+		s.Require().Equal(expected.LastUpdated, got.LastUpdated)
+		s.Require().True(expected.MarketMap.Equal(got.MarketMap))
+	}
+
+	enabledCP := connecttypes.NewCurrencyPair("VERI", "FIED")
+	disabledCP := connecttypes.NewCurrencyPair("UNVERI", "FIED")
+
+	s.Run("fetch the market map with added markets", func() {
+		s.Require().NoError(s.AddCurrencyPairs(s.chain, s.user, 1.1, []mmtypes.Ticker{
+			enabledTicker(enabledCP),
+			disabledTicker(disabledCP),
+		}...))
+
+		expectMarketMap()
+	})
+
+	s.Run("fetch the market map with a removed market", func() {
+		s.Require().NoError(s.RemoveMarket(s.chain, []connecttypes.CurrencyPair{disabledCP}))
+
+		expectMarketMap()
+	})
+}
+
 func getIDForCurrencyPair(ctx context.Context, client oracletypes.QueryClient, cp connecttypes.CurrencyPair) (uint64, error) {
 	// query for the given currency pair
 	resp, err := client.GetPrice(ctx, &oracletypes.GetPriceRequest{
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -24,6 +28,10 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMarketMap(),
 		CmdQueryLastUpdated(),
 		CmdQueryMarket(),
+		CmdQueryPendingMarkets(),
+		CmdQueryMarketMapProposals(),
+		CmdQueryMarketMapProposal(),
+		CmdQuerySimulateMarketUpdates(),
 	)
 
 	return cmd
@@ -133,3 +141,124 @@ func CmdQueryLastUpdated() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryPendingMarkets returns the command for querying the markets scheduled for activation.
+func CmdQueryPendingMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-markets",
+		Short: "Query the market creates and updates scheduled for activation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingMarkets(cmd.Context(), &types.PendingMarketsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketMapProposals returns the command for querying the market map proposals under review.
+func CmdQueryMarketMapProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals",
+		Short: "Query the market map proposals under review",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketMapProposals(cmd.Context(), &types.MarketMapProposalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketMapProposal returns the command for querying a market map proposal under review by id.
+func CmdQueryMarketMapProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [id]",
+		Short: "Query a market map proposal under review",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketMapProposal(cmd.Context(), &types.MarketMapProposalRequest{ProposalId: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Proposal)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQuerySimulateMarketUpdates returns the command for performing a dry run of a market upsert.
+func CmdQuerySimulateMarketUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-market-updates [markets-file]",
+		Short: "Simulate upserting the markets in the given JSON file and show the diff and validation errors",
+		Long: `Simulate upserting the markets in the given JSON file against the current market map, without
+submitting a transaction. The file contains the markets as in a MsgUpsertMarkets, e.g. {"markets": [...]}.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read markets file %s: %w", args[0], err)
+			}
+
+			var req types.SimulateMarketUpdatesRequest
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &req); err != nil {
+				return fmt.Errorf("failed to parse markets file %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateMarketUpdates(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker is called at the beginning of every block. It applies the pending markets whose activation
+// height or time has been reached, and the market map proposals whose review period has ended.
+func (k *Keeper) BeginBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ActivatePendingMarkets(ctx); err != nil {
+		return err
+	}
+
+	return k.ApplyDueMarketMapProposals(ctx)
+}
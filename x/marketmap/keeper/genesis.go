@@ -30,6 +30,22 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	for _, pm := range gs.PendingMarkets {
+		if err := k.SchedulePendingMarket(ctx, pm); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, proposal := range gs.Proposals {
+		if err := k.SetMarketMapProposal(ctx, proposal); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetNextProposalID(ctx, gs.NextProposalId); err != nil {
+		panic(err)
+	}
+
 	if k.hooks != nil {
 		if err := k.hooks.AfterMarketGenesis(ctx, gs.MarketMap.Markets); err != nil {
 			panic(err)
@@ -54,12 +70,30 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	pendingMarkets, err := k.GetAllPendingMarkets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	proposals, err := k.GetAllMarketMapProposals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextProposalID, err := k.GetNextProposalID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		MarketMap: types.MarketMap{
 			Markets: markets,
 		},
-		LastUpdated: lastUpdated,
-		Params:      params,
+		LastUpdated:    lastUpdated,
+		Params:         params,
+		PendingMarkets: pendingMarkets,
+		Proposals:      proposals,
+		NextProposalId: nextProposalID,
 	}
 }
 
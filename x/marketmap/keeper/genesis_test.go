@@ -114,4 +114,42 @@ func (s *KeeperTestSuite) TestInitExportGenesisValid() {
 
 		s.Require().Equal(gs, gotState)
 	})
+
+	s.Run("init valid genesis with pending markets", func() {
+		gs := types.DefaultGenesisState()
+		gs.PendingMarkets = []types.PendingMarket{
+			types.NewPendingMarket(btcusdt, 100, nil, types.PendingMarketActionCreate, s.marketAuthorities[0]),
+		}
+
+		s.Require().NotPanics(func() {
+			s.keeper.InitGenesis(s.ctx, *gs)
+		})
+
+		var gotState *types.GenesisState
+		s.Require().NotPanics(func() {
+			gotState = s.keeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs.PendingMarkets, gotState.PendingMarkets)
+	})
+
+	s.Run("init valid genesis with proposals", func() {
+		gs := types.DefaultGenesisState()
+		gs.Proposals = []types.MarketMapProposal{
+			types.NewMarketMapProposal(3, s.marketAuthorities[0], []types.Market{btcusdt}, 10, 20),
+		}
+		gs.NextProposalId = 4
+
+		s.Require().NotPanics(func() {
+			s.keeper.InitGenesis(s.ctx, *gs)
+		})
+
+		var gotState *types.GenesisState
+		s.Require().NotPanics(func() {
+			gotState = s.keeper.ExportGenesis(s.ctx)
+		})
+
+		s.Require().Equal(gs.Proposals, gotState.Proposals)
+		s.Require().Equal(gs.NextProposalId, gotState.NextProposalId)
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
@@ -33,6 +34,25 @@ type Keeper struct {
 	// params is the module's parameters.
 	params collections.Item[types.Params]
 
+	// pendingMarkets is keyed by CurrencyPair string (BASE/QUOTE) and contains the market creates and
+	// updates scheduled to be applied at a future block height or time.
+	pendingMarkets collections.Map[types.TickerString, types.PendingMarket]
+
+	// pendingMarketsByHeight indexes the pending markets scheduled by height by (activation height, ticker).
+	pendingMarketsByHeight collections.KeySet[collections.Pair[uint64, types.TickerString]]
+
+	// pendingMarketsByTime indexes the pending markets scheduled by time by (activation time, ticker).
+	pendingMarketsByTime collections.KeySet[collections.Pair[time.Time, types.TickerString]]
+
+	// proposals is keyed by proposal id and contains the market map proposals under review.
+	proposals collections.Map[uint64, types.MarketMapProposal]
+
+	// proposalsByReviewEnd indexes the market map proposals by (review end height, proposal id).
+	proposalsByReviewEnd collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// nextProposalID is the id assigned to the next submitted market map proposal.
+	nextProposalID collections.Sequence
+
 	// deleteValidationHooks are called by the keeper before any deletion call is performed.
 	deleteMarketValidationHooks types.MarketValidationHooks
 }
@@ -55,6 +75,12 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 		markets:                     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
 		lastUpdated:                 collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
 		params:                      params,
+		pendingMarkets:              collections.NewMap(sb, types.PendingMarketsPrefix, "pending_markets", types.TickersCodec, codec.CollValue[types.PendingMarket](cdc)),
+		pendingMarketsByHeight:      collections.NewKeySet(sb, types.PendingMarketsByHeightPrefix, "pending_markets_by_height", collections.PairKeyCodec(collections.Uint64Key, types.TickersCodec)),
+		pendingMarketsByTime:        collections.NewKeySet(sb, types.PendingMarketsByTimePrefix, "pending_markets_by_time", collections.PairKeyCodec(sdk.TimeKey, types.TickersCodec)),
+		proposals:                   collections.NewMap(sb, types.ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[types.MarketMapProposal](cdc)),
+		proposalsByReviewEnd:        collections.NewKeySet(sb, types.ProposalsByReviewEndPrefix, "proposals_by_review_end", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		nextProposalID:              collections.NewSequence(sb, types.NextProposalIDPrefix, "next_proposal_id"),
 		hooks:                       &types.NoopMarketMapHooks{},
 		deleteMarketValidationHooks: types.DefaultDeleteMarketValidationHooks(),
 	}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the x/marketmap module.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator for the given keeper.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/marketmap module from consensus version 1 to 2. Params stored by version 1
+// have no proposal review period or quorum, so the defaults of both are written into the existing params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+
+	params.ProposalReviewPeriod = types.DefaultProposalReviewPeriod
+	params.ProposalQuorum = types.DefaultProposalQuorum
+
+	if err := params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid migrated params: %w", err)
+	}
+
+	return m.k.SetParams(ctx, params)
+}
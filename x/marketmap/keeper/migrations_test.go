@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	// params as stored by consensus version 1.
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.Params{
+		MarketAuthorities: s.marketAuthorities,
+		Admin:             s.admin,
+	}))
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Params{
+		MarketAuthorities:    s.marketAuthorities,
+		Admin:                s.admin,
+		ProposalReviewPeriod: types.DefaultProposalReviewPeriod,
+		ProposalQuorum:       types.DefaultProposalQuorum,
+	}, params)
+}
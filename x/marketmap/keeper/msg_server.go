@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
 )
@@ -34,10 +38,23 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
+	if err := ms.k.verifyProposalsNotRequired(ctx); err != nil {
+		return nil, err
+	}
+
+	// if an activation height or time is set, schedule the markets instead of applying them now
+	if types.IsScheduled(msg.ActivationHeight, msg.ActivationTime) {
+		if err := ms.schedulePendingMarkets(ctx, msg.Authority, msg.Markets, msg.ActivationHeight, msg.ActivationTime, types.PendingMarketActionUpsert); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgUpsertMarketsResponse{}, nil
+	}
+
 	// iterate over all markets and either create them (if no market exists), or update them
 	for _, market := range msg.Markets {
 		// check if market exists
-		exists, err := ms.k.HasMarket(ctx, market.Ticker.String())
+		previous, exists, err := ms.getPreviousMarket(ctx, market.Ticker.String())
 		if err != nil {
 			return nil, err
 		}
@@ -55,6 +72,13 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 				return nil, err
 			}
 
+			if err = ctx.EventManager().EmitTypedEvent(&types.EventMarketCreated{
+				Market:    market,
+				Authority: msg.Authority,
+			}); err != nil {
+				return nil, err
+			}
+
 			eventType = types.EventTypeCreateMarket
 		} else {
 			err = ms.k.UpdateMarket(ctx, market)
@@ -67,6 +91,14 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 				return nil, err
 			}
 
+			if err = ctx.EventManager().EmitTypedEvent(&types.EventMarketUpdated{
+				PreviousMarket: previous,
+				Market:         market,
+				Authority:      msg.Authority,
+			}); err != nil {
+				return nil, err
+			}
+
 			eventType = types.EventTypeUpdateMarket
 		}
 
@@ -98,6 +130,19 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
+	if err := ms.k.verifyProposalsNotRequired(ctx); err != nil {
+		return nil, err
+	}
+
+	// if an activation height or time is set, schedule the markets instead of applying them now
+	if types.IsScheduled(msg.ActivationHeight, msg.ActivationTime) {
+		if err := ms.schedulePendingMarkets(ctx, msg.Authority, msg.CreateMarkets, msg.ActivationHeight, msg.ActivationTime, types.PendingMarketActionCreate); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgCreateMarketsResponse{}, nil
+	}
+
 	// create markets
 	for _, market := range msg.CreateMarkets {
 		err := ms.k.CreateMarket(ctx, market)
@@ -110,6 +155,13 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 			return nil, fmt.Errorf("unable to run create market hook: %w", err)
 		}
 
+		if err = ctx.EventManager().EmitTypedEvent(&types.EventMarketCreated{
+			Market:    market,
+			Authority: msg.Authority,
+		}); err != nil {
+			return nil, err
+		}
+
 		event := sdk.NewEvent(
 			types.EventTypeCreateMarket,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
@@ -139,8 +191,26 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
+	if err := ms.k.verifyProposalsNotRequired(ctx); err != nil {
+		return nil, err
+	}
+
+	// if an activation height or time is set, schedule the markets instead of applying them now
+	if types.IsScheduled(msg.ActivationHeight, msg.ActivationTime) {
+		if err := ms.schedulePendingMarkets(ctx, msg.Authority, msg.UpdateMarkets, msg.ActivationHeight, msg.ActivationTime, types.PendingMarketActionUpdate); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgUpdateMarketsResponse{}, nil
+	}
+
 	for _, market := range msg.UpdateMarkets {
-		err := ms.k.UpdateMarket(ctx, market)
+		previous, _, err := ms.getPreviousMarket(ctx, market.Ticker.String())
+		if err != nil {
+			return nil, err
+		}
+
+		err = ms.k.UpdateMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to update market: %w", err)
 		}
@@ -150,6 +220,14 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 			return nil, fmt.Errorf("unable to run update market hook: %w", err)
 		}
 
+		if err = ctx.EventManager().EmitTypedEvent(&types.EventMarketUpdated{
+			PreviousMarket: previous,
+			Market:         market,
+			Authority:      msg.Authority,
+		}); err != nil {
+			return nil, err
+		}
+
 		event := sdk.NewEvent(
 			types.EventTypeUpdateMarket,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
@@ -205,10 +283,33 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgParams) (*
 		return nil, fmt.Errorf("request authority %s does not match module keeper authority %s", msg.Authority, ms.k.authority.String())
 	}
 
+	previous, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		PreviousParams: previous,
+		Params:         msg.Params,
+		Authority:      msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	if !slices.Equal(previous.MarketAuthorities, msg.Params.MarketAuthorities) {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketAuthoritiesUpdated{
+			PreviousMarketAuthorities: previous.MarketAuthorities,
+			MarketAuthorities:         msg.Params.MarketAuthorities,
+			Authority:                 msg.Authority,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgParamsResponse{}, nil
 }
 
@@ -232,6 +333,8 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 		return nil, fmt.Errorf("remove addresses must be a subset of the current market authorities")
 	}
 
+	previousAuthorities := slices.Clone(params.MarketAuthorities)
+
 	removeAddresses := make(map[string]struct{}, len(msg.RemoveAddresses))
 	for _, remove := range msg.RemoveAddresses {
 		removeAddresses[remove] = struct{}{}
@@ -243,10 +346,23 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 		}
 	}
 
+	// keep the proposal quorum reachable by the remaining market authorities
+	if params.ProposalQuorum > uint64(len(params.MarketAuthorities)) {
+		params.ProposalQuorum = uint64(len(params.MarketAuthorities))
+	}
+
 	if err := ms.k.SetParams(ctx, params); err != nil {
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketAuthoritiesUpdated{
+		PreviousMarketAuthorities: previousAuthorities,
+		MarketAuthorities:         params.MarketAuthorities,
+		Authority:                 msg.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgRemoveMarketAuthoritiesResponse{}, nil
 }
 
@@ -270,6 +386,11 @@ func (ms msgServer) RemoveMarkets(
 
 	deletedMarkets := make([]string, 0, len(msg.Markets))
 	for _, market := range msg.Markets {
+		previous, _, err := ms.getPreviousMarket(ctx, market)
+		if err != nil {
+			return nil, err
+		}
+
 		deleted, err := ms.k.DeleteMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to delete market: %w", err)
@@ -278,6 +399,13 @@ func (ms msgServer) RemoveMarkets(
 		if deleted {
 			ctx.Logger().Info(fmt.Sprintf("deleted market %s", market))
 			deletedMarkets = append(deletedMarkets, market)
+
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketRemoved{
+				PreviousMarket: previous,
+				Authority:      msg.Authority,
+			}); err != nil {
+				return nil, err
+			}
 		}
 
 		if err := ms.k.hooks.AfterMarketRemoved(ctx, market); err != nil {
@@ -296,11 +424,172 @@ func (ms msgServer) RemoveMarkets(
 		return nil, fmt.Errorf("invalid state resulting from removals: %w", err)
 	}
 
+	// bump the last updated height so that clients following it pick up the removals
+	if len(deletedMarkets) > 0 {
+		if err := ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())); err != nil { //nolint:gosec
+			return nil, err
+		}
+	}
+
 	return &types.MsgRemoveMarketsResponse{
 		DeletedMarkets: deletedMarkets,
 	}, nil
 }
 
+// EnableMarkets enables the given markets in the MarketMap. The resulting state of the MarketMap is validated, so
+// that a market cannot be enabled unless the markets it is normalized by are also enabled.
+func (ms msgServer) EnableMarkets(goCtx context.Context, msg *types.MsgEnableMarkets) (*types.MsgEnableMarketsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	if err := ms.setMarketsEnabled(ctx, msg.Authority, msg.Markets, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnableMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// DisableMarkets disables the given markets in the MarketMap. The resulting state of the MarketMap is validated, so
+// that a market cannot be disabled while it is used to normalize an enabled market.
+func (ms msgServer) DisableMarkets(goCtx context.Context, msg *types.MsgDisableMarkets) (*types.MsgDisableMarketsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	if err := ms.setMarketsEnabled(ctx, msg.Authority, msg.Markets, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDisableMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// CancelPendingMarkets cancels the scheduled activations of the given markets. All markets must have a pending
+// activation.
+func (ms msgServer) CancelPendingMarkets(
+	goCtx context.Context,
+	msg *types.MsgCancelPendingMarkets,
+) (*types.MsgCancelPendingMarketsResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	for _, market := range msg.Markets {
+		if err := ms.k.CancelPendingMarket(ctx, market, msg.Authority); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgCancelPendingMarketsResponse{}, nil
+}
+
+// setMarketsEnabled enables or disables the given markets, runs the market update hooks and emits an event for each
+// market, and then checks that the resulting state of the MarketMap is valid.
+func (ms msgServer) setMarketsEnabled(ctx sdk.Context, authority string, markets []string, enabled bool) error {
+	updated := make([]types.Market, 0, len(markets))
+	for _, ticker := range markets {
+		previous, err := ms.k.GetMarket(ctx, ticker)
+		if err != nil {
+			return fmt.Errorf("unable to get market %s: %w", ticker, err)
+		}
+
+		if enabled {
+			err = ms.k.EnableMarket(ctx, ticker)
+		} else {
+			err = ms.k.DisableMarket(ctx, ticker)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to set enabled=%t for market %s: %w", enabled, ticker, err)
+		}
+
+		market, err := ms.k.GetMarket(ctx, ticker)
+		if err != nil {
+			return err
+		}
+
+		if err := ms.k.hooks.AfterMarketUpdated(ctx, market); err != nil {
+			return fmt.Errorf("unable to run update market hook: %w", err)
+		}
+
+		var event proto.Message = &types.EventMarketDisabled{
+			PreviousMarket: previous,
+			Market:         market,
+			Authority:      authority,
+		}
+		eventType := types.EventTypeDisableMarket
+		if enabled {
+			event = &types.EventMarketEnabled{
+				PreviousMarket: previous,
+				Market:         market,
+				Authority:      authority,
+			}
+			eventType = types.EventTypeEnableMarket
+		}
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+		))
+
+		updated = append(updated, market)
+	}
+
+	// validate that the new state of the marketmap is valid
+	if err := ms.k.ValidateState(ctx, updated); err != nil {
+		return fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
+	allMarkets, err := ms.k.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	mm := types.MarketMap{Markets: allMarkets}
+	if err := mm.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid state resulting from update: %w", err)
+	}
+
+	return nil
+}
+
+// getPreviousMarket returns the market currently stored for the given ticker, if any, so that it can be included in
+// the events emitted for the market.
+func (ms msgServer) getPreviousMarket(ctx sdk.Context, ticker string) (types.Market, bool, error) {
+	market, err := ms.k.GetMarket(ctx, ticker)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.Market{}, false, nil
+	case err != nil:
+		return types.Market{}, false, fmt.Errorf("unable to get market %s: %w", ticker, err)
+	}
+
+	return market, true, nil
+}
+
 // checkMarketAuthority checks if the given authority is the x/marketmap's list of MarketAuthorities.
 func checkMarketAuthority(authority string, params types.Params) bool {
 	if len(params.MarketAuthorities) == 0 {
@@ -315,3 +604,199 @@ func checkMarketAuthority(authority string, params types.Params) bool {
 
 	return false
 }
+
+// schedulePendingMarkets adds the given markets to the pending queue, to be applied by the BeginBlocker once the
+// activation height or time is reached. The activation must be in the future, and the markets must be valid
+// targets for the given action at scheduling time.
+func (ms msgServer) schedulePendingMarkets(
+	ctx sdk.Context,
+	authority string,
+	markets []types.Market,
+	activationHeight uint64,
+	activationTime *time.Time,
+	action string,
+) error {
+	if activationHeight != 0 && activationHeight <= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return fmt.Errorf("activation height %d must be greater than the current block height %d", activationHeight, ctx.BlockHeight())
+	}
+
+	if activationTime != nil && !activationTime.After(ctx.BlockTime()) {
+		return fmt.Errorf("activation time %s must be after the current block time %s", activationTime, ctx.BlockTime())
+	}
+
+	for _, market := range markets {
+		exists, err := ms.k.HasMarket(ctx, market.Ticker.String())
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case action == types.PendingMarketActionCreate && exists:
+			return types.NewMarketAlreadyExistsError(types.TickerString(market.Ticker.String()))
+		case action == types.PendingMarketActionUpdate && !exists:
+			return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+		}
+
+		pm := types.NewPendingMarket(market, activationHeight, activationTime, action, authority)
+		if err := ms.k.SchedulePendingMarket(ctx, pm); err != nil {
+			return fmt.Errorf("unable to schedule market: %w", err)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketActivationScheduled{
+			PendingMarket: pm,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SubmitMarketMapProposal stores the given markets as a MarketMapProposal to be reviewed by the admin and the
+// market authorities before it is applied to the market map.
+func (ms msgServer) SubmitMarketMapProposal(
+	goCtx context.Context,
+	msg *types.MsgSubmitMarketMapProposal,
+) (*types.MsgSubmitMarketMapProposalResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
+	}
+
+	proposal, err := ms.k.SubmitMarketMapProposal(ctx, msg.Authority, msg.Markets)
+	if err != nil {
+		return nil, fmt.Errorf("unable to submit proposal: %w", err)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketMapProposalSubmitted{
+		Proposal: proposal,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitMarketMapProposalResponse{ProposalId: proposal.Id}, nil
+}
+
+// ApproveMarketMapProposal approves the given proposal. The proposal is applied to the market map if the signer
+// is the admin, or if a quorum of market authorities has approved it.
+func (ms msgServer) ApproveMarketMapProposal(
+	goCtx context.Context,
+	msg *types.MsgApproveMarketMapProposal,
+) (*types.MsgApproveMarketMapProposalResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, decided, err := ms.voteMarketMapProposal(ctx, msg.Signer, msg.ProposalId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !decided {
+		return &types.MsgApproveMarketMapProposalResponse{}, nil
+	}
+
+	if err := ms.k.ApplyMarketMapProposal(ctx, proposal); err != nil {
+		return nil, fmt.Errorf("unable to apply proposal %d: %w", proposal.Id, err)
+	}
+
+	return &types.MsgApproveMarketMapProposalResponse{Applied: true}, nil
+}
+
+// VetoMarketMapProposal vetoes the given proposal. The proposal is discarded if the signer is the admin, or if a
+// quorum of market authorities has vetoed it.
+func (ms msgServer) VetoMarketMapProposal(
+	goCtx context.Context,
+	msg *types.MsgVetoMarketMapProposal,
+) (*types.MsgVetoMarketMapProposalResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, decided, err := ms.voteMarketMapProposal(ctx, msg.Signer, msg.ProposalId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !decided {
+		return &types.MsgVetoMarketMapProposalResponse{}, nil
+	}
+
+	if err := ms.k.RemoveMarketMapProposal(ctx, proposal.Id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketMapProposalVetoed{
+		Proposal: proposal,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVetoMarketMapProposalResponse{Vetoed: true}, nil
+}
+
+// voteMarketMapProposal records the approval or veto of the signer on the given proposal, and returns whether the
+// vote is decisive: the signer is the admin, or the votes of the same kind have reached the proposal quorum. The
+// signer must be the admin or a market authority that has not yet voted on the proposal.
+func (ms msgServer) voteMarketMapProposal(
+	ctx sdk.Context,
+	signer string,
+	id uint64,
+	approve bool,
+) (types.MarketMapProposal, bool, error) {
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return types.MarketMapProposal{}, false, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	isAdmin := signer == params.Admin
+	if !isAdmin && !checkMarketAuthority(signer, params) {
+		return types.MarketMapProposal{}, false, fmt.Errorf("request signer %s is not the admin or a market authority", signer)
+	}
+
+	proposal, err := ms.k.GetMarketMapProposal(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MarketMapProposal{}, false, fmt.Errorf("proposal %d does not exist", id)
+		}
+		return types.MarketMapProposal{}, false, err
+	}
+
+	if !isAdmin && proposal.HasVoted(signer) {
+		return types.MarketMapProposal{}, false, fmt.Errorf("market authority %s has already voted on proposal %d", signer, id)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketMapProposalVoted{
+		ProposalId: id,
+		Voter:      signer,
+		Approve:    approve,
+	}); err != nil {
+		return types.MarketMapProposal{}, false, err
+	}
+
+	if isAdmin {
+		return proposal, true, nil
+	}
+
+	votes := &proposal.Vetoes
+	if approve {
+		votes = &proposal.Approvals
+	}
+	*votes = append(*votes, signer)
+
+	if len(*votes) >= params.ProposalQuorumThreshold() {
+		return proposal, true, nil
+	}
+
+	return proposal, false, ms.k.SetMarketMapProposal(ctx, proposal)
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/skip-mev/chaintestutil/sample"
@@ -570,6 +571,8 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		err := s.keeper.CreateMarket(s.ctx, copyBTC)
 		s.Require().NoError(err)
 
+		s.Require().NoError(s.keeper.SetLastUpdated(s.ctx, 0))
+
 		resp, err := msgServer.RemoveMarkets(s.ctx, msg)
 		s.Require().NoError(err)
 		s.Require().Equal([]string{copyBTC.Ticker.String()}, resp.DeletedMarkets)
@@ -577,6 +580,11 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		// market should not exist
 		_, err = s.keeper.GetMarket(s.ctx, copyBTC.Ticker.String())
 		s.Require().Error(err)
+
+		// check that last updated is correct
+		lastUpdated, err := s.keeper.GetLastUpdated(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(s.ctx.BlockHeight()), lastUpdated) //nolint:gosec
 	})
 
 	s.Run("do not remove enabled market", func() {
@@ -730,3 +738,282 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServerEnableDisableMarkets() {
+	hooks := mmmocks.NewMarketMapHooks(s.T())
+
+	// init keeper w/ mocked hooks
+	s.keeper = s.initKeeperWithHooks(hooks)
+
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.EnableMarkets(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		disableResp, err := msgServer.DisableMarkets(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(disableResp)
+	})
+
+	s.Run("unable to process for invalid authority", func() {
+		resp, err := msgServer.EnableMarkets(s.ctx, &types.MsgEnableMarkets{
+			Authority: sdk.AccAddress("invalid").String(),
+			Markets:   []string{btcusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+
+		disableResp, err := msgServer.DisableMarkets(s.ctx, &types.MsgDisableMarkets{
+			Authority: sdk.AccAddress("invalid").String(),
+			Markets:   []string{btcusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(disableResp)
+	})
+
+	s.Run("unable to enable a non-existent market", func() {
+		resp, err := msgServer.EnableMarkets(s.ctx, &types.MsgEnableMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{"NON/EXISTENT"},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	// create a disabled market and a disabled market normalized by it
+	copyBTC := btcusdt
+	copyBTC.Ticker.Enabled = false
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, copyBTC))
+
+	copyETH := ethusdt
+	copyETH.Ticker.Enabled = false
+	copyETH.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "normalized",
+			OffChainTicker:  "normalized",
+			NormalizeByPair: &copyBTC.Ticker.CurrencyPair,
+		},
+	}
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, copyETH))
+
+	s.Run("unable to enable a market normalized by a disabled market", func() {
+		hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(nil).Once()
+
+		cacheCtx, _ := s.ctx.CacheContext()
+		resp, err := msgServer.EnableMarkets(cacheCtx, &types.MsgEnableMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{copyETH.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("enable a market and the market it is normalized by", func() {
+		hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(nil).Twice()
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := msgServer.EnableMarkets(ctx, &types.MsgEnableMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{copyBTC.Ticker.String(), copyETH.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		for _, ticker := range []string{copyBTC.Ticker.String(), copyETH.Ticker.String()} {
+			market, err := s.keeper.GetMarket(s.ctx, ticker)
+			s.Require().NoError(err)
+			s.Require().True(market.Ticker.Enabled)
+		}
+
+		// a typed and a string event are emitted for each market
+		events := ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, 4)
+		s.Require().Equal(types.EventTypeEnableMarket, events[1].Type)
+		s.Require().Equal(types.EventTypeEnableMarket, events[3].Type)
+		s.Require().Equal(copyETH.Ticker.String(), events[3].Attributes[0].Value)
+
+		event, err := sdk.ParseTypedEvent(events[2])
+		s.Require().NoError(err)
+		enabled, ok := event.(*types.EventMarketEnabled)
+		s.Require().True(ok)
+		s.Require().False(enabled.PreviousMarket.Ticker.Enabled)
+		s.Require().True(enabled.Market.Ticker.Enabled)
+		s.Require().Equal(copyETH.Ticker.String(), enabled.Market.Ticker.String())
+		s.Require().Equal(s.marketAuthorities[0], enabled.Authority)
+	})
+
+	s.Run("unable to disable a market used to normalize an enabled market", func() {
+		hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(nil).Once()
+
+		cacheCtx, _ := s.ctx.CacheContext()
+		resp, err := msgServer.DisableMarkets(cacheCtx, &types.MsgDisableMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{copyBTC.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to disable a market if a hook fails", func() {
+		hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(fmt.Errorf("hook error")).Once()
+
+		cacheCtx, _ := s.ctx.CacheContext()
+		resp, err := msgServer.DisableMarkets(cacheCtx, &types.MsgDisableMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{copyETH.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("disable a market and the market it is normalized by", func() {
+		hooks.On("AfterMarketUpdated", mock.Anything, mock.Anything).Return(nil).Twice()
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := msgServer.DisableMarkets(ctx, &types.MsgDisableMarkets{
+			Authority: s.marketAuthorities[1],
+			Markets:   []string{copyETH.Ticker.String(), copyBTC.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		for _, ticker := range []string{copyBTC.Ticker.String(), copyETH.Ticker.String()} {
+			market, err := s.keeper.GetMarket(s.ctx, ticker)
+			s.Require().NoError(err)
+			s.Require().False(market.Ticker.Enabled)
+		}
+
+		// a typed and a string event are emitted for each market
+		events := ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, 4)
+		s.Require().Equal(types.EventTypeDisableMarket, events[1].Type)
+		s.Require().Equal(types.EventTypeDisableMarket, events[3].Type)
+		s.Require().Equal(copyETH.Ticker.String(), events[1].Attributes[0].Value)
+
+		event, err := sdk.ParseTypedEvent(events[0])
+		s.Require().NoError(err)
+		disabled, ok := event.(*types.EventMarketDisabled)
+		s.Require().True(ok)
+		s.Require().True(disabled.PreviousMarket.Ticker.Enabled)
+		s.Require().False(disabled.Market.Ticker.Enabled)
+		s.Require().Equal(copyETH.Ticker.String(), disabled.Market.Ticker.String())
+		s.Require().Equal(s.marketAuthorities[1], disabled.Authority)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServerTypedEvents() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	// parseEvent returns the typed event of the given type emitted to the ctx.
+	parseEvent := func(ctx sdk.Context, eventType string) proto.Message {
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type != eventType {
+				continue
+			}
+
+			msg, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+			return msg
+		}
+
+		s.Require().Failf("event not found", "event %s was not emitted", eventType)
+		return nil
+	}
+
+	market := usdtusd
+	market.Ticker.Enabled = false
+
+	s.Run("create emits the created market", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.CreateMarkets(ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+
+		s.Require().Equal(&types.EventMarketCreated{
+			Market:    market,
+			Authority: s.marketAuthorities[0],
+		}, parseEvent(ctx, proto.MessageName(&types.EventMarketCreated{})))
+	})
+
+	s.Run("update emits the previous and updated market", func() {
+		updated := market
+		updated.Ticker.MinProviderCount = 2
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.UpsertMarkets(ctx, &types.MsgUpsertMarkets{
+			Authority: s.marketAuthorities[1],
+			Markets:   []types.Market{updated},
+		})
+		s.Require().NoError(err)
+
+		s.Require().Equal(&types.EventMarketUpdated{
+			PreviousMarket: market,
+			Market:         updated,
+			Authority:      s.marketAuthorities[1],
+		}, parseEvent(ctx, proto.MessageName(&types.EventMarketUpdated{})))
+
+		market = updated
+	})
+
+	s.Run("remove emits the removed market", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.RemoveMarkets(ctx, &types.MsgRemoveMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []string{market.Ticker.String()},
+		})
+		s.Require().NoError(err)
+
+		s.Require().Equal(&types.EventMarketRemoved{
+			PreviousMarket: market,
+			Authority:      s.marketAuthorities[0],
+		}, parseEvent(ctx, proto.MessageName(&types.EventMarketRemoved{})))
+	})
+
+	s.Run("params update emits the previous and updated params and authorities", func() {
+		previous, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		params := types.Params{
+			MarketAuthorities: []string{s.marketAuthorities[0]},
+			Admin:             s.admin,
+		}
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.UpdateParams(ctx, &types.MsgParams{
+			Authority: s.authority.String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		s.Require().Equal(&types.EventParamsUpdated{
+			PreviousParams: previous,
+			Params:         params,
+			Authority:      s.authority.String(),
+		}, parseEvent(ctx, proto.MessageName(&types.EventParamsUpdated{})))
+
+		s.Require().Equal(&types.EventMarketAuthoritiesUpdated{
+			PreviousMarketAuthorities: previous.MarketAuthorities,
+			MarketAuthorities:         params.MarketAuthorities,
+			Authority:                 s.authority.String(),
+		}, parseEvent(ctx, proto.MessageName(&types.EventMarketAuthoritiesUpdated{})))
+	})
+
+	s.Run("removing market authorities emits the previous and updated authorities", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.RemoveMarketAuthorities(ctx, &types.MsgRemoveMarketAuthorities{
+			RemoveAddresses: []string{s.marketAuthorities[0]},
+			Admin:           s.admin,
+		})
+		s.Require().NoError(err)
+
+		s.Require().Equal(&types.EventMarketAuthoritiesUpdated{
+			PreviousMarketAuthorities: []string{s.marketAuthorities[0]},
+			MarketAuthorities:         []string{},
+			Authority:                 s.admin,
+		}, parseEvent(ctx, proto.MessageName(&types.EventMarketAuthoritiesUpdated{})))
+	})
+}
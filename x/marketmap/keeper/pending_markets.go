@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SchedulePendingMarket adds a market create or update to the pending queue, to be applied at its activation
+// height or time. A market can only have one pending activation at a time; a scheduled activation can be
+// cancelled with CancelPendingMarket.
+func (k *Keeper) SchedulePendingMarket(ctx context.Context, pm types.PendingMarket) error {
+	if err := pm.ValidateBasic(); err != nil {
+		return err
+	}
+
+	ticker := types.TickerString(pm.Market.Ticker.String())
+	exists, err := k.pendingMarkets.Has(ctx, ticker)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("market %s already has a pending activation", ticker)
+	}
+
+	if pm.ActivationHeight > 0 {
+		err = k.pendingMarketsByHeight.Set(ctx, collections.Join(pm.ActivationHeight, ticker))
+	} else {
+		err = k.pendingMarketsByTime.Set(ctx, collections.Join(*pm.ActivationTime, ticker))
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.pendingMarkets.Set(ctx, ticker, pm)
+}
+
+// GetPendingMarket returns the pending market for the given ticker.
+func (k *Keeper) GetPendingMarket(ctx context.Context, tickerStr string) (types.PendingMarket, error) {
+	return k.pendingMarkets.Get(ctx, types.TickerString(tickerStr))
+}
+
+// GetAllPendingMarkets returns all pending markets, sorted by ticker.
+func (k *Keeper) GetAllPendingMarkets(ctx context.Context) ([]types.PendingMarket, error) {
+	iter, err := k.pendingMarkets.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// ActivatePendingMarkets applies all pending markets whose activation height or time has been reached, in order of
+// their tickers. Only the due range of the activation indexes is iterated. Each market is applied in isolation: a
+// market whose create or update fails, or which would leave the market map in an invalid state, is discarded and an
+// EventMarketActivationFailed is emitted, without affecting the other pending markets.
+func (k *Keeper) ActivatePendingMarkets(ctx sdk.Context) error {
+	pending, err := k.getDuePendingMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	activated := false
+	for _, pm := range pending {
+		ticker := pm.Market.Ticker.String()
+		if err := k.RemovePendingMarket(ctx, ticker); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.applyPendingMarket(cacheCtx, pm); err != nil {
+			ctx.Logger().Error("failed to activate pending market", "market", ticker, "err", err)
+
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketActivationFailed{
+				PendingMarket: pm,
+				Reason:        err.Error(),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		write()
+		activated = true
+		ctx.Logger().Info("activated pending market", "market", ticker, "action", pm.Action)
+	}
+
+	if !activated {
+		return nil
+	}
+
+	return k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// applyPendingMarket applies the market of the pending market, and checks that the resulting state of the market
+// map is valid.
+func (k *Keeper) applyPendingMarket(ctx sdk.Context, pm types.PendingMarket) error {
+	if err := k.applyMarket(ctx, pm.Market, pm.Action, pm.Authority); err != nil {
+		return err
+	}
+
+	return k.validateAppliedMarkets(ctx, []types.Market{pm.Market})
+}
+
+// applyMarket creates or updates the given market according to the action (one of the PendingMarketAction
+// values), runs the market hooks and emits the corresponding event on behalf of the given authority.
+func (k *Keeper) applyMarket(ctx sdk.Context, market types.Market, action, authority string) error {
+	exists, err := k.HasMarket(ctx, market.Ticker.String())
+	if err != nil {
+		return err
+	}
+
+	create := action == types.PendingMarketActionCreate || (action == types.PendingMarketActionUpsert && !exists)
+	if create {
+		if err := k.CreateMarket(ctx, market); err != nil {
+			return err
+		}
+
+		if err := k.hooks.AfterMarketCreated(ctx, market); err != nil {
+			return fmt.Errorf("unable to run create market hook: %w", err)
+		}
+
+		return ctx.EventManager().EmitTypedEvent(&types.EventMarketCreated{
+			Market:    market,
+			Authority: authority,
+		})
+	}
+
+	if !exists {
+		return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+	}
+
+	previous, err := k.GetMarket(ctx, market.Ticker.String())
+	if err != nil {
+		return err
+	}
+
+	if err := k.UpdateMarket(ctx, market); err != nil {
+		return err
+	}
+
+	if err := k.hooks.AfterMarketUpdated(ctx, market); err != nil {
+		return fmt.Errorf("unable to run update market hook: %w", err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarketUpdated{
+		PreviousMarket: previous,
+		Market:         market,
+		Authority:      authority,
+	})
+}
+
+// validateAppliedMarkets checks that the state of the market map resulting from applying the given markets is
+// valid.
+func (k *Keeper) validateAppliedMarkets(ctx sdk.Context, markets []types.Market) error {
+	if err := k.ValidateState(ctx, markets); err != nil {
+		return fmt.Errorf("invalid state resulting from activation: %w", err)
+	}
+
+	allMarkets, err := k.GetAllMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	mm := types.MarketMap{Markets: allMarkets}
+	if err := mm.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid state resulting from activation: %w", err)
+	}
+
+	return nil
+}
+
+// getDuePendingMarkets returns the pending markets whose activation height or time has been reached, sorted by
+// ticker.
+func (k *Keeper) getDuePendingMarkets(ctx sdk.Context) ([]types.PendingMarket, error) {
+	var tickers []types.TickerString
+	if ctx.BlockHeight() > 0 {
+		iter, err := k.pendingMarketsByHeight.Iterate(
+			ctx,
+			collections.NewPrefixUntilPairRange[uint64, types.TickerString](uint64(ctx.BlockHeight())),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		keys, err := iter.Keys()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			tickers = append(tickers, key.K2())
+		}
+	}
+
+	iter, err := k.pendingMarketsByTime.Iterate(
+		ctx,
+		collections.NewPrefixUntilPairRange[time.Time, types.TickerString](ctx.BlockTime()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		tickers = append(tickers, key.K2())
+	}
+
+	slices.Sort(tickers)
+
+	pending := make([]types.PendingMarket, 0, len(tickers))
+	for _, ticker := range tickers {
+		pm, err := k.pendingMarkets.Get(ctx, ticker)
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, pm)
+	}
+
+	return pending, nil
+}
+
+// RemovePendingMarket removes the pending activation of the market for the given ticker.
+func (k *Keeper) RemovePendingMarket(ctx context.Context, tickerStr string) error {
+	ticker := types.TickerString(tickerStr)
+	pm, err := k.pendingMarkets.Get(ctx, ticker)
+	if err != nil {
+		return err
+	}
+
+	if pm.ActivationHeight > 0 {
+		err = k.pendingMarketsByHeight.Remove(ctx, collections.Join(pm.ActivationHeight, ticker))
+	} else {
+		err = k.pendingMarketsByTime.Remove(ctx, collections.Join(*pm.ActivationTime, ticker))
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.pendingMarkets.Remove(ctx, ticker)
+}
+
+// CancelPendingMarket removes the pending activation of the market for the given ticker, and emits an
+// EventMarketActivationCancelled on behalf of the given authority. It returns an error if the market has no
+// pending activation.
+func (k *Keeper) CancelPendingMarket(ctx sdk.Context, tickerStr, authority string) error {
+	pm, err := k.GetPendingMarket(ctx, tickerStr)
+	if err != nil {
+		return fmt.Errorf("market %s has no pending activation: %w", tickerStr, err)
+	}
+
+	if err := k.RemovePendingMarket(ctx, tickerStr); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarketActivationCancelled{
+		PendingMarket: pm,
+		Authority:     authority,
+	})
+}
@@ -0,0 +1,316 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServerScheduleMarkets() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	queryServer := keeper.NewQueryServer(s.keeper)
+
+	activationHeight := uint64(s.ctx.BlockHeight()) + 5 //nolint:gosec
+
+	s.Run("scheduled create does not modify the market map", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.CreateMarkets(ctx, &types.MsgCreateMarkets{
+			Authority:        s.marketAuthorities[0],
+			CreateMarkets:    []types.Market{btcusdt, usdtusd},
+			ActivationHeight: activationHeight,
+		})
+		s.Require().NoError(err)
+
+		has, err := s.keeper.HasMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+		s.Require().Empty(s.oracleKeeper.GetAllCurrencyPairs(s.ctx))
+
+		resp, err := queryServer.PendingMarkets(s.ctx, &types.PendingMarketsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingMarket{
+			types.NewPendingMarket(btcusdt, activationHeight, nil, types.PendingMarketActionCreate, s.marketAuthorities[0]),
+			types.NewPendingMarket(usdtusd, activationHeight, nil, types.PendingMarketActionCreate, s.marketAuthorities[0]),
+		}, resp.PendingMarkets)
+
+		var scheduled int
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type == proto.MessageName(&types.EventMarketActivationScheduled{}) {
+				scheduled++
+			}
+		}
+		s.Require().Equal(2, scheduled)
+	})
+
+	s.Run("market with a pending activation cannot be scheduled again", func() {
+		_, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority:        s.marketAuthorities[0],
+			Markets:          []types.Market{btcusdt},
+			ActivationHeight: activationHeight + 1,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("activation height must be in the future", func() {
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:        s.marketAuthorities[0],
+			CreateMarkets:    []types.Market{ethusdt},
+			ActivationHeight: uint64(s.ctx.BlockHeight()), //nolint:gosec
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("activation time must be in the future", func() {
+		activationTime := s.ctx.BlockTime()
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:      s.marketAuthorities[0],
+			CreateMarkets:  []types.Market{ethusdt},
+			ActivationTime: &activationTime,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("scheduled update of a non-existent market fails", func() {
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:        s.marketAuthorities[0],
+			UpdateMarkets:    []types.Market{ethusdt},
+			ActivationHeight: activationHeight,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("pending markets are not activated before the activation height", func() {
+		ctx := s.ctx.WithBlockHeight(int64(activationHeight) - 1) //nolint:gosec
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		pending, err := s.keeper.GetAllPendingMarkets(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(pending, 2)
+	})
+
+	s.Run("pending markets are activated at the activation height", func() {
+		ctx := s.ctx.WithBlockHeight(int64(activationHeight)).WithEventManager(sdk.NewEventManager()) //nolint:gosec
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		pending, err := s.keeper.GetAllPendingMarkets(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(pending)
+
+		got, err := s.keeper.GetAllMarkets(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]types.Market{
+			btcusdt.Ticker.String(): btcusdt,
+			usdtusd.Ticker.String(): usdtusd,
+		}, got)
+
+		// hooks are run on activation
+		s.Require().Equal(
+			[]connecttypes.CurrencyPair{btcusdt.Ticker.CurrencyPair, usdtusd.Ticker.CurrencyPair},
+			s.oracleKeeper.GetAllCurrencyPairs(ctx),
+		)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(activationHeight, lastUpdated)
+	})
+}
+
+func (s *KeeperTestSuite) TestActivatePendingMarkets() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{btcusdt, usdtusd},
+	})
+	s.Require().NoError(err)
+
+	activationTime := s.ctx.BlockTime().Add(time.Hour)
+
+	updated := btcusdt
+	updated.Ticker.Decimals = 10
+
+	s.Run("scheduled update is activated at the activation time", func() {
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:      s.marketAuthorities[1],
+			UpdateMarkets:  []types.Market{updated},
+			ActivationTime: &activationTime,
+		})
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithBlockTime(activationTime).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		got, err := s.keeper.GetMarket(ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(updated, got)
+	})
+
+	s.Run("failed activation is discarded without affecting other markets", func() {
+		// ethusdt is normalized by a market that will not exist at activation
+		invalid := ethusdt
+		invalid.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "eth-usdt",
+				NormalizeByPair: &connecttypes.CurrencyPair{
+					Base:  "USDC",
+					Quote: "USD",
+				},
+			},
+		}
+
+		activationHeight := uint64(s.ctx.BlockHeight()) + 1 //nolint:gosec
+		_, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority:        s.marketAuthorities[0],
+			Markets:          []types.Market{invalid, usdcusd},
+			ActivationHeight: activationHeight,
+		})
+		s.Require().NoError(err)
+
+		// remove the usdc/usd pending market so that the normalization pair is never created
+		s.Require().NoError(s.keeper.RemovePendingMarket(s.ctx, usdcusd.Ticker.String()))
+
+		ctx := s.ctx.WithBlockHeight(int64(activationHeight)).WithEventManager(sdk.NewEventManager()) //nolint:gosec
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		has, err := s.keeper.HasMarket(ctx, invalid.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		pending, err := s.keeper.GetAllPendingMarkets(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(pending)
+
+		var failed *types.EventMarketActivationFailed
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type != proto.MessageName(&types.EventMarketActivationFailed{}) {
+				continue
+			}
+
+			msg, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+			failed = msg.(*types.EventMarketActivationFailed)
+		}
+		s.Require().NotNil(failed)
+		s.Require().Equal(invalid, failed.PendingMarket.Market)
+		s.Require().NotEmpty(failed.Reason)
+	})
+}
+
+func (s *KeeperTestSuite) TestCancelPendingMarkets() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	activationHeight := uint64(s.ctx.BlockHeight()) + 5 //nolint:gosec
+	activationTime := s.ctx.BlockTime().Add(time.Hour)
+
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:        s.marketAuthorities[0],
+		CreateMarkets:    []types.Market{btcusdt},
+		ActivationHeight: activationHeight,
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:      s.marketAuthorities[0],
+		CreateMarkets:  []types.Market{usdtusd},
+		ActivationTime: &activationTime,
+	})
+	s.Require().NoError(err)
+
+	s.Run("only market authorities can cancel pending activations", func() {
+		_, err := msgServer.CancelPendingMarkets(s.ctx, &types.MsgCancelPendingMarkets{
+			Authority: s.admin,
+			Markets:   []string{btcusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("markets without a pending activation cannot be cancelled", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := msgServer.CancelPendingMarkets(cacheCtx, &types.MsgCancelPendingMarkets{
+			Authority: s.marketAuthorities[1],
+			Markets:   []string{btcusdt.Ticker.String(), ethusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("cancelled markets are not activated and can be scheduled again", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.CancelPendingMarkets(ctx, &types.MsgCancelPendingMarkets{
+			Authority: s.marketAuthorities[1],
+			Markets:   []string{btcusdt.Ticker.String(), usdtusd.Ticker.String()},
+		})
+		s.Require().NoError(err)
+
+		var cancelled []types.PendingMarket
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+			cancelled = append(cancelled, msg.(*types.EventMarketActivationCancelled).PendingMarket)
+		}
+		s.Require().Equal([]types.PendingMarket{
+			types.NewPendingMarket(btcusdt, activationHeight, nil, types.PendingMarketActionCreate, s.marketAuthorities[0]),
+			types.NewPendingMarket(usdtusd, 0, &activationTime, types.PendingMarketActionCreate, s.marketAuthorities[0]),
+		}, cancelled)
+
+		pending, err := s.keeper.GetAllPendingMarkets(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(pending)
+
+		ctx = s.ctx.WithBlockHeight(int64(activationHeight)).WithBlockTime(activationTime) //nolint:gosec
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		has, err := s.keeper.HasMarket(ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:        s.marketAuthorities[0],
+			CreateMarkets:    []types.Market{btcusdt},
+			ActivationHeight: activationHeight + 1,
+		})
+		s.Require().NoError(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestActivatePendingMarketsOnlyDue() {
+	activationTime := s.ctx.BlockTime().Add(time.Hour)
+	height := uint64(s.ctx.BlockHeight()) //nolint:gosec
+
+	s.Require().NoError(s.keeper.SchedulePendingMarket(s.ctx, types.NewPendingMarket(
+		btcusdt, height+1, nil, types.PendingMarketActionCreate, s.marketAuthorities[0],
+	)))
+	s.Require().NoError(s.keeper.SchedulePendingMarket(s.ctx, types.NewPendingMarket(
+		usdtusd, height+10, nil, types.PendingMarketActionCreate, s.marketAuthorities[0],
+	)))
+	s.Require().NoError(s.keeper.SchedulePendingMarket(s.ctx, types.NewPendingMarket(
+		ethusdt, 0, &activationTime, types.PendingMarketActionCreate, s.marketAuthorities[0],
+	)))
+
+	// only the market scheduled at the next height is due
+	ctx := s.ctx.WithBlockHeight(int64(height) + 1) //nolint:gosec
+	s.Require().NoError(s.keeper.ActivatePendingMarkets(ctx))
+
+	pending, err := s.keeper.GetAllPendingMarkets(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 2)
+
+	has, err := s.keeper.HasMarket(ctx, btcusdt.Ticker.String())
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	// the market scheduled by time is due once the block time reaches its activation
+	ctx = ctx.WithBlockHeight(int64(height) + 2).WithBlockTime(activationTime) //nolint:gosec
+	s.Require().NoError(s.keeper.ActivatePendingMarkets(ctx))
+
+	pending, err = s.keeper.GetAllPendingMarkets(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PendingMarket{
+		types.NewPendingMarket(usdtusd, height+10, nil, types.PendingMarketActionCreate, s.marketAuthorities[0]),
+	}, pending)
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+// SubmitMarketMapProposal stores a new MarketMapProposal for the given markets, whose review period ends
+// Params.ProposalReviewPeriod blocks after the current block.
+func (k *Keeper) SubmitMarketMapProposal(ctx sdk.Context, proposer string, markets []types.Market) (types.MarketMapProposal, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.MarketMapProposal{}, err
+	}
+
+	id, err := k.nextProposalID.Next(ctx)
+	if err != nil {
+		return types.MarketMapProposal{}, err
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	proposal := types.NewMarketMapProposal(id, proposer, markets, height, height+params.ProposalReviewPeriod)
+	if err := proposal.ValidateBasic(); err != nil {
+		return types.MarketMapProposal{}, err
+	}
+
+	return proposal, k.SetMarketMapProposal(ctx, proposal)
+}
+
+// SetMarketMapProposal sets the given proposal in state and indexes it by its review end height.
+func (k *Keeper) SetMarketMapProposal(ctx context.Context, proposal types.MarketMapProposal) error {
+	if err := k.proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return err
+	}
+
+	return k.proposalsByReviewEnd.Set(ctx, collections.Join(proposal.ReviewEndHeight, proposal.Id))
+}
+
+// GetMarketMapProposal returns the proposal with the given id.
+func (k *Keeper) GetMarketMapProposal(ctx context.Context, id uint64) (types.MarketMapProposal, error) {
+	return k.proposals.Get(ctx, id)
+}
+
+// GetAllMarketMapProposals returns all proposals under review, sorted by id.
+func (k *Keeper) GetAllMarketMapProposals(ctx context.Context) ([]types.MarketMapProposal, error) {
+	iter, err := k.proposals.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// RemoveMarketMapProposal removes the proposal with the given id and its index entry.
+func (k *Keeper) RemoveMarketMapProposal(ctx context.Context, id uint64) error {
+	proposal, err := k.proposals.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.proposalsByReviewEnd.Remove(ctx, collections.Join(proposal.ReviewEndHeight, id)); err != nil {
+		return err
+	}
+
+	return k.proposals.Remove(ctx, id)
+}
+
+// getDueMarketMapProposals returns the proposals whose review period ends at or before the given height, sorted by
+// review end height and id.
+func (k *Keeper) getDueMarketMapProposals(ctx context.Context, height uint64) ([]types.MarketMapProposal, error) {
+	iter, err := k.proposalsByReviewEnd.Iterate(ctx, collections.NewPrefixUntilPairRange[uint64, uint64](height))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]types.MarketMapProposal, 0, len(keys))
+	for _, key := range keys {
+		proposal, err := k.proposals.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
+// verifyProposalsNotRequired returns an error if Params.RequireProposals is set, in which case markets can only be
+// created or updated through a MarketMapProposal.
+func (k *Keeper) verifyProposalsNotRequired(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if params.RequireProposals {
+		return fmt.Errorf("market updates must be submitted as a market map proposal")
+	}
+
+	return nil
+}
+
+// GetNextProposalID returns the id that will be assigned to the next submitted proposal.
+func (k *Keeper) GetNextProposalID(ctx context.Context) (uint64, error) {
+	return k.nextProposalID.Peek(ctx)
+}
+
+// SetNextProposalID sets the id that will be assigned to the next submitted proposal.
+func (k *Keeper) SetNextProposalID(ctx context.Context, id uint64) error {
+	return k.nextProposalID.Set(ctx, id)
+}
+
+// ApplyMarketMapProposal removes the proposal from state and upserts its markets through the CreateMarket and
+// UpdateMarket paths, running the market hooks. The markets are applied atomically: if any of them fails, or the
+// resulting market map is invalid, no market is applied and an error is returned.
+func (k *Keeper) ApplyMarketMapProposal(ctx sdk.Context, proposal types.MarketMapProposal) error {
+	if err := k.RemoveMarketMapProposal(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	for _, market := range proposal.Markets {
+		if err := k.applyMarket(cacheCtx, market, types.PendingMarketActionUpsert, proposal.Proposer); err != nil {
+			return err
+		}
+	}
+
+	if err := k.validateAppliedMarkets(cacheCtx, proposal.Markets); err != nil {
+		return err
+	}
+
+	write()
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketMapProposalApplied{
+		Proposal: proposal,
+	}); err != nil {
+		return err
+	}
+
+	return k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// ApplyDueMarketMapProposals applies all proposals whose review period has ended. A proposal that cannot be
+// applied is discarded and an EventMarketMapProposalFailed is emitted, without affecting the other proposals.
+func (k *Keeper) ApplyDueMarketMapProposals(ctx sdk.Context) error {
+	proposals, err := k.getDueMarketMapProposals(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range proposals {
+		if err := k.ApplyMarketMapProposal(ctx, proposal); err != nil {
+			ctx.Logger().Error("failed to apply market map proposal", "id", proposal.Id, "err", err)
+
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketMapProposalFailed{
+				Proposal: proposal,
+				Reason:   err.Error(),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		ctx.Logger().Info("applied market map proposal", "id", proposal.Id)
+	}
+
+	return nil
+}
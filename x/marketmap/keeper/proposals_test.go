@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/keeper"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServerMarketMapProposals() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.ProposalReviewPeriod = 10
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	// submit returns the id of a new proposal for the given markets
+	submit := func(markets ...types.Market) uint64 {
+		resp, err := msgServer.SubmitMarketMapProposal(s.ctx, &types.MsgSubmitMarketMapProposal{
+			Authority: s.marketAuthorities[0],
+			Markets:   markets,
+		})
+		s.Require().NoError(err)
+		return resp.ProposalId
+	}
+
+	s.Run("only market authorities can submit proposals", func() {
+		_, err := msgServer.SubmitMarketMapProposal(s.ctx, &types.MsgSubmitMarketMapProposal{
+			Authority: s.admin,
+			Markets:   []types.Market{btcusdt},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("submitted proposal is held for review", func() {
+		id := submit(usdtusd)
+
+		proposal, err := s.keeper.GetMarketMapProposal(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.NewMarketMapProposal(
+			id,
+			s.marketAuthorities[0],
+			[]types.Market{usdtusd},
+			uint64(s.ctx.BlockHeight()),    //nolint:gosec
+			uint64(s.ctx.BlockHeight())+10, //nolint:gosec
+		), proposal)
+
+		has, err := s.keeper.HasMarket(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		// the proposal is applied at the end of the review period
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 9)
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+		_, err = s.keeper.GetMarketMapProposal(ctx, id)
+		s.Require().NoError(err)
+
+		ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		got, err := s.keeper.GetMarket(ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(usdtusd, got)
+
+		_, err = s.keeper.GetMarketMapProposal(ctx, id)
+		s.Require().Error(err)
+		s.Require().True(hasEvent(ctx, &types.EventMarketMapProposalApplied{}))
+	})
+
+	s.Run("admin approval applies the proposal immediately", func() {
+		id := submit(btcusdt)
+
+		resp, err := msgServer.ApproveMarketMapProposal(s.ctx, &types.MsgApproveMarketMapProposal{
+			Signer:     s.admin,
+			ProposalId: id,
+		})
+		s.Require().NoError(err)
+		s.Require().True(resp.Applied)
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(btcusdt, got)
+	})
+
+	s.Run("quorum of market authorities applies the proposal", func() {
+		updated := btcusdt
+		updated.Ticker.Decimals = 10
+		id := submit(updated)
+
+		resp, err := msgServer.ApproveMarketMapProposal(s.ctx, &types.MsgApproveMarketMapProposal{
+			Signer:     s.marketAuthorities[1],
+			ProposalId: id,
+		})
+		s.Require().NoError(err)
+		s.Require().False(resp.Applied)
+
+		// a market authority cannot vote twice
+		_, err = msgServer.VetoMarketMapProposal(s.ctx, &types.MsgVetoMarketMapProposal{
+			Signer:     s.marketAuthorities[1],
+			ProposalId: id,
+		})
+		s.Require().Error(err)
+
+		resp, err = msgServer.ApproveMarketMapProposal(s.ctx, &types.MsgApproveMarketMapProposal{
+			Signer:     s.marketAuthorities[2],
+			ProposalId: id,
+		})
+		s.Require().NoError(err)
+		s.Require().True(resp.Applied)
+
+		got, err := s.keeper.GetMarket(s.ctx, btcusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(updated, got)
+	})
+
+	s.Run("quorum of market authorities vetoes the proposal", func() {
+		id := submit(ethusdt)
+
+		for i, authority := range s.marketAuthorities[:2] {
+			resp, err := msgServer.VetoMarketMapProposal(s.ctx, &types.MsgVetoMarketMapProposal{
+				Signer:     authority,
+				ProposalId: id,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(i == 1, resp.Vetoed)
+		}
+
+		_, err := s.keeper.GetMarketMapProposal(s.ctx, id)
+		s.Require().Error(err)
+
+		has, err := s.keeper.HasMarket(s.ctx, ethusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+	})
+
+	s.Run("admin veto discards the proposal", func() {
+		id := submit(ethusdt)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := msgServer.VetoMarketMapProposal(ctx, &types.MsgVetoMarketMapProposal{
+			Signer:     s.admin,
+			ProposalId: id,
+		})
+		s.Require().NoError(err)
+		s.Require().True(resp.Vetoed)
+		s.Require().True(hasEvent(ctx, &types.EventMarketMapProposalVetoed{}))
+
+		_, err = s.keeper.GetMarketMapProposal(s.ctx, id)
+		s.Require().Error(err)
+	})
+
+	s.Run("other signers cannot vote", func() {
+		id := submit(ethusdt)
+
+		_, err := msgServer.ApproveMarketMapProposal(s.ctx, &types.MsgApproveMarketMapProposal{
+			Signer:     sdk.AccAddress("other").String(),
+			ProposalId: id,
+		})
+		s.Require().Error(err)
+
+		s.Require().NoError(s.keeper.RemoveMarketMapProposal(s.ctx, id))
+	})
+
+	s.Run("proposal that cannot be applied is discarded at the end of the review period", func() {
+		invalid := ethusdt
+		invalid.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "eth-usdc",
+				NormalizeByPair: &usdcusd.Ticker.CurrencyPair,
+			},
+		}
+		id := submit(invalid)
+
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		_, err := s.keeper.GetMarketMapProposal(ctx, id)
+		s.Require().Error(err)
+
+		has, err := s.keeper.HasMarket(ctx, invalid.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+		s.Require().True(hasEvent(ctx, &types.EventMarketMapProposalFailed{}))
+	})
+
+	s.Run("only proposals whose review period has ended are applied", func() {
+		early := submit(usdcusd)
+
+		longer := params
+		longer.ProposalReviewPeriod = 20
+		s.Require().NoError(s.keeper.SetParams(s.ctx, longer))
+		late := submit(ethusdt)
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10)
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		_, err := s.keeper.GetMarketMapProposal(ctx, early)
+		s.Require().Error(err)
+		has, err := s.keeper.HasMarket(ctx, usdcusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().True(has)
+
+		_, err = s.keeper.GetMarketMapProposal(ctx, late)
+		s.Require().NoError(err)
+		has, err = s.keeper.HasMarket(ctx, ethusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		s.Require().NoError(s.keeper.RemoveMarketMapProposal(ctx, late))
+	})
+
+	s.Run("direct market updates are rejected when proposals are required", func() {
+		required := params
+		required.RequireProposals = true
+		s.Require().NoError(s.keeper.SetParams(s.ctx, required))
+		defer func() {
+			s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+		}()
+
+		_, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority: s.marketAuthorities[0],
+			Markets:   []types.Market{ethusdt},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{ethusdt},
+		})
+		s.Require().Error(err)
+
+		_, err = msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{btcusdt},
+		})
+		s.Require().Error(err)
+
+		// proposals are still accepted
+		id := submit(ethusdt)
+		s.Require().NoError(s.keeper.RemoveMarketMapProposal(s.ctx, id))
+	})
+}
+
+func (s *KeeperTestSuite) TestRemoveMarketAuthoritiesCapsProposalQuorum() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.ProposalQuorum = uint64(len(params.MarketAuthorities))
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	_, err = msgServer.RemoveMarketAuthorities(s.ctx, &types.MsgRemoveMarketAuthorities{
+		RemoveAddresses: []string{s.marketAuthorities[0]},
+		Admin:           s.admin,
+	})
+	s.Require().NoError(err)
+
+	params, err = s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(len(params.MarketAuthorities)), params.ProposalQuorum)
+	s.Require().NoError(params.ValidateBasic())
+}
+
+// hasEvent returns true if a typed event of the same type as the given message was emitted to the ctx.
+func hasEvent(ctx sdk.Context, msg proto.Message) bool {
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type == proto.MessageName(msg) {
+			return true
+		}
+	}
+
+	return false
+}
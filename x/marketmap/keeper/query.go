@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/connect/v2/x/marketmap/types"
@@ -107,3 +109,133 @@ func (q queryServerImpl) Params(ctx context.Context, req *types.ParamsRequest) (
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// PendingMarkets returns all market creates and updates scheduled for activation at a future block height or
+// time.
+func (q queryServerImpl) PendingMarkets(ctx context.Context, req *types.PendingMarketsRequest) (*types.PendingMarketsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	pending, err := q.k.GetAllPendingMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PendingMarketsResponse{PendingMarkets: pending}, nil
+}
+
+// MarketMapProposals returns all market map proposals under review.
+func (q queryServerImpl) MarketMapProposals(ctx context.Context, req *types.MarketMapProposalsRequest) (*types.MarketMapProposalsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	proposals, err := q.k.GetAllMarketMapProposals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketMapProposalsResponse{Proposals: proposals}, nil
+}
+
+// MarketMapProposal returns the market map proposal under review with the requested id.
+func (q queryServerImpl) MarketMapProposal(ctx context.Context, req *types.MarketMapProposalRequest) (*types.MarketMapProposalResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	proposal, err := q.k.GetMarketMapProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketMapProposalResponse{Proposal: proposal}, nil
+}
+
+// SimulateMarketUpdates performs a dry run of upserting the requested markets. The markets are applied to a
+// cached branch of the state, which is discarded, in the same way as in MsgUpsertMarkets: every validation error
+// encountered along the way (whether proposals are required, stateless market validation, create and update checks,
+// market hooks, normalization markets and the validity of the resulting market map) is collected in the response,
+// along with the diff of each market against the current state.
+func (q queryServerImpl) SimulateMarketUpdates(goCtx context.Context, req *types.SimulateMarketUpdatesRequest) (*types.SimulateMarketUpdatesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context, and branch it so that no state is written
+	ctx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+
+	var (
+		diffs    = make([]types.MarketDiff, 0, len(req.Markets))
+		errs     []string
+		applied  = make([]types.Market, 0, len(req.Markets))
+		seen     = make(map[string]struct{}, len(req.Markets))
+		addError = func(ticker string, err error) {
+			errs = append(errs, fmt.Sprintf("market %s: %s", ticker, err))
+		}
+	)
+
+	// MsgUpsertMarkets is rejected if market updates must be submitted as a market map proposal
+	if err := q.k.verifyProposalsNotRequired(ctx); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if len(req.Markets) == 0 {
+		errs = append(errs, "no markets to upsert")
+	}
+
+	for _, market := range req.Markets {
+		ticker := market.Ticker.String()
+		if _, ok := seen[ticker]; ok {
+			addError(ticker, fmt.Errorf("duplicate ticker"))
+			continue
+		}
+		seen[ticker] = struct{}{}
+
+		previous, err := q.k.GetMarket(ctx, ticker)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			diffs = append(diffs, types.NewMarketDiff(nil, market))
+		case err != nil:
+			return nil, err
+		default:
+			diffs = append(diffs, types.NewMarketDiff(&previous, market))
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			addError(ticker, err)
+			continue
+		}
+
+		if err := q.k.applyMarket(ctx, market, types.PendingMarketActionUpsert, ""); err != nil {
+			addError(ticker, err)
+			continue
+		}
+
+		applied = append(applied, market)
+	}
+
+	// validate the resulting state as MsgUpsertMarkets does, reporting every invalid market
+	for _, market := range applied {
+		if err := q.k.IsMarketValid(ctx, market); err != nil {
+			addError(market.Ticker.String(), err)
+		}
+	}
+
+	allMarkets, err := q.k.GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mm := types.MarketMap{Markets: allMarkets}
+	if err := mm.ValidateBasic(); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid state resulting from update: %s", err))
+	}
+
+	return &types.SimulateMarketUpdatesResponse{
+		Diffs:  diffs,
+		Errors: errs,
+		Valid:  len(errs) == 0,
+	}, nil
+}
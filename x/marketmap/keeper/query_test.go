@@ -180,3 +180,85 @@ func (s *KeeperTestSuite) TestLastUpdated() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestSimulateMarketUpdates() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+	s.Run("invalid for nil request", func() {
+		_, err := qs.SimulateMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("valid updates return diffs without modifying state", func() {
+		updated := usdtusd
+		updated.Ticker.Decimals = 10
+
+		resp, err := qs.SimulateMarketUpdates(s.ctx, &types.SimulateMarketUpdatesRequest{
+			Markets: []types.Market{usdcusd, updated},
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Errors)
+		s.Require().True(resp.Valid)
+		s.Require().Equal([]types.MarketDiff{
+			types.NewMarketDiff(nil, usdcusd),
+			types.NewMarketDiff(&usdtusd, updated),
+		}, resp.Diffs)
+		s.Require().Equal(uint64(8), resp.Diffs[1].PreviousDecimals)
+		s.Require().Equal(uint64(10), resp.Diffs[1].Decimals)
+
+		has, err := s.keeper.HasMarket(s.ctx, usdcusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		got, err := s.keeper.GetMarket(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(usdtusd, got)
+	})
+
+	s.Run("every validation error is returned", func() {
+		// invalid stateless market
+		invalid := btcusdt
+		invalid.Ticker.Decimals = 0
+
+		// enabled market normalized by a market that does not exist
+		normalized := ethusdt
+		normalized.Ticker.Enabled = true
+		normalized.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "eth-btc",
+				NormalizeByPair: &connecttypes.CurrencyPair{
+					Base:  "BTC",
+					Quote: "USD",
+				},
+			},
+		}
+
+		resp, err := qs.SimulateMarketUpdates(s.ctx, &types.SimulateMarketUpdatesRequest{
+			Markets: []types.Market{invalid, normalized, normalized},
+		})
+		s.Require().NoError(err)
+		s.Require().False(resp.Valid)
+		s.Require().Len(resp.Diffs, 2)
+
+		// stateless error, duplicate ticker, missing normalize market and invalid resulting market map
+		s.Require().Len(resp.Errors, 4)
+	})
+
+	s.Run("updates are invalid if proposals are required", func() {
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.RequireProposals = true
+		params.ProposalReviewPeriod = types.DefaultProposalReviewPeriod
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		resp, err := qs.SimulateMarketUpdates(s.ctx, &types.SimulateMarketUpdatesRequest{
+			Markets: []types.Market{usdcusd},
+		})
+		s.Require().NoError(err)
+		s.Require().False(resp.Valid)
+		s.Require().Equal([]string{"market updates must be submitted as a market map proposal"}, resp.Errors)
+	})
+}
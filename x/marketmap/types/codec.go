@@ -17,6 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "connect/x/marketmap/MsgParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpsertMarkets{}, "connect/x/marketmap/MsgUpsertMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarkets{}, "connect/x/marketmap/MsgRemoveMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgEnableMarkets{}, "connect/x/marketmap/MsgEnableMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgDisableMarkets{}, "connect/x/marketmap/MsgDisableMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPendingMarkets{}, "connect/x/marketmap/MsgCancelPending")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitMarketMapProposal{}, "connect/x/marketmap/MsgSubmitProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveMarketMapProposal{}, "connect/x/marketmap/MsgApproveProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoMarketMapProposal{}, "connect/x/marketmap/MsgVetoProposal")
 }
 
 // RegisterInterfaces registers the x/marketmap messages + message service w/ the InterfaceRegistry (registry).
@@ -29,6 +35,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpsertMarkets{},
 		&MsgRemoveMarkets{},
 		&MsgRemoveMarketAuthorities{},
+		&MsgEnableMarkets{},
+		&MsgDisableMarkets{},
+		&MsgCancelPendingMarkets{},
+		&MsgSubmitMarketMapProposal{},
+		&MsgApproveMarketMapProposal{},
+		&MsgVetoMarketMapProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
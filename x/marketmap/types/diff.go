@@ -0,0 +1,68 @@
+package types
+
+// providerKey identifies a provider config within a market by its provider name and off-chain ticker.
+type providerKey struct {
+	name           string
+	offChainTicker string
+}
+
+// newProviderKey returns the providerKey of the given provider config.
+func newProviderKey(providerConfig ProviderConfig) providerKey {
+	return providerKey{
+		name:           providerConfig.Name,
+		offChainTicker: providerConfig.OffChainTicker,
+	}
+}
+
+// NewMarketDiff returns the difference between the given previous market, which is nil if the market does not
+// exist, and the market that would replace it. Provider configs are identified by provider name and off-chain
+// ticker.
+func NewMarketDiff(previous *Market, market Market) MarketDiff {
+	diff := MarketDiff{
+		Ticker:           market.Ticker.String(),
+		Created:          previous == nil,
+		Decimals:         market.Ticker.Decimals,
+		MinProviderCount: market.Ticker.MinProviderCount,
+		Enabled:          market.Ticker.Enabled,
+	}
+
+	if previous == nil {
+		diff.AddedProviders = market.ProviderConfigs
+		return diff
+	}
+
+	diff.PreviousDecimals = previous.Ticker.Decimals
+	diff.PreviousMinProviderCount = previous.Ticker.MinProviderCount
+	diff.PreviousEnabled = previous.Ticker.Enabled
+	diff.MetadataChanged = previous.Ticker.Metadata_JSON != market.Ticker.Metadata_JSON
+
+	previousProviders := make(map[providerKey]ProviderConfig, len(previous.ProviderConfigs))
+	for _, providerConfig := range previous.ProviderConfigs {
+		previousProviders[newProviderKey(providerConfig)] = providerConfig
+	}
+
+	seenProviders := make(map[providerKey]struct{}, len(market.ProviderConfigs))
+	for _, providerConfig := range market.ProviderConfigs {
+		key := newProviderKey(providerConfig)
+		seenProviders[key] = struct{}{}
+
+		previousConfig, ok := previousProviders[key]
+		switch {
+		case !ok:
+			diff.AddedProviders = append(diff.AddedProviders, providerConfig)
+		case !previousConfig.Equal(providerConfig):
+			diff.ChangedProviders = append(diff.ChangedProviders, ProviderConfigDiff{
+				Previous:       previousConfig,
+				ProviderConfig: providerConfig,
+			})
+		}
+	}
+
+	for _, providerConfig := range previous.ProviderConfigs {
+		if _, ok := seenProviders[newProviderKey(providerConfig)]; !ok {
+			diff.RemovedProviders = append(diff.RemovedProviders, providerConfig)
+		}
+	}
+
+	return diff
+}
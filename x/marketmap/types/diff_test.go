@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types"
+)
+
+func TestNewMarketDiff(t *testing.T) {
+	previous := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     connecttypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         8,
+			MinProviderCount: 2,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "btc-usdt"},
+			{Name: "okx", OffChainTicker: "BTC-USDT"},
+			{Name: "mexc", OffChainTicker: "BTCUSDT"},
+		},
+	}
+
+	t.Run("created market adds all providers", func(t *testing.T) {
+		diff := types.NewMarketDiff(nil, previous)
+		require.True(t, diff.Created)
+		require.Equal(t, "BTC/USD", diff.Ticker)
+		require.Equal(t, previous.ProviderConfigs, diff.AddedProviders)
+		require.Empty(t, diff.RemovedProviders)
+		require.Empty(t, diff.ChangedProviders)
+	})
+
+	t.Run("updated market", func(t *testing.T) {
+		usdtusd := connecttypes.NewCurrencyPair("USDT", "USD")
+
+		market := previous
+		market.Ticker.Decimals = 10
+		market.Ticker.Metadata_JSON = `{"key":"value"}`
+		market.ProviderConfigs = []types.ProviderConfig{
+			{Name: "kucoin", OffChainTicker: "btc-usdt", NormalizeByPair: &usdtusd},
+			{Name: "okx", OffChainTicker: "BTC-USDT"},
+			{Name: "binance", OffChainTicker: "BTCUSDT"},
+		}
+
+		diff := types.NewMarketDiff(&previous, market)
+		require.False(t, diff.Created)
+		require.Equal(t, uint64(8), diff.PreviousDecimals)
+		require.Equal(t, uint64(10), diff.Decimals)
+		require.Equal(t, uint64(2), diff.PreviousMinProviderCount)
+		require.Equal(t, uint64(2), diff.MinProviderCount)
+		require.True(t, diff.PreviousEnabled)
+		require.True(t, diff.Enabled)
+		require.True(t, diff.MetadataChanged)
+		require.Equal(t, []types.ProviderConfig{market.ProviderConfigs[2]}, diff.AddedProviders)
+		require.Equal(t, []types.ProviderConfig{previous.ProviderConfigs[2]}, diff.RemovedProviders)
+		require.Equal(t, []types.ProviderConfigDiff{
+			{
+				Previous:       previous.ProviderConfigs[0],
+				ProviderConfig: market.ProviderConfigs[0],
+			},
+		}, diff.ChangedProviders)
+	})
+	t.Run("providers are not confused by the concatenation of their name and ticker", func(t *testing.T) {
+		before := previous
+		before.ProviderConfigs = []types.ProviderConfig{
+			{Name: "binance", OffChainTicker: "_apiBTC"},
+		}
+
+		market := previous
+		market.ProviderConfigs = []types.ProviderConfig{
+			{Name: "binance_api", OffChainTicker: "BTC"},
+		}
+
+		diff := types.NewMarketDiff(&before, market)
+		require.Equal(t, market.ProviderConfigs, diff.AddedProviders)
+		require.Equal(t, before.ProviderConfigs, diff.RemovedProviders)
+		require.Empty(t, diff.ChangedProviders)
+	})
+}
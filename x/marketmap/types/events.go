@@ -3,8 +3,10 @@ package types
 // market map module event types
 
 const (
-	EventTypeCreateMarket = "create_market"
-	EventTypeUpdateMarket = "update_market"
+	EventTypeCreateMarket  = "create_market"
+	EventTypeUpdateMarket  = "update_market"
+	EventTypeEnableMarket  = "enable_market"
+	EventTypeDisableMarket = "disable_market"
 
 	AttributeKeyCurrencyPair     = "currency_pair"
 	AttributeKeyDecimals         = "decimals"
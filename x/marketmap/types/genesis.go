@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState returns an instance of GenesisState.
 func NewGenesisState(
 	marketMap MarketMap,
@@ -19,6 +21,35 @@ func (gs *GenesisState) ValidateBasic() error {
 		return err
 	}
 
+	seen := make(map[string]struct{}, len(gs.PendingMarkets))
+	for _, pm := range gs.PendingMarkets {
+		ticker := pm.Market.Ticker.String()
+		if _, ok := seen[ticker]; ok {
+			return fmt.Errorf("duplicate pending market %s", ticker)
+		}
+		seen[ticker] = struct{}{}
+
+		if err := pm.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	seenProposals := make(map[uint64]struct{}, len(gs.Proposals))
+	for _, proposal := range gs.Proposals {
+		if _, ok := seenProposals[proposal.Id]; ok {
+			return fmt.Errorf("duplicate proposal %d", proposal.Id)
+		}
+		seenProposals[proposal.Id] = struct{}{}
+
+		if proposal.Id >= gs.NextProposalId {
+			return fmt.Errorf("proposal id %d must be less than the next proposal id %d", proposal.Id, gs.NextProposalId)
+		}
+
+		if err := proposal.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
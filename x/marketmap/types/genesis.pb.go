@@ -34,6 +34,13 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// PendingMarkets are the market creates and updates scheduled to be applied
+	// at a future block height or time.
+	PendingMarkets []PendingMarket `protobuf:"bytes,4,rep,name=pending_markets,json=pendingMarkets,proto3" json:"pending_markets"`
+	// Proposals are the market map proposals under review.
+	Proposals []MarketMapProposal `protobuf:"bytes,5,rep,name=proposals,proto3" json:"proposals"`
+	// NextProposalId is the identifier assigned to the next submitted proposal.
+	NextProposalId uint64 `protobuf:"varint,6,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +97,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPendingMarkets() []PendingMarket {
+	if m != nil {
+		return m.PendingMarkets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposals() []MarketMapProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextProposalId() uint64 {
+	if m != nil {
+		return m.NextProposalId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "connect.marketmap.v2.GenesisState")
 }
@@ -99,24 +127,30 @@ func init() {
 }
 
 var fileDescriptor_02569cd74fc8ae65 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33,
-	0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xaa, 0xd1, 0x83, 0xab, 0xd1, 0x2b, 0x33, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xe1, 0xe0, 0x55, 0x52, 0x90, 0x58,
-	0x94, 0x98, 0x0b, 0xb5, 0x51, 0x69, 0x33, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x0d, 0xc1, 0x25, 0x89,
-	0x25, 0xa9, 0x42, 0x2e, 0x5c, 0x5c, 0x10, 0xd5, 0xf1, 0xb9, 0x89, 0x05, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0xf2, 0x7a, 0xd8, 0xdc, 0xa5, 0xe7, 0x0b, 0xe6, 0xf8, 0x26, 0x16, 0x38, 0xb1,
-	0x9c, 0xb8, 0x27, 0xcf, 0x10, 0xc4, 0x99, 0x0b, 0x13, 0x10, 0x52, 0xe4, 0xe2, 0xc9, 0x49, 0x2c,
-	0x2e, 0x89, 0x2f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x4d, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09,
-	0xe2, 0x06, 0x89, 0x85, 0x42, 0x84, 0x84, 0xac, 0xb8, 0xd8, 0x20, 0x2e, 0x91, 0x60, 0x06, 0x5b,
-	0x22, 0x83, 0xdd, 0x92, 0x00, 0xb0, 0x1a, 0xa8, 0x0d, 0x50, 0x1d, 0x4e, 0x5e, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x5f, 0x9c, 0x9d, 0x59, 0xa0, 0x9b, 0x9b, 0x5a, 0xa6, 0x0f, 0x0b, 0x86, 0x32,
-	0x23, 0xfd, 0x0a, 0xa4, 0xb0, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x84, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x2d, 0x44, 0xf7, 0xa0, 0x01, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0xdb, 0x6d, 0x0e, 0x96, 0x8d, 0x29, 0x61, 0x87, 0x32, 0xa4, 0xfb, 0x77, 0xb0, 0x17,
+	0x1b, 0xa9, 0x37, 0x8f, 0x43, 0x10, 0x95, 0xc1, 0x98, 0x78, 0xf1, 0x52, 0xb2, 0x35, 0xd4, 0xb2,
+	0xa5, 0x09, 0x4d, 0x56, 0xe6, 0xb7, 0xf0, 0x63, 0xed, 0xb8, 0xa3, 0x27, 0x91, 0xed, 0x7b, 0x88,
+	0x2c, 0x69, 0xc7, 0x0e, 0x55, 0x6f, 0xc9, 0xc3, 0xef, 0x7d, 0x9e, 0x27, 0x79, 0x41, 0x7f, 0xc6,
+	0xe2, 0x98, 0xcc, 0x24, 0xa2, 0x38, 0x99, 0x13, 0x49, 0x31, 0x47, 0xa9, 0x87, 0x42, 0x12, 0x13,
+	0x11, 0x09, 0x97, 0x27, 0x4c, 0x32, 0xd8, 0xca, 0x18, 0xf7, 0xc0, 0xb8, 0xa9, 0xd7, 0x6e, 0x85,
+	0x2c, 0x64, 0x0a, 0x40, 0xfb, 0x93, 0x66, 0xdb, 0xbd, 0x42, 0x3f, 0x7d, 0xf9, 0x13, 0xe1, 0x38,
+	0xc1, 0x34, 0x4b, 0x6c, 0x0f, 0x8a, 0x91, 0x84, 0x71, 0x26, 0xf0, 0x42, 0x43, 0xfd, 0xef, 0x12,
+	0x68, 0xdc, 0xe9, 0xa2, 0x4f, 0x12, 0x4b, 0x02, 0x6f, 0x01, 0xd0, 0xbc, 0x4f, 0x31, 0xb7, 0xcc,
+	0xae, 0xe9, 0xd4, 0xbd, 0x8e, 0x5b, 0x54, 0xde, 0x1d, 0xa9, 0xcb, 0x08, 0xf3, 0x61, 0x65, 0xfd,
+	0xd9, 0x31, 0x26, 0x35, 0x9a, 0x0b, 0xb0, 0x07, 0x1a, 0x0b, 0x2c, 0xa4, 0xbf, 0xe4, 0x01, 0x96,
+	0x24, 0xb0, 0x4a, 0x5d, 0xd3, 0xa9, 0x4c, 0xea, 0x7b, 0xed, 0x59, 0x4b, 0xf0, 0x06, 0x54, 0x75,
+	0x5d, 0xab, 0xac, 0x42, 0xce, 0x8b, 0x43, 0xc6, 0x8a, 0xc9, 0x12, 0xb2, 0x09, 0x38, 0x01, 0xa7,
+	0x9c, 0xc4, 0x41, 0x14, 0x87, 0xbe, 0x86, 0x85, 0x55, 0xe9, 0x96, 0x9d, 0xba, 0x37, 0xf8, 0xc5,
+	0x44, 0xc3, 0xba, 0x70, 0xe6, 0xd5, 0xe4, 0xc7, 0xa2, 0x80, 0x8f, 0xa0, 0x96, 0xff, 0x8d, 0xb0,
+	0x4e, 0x94, 0xdb, 0xc5, 0x3f, 0xef, 0x1e, 0x67, 0x7c, 0xfe, 0xfe, 0xc3, 0x3c, 0x74, 0xc0, 0x59,
+	0x4c, 0x56, 0xd2, 0xcf, 0x15, 0x3f, 0x0a, 0xac, 0xaa, 0xfa, 0x83, 0xe6, 0x5e, 0xcf, 0x07, 0xef,
+	0x83, 0xe1, 0xc3, 0x7a, 0x6b, 0x9b, 0x9b, 0xad, 0x6d, 0x7e, 0x6d, 0x6d, 0xf3, 0x7d, 0x67, 0x1b,
+	0x9b, 0x9d, 0x6d, 0x7c, 0xec, 0x6c, 0xe3, 0xe5, 0x2a, 0x8c, 0xe4, 0xeb, 0x72, 0xea, 0xce, 0x18,
+	0x45, 0x62, 0x1e, 0xf1, 0x4b, 0x4a, 0x52, 0x94, 0xef, 0x34, 0xf5, 0xd0, 0xea, 0x68, 0xb1, 0xf2,
+	0x8d, 0x13, 0x31, 0xad, 0xaa, 0x9d, 0x5e, 0xff, 0x0c, 0x00, 0x6a, 0x66, 0x7c, 0xad, 0x90, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +173,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingMarkets) > 0 {
+		for iNdEx := len(m.PendingMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -191,6 +258,21 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingMarkets) > 0 {
+		for _, e := range m.PendingMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextProposalId))
+	}
 	return n
 }
 
@@ -314,6 +396,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMarkets = append(m.PendingMarkets, PendingMarket{})
+			if err := m.PendingMarkets[len(m.PendingMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, MarketMapProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
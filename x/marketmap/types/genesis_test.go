@@ -30,4 +30,47 @@ func TestGenesisState(t *testing.T) {
 		}
 		require.NoError(t, gs.ValidateBasic())
 	})
+
+	t.Run("good genesis state with pending markets", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.PendingMarkets = []types.PendingMarket{
+			types.NewPendingMarket(btcusdt, 100, nil, types.PendingMarketActionCreate, ""),
+		}
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate pending markets - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.PendingMarkets = []types.PendingMarket{
+			types.NewPendingMarket(btcusdt, 100, nil, types.PendingMarketActionCreate, ""),
+			types.NewPendingMarket(btcusdt, 200, nil, types.PendingMarketActionUpdate, ""),
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("good genesis state with proposals", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Proposals = []types.MarketMapProposal{
+			types.NewMarketMapProposal(0, gs.Params.Admin, []types.Market{btcusdt}, 10, 20),
+		}
+		gs.NextProposalId = 1
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("proposal id not less than next proposal id - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Proposals = []types.MarketMapProposal{
+			types.NewMarketMapProposal(1, gs.Params.Admin, []types.Market{btcusdt}, 10, 20),
+		}
+		gs.NextProposalId = 1
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("pending market without activation - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.PendingMarkets = []types.PendingMarket{
+			types.NewPendingMarket(btcusdt, 0, nil, types.PendingMarketActionCreate, ""),
+		}
+		require.Error(t, gs.ValidateBasic())
+	})
 }
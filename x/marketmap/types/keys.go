@@ -22,6 +22,24 @@ var (
 	// ParamsPrefix is the key prefix of the module Params.
 	ParamsPrefix = collections.NewPrefix(3)
 
+	// PendingMarketsPrefix is the key prefix for PendingMarkets.
+	PendingMarketsPrefix = collections.NewPrefix(4)
+
+	// ProposalsPrefix is the key prefix for MarketMapProposals.
+	ProposalsPrefix = collections.NewPrefix(5)
+
+	// NextProposalIDPrefix is the key prefix for the next MarketMapProposal id.
+	NextProposalIDPrefix = collections.NewPrefix(6)
+
+	// PendingMarketsByHeightPrefix is the key prefix for the index of PendingMarkets by activation height.
+	PendingMarketsByHeightPrefix = collections.NewPrefix(7)
+
+	// PendingMarketsByTimePrefix is the key prefix for the index of PendingMarkets by activation time.
+	PendingMarketsByTimePrefix = collections.NewPrefix(8)
+
+	// ProposalsByReviewEndPrefix is the key prefix for the index of MarketMapProposals by review end height.
+	ProposalsByReviewEndPrefix = collections.NewPrefix(9)
+
 	// TickersCodec is the collections.KeyCodec value used for the markets map.
 	TickersCodec = codec.NewStringKeyCodec[TickerString]()
 
@@ -7,16 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/skip-mev/connect/v2/pkg/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -302,53 +306,147 @@ func (m *MarketMap) GetMarkets() map[string]Market {
 	return nil
 }
 
+// PendingMarket is a market create or update that is scheduled to be applied
+// to the market map at a future block height or time.
+type PendingMarket struct {
+	// Market is the market to be created or updated.
+	Market Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	// ActivationHeight is the block height at which the market is applied. It
+	// is zero if the market is scheduled by time.
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// ActivationTime is the block time at which the market is applied. It is
+	// unset if the market is scheduled by height.
+	ActivationTime *time.Time `protobuf:"bytes,3,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time,omitempty"`
+	// Action is the action applied at activation, i.e. "create", "update" or
+	// "upsert".
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Authority is the signer of the message that scheduled the market.
+	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *PendingMarket) Reset()         { *m = PendingMarket{} }
+func (m *PendingMarket) String() string { return proto.CompactTextString(m) }
+func (*PendingMarket) ProtoMessage()    {}
+func (*PendingMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54627e801f077fe4, []int{4}
+}
+func (m *PendingMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMarket.Merge(m, src)
+}
+func (m *PendingMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMarket proto.InternalMessageInfo
+
+func (m *PendingMarket) GetMarket() Market {
+	if m != nil {
+		return m.Market
+	}
+	return Market{}
+}
+
+func (m *PendingMarket) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *PendingMarket) GetActivationTime() *time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return nil
+}
+
+func (m *PendingMarket) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PendingMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Market)(nil), "connect.marketmap.v2.Market")
 	proto.RegisterType((*Ticker)(nil), "connect.marketmap.v2.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "connect.marketmap.v2.ProviderConfig")
 	proto.RegisterType((*MarketMap)(nil), "connect.marketmap.v2.MarketMap")
 	proto.RegisterMapType((map[string]Market)(nil), "connect.marketmap.v2.MarketMap.MarketsEntry")
+	proto.RegisterType((*PendingMarket)(nil), "connect.marketmap.v2.PendingMarket")
 }
 
 func init() { proto.RegisterFile("connect/marketmap/v2/market.proto", fileDescriptor_54627e801f077fe4) }
 
 var fileDescriptor_54627e801f077fe4 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xd2, 0xad, 0x5e, 0xd7, 0x16, 0x6b, 0x42, 0x51, 0x85, 0xb2, 0x52, 0x76, 0xc8,
-	0x61, 0x24, 0x28, 0x5c, 0x50, 0x8f, 0xad, 0x38, 0x50, 0x31, 0x98, 0x02, 0x48, 0x88, 0x4b, 0xe4,
-	0xa6, 0x6e, 0x67, 0xb5, 0xb1, 0x23, 0xc7, 0x8d, 0x28, 0x27, 0x7e, 0x02, 0x47, 0x8e, 0x5c, 0xf8,
-	0x19, 0xdc, 0x77, 0xdc, 0x0d, 0x0e, 0x08, 0xa1, 0x56, 0xe2, 0x77, 0xa0, 0x38, 0x4e, 0xd7, 0x4a,
-	0x45, 0xda, 0xed, 0xbd, 0xe7, 0xcf, 0xdf, 0xf3, 0xf7, 0xf9, 0x3d, 0xf8, 0x20, 0xe4, 0x8c, 0x91,
-	0x50, 0xba, 0x11, 0x16, 0x53, 0x22, 0x23, 0x1c, 0xbb, 0xa9, 0xa7, 0x13, 0x27, 0x16, 0x5c, 0x72,
-	0x74, 0xac, 0x21, 0xce, 0x1a, 0xe2, 0xa4, 0x5e, 0xeb, 0x78, 0xc2, 0x27, 0x5c, 0x01, 0xdc, 0x2c,
-	0xca, 0xb1, 0xad, 0xd3, 0x82, 0x4e, 0x2e, 0x62, 0x92, 0x64, 0x54, 0xe1, 0x5c, 0x08, 0xc2, 0xc2,
-	0x45, 0x10, 0x63, 0x2a, 0x72, 0x54, 0xe7, 0x1b, 0x80, 0x95, 0x73, 0x45, 0x86, 0xba, 0xb0, 0x22,
-	0x69, 0x38, 0x25, 0xc2, 0x04, 0x6d, 0x60, 0x1f, 0x7a, 0xf7, 0x9d, 0x5d, 0xdd, 0x9c, 0x37, 0x0a,
-	0xd3, 0x2b, 0x5f, 0xfd, 0x3e, 0x31, 0x7c, 0x7d, 0x03, 0xbd, 0x85, 0xcd, 0x58, 0xf0, 0x94, 0x8e,
-	0x88, 0x08, 0x42, 0xce, 0xc6, 0x74, 0x92, 0x98, 0x7b, 0xed, 0x92, 0x7d, 0xe8, 0x9d, 0xee, 0x66,
-	0xb9, 0xd0, 0xe8, 0xbe, 0x02, 0x6b, 0xb6, 0x46, 0xbc, 0x55, 0x4d, 0xba, 0x07, 0x5f, 0xbe, 0x9e,
-	0x18, 0x9f, 0x7e, 0xb5, 0x8d, 0xce, 0x5f, 0x00, 0x2b, 0x79, 0x67, 0xf4, 0x1c, 0x1e, 0x6d, 0x29,
-	0xd1, 0xcf, 0xb5, 0xd6, 0x8d, 0x94, 0xe0, 0xac, 0x49, 0x5f, 0xc3, 0x2e, 0x30, 0x2d, 0x1e, 0x5c,
-	0x0b, 0x37, 0x6a, 0xa8, 0x05, 0x0f, 0x46, 0x24, 0xa4, 0x11, 0x9e, 0x65, 0xcf, 0x05, 0x76, 0xd9,
-	0x5f, 0xe7, 0xe8, 0x0c, 0xa2, 0x88, 0xb2, 0x60, 0x43, 0xd6, 0x9c, 0x49, 0xb3, 0xa4, 0x50, 0xcd,
-	0x88, 0xb2, 0x1b, 0x05, 0x73, 0x26, 0x91, 0x09, 0xf7, 0x09, 0xc3, 0xc3, 0x19, 0x19, 0x99, 0xf5,
-	0x36, 0xb0, 0x0f, 0xfc, 0x22, 0x45, 0x0f, 0xe1, 0x51, 0x44, 0x24, 0x1e, 0x61, 0x89, 0x83, 0xc1,
-	0xeb, 0x57, 0x2f, 0xcd, 0x46, 0x1b, 0xd8, 0x55, 0xbf, 0x56, 0x14, 0xb3, 0xda, 0x86, 0xd0, 0x1f,
-	0x00, 0xd6, 0xb7, 0xcd, 0x41, 0x08, 0x96, 0x19, 0x8e, 0x88, 0xd2, 0x59, 0xf5, 0x55, 0x8c, 0x6c,
-	0xd8, 0xe4, 0xe3, 0x71, 0x10, 0x5e, 0x62, 0xca, 0x02, 0xfd, 0x6d, 0x7b, 0xea, 0xbc, 0xce, 0xc7,
-	0xe3, 0x7e, 0x56, 0xd6, 0x76, 0x0d, 0xe0, 0x5d, 0xc6, 0x45, 0x84, 0x67, 0xf4, 0x23, 0x09, 0x86,
-	0xda, 0xb2, 0xd2, 0x6d, 0x2c, 0xf3, 0x1b, 0xeb, 0x8b, 0xbd, 0xdc, 0xaf, 0x7b, 0xb0, 0x42, 0x59,
-	0x4a, 0x84, 0x34, 0xcb, 0x4a, 0xa4, 0xce, 0x6e, 0xa5, 0xb1, 0xf3, 0x1d, 0xc0, 0x6a, 0x3e, 0x6a,
-	0xe7, 0x38, 0x46, 0x2f, 0xe0, 0x7e, 0x3e, 0x10, 0x89, 0x09, 0xd4, 0xa0, 0x9c, 0xed, 0x1e, 0x94,
-	0xf5, 0x0d, 0x1d, 0x25, 0xcf, 0x98, 0x14, 0x0b, 0xfd, 0x9b, 0x05, 0x45, 0xeb, 0x1d, 0xac, 0x6d,
-	0x1e, 0xa3, 0x26, 0x2c, 0x4d, 0xc9, 0x42, 0x3b, 0x96, 0x85, 0xc8, 0x83, 0x77, 0x52, 0x3c, 0x9b,
-	0x13, 0xe5, 0xd2, 0x7f, 0x87, 0x3b, 0x27, 0xf1, 0x73, 0x68, 0x77, 0xef, 0x29, 0xb8, 0xf9, 0x99,
-	0xde, 0xe0, 0x6a, 0x69, 0x81, 0xeb, 0xa5, 0x05, 0xfe, 0x2c, 0x2d, 0xf0, 0x79, 0x65, 0x19, 0xd7,
-	0x2b, 0xcb, 0xf8, 0xb9, 0xb2, 0x8c, 0xf7, 0x8f, 0x27, 0x54, 0x5e, 0xce, 0x87, 0x4e, 0xc8, 0x23,
-	0x37, 0x99, 0xd2, 0xf8, 0x51, 0x44, 0x52, 0xb7, 0x58, 0xbf, 0xd4, 0x73, 0x3f, 0x6c, 0xac, 0xb4,
-	0x72, 0x7a, 0x58, 0x51, 0xdb, 0xf7, 0xe4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa9, 0x16,
-	0x4f, 0xf4, 0x03, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0xcf, 0x26, 0x69, 0xda, 0x6c, 0xdb, 0x24, 0x5d, 0x55, 0x9f, 0xac, 0xa8, 0x72, 0xf2, 0x95,
+	0x1e, 0x22, 0x51, 0x6c, 0x14, 0x2e, 0xa8, 0xc7, 0x54, 0x48, 0xb4, 0xa2, 0x50, 0x99, 0x22, 0x21,
+	0x2e, 0xd6, 0xc6, 0xd9, 0x38, 0xab, 0xc4, 0xbb, 0x96, 0xbd, 0xb6, 0x08, 0x27, 0x1e, 0xa1, 0x47,
+	0x8e, 0x5c, 0x78, 0x0c, 0xee, 0x3d, 0xf6, 0x06, 0x07, 0x04, 0xa8, 0x95, 0x78, 0x07, 0x6e, 0xc8,
+	0xbb, 0xeb, 0xc4, 0x95, 0xaa, 0xaa, 0xb7, 0x9d, 0x99, 0xdf, 0xec, 0xcc, 0x6f, 0xfe, 0xc1, 0xff,
+	0x3d, 0xce, 0x18, 0xf1, 0x84, 0x1d, 0xe0, 0x68, 0x4a, 0x44, 0x80, 0x43, 0x3b, 0xed, 0x6b, 0xc1,
+	0x0a, 0x23, 0x2e, 0x38, 0xda, 0xd6, 0x10, 0x6b, 0x01, 0xb1, 0xd2, 0x7e, 0x7b, 0xdb, 0xe7, 0x3e,
+	0x97, 0x00, 0x3b, 0x7b, 0x29, 0x6c, 0xbb, 0xe3, 0x73, 0xee, 0xcf, 0x88, 0x2d, 0xa5, 0x61, 0x32,
+	0xb6, 0x05, 0x0d, 0x48, 0x2c, 0x70, 0x10, 0x6a, 0xc0, 0x5e, 0x1e, 0x4f, 0xcc, 0x43, 0x12, 0x67,
+	0xb1, 0xbc, 0x24, 0x8a, 0x08, 0xf3, 0xe6, 0x6e, 0x88, 0x69, 0xa4, 0x50, 0xbb, 0x5f, 0x00, 0xac,
+	0x9d, 0xc8, 0x68, 0xe8, 0x00, 0xd6, 0x04, 0xf5, 0xa6, 0x24, 0x32, 0x40, 0x17, 0xf4, 0xd6, 0xfb,
+	0x3b, 0xd6, 0x6d, 0xe9, 0x58, 0x67, 0x12, 0x33, 0xa8, 0x5e, 0xfc, 0xec, 0x94, 0x1c, 0xed, 0x81,
+	0xde, 0xc0, 0x56, 0x18, 0xf1, 0x94, 0x8e, 0x48, 0xe4, 0x7a, 0x9c, 0x8d, 0xa9, 0x1f, 0x1b, 0xe5,
+	0x6e, 0xa5, 0xb7, 0xde, 0xdf, 0xbb, 0xfd, 0x97, 0x53, 0x8d, 0x3e, 0x94, 0x60, 0xfd, 0x5b, 0x33,
+	0xbc, 0xa1, 0x8d, 0x0f, 0xd6, 0x3e, 0x7d, 0xee, 0x94, 0x3e, 0xfe, 0xe8, 0x96, 0x76, 0xff, 0x00,
+	0x58, 0x53, 0x91, 0xd1, 0x11, 0xdc, 0xbc, 0xc1, 0x44, 0xa7, 0x6b, 0x2e, 0x02, 0x49, 0xc2, 0x59,
+	0x90, 0x43, 0x0d, 0x3b, 0xc5, 0x34, 0x4f, 0x78, 0xc3, 0x2b, 0xe8, 0x50, 0x1b, 0xae, 0x8d, 0x88,
+	0x47, 0x03, 0x3c, 0xcb, 0xd2, 0x05, 0xbd, 0xaa, 0xb3, 0x90, 0xd1, 0x3e, 0x44, 0x01, 0x65, 0x6e,
+	0x81, 0x56, 0xc2, 0x84, 0x51, 0x91, 0xa8, 0x56, 0x40, 0xd9, 0x92, 0x41, 0xc2, 0x04, 0x32, 0xe0,
+	0x2a, 0x61, 0x78, 0x38, 0x23, 0x23, 0xa3, 0xd1, 0x05, 0xbd, 0x35, 0x27, 0x17, 0xd1, 0x03, 0xb8,
+	0x19, 0x10, 0x81, 0x47, 0x58, 0x60, 0xf7, 0xf8, 0xf5, 0xab, 0x97, 0x46, 0xb3, 0x0b, 0x7a, 0x75,
+	0x67, 0x23, 0x57, 0x66, 0xba, 0x02, 0xd1, 0x6f, 0x00, 0x36, 0x6e, 0x16, 0x07, 0x21, 0x58, 0x65,
+	0x38, 0x20, 0x92, 0x67, 0xdd, 0x91, 0x6f, 0xd4, 0x83, 0x2d, 0x3e, 0x1e, 0xbb, 0xde, 0x04, 0x53,
+	0xe6, 0xea, 0xb6, 0x95, 0xa5, 0xbd, 0xc1, 0xc7, 0xe3, 0xc3, 0x4c, 0xad, 0xcb, 0x75, 0x0c, 0xb7,
+	0x18, 0x8f, 0x02, 0x3c, 0xa3, 0x1f, 0x88, 0x3b, 0xd4, 0x25, 0xab, 0xdc, 0xa7, 0x64, 0x4e, 0x73,
+	0xe1, 0x38, 0x50, 0xf5, 0xfa, 0x0f, 0xd6, 0x28, 0x4b, 0x49, 0x24, 0x8c, 0xaa, 0x24, 0xa9, 0xa5,
+	0x7b, 0x71, 0xdc, 0xfd, 0x0a, 0x60, 0x5d, 0x8d, 0xda, 0x09, 0x0e, 0xd1, 0x0b, 0xb8, 0xaa, 0x06,
+	0x22, 0x36, 0x80, 0x1c, 0x94, 0xfd, 0xdb, 0x07, 0x65, 0xe1, 0xa1, 0x5f, 0xf1, 0x33, 0x26, 0xa2,
+	0xb9, 0xee, 0x66, 0xfe, 0x45, 0xfb, 0x2d, 0xdc, 0x28, 0x9a, 0x51, 0x0b, 0x56, 0xa6, 0x64, 0xae,
+	0x2b, 0x96, 0x3d, 0x51, 0x1f, 0xae, 0xa4, 0x78, 0x96, 0x10, 0xa3, 0x7c, 0xd7, 0x70, 0xab, 0x4f,
+	0x1c, 0x05, 0x3d, 0x28, 0x3f, 0x05, 0x85, 0xce, 0xfc, 0x05, 0x70, 0xf3, 0x94, 0xb0, 0x11, 0x65,
+	0xfe, 0x72, 0x63, 0x94, 0xf7, 0xdd, 0x1b, 0xa3, 0xd0, 0xf9, 0xc6, 0x28, 0x13, 0x7a, 0x08, 0xb7,
+	0xb0, 0x27, 0x68, 0x8a, 0x05, 0xe5, 0xcc, 0x9d, 0x10, 0xea, 0x4f, 0x84, 0x9e, 0xc1, 0xd6, 0xd2,
+	0xf0, 0x5c, 0xea, 0xd1, 0x11, 0x6c, 0x16, 0xc0, 0xd9, 0xa6, 0xeb, 0x0e, 0xb6, 0x2d, 0x75, 0x06,
+	0xac, 0xfc, 0x0c, 0x58, 0x67, 0xf9, 0x19, 0x18, 0x54, 0xcf, 0x7f, 0x75, 0x80, 0xd3, 0x58, 0x3a,
+	0x66, 0xa6, 0xac, 0x85, 0x99, 0x86, 0x33, 0xd9, 0xc2, 0xba, 0xa3, 0x25, 0xb4, 0x03, 0xeb, 0x38,
+	0x11, 0x13, 0x1e, 0x51, 0x31, 0x37, 0x56, 0xa4, 0x69, 0xa9, 0x18, 0x1c, 0x5f, 0x5c, 0x99, 0xe0,
+	0xf2, 0xca, 0x04, 0xbf, 0xaf, 0x4c, 0x70, 0x7e, 0x6d, 0x96, 0x2e, 0xaf, 0xcd, 0xd2, 0xf7, 0x6b,
+	0xb3, 0xf4, 0xee, 0xb1, 0x4f, 0xc5, 0x24, 0x19, 0x5a, 0x1e, 0x0f, 0xec, 0x78, 0x4a, 0xc3, 0x47,
+	0x01, 0x49, 0xed, 0xfc, 0xf4, 0xa4, 0x7d, 0xfb, 0x7d, 0xe1, 0xde, 0xc9, 0x29, 0x1b, 0xd6, 0x64,
+	0xae, 0x4f, 0xfe, 0x0d, 0x00, 0xdc, 0xaa, 0x69, 0x73, 0x11, 0x05, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -571,6 +669,68 @@ func (m *MarketMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMarket(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -669,6 +829,32 @@ func (m *MarketMap) Size() (n int) {
 	return n
 }
 
+func (m *PendingMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovMarket(uint64(m.ActivationHeight))
+	}
+	if m.ActivationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
 func sovMarket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1346,6 +1532,208 @@ func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationTime == nil {
+				m.ActivationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMarket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -247,6 +247,154 @@ func (_c *QueryClient_MarketMap_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// MarketMapProposal provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketMapProposal(ctx context.Context, in *types.MarketMapProposalRequest, opts ...grpc.CallOption) (*types.MarketMapProposalResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketMapProposal")
+	}
+
+	var r0 *types.MarketMapProposalResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapProposalRequest, ...grpc.CallOption) (*types.MarketMapProposalResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapProposalRequest, ...grpc.CallOption) *types.MarketMapProposalResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketMapProposalResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketMapProposalRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketMapProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketMapProposal'
+type QueryClient_MarketMapProposal_Call struct {
+	*mock.Call
+}
+
+// MarketMapProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketMapProposalRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketMapProposal(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketMapProposal_Call {
+	return &QueryClient_MarketMapProposal_Call{Call: _e.mock.On("MarketMapProposal",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketMapProposal_Call) Run(run func(ctx context.Context, in *types.MarketMapProposalRequest, opts ...grpc.CallOption)) *QueryClient_MarketMapProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketMapProposalRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketMapProposal_Call) Return(_a0 *types.MarketMapProposalResponse, _a1 error) *QueryClient_MarketMapProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketMapProposal_Call) RunAndReturn(run func(context.Context, *types.MarketMapProposalRequest, ...grpc.CallOption) (*types.MarketMapProposalResponse, error)) *QueryClient_MarketMapProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarketMapProposals provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketMapProposals(ctx context.Context, in *types.MarketMapProposalsRequest, opts ...grpc.CallOption) (*types.MarketMapProposalsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketMapProposals")
+	}
+
+	var r0 *types.MarketMapProposalsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapProposalsRequest, ...grpc.CallOption) (*types.MarketMapProposalsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapProposalsRequest, ...grpc.CallOption) *types.MarketMapProposalsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketMapProposalsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketMapProposalsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketMapProposals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketMapProposals'
+type QueryClient_MarketMapProposals_Call struct {
+	*mock.Call
+}
+
+// MarketMapProposals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketMapProposalsRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketMapProposals(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketMapProposals_Call {
+	return &QueryClient_MarketMapProposals_Call{Call: _e.mock.On("MarketMapProposals",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketMapProposals_Call) Run(run func(ctx context.Context, in *types.MarketMapProposalsRequest, opts ...grpc.CallOption)) *QueryClient_MarketMapProposals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketMapProposalsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketMapProposals_Call) Return(_a0 *types.MarketMapProposalsResponse, _a1 error) *QueryClient_MarketMapProposals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketMapProposals_Call) RunAndReturn(run func(context.Context, *types.MarketMapProposalsRequest, ...grpc.CallOption) (*types.MarketMapProposalsResponse, error)) *QueryClient_MarketMapProposals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Markets provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Markets(ctx context.Context, in *types.MarketsRequest, opts ...grpc.CallOption) (*types.MarketsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -395,6 +543,154 @@ func (_c *QueryClient_Params_Call) RunAndReturn(run func(context.Context, *types
 	return _c
 }
 
+// PendingMarkets provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PendingMarkets(ctx context.Context, in *types.PendingMarketsRequest, opts ...grpc.CallOption) (*types.PendingMarketsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingMarkets")
+	}
+
+	var r0 *types.PendingMarketsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.PendingMarketsRequest, ...grpc.CallOption) (*types.PendingMarketsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.PendingMarketsRequest, ...grpc.CallOption) *types.PendingMarketsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PendingMarketsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.PendingMarketsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_PendingMarkets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingMarkets'
+type QueryClient_PendingMarkets_Call struct {
+	*mock.Call
+}
+
+// PendingMarkets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.PendingMarketsRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) PendingMarkets(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_PendingMarkets_Call {
+	return &QueryClient_PendingMarkets_Call{Call: _e.mock.On("PendingMarkets",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_PendingMarkets_Call) Run(run func(ctx context.Context, in *types.PendingMarketsRequest, opts ...grpc.CallOption)) *QueryClient_PendingMarkets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.PendingMarketsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_PendingMarkets_Call) Return(_a0 *types.PendingMarketsResponse, _a1 error) *QueryClient_PendingMarkets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_PendingMarkets_Call) RunAndReturn(run func(context.Context, *types.PendingMarketsRequest, ...grpc.CallOption) (*types.PendingMarketsResponse, error)) *QueryClient_PendingMarkets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateMarketUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SimulateMarketUpdates(ctx context.Context, in *types.SimulateMarketUpdatesRequest, opts ...grpc.CallOption) (*types.SimulateMarketUpdatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateMarketUpdates")
+	}
+
+	var r0 *types.SimulateMarketUpdatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateMarketUpdatesRequest, ...grpc.CallOption) (*types.SimulateMarketUpdatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateMarketUpdatesRequest, ...grpc.CallOption) *types.SimulateMarketUpdatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SimulateMarketUpdatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SimulateMarketUpdatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_SimulateMarketUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateMarketUpdates'
+type QueryClient_SimulateMarketUpdates_Call struct {
+	*mock.Call
+}
+
+// SimulateMarketUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.SimulateMarketUpdatesRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) SimulateMarketUpdates(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_SimulateMarketUpdates_Call {
+	return &QueryClient_SimulateMarketUpdates_Call{Call: _e.mock.On("SimulateMarketUpdates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_SimulateMarketUpdates_Call) Run(run func(ctx context.Context, in *types.SimulateMarketUpdatesRequest, opts ...grpc.CallOption)) *QueryClient_SimulateMarketUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.SimulateMarketUpdatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_SimulateMarketUpdates_Call) Return(_a0 *types.SimulateMarketUpdatesResponse, _a1 error) *QueryClient_SimulateMarketUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_SimulateMarketUpdates_Call) RunAndReturn(run func(context.Context, *types.SimulateMarketUpdatesRequest, ...grpc.CallOption) (*types.SimulateMarketUpdatesResponse, error)) *QueryClient_SimulateMarketUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryClient(t interface {
@@ -15,6 +15,12 @@ var (
 	_ sdk.Msg = &MsgRemoveMarketAuthorities{}
 	_ sdk.Msg = &MsgUpsertMarkets{}
 	_ sdk.Msg = &MsgRemoveMarkets{}
+	_ sdk.Msg = &MsgEnableMarkets{}
+	_ sdk.Msg = &MsgDisableMarkets{}
+	_ sdk.Msg = &MsgCancelPendingMarkets{}
+	_ sdk.Msg = &MsgSubmitMarketMapProposal{}
+	_ sdk.Msg = &MsgApproveMarketMapProposal{}
+	_ sdk.Msg = &MsgVetoMarketMapProposal{}
 )
 
 // ValidateBasic asserts that the authority address in the upsert-markets message is formatted correctly.
@@ -25,26 +31,11 @@ func (m *MsgUpsertMarkets) ValidateBasic() error {
 		return err
 	}
 
-	if len(m.Markets) == 0 {
-		return fmt.Errorf("no markets to upsert")
-	}
-
-	seenTickers := make(map[string]struct{})
-	for _, market := range m.Markets {
-		ticker := market.Ticker.CurrencyPair.String()
-
-		if _, seen := seenTickers[ticker]; seen {
-			return fmt.Errorf("duplicate ticker: %s", ticker)
-		}
-
-		if err := market.ValidateBasic(); err != nil {
-			return err
-		}
-
-		seenTickers[ticker] = struct{}{}
+	if err := validateUpsertMarkets(m.Markets); err != nil {
+		return err
 	}
 
-	return nil
+	return ValidateActivation(m.ActivationHeight, m.ActivationTime)
 }
 
 // ValidateBasic determines whether the information in the message is formatted correctly, specifically
@@ -65,7 +56,7 @@ func (m *MsgCreateMarkets) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	return ValidateActivation(m.ActivationHeight, m.ActivationTime)
 }
 
 // ValidateBasic determines whether the information in the message is formatted correctly, specifically
@@ -86,7 +77,7 @@ func (m *MsgUpdateMarkets) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	return ValidateActivation(m.ActivationHeight, m.ActivationTime)
 }
 
 // ValidateBasic determines whether the information in the message is formatted correctly, specifically
@@ -155,3 +146,91 @@ func (m *MsgRemoveMarkets) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the markets are valid, unique currency pairs.
+func (m *MsgEnableMarkets) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("markets to enable cannot be nil")
+	}
+
+	return validateMarketKeys(m.Markets)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the markets are valid, unique currency pairs.
+func (m *MsgDisableMarkets) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("markets to disable cannot be nil")
+	}
+
+	return validateMarketKeys(m.Markets)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the markets are valid, unique currency pairs.
+func (m *MsgCancelPendingMarkets) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if len(m.Markets) == 0 {
+		return fmt.Errorf("markets to cancel cannot be nil")
+	}
+
+	return validateMarketKeys(m.Markets)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address and the markets are valid and unique.
+func (m *MsgSubmitMarketMapProposal) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	return validateUpsertMarkets(m.Markets)
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address.
+func (m *MsgApproveMarketMapProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	return err
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address.
+func (m *MsgVetoMarketMapProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	return err
+}
+
+// validateMarketKeys checks that the given markets are unique, valid currency pair strings.
+func validateMarketKeys(markets []string) error {
+	seenMarkets := make(map[string]struct{}, len(markets))
+	for _, market := range markets {
+		if _, seen := seenMarkets[market]; seen {
+			return fmt.Errorf("duplicate market %s found", market)
+		}
+
+		if _, err := connecttypes.CurrencyPairFromString(market); err != nil {
+			return fmt.Errorf("invalid market pair string: %w", err)
+		}
+
+		seenMarkets[market] = struct{}{}
+	}
+
+	return nil
+}
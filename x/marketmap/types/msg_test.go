@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/skip-mev/chaintestutil/sample"
 	"github.com/stretchr/testify/require"
@@ -412,6 +413,66 @@ func TestValidateBasicMsgUpdateMarket(t *testing.T) {
 	}
 }
 
+func TestValidateBasicMsgActivation(t *testing.T) {
+	authority := sample.Address(sample.Rand())
+	activationTime := time.Unix(1700000000, 0).UTC()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgCreateMarkets
+		expectPass bool
+	}{
+		{
+			"activation height - pass",
+			types.MsgCreateMarkets{
+				Authority:        authority,
+				CreateMarkets:    []types.Market{btcusdt},
+				ActivationHeight: 100,
+			},
+			true,
+		},
+		{
+			"activation time - pass",
+			types.MsgCreateMarkets{
+				Authority:      authority,
+				CreateMarkets:  []types.Market{btcusdt},
+				ActivationTime: &activationTime,
+			},
+			true,
+		},
+		{
+			"activation height and time - fail",
+			types.MsgCreateMarkets{
+				Authority:        authority,
+				CreateMarkets:    []types.Market{btcusdt},
+				ActivationHeight: 100,
+				ActivationTime:   &activationTime,
+			},
+			false,
+		},
+		{
+			"zero activation time - fail",
+			types.MsgCreateMarkets{
+				Authority:      authority,
+				CreateMarkets:  []types.Market{btcusdt},
+				ActivationTime: &time.Time{},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateBasicMsgParams(t *testing.T) {
 	rng := sample.Rand()
 
@@ -577,3 +638,186 @@ func TestValidateBasicMsgRemoveMarkets(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgEnableMarkets(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgEnableMarkets
+		expectPass bool
+	}{
+		{
+			name: "if the Authority is not an acc-address - fail",
+			msg: types.MsgEnableMarkets{
+				Authority: "invalid",
+				Markets:   []string{"USDT/USD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (no markets) - fail",
+			msg: types.MsgEnableMarkets{
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (invalid market) - fail",
+			msg: types.MsgEnableMarkets{
+				Markets:   []string{"USDT"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate markets) - fail",
+			msg: types.MsgEnableMarkets{
+				Markets:   []string{"USDT/USD", "USDT/USD"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message - multiple markets",
+			msg: types.MsgEnableMarkets{
+				Markets:   []string{"USDT/USD", "ETH/USD"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgDisableMarkets(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgDisableMarkets
+		expectPass bool
+	}{
+		{
+			name: "if the Authority is not an acc-address - fail",
+			msg: types.MsgDisableMarkets{
+				Authority: "invalid",
+				Markets:   []string{"USDT/USD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (no markets) - fail",
+			msg: types.MsgDisableMarkets{
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (invalid market) - fail",
+			msg: types.MsgDisableMarkets{
+				Markets:   []string{"USDT"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate markets) - fail",
+			msg: types.MsgDisableMarkets{
+				Markets:   []string{"USDT/USD", "USDT/USD"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message - multiple markets",
+			msg: types.MsgDisableMarkets{
+				Markets:   []string{"USDT/USD", "ETH/USD"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBasicMsgCancelPendingMarkets(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgCancelPendingMarkets
+		expectPass bool
+	}{
+		{
+			name: "if the Authority is not an acc-address - fail",
+			msg: types.MsgCancelPendingMarkets{
+				Authority: "invalid",
+				Markets:   []string{"USDT/USD"},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (no markets) - fail",
+			msg: types.MsgCancelPendingMarkets{
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (invalid market) - fail",
+			msg: types.MsgCancelPendingMarkets{
+				Markets:   []string{"USDT"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate markets) - fail",
+			msg: types.MsgCancelPendingMarkets{
+				Markets:   []string{"USDT/USD", "USDT/USD"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message - multiple markets",
+			msg: types.MsgCancelPendingMarkets{
+				Markets:   []string{"USDT/USD", "ETH/USD"},
+				Authority: sample.Address(rng),
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
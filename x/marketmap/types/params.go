@@ -8,11 +8,24 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+const (
+	// DefaultProposalReviewPeriod is the default number of blocks a MarketMapProposal is held for review.
+	DefaultProposalReviewPeriod uint64 = 100
+
+	// DefaultProposalQuorum is the default ProposalQuorum, which requires a majority of the market authorities.
+	DefaultProposalQuorum uint64 = 0
+
+	// MaxProposalReviewPeriod is the maximum number of blocks a MarketMapProposal can be held for review.
+	MaxProposalReviewPeriod uint64 = 1_000_000
+)
+
 // DefaultParams returns default marketmap parameters.
 func DefaultParams() Params {
 	return Params{
-		MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
-		Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		MarketAuthorities:    []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+		Admin:                authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		ProposalReviewPeriod: DefaultProposalReviewPeriod,
+		ProposalQuorum:       DefaultProposalQuorum,
 	}
 }
 
@@ -23,8 +36,10 @@ func NewParams(authorities []string, admin string) (Params, error) {
 	}
 
 	return Params{
-		MarketAuthorities: authorities,
-		Admin:             admin,
+		MarketAuthorities:    authorities,
+		Admin:                admin,
+		ProposalReviewPeriod: DefaultProposalReviewPeriod,
+		ProposalQuorum:       DefaultProposalQuorum,
 	}, nil
 }
 
@@ -51,5 +66,34 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("invalid marketmap admin string: %w", err)
 	}
 
+	if p.ProposalReviewPeriod > MaxProposalReviewPeriod {
+		return fmt.Errorf("proposal review period %d exceeds the maximum of %d blocks", p.ProposalReviewPeriod, MaxProposalReviewPeriod)
+	}
+
+	if p.RequireProposals && p.ProposalReviewPeriod == 0 {
+		return fmt.Errorf("proposal review period must be positive when proposals are required")
+	}
+
+	if p.ProposalQuorum > uint64(len(p.MarketAuthorities)) {
+		return fmt.Errorf("proposal quorum %d exceeds the number of market authorities %d", p.ProposalQuorum, len(p.MarketAuthorities))
+	}
+
 	return nil
 }
+
+// ProposalQuorumThreshold returns the number of market authority approvals or vetoes required to apply or
+// discard a MarketMapProposal before the end of its review period. If ProposalQuorum is unset, a majority of
+// the market authorities is required. The threshold is capped at the number of market authorities, so that
+// removing market authorities cannot make proposals impossible to approve or veto.
+func (p *Params) ProposalQuorumThreshold() int {
+	authorities := len(p.MarketAuthorities)
+	if p.ProposalQuorum == 0 {
+		return authorities/2 + 1
+	}
+
+	if p.ProposalQuorum > uint64(authorities) {
+		return authorities
+	}
+
+	return int(p.ProposalQuorum) //nolint:gosec
+}
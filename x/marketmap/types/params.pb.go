@@ -30,6 +30,17 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// ProposalReviewPeriod is the number of blocks a MarketMapProposal is held
+	// for review before it is applied to the market map.
+	ProposalReviewPeriod uint64 `protobuf:"varint,3,opt,name=proposal_review_period,json=proposalReviewPeriod,proto3" json:"proposal_review_period,omitempty"`
+	// ProposalQuorum is the number of market authorities that must approve or
+	// veto a MarketMapProposal to apply or discard it before the end of its
+	// review period. If zero, a majority of the market authorities is required.
+	ProposalQuorum uint64 `protobuf:"varint,4,opt,name=proposal_quorum,json=proposalQuorum,proto3" json:"proposal_quorum,omitempty"`
+	// RequireProposals, if true, rejects MsgCreateMarkets, MsgUpdateMarkets and
+	// MsgUpsertMarkets, so that market authorities can only change the market
+	// map through a MarketMapProposal.
+	RequireProposals bool `protobuf:"varint,5,opt,name=require_proposals,json=requireProposals,proto3" json:"require_proposals,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +90,27 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetProposalReviewPeriod() uint64 {
+	if m != nil {
+		return m.ProposalReviewPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetProposalQuorum() uint64 {
+	if m != nil {
+		return m.ProposalQuorum
+	}
+	return 0
+}
+
+func (m *Params) GetRequireProposals() bool {
+	if m != nil {
+		return m.RequireProposals
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "connect.marketmap.v2.Params")
 }
@@ -86,19 +118,24 @@ func init() {
 func init() { proto.RegisterFile("connect/marketmap/v2/params.proto", fileDescriptor_40e3a88492006139) }
 
 var fileDescriptor_40e3a88492006139 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33,
-	0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2a, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x52, 0xf2, 0xe5, 0x62, 0x0b, 0x00, 0xab, 0x12, 0xd2,
-	0xe5, 0x12, 0x82, 0xc8, 0xc4, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6, 0x16,
-	0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22, 0x5c,
-	0xac, 0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93,
-	0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9,
-	0xc3, 0x5c, 0x5d, 0x66, 0xa4, 0x5f, 0x81, 0xe4, 0xf4, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xbb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x37, 0x9c, 0xf0, 0x12, 0xdc, 0x00, 0x00,
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd0, 0xbf, 0x4e, 0xeb, 0x30,
+	0x18, 0x05, 0xf0, 0xf8, 0xf6, 0x8f, 0x6e, 0x3d, 0x00, 0xb5, 0x22, 0x94, 0xc9, 0x0a, 0x2c, 0x44,
+	0x42, 0x8d, 0x51, 0xe0, 0x05, 0x60, 0x64, 0x0a, 0x19, 0x59, 0x22, 0x37, 0xb5, 0xa8, 0x55, 0x1c,
+	0xbb, 0xb6, 0x13, 0xe0, 0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0xb0, 0xa1, 0xe4, 0x45, 0x90, 0x9c, 0xba,
+	0x30, 0x7e, 0xe7, 0x77, 0xbe, 0xe5, 0xc0, 0xb3, 0x4a, 0xd6, 0x35, 0xab, 0x2c, 0x11, 0x54, 0x6f,
+	0x98, 0x15, 0x54, 0x91, 0x36, 0x23, 0x8a, 0x6a, 0x2a, 0x4c, 0xaa, 0xb4, 0xb4, 0x12, 0x85, 0xfb,
+	0x4a, 0x7a, 0xa8, 0xa4, 0x6d, 0x76, 0xfe, 0x05, 0xe0, 0x34, 0x77, 0x35, 0xb4, 0x80, 0x68, 0xa0,
+	0x92, 0x36, 0x76, 0x2d, 0x35, 0xb7, 0x9c, 0x99, 0x08, 0xc4, 0xa3, 0x64, 0x56, 0xcc, 0x07, 0xb9,
+	0xfd, 0x05, 0x14, 0xc2, 0x09, 0x5d, 0x09, 0x5e, 0x47, 0xff, 0x62, 0x90, 0xcc, 0x8a, 0xe1, 0x40,
+	0x37, 0xf0, 0x54, 0x69, 0xa9, 0xa4, 0xa1, 0xcf, 0xa5, 0x66, 0x2d, 0x67, 0x2f, 0xa5, 0x62, 0x9a,
+	0xcb, 0x55, 0x34, 0x8a, 0x41, 0x32, 0x2e, 0x42, 0xaf, 0x85, 0xc3, 0xdc, 0x19, 0xba, 0x80, 0xc7,
+	0x87, 0xaf, 0x6d, 0x23, 0x75, 0x23, 0xa2, 0xb1, 0xab, 0x1f, 0xf9, 0xf8, 0xc1, 0xa5, 0xe8, 0x12,
+	0xce, 0x35, 0xdb, 0x36, 0x5c, 0xb3, 0xd2, 0x8b, 0x89, 0x26, 0x31, 0x48, 0xfe, 0x17, 0x27, 0x7b,
+	0xc8, 0x7d, 0x7e, 0x77, 0xff, 0xd1, 0x61, 0xb0, 0xeb, 0x30, 0xf8, 0xee, 0x30, 0x78, 0xef, 0x71,
+	0xb0, 0xeb, 0x71, 0xf0, 0xd9, 0xe3, 0xe0, 0xf1, 0xea, 0x89, 0xdb, 0x75, 0xb3, 0x4c, 0x2b, 0x29,
+	0x88, 0xd9, 0x70, 0xb5, 0x10, 0xac, 0x25, 0x7e, 0xc2, 0x36, 0x23, 0xaf, 0x7f, 0x76, 0xb4, 0x6f,
+	0x8a, 0x99, 0xe5, 0xd4, 0x8d, 0x78, 0xfd, 0x33, 0x00, 0x69, 0x39, 0xc0, 0x70, 0x69, 0x01, 0x00,
 	0x00,
 }
 
@@ -122,6 +159,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequireProposals {
+		i--
+		if m.RequireProposals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProposalQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProposalQuorum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposalReviewPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProposalReviewPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -168,6 +225,15 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.ProposalReviewPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ProposalReviewPeriod))
+	}
+	if m.ProposalQuorum != 0 {
+		n += 1 + sovParams(uint64(m.ProposalQuorum))
+	}
+	if m.RequireProposals {
+		n += 2
+	}
 	return n
 }
 
@@ -270,6 +336,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalReviewPeriod", wireType)
+			}
+			m.ProposalReviewPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalReviewPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalQuorum", wireType)
+			}
+			m.ProposalQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalQuorum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireProposals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireProposals = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
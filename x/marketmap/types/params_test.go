@@ -63,6 +63,43 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "invalid proposal review period above the maximum",
+			params: types.Params{
+				MarketAuthorities:    []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:                authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ProposalReviewPeriod: types.MaxProposalReviewPeriod + 1,
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid zero proposal review period when proposals are required",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				RequireProposals:  true,
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid required proposals",
+			params: types.Params{
+				MarketAuthorities:    []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:                authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ProposalReviewPeriod: types.DefaultProposalReviewPeriod,
+				RequireProposals:     true,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid proposal quorum above the number of market authorities",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ProposalQuorum:    2,
+			},
+			expectErr: true,
+		},
 		{
 			name:      "invalid empty params",
 			params:    types.Params{},
@@ -82,3 +119,43 @@ func TestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestProposalQuorumThreshold(t *testing.T) {
+	authorities := []string{
+		authtypes.NewModuleAddress(authtypes.ModuleName).String(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+	}
+
+	testCases := []struct {
+		name     string
+		quorum   uint64
+		expected int
+	}{
+		{
+			name:     "unset quorum requires a majority",
+			quorum:   0,
+			expected: 2,
+		},
+		{
+			name:     "quorum is used as is",
+			quorum:   3,
+			expected: 3,
+		},
+		{
+			name:     "quorum is capped at the number of authorities",
+			quorum:   5,
+			expected: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.Params{
+				MarketAuthorities: authorities,
+				ProposalQuorum:    tc.quorum,
+			}
+			require.Equal(t, tc.expected, params.ProposalQuorumThreshold())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// PendingMarketActionCreate creates the market at activation.
+	PendingMarketActionCreate = "create"
+	// PendingMarketActionUpdate updates the market at activation.
+	PendingMarketActionUpdate = "update"
+	// PendingMarketActionUpsert creates the market at activation if it does not exist, and updates it otherwise.
+	PendingMarketActionUpsert = "upsert"
+)
+
+// NewPendingMarket returns a new PendingMarket scheduled at the given activation height or time.
+func NewPendingMarket(
+	market Market,
+	activationHeight uint64,
+	activationTime *time.Time,
+	action string,
+	authority string,
+) PendingMarket {
+	return PendingMarket{
+		Market:           market,
+		ActivationHeight: activationHeight,
+		ActivationTime:   activationTime,
+		Action:           action,
+		Authority:        authority,
+	}
+}
+
+// ValidateBasic performs stateless validation of the PendingMarket.
+func (pm *PendingMarket) ValidateBasic() error {
+	if err := pm.Market.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := ValidateActivation(pm.ActivationHeight, pm.ActivationTime); err != nil {
+		return err
+	}
+
+	if !IsScheduled(pm.ActivationHeight, pm.ActivationTime) {
+		return fmt.Errorf("pending market %s must have an activation height or time", pm.Market.Ticker.String())
+	}
+
+	switch pm.Action {
+	case PendingMarketActionCreate, PendingMarketActionUpdate, PendingMarketActionUpsert:
+	default:
+		return fmt.Errorf("invalid pending market action %q", pm.Action)
+	}
+
+	return nil
+}
+
+// IsScheduled returns true if an activation height or time is set.
+func IsScheduled(activationHeight uint64, activationTime *time.Time) bool {
+	return activationHeight > 0 || activationTime != nil
+}
+
+// ValidateActivation checks that at most one of the activation height and time is set.
+func ValidateActivation(activationHeight uint64, activationTime *time.Time) error {
+	if activationHeight > 0 && activationTime != nil {
+		return fmt.Errorf("only one of activation height and activation time can be set")
+	}
+
+	if activationTime != nil && activationTime.IsZero() {
+		return fmt.Errorf("activation time cannot be zero")
+	}
+
+	return nil
+}
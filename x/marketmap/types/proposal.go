@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMarketMapProposal returns a new MarketMapProposal submitted at the given height, whose review period ends
+// at reviewEndHeight.
+func NewMarketMapProposal(
+	id uint64,
+	proposer string,
+	markets []Market,
+	submitHeight uint64,
+	reviewEndHeight uint64,
+) MarketMapProposal {
+	return MarketMapProposal{
+		Id:              id,
+		Proposer:        proposer,
+		Markets:         markets,
+		SubmitHeight:    submitHeight,
+		ReviewEndHeight: reviewEndHeight,
+	}
+}
+
+// ValidateBasic performs stateless validation of the MarketMapProposal.
+func (p *MarketMapProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer: %w", err)
+	}
+
+	if err := validateUpsertMarkets(p.Markets); err != nil {
+		return err
+	}
+
+	if p.ReviewEndHeight < p.SubmitHeight {
+		return fmt.Errorf("review end height %d cannot be before submit height %d", p.ReviewEndHeight, p.SubmitHeight)
+	}
+
+	seenVoters := make(map[string]struct{}, len(p.Approvals)+len(p.Vetoes))
+	for _, voter := range append(slices.Clone(p.Approvals), p.Vetoes...) {
+		if _, seen := seenVoters[voter]; seen {
+			return fmt.Errorf("duplicate vote by %s", voter)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(voter); err != nil {
+			return fmt.Errorf("invalid voter: %w", err)
+		}
+
+		seenVoters[voter] = struct{}{}
+	}
+
+	return nil
+}
+
+// HasVoted returns true if the given address has approved or vetoed the proposal.
+func (p *MarketMapProposal) HasVoted(address string) bool {
+	return slices.Contains(p.Approvals, address) || slices.Contains(p.Vetoes, address)
+}
+
+// validateUpsertMarkets checks that the given markets are non-empty, valid and unique.
+func validateUpsertMarkets(markets []Market) error {
+	if len(markets) == 0 {
+		return fmt.Errorf("no markets to upsert")
+	}
+
+	seenTickers := make(map[string]struct{}, len(markets))
+	for _, market := range markets {
+		ticker := market.Ticker.CurrencyPair.String()
+
+		if _, seen := seenTickers[ticker]; seen {
+			return fmt.Errorf("duplicate ticker: %s", ticker)
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenTickers[ticker] = struct{}{}
+	}
+
+	return nil
+}
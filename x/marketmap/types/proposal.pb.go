@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/marketmap/v2/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketMapProposal is a set of market creates and updates submitted by a
+// market authority that is held for review before it is applied to the market
+// map. During the review period, the admin or a quorum of market authorities
+// can approve the proposal, applying it immediately, or veto it, discarding
+// it. If neither happens, the proposal is applied at the end of the review
+// period.
+type MarketMapProposal struct {
+	// Id is the unique identifier of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Proposer is the market authority that submitted the proposal.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// Markets is the list of markets to upsert (create if they do not exist,
+	// update otherwise) when the proposal is applied.
+	Markets []Market `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets"`
+	// SubmitHeight is the block height at which the proposal was submitted.
+	SubmitHeight uint64 `protobuf:"varint,4,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// ReviewEndHeight is the block height at which the proposal is applied if
+	// it has not been approved or vetoed before.
+	ReviewEndHeight uint64 `protobuf:"varint,5,opt,name=review_end_height,json=reviewEndHeight,proto3" json:"review_end_height,omitempty"`
+	// Approvals is the list of market authorities that approved the proposal.
+	Approvals []string `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// Vetoes is the list of market authorities that vetoed the proposal.
+	Vetoes []string `protobuf:"bytes,7,rep,name=vetoes,proto3" json:"vetoes,omitempty"`
+}
+
+func (m *MarketMapProposal) Reset()         { *m = MarketMapProposal{} }
+func (m *MarketMapProposal) String() string { return proto.CompactTextString(m) }
+func (*MarketMapProposal) ProtoMessage()    {}
+func (*MarketMapProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bcd77a75fb4fae7, []int{0}
+}
+func (m *MarketMapProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketMapProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapProposal.Merge(m, src)
+}
+func (m *MarketMapProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketMapProposal proto.InternalMessageInfo
+
+func (m *MarketMapProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarketMapProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MarketMapProposal) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+func (m *MarketMapProposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *MarketMapProposal) GetReviewEndHeight() uint64 {
+	if m != nil {
+		return m.ReviewEndHeight
+	}
+	return 0
+}
+
+func (m *MarketMapProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *MarketMapProposal) GetVetoes() []string {
+	if m != nil {
+		return m.Vetoes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MarketMapProposal)(nil), "connect.marketmap.v2.MarketMapProposal")
+}
+
+func init() {
+	proto.RegisterFile("connect/marketmap/v2/proposal.proto", fileDescriptor_0bcd77a75fb4fae7)
+}
+
+var fileDescriptor_0bcd77a75fb4fae7 = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x4f, 0x4b, 0xfb, 0x30,
+	0x1c, 0xc6, 0xdb, 0x6e, 0xbf, 0xed, 0xb7, 0xf8, 0x8f, 0x85, 0x21, 0x65, 0x8c, 0x5a, 0xdd, 0xa5,
+	0x08, 0x36, 0x52, 0xaf, 0x9e, 0x06, 0x82, 0x08, 0x03, 0xe9, 0xd1, 0xcb, 0xc8, 0xd6, 0xd0, 0x85,
+	0xad, 0x4d, 0x48, 0xb2, 0xa8, 0x77, 0x5f, 0x80, 0x2f, 0x6b, 0xc7, 0x1d, 0x3d, 0x89, 0x6c, 0x6f,
+	0x44, 0x4c, 0xba, 0xe9, 0x61, 0xb7, 0x3c, 0xcf, 0xf7, 0x93, 0xef, 0xf3, 0xe5, 0x01, 0xfd, 0x09,
+	0x2b, 0x4b, 0x32, 0x51, 0xa8, 0xc0, 0x62, 0x46, 0x54, 0x81, 0x39, 0xd2, 0x09, 0xe2, 0x82, 0x71,
+	0x26, 0xf1, 0x3c, 0xe6, 0x82, 0x29, 0x06, 0x3b, 0x15, 0x14, 0xef, 0xa0, 0x58, 0x27, 0xdd, 0x4e,
+	0xce, 0x72, 0x66, 0x00, 0xf4, 0xf3, 0xb2, 0x6c, 0xf7, 0x7c, 0xef, 0x42, 0x2b, 0x2c, 0x72, 0xf1,
+	0xe6, 0x81, 0xf6, 0xd0, 0x18, 0x43, 0xcc, 0x1f, 0xab, 0x28, 0x78, 0x0c, 0x3c, 0x9a, 0xf9, 0x6e,
+	0xe8, 0x46, 0xf5, 0xd4, 0xa3, 0x19, 0xec, 0x82, 0xff, 0xf6, 0x0c, 0x22, 0x7c, 0x2f, 0x74, 0xa3,
+	0x56, 0xba, 0xd3, 0xf0, 0x16, 0x34, 0xed, 0x46, 0xe9, 0xd7, 0xc2, 0x5a, 0x74, 0x90, 0xf4, 0xe2,
+	0x7d, 0x27, 0xc6, 0x36, 0x65, 0x50, 0x5f, 0x7e, 0x9e, 0x39, 0xe9, 0xf6, 0x0b, 0xec, 0x83, 0x23,
+	0xb9, 0x18, 0x17, 0x54, 0x8d, 0xa6, 0x84, 0xe6, 0x53, 0xe5, 0xd7, 0x4d, 0xe8, 0xa1, 0x35, 0xef,
+	0x8d, 0x07, 0x2f, 0x41, 0x5b, 0x10, 0x4d, 0xc9, 0xf3, 0x88, 0x94, 0xd9, 0x16, 0xfc, 0x67, 0xc0,
+	0x13, 0x3b, 0xb8, 0x2b, 0xb3, 0x8a, 0xed, 0x81, 0x16, 0xe6, 0x5c, 0x30, 0x8d, 0xe7, 0xd2, 0x6f,
+	0x84, 0xb5, 0xa8, 0x95, 0xfe, 0x1a, 0xf0, 0x14, 0x34, 0x34, 0x51, 0x8c, 0x48, 0xbf, 0x69, 0x46,
+	0x95, 0x1a, 0x3c, 0x2c, 0xd7, 0x81, 0xbb, 0x5a, 0x07, 0xee, 0xd7, 0x3a, 0x70, 0xdf, 0x37, 0x81,
+	0xb3, 0xda, 0x04, 0xce, 0xc7, 0x26, 0x70, 0x9e, 0xae, 0x73, 0xaa, 0xa6, 0x8b, 0x71, 0x3c, 0x61,
+	0x05, 0x92, 0x33, 0xca, 0xaf, 0x0a, 0xa2, 0xd1, 0xb6, 0x57, 0x9d, 0xa0, 0x97, 0x3f, 0xe5, 0xaa,
+	0x57, 0x4e, 0xe4, 0xb8, 0x61, 0x9a, 0xbd, 0xf9, 0x1e, 0x00, 0x81, 0x63, 0xcf, 0x77, 0xcf, 0x01,
+	0x00, 0x00,
+}
+
+func (m *MarketMapProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vetoes) > 0 {
+		for iNdEx := len(m.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vetoes[iNdEx])
+			copy(dAtA[i:], m.Vetoes[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Vetoes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ReviewEndHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ReviewEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketMapProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProposal(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovProposal(uint64(m.SubmitHeight))
+	}
+	if m.ReviewEndHeight != 0 {
+		n += 1 + sovProposal(uint64(m.ReviewEndHeight))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.Vetoes) > 0 {
+		for _, s := range m.Vetoes {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketMapProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewEndHeight", wireType)
+			}
+			m.ReviewEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoes = append(m.Vetoes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -478,767 +478,3294 @@ func (m *LastUpdatedResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MarketMapRequest)(nil), "connect.marketmap.v2.MarketMapRequest")
-	proto.RegisterType((*MarketMapResponse)(nil), "connect.marketmap.v2.MarketMapResponse")
-	proto.RegisterType((*MarketsRequest)(nil), "connect.marketmap.v2.MarketsRequest")
-	proto.RegisterType((*MarketsResponse)(nil), "connect.marketmap.v2.MarketsResponse")
-	proto.RegisterType((*MarketRequest)(nil), "connect.marketmap.v2.MarketRequest")
-	proto.RegisterType((*MarketResponse)(nil), "connect.marketmap.v2.MarketResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "connect.marketmap.v2.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "connect.marketmap.v2.ParamsResponse")
-	proto.RegisterType((*LastUpdatedRequest)(nil), "connect.marketmap.v2.LastUpdatedRequest")
-	proto.RegisterType((*LastUpdatedResponse)(nil), "connect.marketmap.v2.LastUpdatedResponse")
+// PendingMarketsRequest is the request type for the Query/PendingMarkets RPC
+// method.
+type PendingMarketsRequest struct {
 }
 
-func init() { proto.RegisterFile("connect/marketmap/v2/query.proto", fileDescriptor_fc65f1e15c5a0bef) }
-
-var fileDescriptor_fc65f1e15c5a0bef = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0xcd, 0xf6, 0x4f, 0xf2, 0xcb, 0xa4, 0x69, 0xfb, 0x5b, 0x72, 0x08, 0x51, 0x70, 0x12, 0x93,
-	0xd2, 0x80, 0x84, 0x8d, 0xcc, 0x05, 0x21, 0x4e, 0x85, 0x4b, 0x51, 0x2a, 0x4a, 0x24, 0x2e, 0xbd,
-	0x44, 0x5b, 0x67, 0x95, 0x5a, 0xad, 0xbd, 0x5b, 0xdb, 0x89, 0xc8, 0x81, 0x0b, 0x12, 0x57, 0x84,
-	0x84, 0x10, 0x5f, 0xa9, 0xc7, 0x4a, 0x5c, 0x38, 0x21, 0x94, 0xf0, 0x41, 0x90, 0xbd, 0xbb, 0xae,
-	0x43, 0x53, 0xa7, 0x37, 0x7b, 0xf6, 0xcd, 0x7b, 0xcf, 0x33, 0xcf, 0x0b, 0x4d, 0x9b, 0x79, 0x1e,
-	0xb5, 0x43, 0xd3, 0x25, 0xfe, 0x29, 0x0d, 0x5d, 0xc2, 0xcd, 0xb1, 0x65, 0x9e, 0x8f, 0xa8, 0x3f,
-	0x31, 0xb8, 0xcf, 0x42, 0x86, 0x2b, 0x12, 0x61, 0x24, 0x08, 0x63, 0x6c, 0xd5, 0x2a, 0x43, 0x36,
-	0x64, 0x31, 0xc0, 0x8c, 0x9e, 0x04, 0xb6, 0x56, 0x1f, 0x32, 0x36, 0x3c, 0xa3, 0x26, 0xe1, 0x8e,
-	0x49, 0x3c, 0x8f, 0x85, 0x24, 0x74, 0x98, 0x17, 0xc8, 0xd3, 0xb6, 0xd2, 0x0a, 0x27, 0x9c, 0x06,
-	0x91, 0x8e, 0x3d, 0xf2, 0x7d, 0xea, 0xd9, 0x93, 0x3e, 0x27, 0x8e, 0x2f, 0x51, 0xad, 0x85, 0x8e,
-	0xc4, 0x4b, 0x26, 0x84, 0x13, 0x9f, 0xb8, 0x52, 0x4b, 0xc7, 0xb0, 0x7d, 0x10, 0x1f, 0x1e, 0x10,
-	0xde, 0xa3, 0xe7, 0x23, 0x1a, 0x84, 0xfa, 0x77, 0x04, 0xff, 0xa7, 0x8a, 0x01, 0x67, 0x5e, 0x40,
-	0xf1, 0x2b, 0x00, 0x41, 0xd3, 0x77, 0x09, 0xaf, 0xa2, 0x26, 0xea, 0x94, 0xac, 0x86, 0xb1, 0xe8,
-	0xa3, 0x8d, 0xa4, 0x79, 0x6f, 0xed, 0xe2, 0x57, 0x23, 0xd7, 0x2b, 0xba, 0xaa, 0x80, 0x5b, 0xb0,
-	0x71, 0x46, 0x82, 0xb0, 0x3f, 0xe2, 0x03, 0x12, 0xd2, 0x41, 0x75, 0xa5, 0x89, 0x3a, 0x6b, 0xbd,
-	0x52, 0x54, 0x7b, 0x27, 0x4a, 0xf8, 0x2e, 0xfc, 0x67, 0x9f, 0x10, 0xc7, 0xeb, 0x3b, 0x83, 0xea,
-	0x6a, 0x13, 0x75, 0x8a, 0xbd, 0x42, 0xfc, 0xbe, 0x3f, 0xd0, 0xb7, 0x61, 0x53, 0x70, 0x07, 0xca,
-	0xeb, 0x1b, 0xd8, 0x4a, 0x2a, 0xd2, 0xe8, 0x0b, 0x28, 0x08, 0xbd, 0xa0, 0x8a, 0x9a, 0xab, 0x9d,
-	0x92, 0x55, 0xcf, 0x72, 0x29, 0x2d, 0xaa, 0x16, 0xfd, 0x08, 0xca, 0xe2, 0x40, 0x2a, 0xe0, 0x7d,
-	0x28, 0xcf, 0x8d, 0x5f, 0x7e, 0xba, 0x96, 0x90, 0xc6, 0x5b, 0x8a, 0x08, 0x5f, 0x4a, 0xd8, 0x21,
-	0x71, 0x7c, 0x49, 0xbb, 0x61, 0xa7, 0x6a, 0x7a, 0x57, 0xd9, 0x4f, 0xbc, 0x3e, 0x87, 0xbc, 0x10,
-	0x96, 0xac, 0xb7, 0xb1, 0x2a, 0x3b, 0xf4, 0x2d, 0x28, 0x1f, 0xc6, 0xab, 0x54, 0xb3, 0xe8, 0xc2,
-	0xa6, 0x2a, 0x5c, 0xd1, 0x8b, 0x6d, 0x67, 0xd3, 0x8b, 0x2e, 0x45, 0x2f, 0x3a, 0xf4, 0x0a, 0xe0,
-	0xee, 0xd5, 0x56, 0x94, 0xc6, 0x33, 0xb8, 0x33, 0x57, 0x95, 0x42, 0xff, 0xae, 0x15, 0x5d, 0x5b,
-	0xab, 0xf5, 0x6d, 0x1d, 0xd6, 0xdf, 0x46, 0xff, 0x0b, 0xfe, 0x84, 0xa0, 0x98, 0x44, 0x04, 0x3f,
-	0x58, 0x92, 0x21, 0xa9, 0x5c, 0xdb, 0x5d, 0x8a, 0x13, 0x5e, 0xf4, 0xdd, 0x8f, 0x3f, 0xfe, 0x7c,
-	0x5d, 0x69, 0xe1, 0x86, 0x99, 0xf1, 0x87, 0xb8, 0x84, 0xe3, 0x0f, 0x50, 0x90, 0xd9, 0xc1, 0xed,
-	0x2c, 0x72, 0x35, 0xe0, 0xda, 0xce, 0x12, 0x94, 0x34, 0xb0, 0x13, 0x1b, 0x68, 0xe0, 0x7b, 0x59,
-	0x06, 0x02, 0x3c, 0x81, 0xbc, 0xe8, 0xc4, 0xf7, 0xb3, 0x78, 0x95, 0x78, 0x3b, 0x1b, 0x24, 0xb5,
-	0xdb, 0xb1, 0xb6, 0x86, 0xeb, 0x59, 0xda, 0xf8, 0x33, 0x82, 0x52, 0x6a, 0x8d, 0xb8, 0xb3, 0x98,
-	0xfb, 0xfa, 0xfe, 0x6b, 0x0f, 0x6f, 0x81, 0x94, 0x56, 0x1e, 0xc5, 0x56, 0xda, 0x58, 0x5f, 0x6c,
-	0x25, 0x9d, 0x97, 0x68, 0x16, 0x22, 0x84, 0x37, 0xcd, 0x62, 0x2e, 0xe9, 0x37, 0xcd, 0x62, 0x3e,
-	0xfd, 0xcb, 0x66, 0x21, 0x72, 0xbe, 0xf7, 0xfa, 0x62, 0xaa, 0xa1, 0xcb, 0xa9, 0x86, 0x7e, 0x4f,
-	0x35, 0xf4, 0x65, 0xa6, 0xe5, 0x2e, 0x67, 0x5a, 0xee, 0xe7, 0x4c, 0xcb, 0x1d, 0x3d, 0x19, 0x3a,
-	0xe1, 0xc9, 0xe8, 0xd8, 0xb0, 0x99, 0x6b, 0x06, 0xa7, 0x0e, 0x7f, 0xec, 0xd2, 0x71, 0x42, 0x35,
-	0xb6, 0xcc, 0xf7, 0x29, 0xbe, 0xf8, 0x12, 0x38, 0xce, 0xc7, 0x97, 0xea, 0xd3, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x10, 0x69, 0xe0, 0x2c, 0x2e, 0x06, 0x00, 0x00,
+func (m *PendingMarketsRequest) Reset()         { *m = PendingMarketsRequest{} }
+func (m *PendingMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingMarketsRequest) ProtoMessage()    {}
+func (*PendingMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{10}
+}
+func (m *PendingMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMarketsRequest.Merge(m, src)
+}
+func (m *PendingMarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMarketsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_PendingMarketsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// PendingMarketsResponse is the response type for the Query/PendingMarkets RPC
+// method.
+type PendingMarketsResponse struct {
+	// PendingMarkets is a list of all pending markets, sorted by ticker.
+	PendingMarkets []PendingMarket `protobuf:"bytes,1,rep,name=pending_markets,json=pendingMarkets,proto3" json:"pending_markets"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.  NOTE: the value returned by this query is not safe for on-chain
-	// code.
-	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
-	// Market returns all stored in the x/marketmap
-	// module as a sorted list.
-	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+func (m *PendingMarketsResponse) Reset()         { *m = PendingMarketsResponse{} }
+func (m *PendingMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingMarketsResponse) ProtoMessage()    {}
+func (*PendingMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{11}
+}
+func (m *PendingMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMarketsResponse.Merge(m, src)
+}
+func (m *PendingMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMarketsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_PendingMarketsResponse proto.InternalMessageInfo
+
+func (m *PendingMarketsResponse) GetPendingMarkets() []PendingMarket {
+	if m != nil {
+		return m.PendingMarkets
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// MarketMapProposalsRequest is the request type for the
+// Query/MarketMapProposals RPC method.
+type MarketMapProposalsRequest struct {
 }
 
-func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
-	out := new(MarketMapResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketMapProposalsRequest) Reset()         { *m = MarketMapProposalsRequest{} }
+func (m *MarketMapProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketMapProposalsRequest) ProtoMessage()    {}
+func (*MarketMapProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{12}
+}
+func (m *MarketMapProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MarketMapProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapProposalsRequest.Merge(m, src)
+}
+func (m *MarketMapProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapProposalsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
-	out := new(MarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Markets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MarketMapProposalsRequest proto.InternalMessageInfo
+
+// MarketMapProposalsResponse is the response type for the
+// Query/MarketMapProposals RPC method.
+type MarketMapProposalsResponse struct {
+	// Proposals is a list of all proposals under review, sorted by id.
+	Proposals []MarketMapProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
 }
 
-func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
-	out := new(MarketResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Market", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketMapProposalsResponse) Reset()         { *m = MarketMapProposalsResponse{} }
+func (m *MarketMapProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketMapProposalsResponse) ProtoMessage()    {}
+func (*MarketMapProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{13}
+}
+func (m *MarketMapProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MarketMapProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapProposalsResponse.Merge(m, src)
+}
+func (m *MarketMapProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapProposalsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
-	out := new(LastUpdatedResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/LastUpdated", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketMapProposalsResponse proto.InternalMessageInfo
+
+func (m *MarketMapProposalsResponse) GetProposals() []MarketMapProposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MarketMapProposalRequest is the request type for the
+// Query/MarketMapProposal RPC method.
+type MarketMapProposalRequest struct {
+	// ProposalId is the identifier of the proposal to query.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MarketMapProposalRequest) Reset()         { *m = MarketMapProposalRequest{} }
+func (m *MarketMapProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketMapProposalRequest) ProtoMessage()    {}
+func (*MarketMapProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{14}
+}
+func (m *MarketMapProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MarketMapProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapProposalRequest.Merge(m, src)
+}
+func (m *MarketMapProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapProposalRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.  NOTE: the value returned by this query is not safe for on-chain
-	// code.
-	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
-	// Market returns all stored in the x/marketmap
-	// module as a sorted list.
-	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(context.Context, *MarketRequest) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+var xxx_messageInfo_MarketMapProposalRequest proto.InternalMessageInfo
+
+func (m *MarketMapProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// SimulateMarketUpdatesRequest is the request type for the
+// Query/SimulateMarketUpdates RPC method.
+type SimulateMarketUpdatesRequest struct {
+	// Markets is the list of markets to upsert, as they would be submitted in a
+	// MsgUpsertMarkets.
+	Markets []Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
 }
 
-func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+func (m *SimulateMarketUpdatesRequest) Reset()         { *m = SimulateMarketUpdatesRequest{} }
+func (m *SimulateMarketUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateMarketUpdatesRequest) ProtoMessage()    {}
+func (*SimulateMarketUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{15}
 }
-func (*UnimplementedQueryServer) Markets(ctx context.Context, req *MarketsRequest) (*MarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+func (m *SimulateMarketUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+func (m *SimulateMarketUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateMarketUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+func (m *SimulateMarketUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateMarketUpdatesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SimulateMarketUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateMarketUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateMarketUpdatesRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_SimulateMarketUpdatesRequest proto.InternalMessageInfo
+
+func (m *SimulateMarketUpdatesRequest) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
 }
 
-func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketMapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// SimulateMarketUpdatesResponse is the response type for the
+// Query/SimulateMarketUpdates RPC method.
+type SimulateMarketUpdatesResponse struct {
+	// Diffs is the diff of each of the requested markets against the current
+	// market map, in request order.
+	Diffs []MarketDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs"`
+	// Errors is the list of every validation error the upsert would encounter.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Valid is true if the upsert would succeed, i.e. there are no errors.
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (m *SimulateMarketUpdatesResponse) Reset()         { *m = SimulateMarketUpdatesResponse{} }
+func (m *SimulateMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateMarketUpdatesResponse) ProtoMessage()    {}
+func (*SimulateMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{16}
+}
+func (m *SimulateMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MarketMap(ctx, in)
+}
+func (m *SimulateMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateMarketUpdatesResponse.Merge(m, src)
+}
+func (m *SimulateMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateMarketUpdatesResponse proto.InternalMessageInfo
+
+func (m *SimulateMarketUpdatesResponse) GetDiffs() []MarketDiff {
+	if m != nil {
+		return m.Diffs
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/MarketMap",
+	return nil
+}
+
+func (m *SimulateMarketUpdatesResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+	return nil
+}
+
+func (m *SimulateMarketUpdatesResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Markets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Markets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Markets(ctx, req.(*MarketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MarketDiff is the difference between a market in the market map and the
+// market that would replace it.
+type MarketDiff struct {
+	// Ticker is the ticker string (BASE/QUOTE) of the market.
+	Ticker string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Created is true if the market does not exist and would be created. In
+	// that case, all previous fields are unset and all providers are added.
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// PreviousDecimals is the current number of decimals of the market.
+	PreviousDecimals uint64 `protobuf:"varint,3,opt,name=previous_decimals,json=previousDecimals,proto3" json:"previous_decimals,omitempty"`
+	// Decimals is the number of decimals of the market after the update.
+	Decimals uint64 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// PreviousMinProviderCount is the current minimum provider count of the
+	// market.
+	PreviousMinProviderCount uint64 `protobuf:"varint,5,opt,name=previous_min_provider_count,json=previousMinProviderCount,proto3" json:"previous_min_provider_count,omitempty"`
+	// MinProviderCount is the minimum provider count of the market after the
+	// update.
+	MinProviderCount uint64 `protobuf:"varint,6,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// PreviousEnabled is true if the market is currently enabled.
+	PreviousEnabled bool `protobuf:"varint,7,opt,name=previous_enabled,json=previousEnabled,proto3" json:"previous_enabled,omitempty"`
+	// Enabled is true if the market is enabled after the update.
+	Enabled bool `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// MetadataChanged is true if the ticker metadata JSON changes.
+	MetadataChanged bool `protobuf:"varint,9,opt,name=metadata_changed,json=metadataChanged,proto3" json:"metadata_changed,omitempty"`
+	// AddedProviders are the provider configs, identified by provider name and
+	// off-chain ticker, that would be added to the market.
+	AddedProviders []ProviderConfig `protobuf:"bytes,10,rep,name=added_providers,json=addedProviders,proto3" json:"added_providers"`
+	// RemovedProviders are the provider configs that would be removed from the
+	// market.
+	RemovedProviders []ProviderConfig `protobuf:"bytes,11,rep,name=removed_providers,json=removedProviders,proto3" json:"removed_providers"`
+	// ChangedProviders are the provider configs that exist both before and
+	// after the update, but whose configuration changes.
+	ChangedProviders []ProviderConfigDiff `protobuf:"bytes,12,rep,name=changed_providers,json=changedProviders,proto3" json:"changed_providers"`
 }
 
-func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Market(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Market",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+func (m *MarketDiff) Reset()         { *m = MarketDiff{} }
+func (m *MarketDiff) String() string { return proto.CompactTextString(m) }
+func (*MarketDiff) ProtoMessage()    {}
+func (*MarketDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{17}
+}
+func (m *MarketDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MarketDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDiff.Merge(m, src)
+}
+func (m *MarketDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDiff.DiscardUnknown(m)
 }
 
-func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastUpdatedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastUpdated(ctx, in)
+var xxx_messageInfo_MarketDiff proto.InternalMessageInfo
+
+func (m *MarketDiff) GetTicker() string {
+	if m != nil {
+		return m.Ticker
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/LastUpdated",
+	return ""
+}
+
+func (m *MarketDiff) GetCreated() bool {
+	if m != nil {
+		return m.Created
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+	return false
+}
+
+func (m *MarketDiff) GetPreviousDecimals() uint64 {
+	if m != nil {
+		return m.PreviousDecimals
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MarketDiff) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *MarketDiff) GetPreviousMinProviderCount() uint64 {
+	if m != nil {
+		return m.PreviousMinProviderCount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Query/Params",
+	return 0
+}
+
+func (m *MarketDiff) GetMinProviderCount() uint64 {
+	if m != nil {
+		return m.MinProviderCount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	return 0
+}
+
+func (m *MarketDiff) GetPreviousEnabled() bool {
+	if m != nil {
+		return m.PreviousEnabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.marketmap.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MarketMap",
-			Handler:    _Query_MarketMap_Handler,
-		},
-		{
-			MethodName: "Markets",
-			Handler:    _Query_Markets_Handler,
-		},
-		{
-			MethodName: "Market",
-			Handler:    _Query_Market_Handler,
-		},
-		{
-			MethodName: "LastUpdated",
-			Handler:    _Query_LastUpdated_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/marketmap/v2/query.proto",
+func (m *MarketDiff) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketDiff) GetMetadataChanged() bool {
+	if m != nil {
+		return m.MetadataChanged
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketDiff) GetAddedProviders() []ProviderConfig {
+	if m != nil {
+		return m.AddedProviders
+	}
+	return nil
 }
 
-func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketDiff) GetRemovedProviders() []ProviderConfig {
+	if m != nil {
+		return m.RemovedProviders
+	}
+	return nil
 }
 
-func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketDiff) GetChangedProviders() []ProviderConfigDiff {
+	if m != nil {
+		return m.ChangedProviders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProviderConfigDiff is the difference of a provider config, identified by
+// provider name and off-chain ticker, before and after a market update.
+type ProviderConfigDiff struct {
+	// Previous is the current provider config.
+	Previous ProviderConfig `protobuf:"bytes,1,opt,name=previous,proto3" json:"previous"`
+	// ProviderConfig is the provider config after the update.
+	ProviderConfig ProviderConfig `protobuf:"bytes,2,opt,name=provider_config,json=providerConfig,proto3" json:"provider_config"`
 }
 
-func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+func (m *ProviderConfigDiff) Reset()         { *m = ProviderConfigDiff{} }
+func (m *ProviderConfigDiff) String() string { return proto.CompactTextString(m) }
+func (*ProviderConfigDiff) ProtoMessage()    {}
+func (*ProviderConfigDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{18}
+}
+func (m *ProviderConfigDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderConfigDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderConfigDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ProviderConfigDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderConfigDiff.Merge(m, src)
 }
-
-func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderConfigDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderConfigDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderConfigDiff.DiscardUnknown(m)
 }
 
-func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ProviderConfigDiff proto.InternalMessageInfo
+
+func (m *ProviderConfigDiff) GetPrevious() ProviderConfig {
+	if m != nil {
+		return m.Previous
+	}
+	return ProviderConfig{}
 }
 
-func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderConfigDiff) GetProviderConfig() ProviderConfig {
+	if m != nil {
+		return m.ProviderConfig
 	}
-	return dAtA[:n], nil
+	return ProviderConfig{}
 }
 
-func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketMapProposalResponse is the response type for the
+// Query/MarketMapProposal RPC method.
+type MarketMapProposalResponse struct {
+	// Proposal is the requested proposal.
+	Proposal MarketMapProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
 
-func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MarketMapProposalResponse) Reset()         { *m = MarketMapProposalResponse{} }
+func (m *MarketMapProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketMapProposalResponse) ProtoMessage()    {}
+func (*MarketMapProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc65f1e15c5a0bef, []int{19}
+}
+func (m *MarketMapProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketMapProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapProposalResponse.Merge(m, src)
+}
+func (m *MarketMapProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketMapProposalResponse proto.InternalMessageInfo
+
+func (m *MarketMapProposalResponse) GetProposal() MarketMapProposal {
+	if m != nil {
+		return m.Proposal
 	}
-	return dAtA[:n], nil
+	return MarketMapProposal{}
 }
 
-func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MarketMapRequest)(nil), "connect.marketmap.v2.MarketMapRequest")
+	proto.RegisterType((*MarketMapResponse)(nil), "connect.marketmap.v2.MarketMapResponse")
+	proto.RegisterType((*MarketsRequest)(nil), "connect.marketmap.v2.MarketsRequest")
+	proto.RegisterType((*MarketsResponse)(nil), "connect.marketmap.v2.MarketsResponse")
+	proto.RegisterType((*MarketRequest)(nil), "connect.marketmap.v2.MarketRequest")
+	proto.RegisterType((*MarketResponse)(nil), "connect.marketmap.v2.MarketResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "connect.marketmap.v2.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "connect.marketmap.v2.ParamsResponse")
+	proto.RegisterType((*LastUpdatedRequest)(nil), "connect.marketmap.v2.LastUpdatedRequest")
+	proto.RegisterType((*LastUpdatedResponse)(nil), "connect.marketmap.v2.LastUpdatedResponse")
+	proto.RegisterType((*PendingMarketsRequest)(nil), "connect.marketmap.v2.PendingMarketsRequest")
+	proto.RegisterType((*PendingMarketsResponse)(nil), "connect.marketmap.v2.PendingMarketsResponse")
+	proto.RegisterType((*MarketMapProposalsRequest)(nil), "connect.marketmap.v2.MarketMapProposalsRequest")
+	proto.RegisterType((*MarketMapProposalsResponse)(nil), "connect.marketmap.v2.MarketMapProposalsResponse")
+	proto.RegisterType((*MarketMapProposalRequest)(nil), "connect.marketmap.v2.MarketMapProposalRequest")
+	proto.RegisterType((*SimulateMarketUpdatesRequest)(nil), "connect.marketmap.v2.SimulateMarketUpdatesRequest")
+	proto.RegisterType((*SimulateMarketUpdatesResponse)(nil), "connect.marketmap.v2.SimulateMarketUpdatesResponse")
+	proto.RegisterType((*MarketDiff)(nil), "connect.marketmap.v2.MarketDiff")
+	proto.RegisterType((*ProviderConfigDiff)(nil), "connect.marketmap.v2.ProviderConfigDiff")
+	proto.RegisterType((*MarketMapProposalResponse)(nil), "connect.marketmap.v2.MarketMapProposalResponse")
 }
 
-func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("connect/marketmap/v2/query.proto", fileDescriptor_fc65f1e15c5a0bef) }
+
+var fileDescriptor_fc65f1e15c5a0bef = []byte{
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0xe3, 0x54,
+	0x10, 0xaf, 0xfb, 0x99, 0x4c, 0xfa, 0x39, 0x74, 0x17, 0x6f, 0xb6, 0x9b, 0xa6, 0x6e, 0x4a, 0xb3,
+	0x1f, 0x8d, 0x57, 0x59, 0x21, 0xad, 0x16, 0xb8, 0x6c, 0x0b, 0x52, 0xa1, 0x15, 0x25, 0x2b, 0x84,
+	0xb4, 0x20, 0x59, 0xaf, 0xf6, 0x4b, 0x6a, 0x35, 0xfe, 0x58, 0xdb, 0x89, 0xa8, 0xd0, 0x5e, 0x90,
+	0x38, 0x21, 0x21, 0x24, 0x0e, 0x48, 0x9c, 0xb8, 0x73, 0xe2, 0xca, 0x89, 0xe3, 0x1e, 0x57, 0xe2,
+	0xc2, 0x09, 0xa1, 0x96, 0x3b, 0xff, 0x02, 0xb2, 0xdf, 0x47, 0x9d, 0x36, 0x71, 0x5d, 0x71, 0xcb,
+	0x9b, 0xf7, 0x9b, 0xdf, 0xfc, 0xde, 0xcc, 0x9b, 0xe7, 0x09, 0x54, 0x4d, 0xcf, 0x75, 0xa9, 0x19,
+	0xe9, 0x0e, 0x09, 0x8e, 0x69, 0xe4, 0x10, 0x5f, 0xef, 0x37, 0xf5, 0x17, 0x3d, 0x1a, 0x9c, 0x34,
+	0xfc, 0xc0, 0x8b, 0x3c, 0x5c, 0xe6, 0x88, 0x86, 0x44, 0x34, 0xfa, 0xcd, 0xf2, 0x72, 0xc7, 0xeb,
+	0x78, 0x09, 0x40, 0x8f, 0x7f, 0x31, 0x6c, 0x79, 0xa5, 0xe3, 0x79, 0x9d, 0x2e, 0xd5, 0x89, 0x6f,
+	0xeb, 0xc4, 0x75, 0xbd, 0x88, 0x44, 0xb6, 0xe7, 0x86, 0x7c, 0xb7, 0x26, 0x62, 0x45, 0x27, 0x3e,
+	0x0d, 0xe3, 0x38, 0x66, 0x2f, 0x08, 0xa8, 0x6b, 0x9e, 0x18, 0x3e, 0xb1, 0x03, 0x8e, 0x5a, 0x1b,
+	0xaa, 0x88, 0x2d, 0x32, 0x21, 0x3e, 0x09, 0x88, 0x23, 0x62, 0xad, 0x0f, 0x87, 0x04, 0x9e, 0xef,
+	0x85, 0xa4, 0xcb, 0x40, 0x1a, 0xc2, 0xe2, 0x7e, 0xb2, 0xbd, 0x4f, 0xfc, 0x16, 0x7d, 0xd1, 0xa3,
+	0x61, 0xa4, 0xfd, 0xa8, 0xc0, 0x52, 0xca, 0x18, 0xfa, 0x9e, 0x1b, 0x52, 0xdc, 0x01, 0x60, 0x44,
+	0x86, 0x43, 0x7c, 0x55, 0xa9, 0x2a, 0xf5, 0x52, 0x73, 0xb5, 0x31, 0x2c, 0x33, 0x0d, 0xe9, 0xfc,
+	0x74, 0xf2, 0xd5, 0x5f, 0xab, 0x63, 0xad, 0xa2, 0x23, 0x0c, 0xb8, 0x06, 0xb3, 0x5d, 0x12, 0x46,
+	0x46, 0xcf, 0xb7, 0x48, 0x44, 0x2d, 0x75, 0xbc, 0xaa, 0xd4, 0x27, 0x5b, 0xa5, 0xd8, 0xf6, 0x29,
+	0x33, 0xe1, 0x2d, 0x28, 0x98, 0x47, 0xc4, 0x76, 0x0d, 0xdb, 0x52, 0x27, 0xaa, 0x4a, 0xbd, 0xd8,
+	0x9a, 0x49, 0xd6, 0xbb, 0x96, 0xb6, 0x08, 0xf3, 0x8c, 0x3b, 0x14, 0x5a, 0x3f, 0x86, 0x05, 0x69,
+	0xe1, 0x42, 0xdf, 0x85, 0x19, 0x16, 0x2f, 0x54, 0x95, 0xea, 0x44, 0xbd, 0xd4, 0x5c, 0xc9, 0x52,
+	0xc9, 0x25, 0x0a, 0x17, 0xed, 0x39, 0xcc, 0xb1, 0x0d, 0x1e, 0x01, 0x77, 0x61, 0x6e, 0xa0, 0x46,
+	0xfc, 0xe8, 0x15, 0x49, 0x9a, 0x94, 0x32, 0x26, 0xdc, 0xe6, 0xb0, 0x03, 0x62, 0x07, 0x9c, 0x76,
+	0xd6, 0x4c, 0xd9, 0xb4, 0x3d, 0x21, 0x5f, 0x6a, 0x7d, 0x02, 0xd3, 0x2c, 0x30, 0x67, 0xcd, 0x23,
+	0x95, 0x7b, 0x68, 0x0b, 0x30, 0x77, 0x90, 0xd4, 0x5b, 0xe4, 0x62, 0x0f, 0xe6, 0x85, 0xe1, 0x9c,
+	0x9e, 0x5d, 0x89, 0x6c, 0x7a, 0xe6, 0x25, 0xe8, 0x99, 0x87, 0xb6, 0x0c, 0xb8, 0x77, 0x5e, 0x15,
+	0x11, 0xe3, 0x31, 0xbc, 0x31, 0x60, 0xe5, 0x81, 0x2e, 0x96, 0x55, 0xb9, 0x54, 0x56, 0xed, 0x4d,
+	0xb8, 0x71, 0x40, 0x5d, 0xcb, 0x76, 0x3b, 0x17, 0x4a, 0xd8, 0x85, 0x9b, 0x17, 0x37, 0x38, 0x6b,
+	0x0b, 0x16, 0x7c, 0xb6, 0x63, 0x0c, 0x56, 0x74, 0x7d, 0xc4, 0x39, 0xd2, 0x34, 0xfc, 0x38, 0xf3,
+	0xfe, 0x00, 0xb7, 0x76, 0x1b, 0x6e, 0xc9, 0xeb, 0x79, 0xc0, 0x7b, 0x41, 0x4a, 0xb1, 0xa1, 0x3c,
+	0x6c, 0x93, 0xcb, 0xf9, 0x08, 0x8a, 0xa2, 0x7b, 0x84, 0x90, 0xcd, 0x2b, 0x1a, 0x40, 0x90, 0x88,
+	0x46, 0x90, 0xfe, 0xda, 0x3b, 0xa0, 0x5e, 0x42, 0x89, 0x2b, 0xb7, 0x0a, 0x25, 0x01, 0x34, 0x6c,
+	0x91, 0x4c, 0x10, 0xa6, 0x5d, 0x4b, 0xfb, 0x02, 0x56, 0x9e, 0xd9, 0x4e, 0xaf, 0x4b, 0x22, 0xca,
+	0x48, 0x58, 0x92, 0xc5, 0x39, 0xfe, 0x67, 0x0b, 0x7c, 0xab, 0xc0, 0x9d, 0x11, 0xf4, 0xb2, 0xc5,
+	0xa6, 0x2c, 0xbb, 0xdd, 0x16, 0xec, 0xd5, 0x2c, 0xf6, 0x1d, 0xbb, 0xdd, 0xe6, 0x11, 0x98, 0x13,
+	0xde, 0x84, 0x69, 0x1a, 0x04, 0x5e, 0x10, 0xaa, 0xe3, 0xd5, 0x89, 0x7a, 0xb1, 0xc5, 0x57, 0xb8,
+	0x0c, 0x53, 0x7d, 0xd2, 0xe5, 0x5d, 0x5f, 0x68, 0xb1, 0x85, 0xf6, 0xef, 0x24, 0xc0, 0x39, 0x53,
+	0xec, 0x1c, 0xd9, 0xe6, 0x31, 0x65, 0x7d, 0x58, 0x6c, 0xf1, 0x15, 0xaa, 0x30, 0x63, 0x06, 0x54,
+	0xbe, 0x29, 0x85, 0x96, 0x58, 0xe2, 0x7d, 0x58, 0xf2, 0x03, 0xda, 0xb7, 0xbd, 0x5e, 0x68, 0x58,
+	0xd4, 0xb4, 0x9d, 0xb8, 0x7c, 0x13, 0x49, 0x4e, 0x17, 0xc5, 0xc6, 0x0e, 0xb7, 0x63, 0x19, 0x0a,
+	0x12, 0x33, 0x99, 0x60, 0xe4, 0x1a, 0xdf, 0x83, 0xdb, 0x92, 0xc8, 0xb1, 0x5d, 0xc3, 0x0f, 0xbc,
+	0xbe, 0x6d, 0xd1, 0xc0, 0x30, 0xbd, 0x9e, 0x1b, 0xa9, 0x53, 0x09, 0x5c, 0x15, 0x90, 0x7d, 0xdb,
+	0x3d, 0xe0, 0x80, 0xed, 0x78, 0x1f, 0x1f, 0x00, 0x0e, 0xf1, 0x9a, 0x66, 0x42, 0x9c, 0x8b, 0xe8,
+	0xbb, 0x20, 0xc5, 0x19, 0xd4, 0x25, 0x87, 0x5d, 0x6a, 0xa9, 0x33, 0xc9, 0xc1, 0x16, 0x84, 0xfd,
+	0x7d, 0x66, 0x8e, 0x8f, 0x2e, 0x10, 0x05, 0x76, 0x74, 0xbe, 0x8c, 0x49, 0x1c, 0x1a, 0x11, 0x8b,
+	0x44, 0xc4, 0x30, 0x8f, 0x88, 0xdb, 0xa1, 0x96, 0x5a, 0x64, 0x24, 0xc2, 0xbe, 0xcd, 0xcc, 0xf8,
+	0x0c, 0x16, 0x88, 0x65, 0x51, 0x4b, 0xea, 0x0b, 0x55, 0x48, 0x8a, 0x5b, 0x1b, 0xd1, 0x6b, 0x52,
+	0xad, 0xdb, 0xb6, 0x3b, 0xa2, 0xd9, 0x12, 0x0a, 0xb1, 0x15, 0xe2, 0x67, 0xb0, 0x14, 0x50, 0xc7,
+	0xeb, 0x0f, 0xd0, 0x96, 0xae, 0x4d, 0xbb, 0xc8, 0x49, 0xce, 0x89, 0x3f, 0x87, 0x25, 0x7e, 0x9e,
+	0x14, 0xf1, 0x6c, 0x42, 0x5c, 0xcf, 0x43, 0x9c, 0xba, 0x94, 0x8b, 0x9c, 0x48, 0x92, 0x6b, 0xbf,
+	0x2a, 0x80, 0x97, 0xe1, 0xf8, 0x01, 0x14, 0x44, 0xe6, 0xf9, 0x73, 0x7a, 0x9d, 0x33, 0x48, 0xdf,
+	0x38, 0xd3, 0xa9, 0x3b, 0x10, 0x43, 0xd4, 0xf1, 0x6b, 0xd3, 0xcd, 0xfb, 0x03, 0x56, 0xad, 0x3d,
+	0xe4, 0x59, 0x93, 0xed, 0xba, 0x1b, 0x2b, 0x67, 0x36, 0xae, 0xfc, 0x9a, 0xef, 0x96, 0x74, 0x6f,
+	0xfe, 0x0e, 0x30, 0xf5, 0x49, 0x3c, 0x1a, 0xe1, 0x37, 0x0a, 0x14, 0x25, 0x1e, 0xdf, 0xba, 0x82,
+	0x90, 0xbf, 0x4c, 0xe5, 0xcd, 0x2b, 0x71, 0x4c, 0xb3, 0xb6, 0xf9, 0xf5, 0x1f, 0xff, 0xfc, 0x30,
+	0xbe, 0x86, 0xab, 0x7a, 0xc6, 0x30, 0xe4, 0x10, 0x1f, 0x5f, 0xc2, 0x0c, 0xf3, 0x0e, 0xb1, 0x96,
+	0x45, 0x2e, 0x1e, 0xc7, 0xf2, 0xc6, 0x15, 0x28, 0x2e, 0x60, 0x23, 0x11, 0xb0, 0x8a, 0x77, 0xb2,
+	0x04, 0x84, 0x78, 0x02, 0xd3, 0xcc, 0x13, 0xd7, 0xb3, 0x78, 0x45, 0xf0, 0x5a, 0x36, 0x88, 0xc7,
+	0xae, 0x25, 0xb1, 0x2b, 0xb8, 0x92, 0x15, 0x1b, 0xbf, 0x53, 0xa0, 0x94, 0xfa, 0x18, 0xe3, 0x88,
+	0x9b, 0x7f, 0xf9, 0x2b, 0x5e, 0xbe, 0x9b, 0x03, 0xc9, 0xa5, 0xdc, 0x4b, 0xa4, 0xd4, 0x50, 0x1b,
+	0x2e, 0x25, 0xfd, 0xd5, 0x8f, 0x73, 0xc1, 0x46, 0x89, 0x51, 0xb9, 0x18, 0x98, 0x57, 0xca, 0xb5,
+	0x6c, 0x50, 0xbe, 0x5c, 0xb0, 0x69, 0x05, 0x7f, 0x52, 0x60, 0x7e, 0x70, 0x8a, 0xc0, 0xfb, 0x39,
+	0x86, 0x04, 0xa9, 0xe5, 0x41, 0x3e, 0x30, 0xd7, 0xb4, 0x95, 0x68, 0xda, 0xc4, 0x8d, 0x11, 0x9a,
+	0x06, 0x87, 0x16, 0xfc, 0x59, 0x01, 0xbc, 0x3c, 0x57, 0xa0, 0x9e, 0xb3, 0x09, 0xa5, 0xc8, 0x87,
+	0xf9, 0x1d, 0xf2, 0x75, 0x91, 0x1c, 0x47, 0xf0, 0x97, 0xf4, 0xcc, 0x2f, 0x78, 0xb0, 0x91, 0x33,
+	0xa0, 0x10, 0xa8, 0xe7, 0xc6, 0x73, 0x7d, 0x6f, 0x27, 0xfa, 0x74, 0xdc, 0xba, 0x42, 0x9f, 0xfe,
+	0x55, 0x6a, 0x20, 0x7a, 0x89, 0xbf, 0x29, 0x70, 0x63, 0xe8, 0x84, 0x82, 0xcd, 0xe1, 0x0a, 0xb2,
+	0xa6, 0xa5, 0xf2, 0xa3, 0x6b, 0xf9, 0x70, 0xe5, 0x8f, 0x13, 0xe5, 0x4d, 0x6d, 0x84, 0xf2, 0x90,
+	0x3b, 0xf3, 0x3b, 0xc0, 0x5b, 0x24, 0x7c, 0xa2, 0xdc, 0x7b, 0xfa, 0xe1, 0xab, 0xd3, 0x8a, 0xf2,
+	0xfa, 0xb4, 0xa2, 0xfc, 0x7d, 0x5a, 0x51, 0xbe, 0x3f, 0xab, 0x8c, 0xbd, 0x3e, 0xab, 0x8c, 0xfd,
+	0x79, 0x56, 0x19, 0x7b, 0xfe, 0xb0, 0x63, 0x47, 0x47, 0xbd, 0xc3, 0x86, 0xe9, 0x39, 0x7a, 0x78,
+	0x6c, 0xfb, 0x5b, 0x0e, 0xed, 0x4b, 0xfa, 0x7e, 0x53, 0xff, 0x32, 0x15, 0x23, 0xf9, 0xd7, 0x71,
+	0x38, 0x9d, 0xfc, 0x8b, 0x7b, 0xf4, 0xdf, 0x00, 0x5e, 0x7b, 0xd1, 0xea, 0xc4, 0x0e, 0x00, 0x00,
 }
 
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MarketMap returns the full market map stored in the x/marketmap
+	// module.  NOTE: the value returned by this query is not safe for on-chain
+	// code.
+	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
+	// Market returns all stored in the x/marketmap
+	// module as a sorted list.
+	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingMarkets returns the market creates and updates that are scheduled
+	// to be applied at a future block height or time.
+	PendingMarkets(ctx context.Context, in *PendingMarketsRequest, opts ...grpc.CallOption) (*PendingMarketsResponse, error)
+	// MarketMapProposals returns all market map proposals under review.
+	MarketMapProposals(ctx context.Context, in *MarketMapProposalsRequest, opts ...grpc.CallOption) (*MarketMapProposalsResponse, error)
+	// MarketMapProposal returns the market map proposal under review with the
+	// given id.
+	MarketMapProposal(ctx context.Context, in *MarketMapProposalRequest, opts ...grpc.CallOption) (*MarketMapProposalResponse, error)
+	// SimulateMarketUpdates performs a dry run of upserting the given markets
+	// against the current state. It returns the diff of each market against
+	// the current market map and every validation error the upsert would
+	// encounter, without modifying state.
+	SimulateMarketUpdates(ctx context.Context, in *SimulateMarketUpdatesRequest, opts ...grpc.CallOption) (*SimulateMarketUpdatesResponse, error)
 }
 
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
+	out := new(MarketMapResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
+	out := new(MarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
+	out := new(MarketResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Market", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
+	out := new(LastUpdatedResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/LastUpdated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingMarkets(ctx context.Context, in *PendingMarketsRequest, opts ...grpc.CallOption) (*PendingMarketsResponse, error) {
+	out := new(PendingMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/PendingMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MarketMapProposals(ctx context.Context, in *MarketMapProposalsRequest, opts ...grpc.CallOption) (*MarketMapProposalsResponse, error) {
+	out := new(MarketMapProposalsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMapProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) MarketMapProposal(ctx context.Context, in *MarketMapProposalRequest, opts ...grpc.CallOption) (*MarketMapProposalResponse, error) {
+	out := new(MarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/MarketMapProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateMarketUpdates(ctx context.Context, in *SimulateMarketUpdatesRequest, opts ...grpc.CallOption) (*SimulateMarketUpdatesResponse, error) {
+	out := new(SimulateMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Query/SimulateMarketUpdates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MarketMap returns the full market map stored in the x/marketmap
+	// module.  NOTE: the value returned by this query is not safe for on-chain
+	// code.
+	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
+	// Market returns all stored in the x/marketmap
+	// module as a sorted list.
+	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(context.Context, *MarketRequest) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingMarkets returns the market creates and updates that are scheduled
+	// to be applied at a future block height or time.
+	PendingMarkets(context.Context, *PendingMarketsRequest) (*PendingMarketsResponse, error)
+	// MarketMapProposals returns all market map proposals under review.
+	MarketMapProposals(context.Context, *MarketMapProposalsRequest) (*MarketMapProposalsResponse, error)
+	// MarketMapProposal returns the market map proposal under review with the
+	// given id.
+	MarketMapProposal(context.Context, *MarketMapProposalRequest) (*MarketMapProposalResponse, error)
+	// SimulateMarketUpdates performs a dry run of upserting the given markets
+	// against the current state. It returns the diff of each market against
+	// the current market map and every validation error the upsert would
+	// encounter, without modifying state.
+	SimulateMarketUpdates(context.Context, *SimulateMarketUpdatesRequest) (*SimulateMarketUpdatesResponse, error)
 }
 
-func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
 }
-func (m *MarketMapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) Markets(ctx context.Context, req *MarketsRequest) (*MarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
 }
-
-func (m *MarketMapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PendingMarkets(ctx context.Context, req *PendingMarketsRequest) (*PendingMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMarkets not implemented")
+}
+func (*UnimplementedQueryServer) MarketMapProposals(ctx context.Context, req *MarketMapProposalsRequest) (*MarketMapProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapProposals not implemented")
+}
+func (*UnimplementedQueryServer) MarketMapProposal(ctx context.Context, req *MarketMapProposalRequest) (*MarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapProposal not implemented")
+}
+func (*UnimplementedQueryServer) SimulateMarketUpdates(ctx context.Context, req *SimulateMarketUpdatesRequest) (*SimulateMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMarketUpdates not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MarketMap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMap(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketMap",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*MarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).LastUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/LastUpdated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/PendingMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMarkets(ctx, req.(*PendingMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MarketMapProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketMapProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapProposals(ctx, req.(*MarketMapProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LastUpdatedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/MarketMapProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapProposal(ctx, req.(*MarketMapProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LastUpdatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulateMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMarketUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMarketUpdates(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Query/SimulateMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMarketUpdates(ctx, req.(*SimulateMarketUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.marketmap.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarketMap",
+			Handler:    _Query_MarketMap_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "LastUpdated",
+			Handler:    _Query_LastUpdated_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingMarkets",
+			Handler:    _Query_PendingMarkets_Handler,
+		},
+		{
+			MethodName: "MarketMapProposals",
+			Handler:    _Query_MarketMapProposals_Handler,
+		},
+		{
+			MethodName: "MarketMapProposal",
+			Handler:    _Query_MarketMapProposal_Handler,
+		},
+		{
+			MethodName: "SimulateMarketUpdates",
+			Handler:    _Query_SimulateMarketUpdates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/marketmap/v2/query.proto",
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingMarkets) > 0 {
+		for iNdEx := len(m.PendingMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateMarketUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateMarketUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateMarketUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangedProviders) > 0 {
+		for iNdEx := len(m.ChangedProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangedProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RemovedProviders) > 0 {
+		for iNdEx := len(m.RemovedProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AddedProviders) > 0 {
+		for iNdEx := len(m.AddedProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MetadataChanged {
+		i--
+		if m.MetadataChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PreviousEnabled {
+		i--
+		if m.PreviousEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinProviderCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinProviderCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PreviousMinProviderCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PreviousMinProviderCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PreviousDecimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Created {
+		i--
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderConfigDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderConfigDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderConfigDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarketMap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LastUpdatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LastUpdatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	return n
+}
+
+func (m *PendingMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PendingMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingMarkets) > 0 {
+		for _, e := range m.PendingMarkets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketMapProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketMapProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketMapProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *SimulateMarketUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *MarketDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Created {
+		n += 2
+	}
+	if m.PreviousDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.PreviousDecimals))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.PreviousMinProviderCount != 0 {
+		n += 1 + sovQuery(uint64(m.PreviousMinProviderCount))
+	}
+	if m.MinProviderCount != 0 {
+		n += 1 + sovQuery(uint64(m.MinProviderCount))
+	}
+	if m.PreviousEnabled {
+		n += 2
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.MetadataChanged {
+		n += 2
+	}
+	if len(m.AddedProviders) > 0 {
+		for _, e := range m.AddedProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemovedProviders) > 0 {
+		for _, e := range m.RemovedProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ChangedProviders) > 0 {
+		for _, e := range m.ChangedProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderConfigDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Previous.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProviderConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketMapProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMarkets = append(m.PendingMarkets, PendingMarket{})
+			if err := m.PendingMarkets[len(m.PendingMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, MarketMapProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateMarketUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateMarketUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateMarketUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1260,7 +3787,7 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,15 +3810,15 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateMarketUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1318,32 +3845,14 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Diffs = append(m.Diffs, MarketDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
-			}
-			m.LastUpdated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1371,8 +3880,28 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1394,7 +3923,7 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1417,65 +3946,203 @@ func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Created = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDecimals", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PreviousDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMinProviderCount", wireType)
+			}
+			m.PreviousMinProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousMinProviderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderCount", wireType)
+			}
+			m.MinProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProviderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreviousEnabled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MetadataChanged = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1502,64 +4169,14 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddedProviders = append(m.AddedProviders, ProviderConfig{})
+			if err := m.AddedProviders[len(m.AddedProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1586,63 +4203,14 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemovedProviders = append(m.RemovedProviders, ProviderConfig{})
+			if err := m.RemovedProviders[len(m.RemovedProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,7 +4237,8 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChangedProviders = append(m.ChangedProviders, ProviderConfigDiff{})
+			if err := m.ChangedProviders[len(m.ChangedProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1694,57 +4263,7 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ProviderConfigDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1767,15 +4286,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderConfigDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderConfigDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1802,60 +4321,43 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1877,7 +4379,7 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketMapProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,17 +4402,17 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMapProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMapProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			m.LastUpdated = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1920,11 +4422,25 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
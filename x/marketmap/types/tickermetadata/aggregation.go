@@ -0,0 +1,63 @@
+package tickermetadata
+
+import "encoding/json"
+
+// Aggregation is the optional aggregation configuration that may be embedded in any Ticker.Metadata_JSON
+// under the `aggregation` key. It selects the strategy the oracle uses to aggregate the converted provider
+// prices of the Ticker's market.
+type Aggregation struct {
+	// Strategy is the name of the aggregation strategy e.g. `median`, `trimmed_mean`,
+	// `liquidity_weighted_median` or `provider_weighted_median`.
+	Strategy string `json:"strategy"`
+	// TrimFraction is the fraction of prices that is discarded from each end before averaging.
+	// This is only used by the `trimmed_mean` strategy.
+	TrimFraction float64 `json:"trim_fraction,omitempty"`
+	// OutlierFilter is the optional outlier filter applied to the converted provider prices before
+	// they are aggregated.
+	OutlierFilter *OutlierFilter `json:"outlier_filter,omitempty"`
+}
+
+// OutlierFilter configures the outlier filter of a Ticker's market.
+type OutlierFilter struct {
+	// Method is the name of the outlier filter e.g. `mad` or `max_deviation`.
+	Method string `json:"method"`
+	// Threshold is the rejection threshold of the filter. For `mad` this is the number of median absolute
+	// deviations a price may be from the median, for `max_deviation` the maximum relative deviation.
+	Threshold float64 `json:"threshold"`
+	// MinPrices is the minimum number of prices required to apply the filter.
+	MinPrices int `json:"min_prices,omitempty"`
+}
+
+// AggregationMetadata is the subset of a Ticker.Metadata_JSON that configures aggregation.
+type AggregationMetadata struct {
+	// Aggregation is the aggregation configuration of the Ticker. This field may not be populated,
+	// in which case the oracle's default strategy is used.
+	Aggregation *Aggregation `json:"aggregation,omitempty"`
+}
+
+// AggregationMetadataFromJSONString returns an AggregationMetadata instance from a JSON string. Any
+// fields of the JSON that do not pertain to aggregation are ignored.
+func AggregationMetadataFromJSONString(jsonString string) (AggregationMetadata, error) {
+	var elem AggregationMetadata
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// ProviderWeight is the optional aggregation configuration that may be embedded in any
+// ProviderConfig.Metadata_JSON. It is consumed by the weighted aggregation strategies.
+type ProviderWeight struct {
+	// Weight is the relative weight of the provider's price. This is used by the
+	// `provider_weighted_median` strategy, and defaults to 1 if unset.
+	Weight *float64 `json:"weight,omitempty"`
+	// Liquidity gives a rough estimate of the liquidity backing the provider's price. This is used
+	// by the `liquidity_weighted_median` strategy. The value stored here is USD denominated.
+	Liquidity float64 `json:"liquidity,omitempty"`
+}
+
+// ProviderWeightFromJSONString returns a ProviderWeight instance from a JSON string. Any fields of
+// the JSON that do not pertain to aggregation are ignored.
+func ProviderWeightFromJSONString(jsonString string) (ProviderWeight, error) {
+	var elem ProviderWeight
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
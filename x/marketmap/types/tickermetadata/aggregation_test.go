@@ -0,0 +1,69 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalAggregationMetadata(t *testing.T) {
+	t.Run("can unmarshal aggregation alongside other metadata", func(t *testing.T) {
+		elemJSON := `{"aggregate_ids":[{"venue":"coingecko","ID":"id"}],"aggregation":{"strategy":"trimmed_mean","trim_fraction":0.2}}`
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, &tickermetadata.Aggregation{
+			Strategy:     "trimmed_mean",
+			TrimFraction: 0.2,
+		}, elem.Aggregation)
+
+		core, err := tickermetadata.CoreMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+		require.Len(t, core.AggregateIDs, 1)
+	})
+
+	t.Run("can unmarshal an outlier filter", func(t *testing.T) {
+		elemJSON := `{"aggregation":{"strategy":"median","outlier_filter":{"method":"mad","threshold":3,"min_prices":4}}}`
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, &tickermetadata.Aggregation{
+			Strategy: "median",
+			OutlierFilter: &tickermetadata.OutlierFilter{
+				Method:    "mad",
+				Threshold: 3,
+				MinPrices: 4,
+			},
+		}, elem.Aggregation)
+	})
+
+	t.Run("aggregation is nil if not configured", func(t *testing.T) {
+		elem, err := tickermetadata.AggregationMetadataFromJSONString(`{"reference_price":100}`)
+		require.NoError(t, err)
+		require.Nil(t, elem.Aggregation)
+	})
+
+	t.Run("errors on invalid JSON", func(t *testing.T) {
+		_, err := tickermetadata.AggregationMetadataFromJSONString(`{"aggregation":`)
+		require.Error(t, err)
+	})
+}
+
+func Test_UnmarshalProviderWeight(t *testing.T) {
+	t.Run("can unmarshal weight and liquidity alongside provider metadata", func(t *testing.T) {
+		elem, err := tickermetadata.ProviderWeightFromJSONString(`{"address":"0x1","weight":2.5,"liquidity":1000}`)
+		require.NoError(t, err)
+		require.NotNil(t, elem.Weight)
+		require.Equal(t, 2.5, *elem.Weight)
+		require.Equal(t, float64(1000), elem.Liquidity)
+	})
+
+	t.Run("weight is nil if not configured", func(t *testing.T) {
+		elem, err := tickermetadata.ProviderWeightFromJSONString(`{}`)
+		require.NoError(t, err)
+		require.Nil(t, elem.Weight)
+		require.Zero(t, elem.Liquidity)
+	})
+}
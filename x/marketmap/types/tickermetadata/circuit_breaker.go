@@ -0,0 +1,29 @@
+package tickermetadata
+
+import "encoding/json"
+
+// CircuitBreaker is the optional circuit breaker configuration that may be embedded in any
+// Ticker.Metadata_JSON under the `circuit_breaker` key. It overrides the x/oracle module's default
+// bound on the change of the Ticker's price in a single block.
+type CircuitBreaker struct {
+	// MaxPriceChangeBps is the maximum change, in basis points of the previous price, that the
+	// Ticker's price may move in a single block. If zero, the circuit breaker is disabled for the Ticker.
+	MaxPriceChangeBps uint64 `json:"max_price_change_bps"`
+	// Action is the action taken when the maximum change is exceeded e.g. `clamp`, `skip` or `halt`.
+	Action string `json:"action"`
+}
+
+// CircuitBreakerMetadata is the subset of a Ticker.Metadata_JSON that configures the circuit breaker.
+type CircuitBreakerMetadata struct {
+	// CircuitBreaker is the circuit breaker configuration of the Ticker. This field may not be
+	// populated, in which case the x/oracle module's default circuit breaker is used.
+	CircuitBreaker *CircuitBreaker `json:"circuit_breaker,omitempty"`
+}
+
+// CircuitBreakerMetadataFromJSONString returns a CircuitBreakerMetadata instance from a JSON string. Any
+// fields of the JSON that do not pertain to the circuit breaker are ignored.
+func CircuitBreakerMetadataFromJSONString(jsonString string) (CircuitBreakerMetadata, error) {
+	var elem CircuitBreakerMetadata
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
@@ -0,0 +1,60 @@
+package tickermetadata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Quorum is the optional quorum configuration that may be embedded in any Ticker.Metadata_JSON under the
+// `quorum` key. It adds requirements on the sources of the converted provider prices of the Ticker's market,
+// on top of the Ticker's MinProviderCount, that must be met for the oracle to aggregate a price.
+type Quorum struct {
+	// MinVenues is the minimum number of distinct venues the prices must come from. Providers of the same
+	// venue, e.g. `binance_api` and `binance_ws`, count as a single venue.
+	MinVenues uint64 `json:"min_venues,omitempty"`
+	// MinDirectSources is the minimum number of prices that must be quoted directly in the Ticker's
+	// currency pair, i.e. that are not normalized by another market.
+	MinDirectSources uint64 `json:"min_direct_sources,omitempty"`
+	// MaxVenueShare is the maximum fraction, in (0, 1], of the prices that may come from a single venue.
+	// If zero, the share of a venue is not limited.
+	MaxVenueShare float64 `json:"max_venue_share,omitempty"`
+}
+
+// ValidateBasic performs stateless validation of the Quorum.
+func (q Quorum) ValidateBasic() error {
+	if q.MaxVenueShare < 0 || q.MaxVenueShare > 1 {
+		return fmt.Errorf("max venue share must be between 0 and 1; got %f", q.MaxVenueShare)
+	}
+
+	return nil
+}
+
+// QuorumMetadata is the subset of a Ticker.Metadata_JSON that configures the quorum.
+type QuorumMetadata struct {
+	// Quorum is the quorum configuration of the Ticker. This field may not be populated, in which case
+	// only the Ticker's MinProviderCount is required.
+	Quorum *Quorum `json:"quorum,omitempty"`
+}
+
+// QuorumMetadataFromJSONString returns a QuorumMetadata instance from a JSON string. Any fields of the
+// JSON that do not pertain to the quorum are ignored.
+func QuorumMetadataFromJSONString(jsonString string) (QuorumMetadata, error) {
+	var elem QuorumMetadata
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// ProviderVenue is the optional venue configuration that may be embedded in any ProviderConfig.Metadata_JSON.
+// It overrides the venue the oracle derives from the provider name when checking a Ticker's Quorum.
+type ProviderVenue struct {
+	// Venue is the name of the venue (e.g. exchange) the provider's price comes from.
+	Venue string `json:"venue,omitempty"`
+}
+
+// ProviderVenueFromJSONString returns a ProviderVenue instance from a JSON string. Any fields of the JSON
+// that do not pertain to the venue are ignored.
+func ProviderVenueFromJSONString(jsonString string) (ProviderVenue, error) {
+	var elem ProviderVenue
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
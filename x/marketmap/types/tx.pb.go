@@ -13,18 +13,22 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_sortkeys "github.com/cosmos/gogoproto/sortkeys"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -41,6 +45,12 @@ type MsgUpsertMarkets struct {
 	// CreateMarkets is the list of all markets to be created for the given
 	// transaction.
 	Markets []Market `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets"`
+	// ActivationHeight, if set, schedules the markets to be applied at the
+	// given future block height instead of immediately.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// ActivationTime, if set, schedules the markets to be applied at the first
+	// block at or after the given future block time instead of immediately.
+	ActivationTime *time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time,omitempty"`
 }
 
 func (m *MsgUpsertMarkets) Reset()         { *m = MsgUpsertMarkets{} }
@@ -90,6 +100,20 @@ func (m *MsgUpsertMarkets) GetMarkets() []Market {
 	return nil
 }
 
+func (m *MsgUpsertMarkets) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgUpsertMarkets) GetActivationTime() *time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return nil
+}
+
 // MsgUpsertMarketsResponse is the response from the UpsertMarkets API in the
 // x/marketmap module.
 type MsgUpsertMarketsResponse struct {
@@ -145,6 +169,12 @@ type MsgCreateMarkets struct {
 	// CreateMarkets is the list of all markets to be created for the given
 	// transaction.
 	CreateMarkets []Market `protobuf:"bytes,2,rep,name=create_markets,json=createMarkets,proto3" json:"create_markets"`
+	// ActivationHeight, if set, schedules the markets to be applied at the
+	// given future block height instead of immediately.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// ActivationTime, if set, schedules the markets to be applied at the first
+	// block at or after the given future block time instead of immediately.
+	ActivationTime *time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time,omitempty"`
 }
 
 func (m *MsgCreateMarkets) Reset()         { *m = MsgCreateMarkets{} }
@@ -194,6 +224,20 @@ func (m *MsgCreateMarkets) GetCreateMarkets() []Market {
 	return nil
 }
 
+func (m *MsgCreateMarkets) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateMarkets) GetActivationTime() *time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return nil
+}
+
 // MsgUpdateMarketMapResponse is the response message for MsgUpdateMarketMap.
 type MsgCreateMarketsResponse struct {
 }
@@ -240,6 +284,12 @@ type MsgUpdateMarkets struct {
 	// UpdateMarkets is the list of all markets to be updated for the given
 	// transaction.
 	UpdateMarkets []Market `protobuf:"bytes,2,rep,name=update_markets,json=updateMarkets,proto3" json:"update_markets"`
+	// ActivationHeight, if set, schedules the markets to be applied at the
+	// given future block height instead of immediately.
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// ActivationTime, if set, schedules the markets to be applied at the first
+	// block at or after the given future block time instead of immediately.
+	ActivationTime *time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time,omitempty"`
 }
 
 func (m *MsgUpdateMarkets) Reset()         { *m = MsgUpdateMarkets{} }
@@ -289,6 +339,20 @@ func (m *MsgUpdateMarkets) GetUpdateMarkets() []Market {
 	return nil
 }
 
+func (m *MsgUpdateMarkets) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgUpdateMarkets) GetActivationTime() *time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return nil
+}
+
 // MsgUpdateMarketsResponse is the response message for MsgUpdateMarkets.
 type MsgUpdateMarketsResponse struct {
 }
@@ -620,980 +684,3597 @@ func (m *MsgRemoveMarketsResponse) GetDeletedMarkets() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgUpsertMarkets)(nil), "connect.marketmap.v2.MsgUpsertMarkets")
-	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse.MarketUpdatesEntry")
-	proto.RegisterType((*MsgCreateMarkets)(nil), "connect.marketmap.v2.MsgCreateMarkets")
-	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "connect.marketmap.v2.MsgCreateMarketsResponse")
-	proto.RegisterType((*MsgUpdateMarkets)(nil), "connect.marketmap.v2.MsgUpdateMarkets")
-	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "connect.marketmap.v2.MsgUpdateMarketsResponse")
-	proto.RegisterType((*MsgParams)(nil), "connect.marketmap.v2.MsgParams")
-	proto.RegisterType((*MsgParamsResponse)(nil), "connect.marketmap.v2.MsgParamsResponse")
-	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthorities")
-	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthoritiesResponse")
-	proto.RegisterType((*MsgRemoveMarkets)(nil), "connect.marketmap.v2.MsgRemoveMarkets")
-	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketsResponse")
+// MsgEnableMarkets defines the Msg/EnableMarkets request type. It contains the
+// markets to be enabled in the market map.
+type MsgEnableMarkets struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Markets is the list of markets to enable.
+	Markets []string `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
 }
 
-func init() { proto.RegisterFile("connect/marketmap/v2/tx.proto", fileDescriptor_37df9476ca9a2f81) }
-
-var fileDescriptor_37df9476ca9a2f81 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x14, 0xca, 0xf7, 0xdb, 0xa7, 0x2d, 0xb0, 0x36, 0x61, 0xdd, 0x68, 0x8b, 0x0d, 0x29,
-	0x48, 0xc2, 0x2e, 0xd6, 0xc4, 0x90, 0xea, 0xc1, 0x96, 0x78, 0xd0, 0xa4, 0x89, 0x59, 0x43, 0x62,
-	0xbc, 0x34, 0x4b, 0x77, 0xb2, 0x6c, 0x60, 0x7f, 0x64, 0x67, 0xba, 0x81, 0x9b, 0xd1, 0x78, 0xf1,
-	0xa2, 0xf1, 0xe4, 0x51, 0xff, 0x03, 0x0e, 0xfe, 0x0b, 0x26, 0x1c, 0x09, 0x27, 0x4f, 0xc6, 0xc0,
-	0x01, 0xff, 0x0c, 0xd3, 0x9d, 0xe9, 0xd2, 0x81, 0x6e, 0x69, 0xd1, 0x0b, 0xcc, 0xbe, 0xf7, 0x79,
-	0xef, 0x7d, 0xde, 0x8f, 0x79, 0x53, 0xb8, 0xdd, 0xf6, 0x5c, 0x17, 0xb7, 0xa9, 0xe6, 0x18, 0xc1,
-	0x36, 0xa6, 0x8e, 0xe1, 0x6b, 0x61, 0x55, 0xa3, 0xbb, 0xaa, 0x1f, 0x78, 0xd4, 0x93, 0x0a, 0x5c,
-	0xad, 0xc6, 0x6a, 0x35, 0xac, 0x2a, 0x73, 0x6d, 0x8f, 0x38, 0x1e, 0xd1, 0x1c, 0x62, 0x69, 0xe1,
-	0xbd, 0xee, 0x3f, 0x06, 0x57, 0x0a, 0x96, 0x67, 0x79, 0xd1, 0x51, 0xeb, 0x9e, 0xb8, 0xf4, 0x26,
-	0x83, 0xb7, 0x98, 0x82, 0x7d, 0x70, 0xd5, 0xac, 0xe1, 0xd8, 0xae, 0xa7, 0x45, 0x7f, 0xb9, 0xe8,
-	0xce, 0x40, 0x46, 0xec, 0x63, 0x28, 0xc4, 0x37, 0x02, 0xc3, 0xe1, 0x8e, 0xcb, 0xdf, 0x11, 0xcc,
-	0x34, 0x89, 0xb5, 0xe1, 0x13, 0x1c, 0xd0, 0x66, 0x04, 0x23, 0xd2, 0x03, 0xc8, 0x1a, 0x1d, 0xba,
-	0xe5, 0x05, 0x36, 0xdd, 0x93, 0xd1, 0x3c, 0x5a, 0xca, 0x36, 0xe4, 0xa3, 0x6f, 0x2b, 0x05, 0x4e,
-	0xa9, 0x6e, 0x9a, 0x01, 0x26, 0xe4, 0x05, 0x0d, 0x6c, 0xd7, 0xd2, 0xcf, 0xa0, 0xd2, 0x23, 0xf8,
-	0x8f, 0x45, 0x22, 0x72, 0x7a, 0x7e, 0x62, 0xe9, 0x5a, 0xf5, 0x96, 0x3a, 0xa8, 0x2e, 0x2a, 0x8b,
-	0xd3, 0x98, 0x3c, 0xf8, 0x59, 0x4a, 0xe9, 0x3d, 0x93, 0xda, 0xc3, 0xdf, 0x5f, 0x4a, 0xa9, 0x37,
-	0xa7, 0xfb, 0xcb, 0x67, 0x1e, 0xdf, 0x9f, 0xee, 0x2f, 0x2f, 0xf4, 0x92, 0xd8, 0xed, 0x4b, 0xe3,
-	0x3c, 0xe5, 0xf2, 0x21, 0x02, 0xf9, 0xbc, 0x50, 0xc7, 0xc4, 0xf7, 0x5c, 0x82, 0xa5, 0x1d, 0xc8,
-	0x33, 0xd3, 0x56, 0xc7, 0x37, 0x0d, 0x8a, 0x89, 0x8c, 0x22, 0x7a, 0xf5, 0x04, 0x7a, 0x09, 0x7e,
-	0x38, 0xef, 0x0d, 0xe6, 0xe3, 0x89, 0x4b, 0x83, 0xbd, 0x46, 0x5a, 0x46, 0x7a, 0xce, 0xe9, 0x97,
-	0x2b, 0x8f, 0x41, 0xba, 0x08, 0x94, 0x66, 0x60, 0x62, 0x1b, 0xf3, 0x6a, 0xea, 0xdd, 0xa3, 0x54,
-	0x80, 0x4c, 0x68, 0xec, 0x74, 0xb0, 0x9c, 0x9e, 0x47, 0x4b, 0xff, 0xeb, 0xec, 0xa3, 0x96, 0x5e,
-	0x43, 0xb5, 0xc9, 0xcf, 0x5f, 0x4b, 0xa8, 0x7c, 0xc4, 0x5a, 0xb3, 0x1e, 0x60, 0x83, 0xe2, 0xbf,
-	0x6d, 0xcd, 0x53, 0xc8, 0xb7, 0x23, 0x47, 0xad, 0xf1, 0x3b, 0x94, 0x6b, 0xf7, 0x53, 0x18, 0xb7,
-	0x4f, 0x02, 0xff, 0xb2, 0x12, 0xb5, 0x49, 0x90, 0xf5, 0xca, 0xdb, 0x4b, 0x98, 0x95, 0xed, 0x1f,
-	0x24, 0xcc, 0x9a, 0x7d, 0x95, 0x84, 0x3b, 0xfd, 0x14, 0xc6, 0x1f, 0x4c, 0xf3, 0x42, 0xc2, 0x82,
-	0x2c, 0x4e, 0xf8, 0x03, 0x82, 0x6c, 0x93, 0x58, 0xcf, 0xa3, 0x0b, 0x29, 0xd5, 0x60, 0x8a, 0x5d,
-	0xcd, 0x28, 0xcd, 0x44, 0xa6, 0x0c, 0xcd, 0x99, 0x72, 0x0b, 0xb1, 0x4a, 0xe9, 0x91, 0xab, 0x54,
-	0xcb, 0x8b, 0x69, 0x95, 0x6f, 0xc0, 0x6c, 0x4c, 0x28, 0xa6, 0xf9, 0x16, 0x81, 0xd2, 0x24, 0x96,
-	0x8e, 0x1d, 0x2f, 0xe4, 0x39, 0xd4, 0xb9, 0x85, 0x8d, 0x89, 0x74, 0x17, 0x66, 0x82, 0x48, 0xd5,
-	0x32, 0x58, 0x18, 0x7e, 0xbf, 0xb2, 0xfa, 0x34, 0x93, 0xd7, 0x7b, 0x62, 0x49, 0x85, 0x8c, 0x61,
-	0x3a, 0xb6, 0x7b, 0x29, 0x45, 0x06, 0xab, 0x41, 0x97, 0x1e, 0x3b, 0x97, 0x17, 0xa0, 0x9c, 0x4c,
-	0x22, 0xe6, 0x4a, 0xa3, 0x11, 0xea, 0x47, 0x5d, 0x7d, 0x84, 0x64, 0x71, 0x9d, 0x65, 0xcf, 0x56,
-	0xd5, 0xf9, 0xb2, 0xad, 0x47, 0x4d, 0x16, 0xa2, 0xc6, 0xcb, 0x67, 0x11, 0xa6, 0x4d, 0xbc, 0x83,
-	0x29, 0x36, 0xe3, 0x49, 0x64, 0xd5, 0xc9, 0x73, 0x31, 0x37, 0xa8, 0x7e, 0xca, 0xc0, 0x44, 0x93,
-	0x58, 0x92, 0x05, 0x39, 0xf1, 0xce, 0x57, 0x12, 0xd7, 0x94, 0x80, 0x53, 0xd4, 0xd1, 0x70, 0x31,
-	0x33, 0x0b, 0x72, 0xe2, 0x5d, 0xab, 0x0c, 0xd9, 0x87, 0xe6, 0x48, 0x81, 0x06, 0xce, 0xb9, 0xf4,
-	0x12, 0xae, 0x33, 0x05, 0x9f, 0xf4, 0x52, 0xa2, 0x3d, 0x03, 0x28, 0x8b, 0x97, 0x00, 0x62, 0xcf,
-	0xef, 0x10, 0xcc, 0x25, 0xcd, 0xe5, 0x6a, 0xa2, 0x93, 0x04, 0x0b, 0x65, 0x6d, 0x5c, 0x0b, 0xb1,
-	0x94, 0xfd, 0x4f, 0x68, 0x65, 0xb4, 0xa7, 0x65, 0x68, 0x29, 0x07, 0x3d, 0x65, 0x16, 0xe4, 0xc4,
-	0xe1, 0xae, 0x8c, 0xc4, 0x79, 0x58, 0xa0, 0x81, 0x63, 0xab, 0x64, 0x5e, 0x9f, 0xee, 0x2f, 0xa3,
-	0xc6, 0xb3, 0x83, 0xe3, 0x22, 0x3a, 0x3c, 0x2e, 0xa2, 0x5f, 0xc7, 0x45, 0xf4, 0xf1, 0xa4, 0x98,
-	0x3a, 0x3c, 0x29, 0xa6, 0x7e, 0x9c, 0x14, 0x53, 0xaf, 0x56, 0x2d, 0x9b, 0x6e, 0x75, 0x36, 0xd5,
-	0xb6, 0xe7, 0x68, 0x64, 0xdb, 0xf6, 0x57, 0x1c, 0x1c, 0x6a, 0xbd, 0x95, 0x18, 0x56, 0x85, 0xad,
-	0x48, 0xf7, 0x7c, 0x4c, 0x36, 0xa7, 0xa2, 0x9f, 0x1c, 0xf7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x23, 0xde, 0x0a, 0x2d, 0x4c, 0x09, 0x00, 0x00,
+func (m *MsgEnableMarkets) Reset()         { *m = MsgEnableMarkets{} }
+func (m *MsgEnableMarkets) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableMarkets) ProtoMessage()    {}
+func (*MsgEnableMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{12}
+}
+func (m *MsgEnableMarkets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableMarkets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableMarkets.Merge(m, src)
+}
+func (m *MsgEnableMarkets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableMarkets.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgEnableMarkets proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateMarkets creates markets from the given message.
-	CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error)
-	// UpdateMarkets updates markets from the given message.
-	UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error)
-	// UpdateParams defines a method for updating the x/marketmap module
-	// parameters.
-	UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
-	// RemoveMarketAuthorities defines a method for removing market authorities
-	// from the x/marketmap module. the signer must be the admin.
-	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
-	// UpsertMarkets wraps both Create / Update markets into a single message.
-	// Specifically if a market does not exist it will be created, otherwise it
-	// will be updated. The response will be a map between ticker -> updated.
-	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
-	// RemoveMarkets removes the given markets from the marketmap if:
-	// - they exist in the map
-	// - they are disabled
-	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+func (m *MsgEnableMarkets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgEnableMarkets) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgEnableMarketsResponse defines the Msg/EnableMarkets response type.
+type MsgEnableMarketsResponse struct {
 }
 
-func (c *msgClient) CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error) {
-	out := new(MsgCreateMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CreateMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEnableMarketsResponse) Reset()         { *m = MsgEnableMarketsResponse{} }
+func (m *MsgEnableMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableMarketsResponse) ProtoMessage()    {}
+func (*MsgEnableMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{13}
+}
+func (m *MsgEnableMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgEnableMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableMarketsResponse.Merge(m, src)
+}
+func (m *MsgEnableMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableMarketsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error) {
-	out := new(MsgUpdateMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgEnableMarketsResponse proto.InternalMessageInfo
+
+// MsgDisableMarkets defines the Msg/DisableMarkets request type. It contains
+// the markets to be disabled in the market map.
+type MsgDisableMarkets struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Markets is the list of markets to disable.
+	Markets []string `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
-	out := new(MsgParamsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDisableMarkets) Reset()         { *m = MsgDisableMarkets{} }
+func (m *MsgDisableMarkets) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableMarkets) ProtoMessage()    {}
+func (*MsgDisableMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{14}
+}
+func (m *MsgDisableMarkets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableMarkets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDisableMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableMarkets.Merge(m, src)
+}
+func (m *MsgDisableMarkets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableMarkets.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error) {
-	out := new(MsgRemoveMarketAuthoritiesResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarketAuthorities", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDisableMarkets proto.InternalMessageInfo
+
+func (m *MsgDisableMarkets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error) {
-	out := new(MsgUpsertMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDisableMarkets) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
-	out := new(MsgRemoveMarketsResponse)
-	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDisableMarketsResponse defines the Msg/DisableMarkets response type.
+type MsgDisableMarketsResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateMarkets creates markets from the given message.
-	CreateMarkets(context.Context, *MsgCreateMarkets) (*MsgCreateMarketsResponse, error)
-	// UpdateMarkets updates markets from the given message.
-	UpdateMarkets(context.Context, *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error)
-	// UpdateParams defines a method for updating the x/marketmap module
-	// parameters.
-	UpdateParams(context.Context, *MsgParams) (*MsgParamsResponse, error)
-	// RemoveMarketAuthorities defines a method for removing market authorities
-	// from the x/marketmap module. the signer must be the admin.
-	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
-	// UpsertMarkets wraps both Create / Update markets into a single message.
-	// Specifically if a market does not exist it will be created, otherwise it
-	// will be updated. The response will be a map between ticker -> updated.
-	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
-	// RemoveMarkets removes the given markets from the marketmap if:
-	// - they exist in the map
-	// - they are disabled
-	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+func (m *MsgDisableMarketsResponse) Reset()         { *m = MsgDisableMarketsResponse{} }
+func (m *MsgDisableMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableMarketsResponse) ProtoMessage()    {}
+func (*MsgDisableMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{15}
+}
+func (m *MsgDisableMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableMarketsResponse.Merge(m, src)
+}
+func (m *MsgDisableMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableMarketsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgDisableMarketsResponse proto.InternalMessageInfo
+
+// MsgCancelPendingMarkets defines the Msg/CancelPendingMarkets request type. It
+// contains the markets whose scheduled activations are cancelled.
+type MsgCancelPendingMarkets struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Markets is the list of markets whose pending activations are cancelled.
+	Markets []string `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
 }
 
-func (*UnimplementedMsgServer) CreateMarkets(ctx context.Context, req *MsgCreateMarkets) (*MsgCreateMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMarkets not implemented")
+func (m *MsgCancelPendingMarkets) Reset()         { *m = MsgCancelPendingMarkets{} }
+func (m *MsgCancelPendingMarkets) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingMarkets) ProtoMessage()    {}
+func (*MsgCancelPendingMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{16}
 }
-func (*UnimplementedMsgServer) UpdateMarkets(ctx context.Context, req *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkets not implemented")
+func (m *MsgCancelPendingMarkets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelPendingMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPendingMarkets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
+func (m *MsgCancelPendingMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingMarkets.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
+func (m *MsgCancelPendingMarkets) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+func (m *MsgCancelPendingMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingMarkets.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgCancelPendingMarkets proto.InternalMessageInfo
 
-func _Msg_CreateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/CreateMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMarkets(ctx, req.(*MsgCreateMarkets))
+func (m *MsgCancelPendingMarkets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpdateMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMarkets(ctx, req.(*MsgUpdateMarkets))
+func (m *MsgCancelPendingMarkets) GetMarkets() []string {
+	if m != nil {
+		return m.Markets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelPendingMarketsResponse defines the Msg/CancelPendingMarkets response
+// type.
+type MsgCancelPendingMarketsResponse struct {
 }
 
-func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMarketAuthorities)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMarketAuthorities(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarketAuthorities",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMarketAuthorities(ctx, req.(*MsgRemoveMarketAuthorities))
+func (m *MsgCancelPendingMarketsResponse) Reset()         { *m = MsgCancelPendingMarketsResponse{} }
+func (m *MsgCancelPendingMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingMarketsResponse) ProtoMessage()    {}
+func (*MsgCancelPendingMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{17}
+}
+func (m *MsgCancelPendingMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPendingMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPendingMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelPendingMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingMarketsResponse.Merge(m, src)
+}
+func (m *MsgCancelPendingMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPendingMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingMarketsResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpsertMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpsertMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/UpsertMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpsertMarkets(ctx, req.(*MsgUpsertMarkets))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelPendingMarketsResponse proto.InternalMessageInfo
+
+// MsgSubmitMarketMapProposal defines the Msg/SubmitMarketMapProposal request
+// type. It contains the markets to be upserted once the proposal is applied.
+type MsgSubmitMarketMapProposal struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Markets is the list of markets to upsert once the proposal is applied.
+	Markets []Market `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets"`
 }
 
-func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMarkets)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMarkets(ctx, in)
+func (m *MsgSubmitMarketMapProposal) Reset()         { *m = MsgSubmitMarketMapProposal{} }
+func (m *MsgSubmitMarketMapProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMarketMapProposal) ProtoMessage()    {}
+func (*MsgSubmitMarketMapProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{18}
+}
+func (m *MsgSubmitMarketMapProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMarketMapProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMarketMapProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarkets",
+}
+func (m *MsgSubmitMarketMapProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMarketMapProposal.Merge(m, src)
+}
+func (m *MsgSubmitMarketMapProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMarketMapProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMarketMapProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMarketMapProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitMarketMapProposal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	return ""
+}
+
+func (m *MsgSubmitMarketMapProposal) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.marketmap.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMarkets",
-			Handler:    _Msg_CreateMarkets_Handler,
-		},
-		{
-			MethodName: "UpdateMarkets",
-			Handler:    _Msg_UpdateMarkets_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RemoveMarketAuthorities",
-			Handler:    _Msg_RemoveMarketAuthorities_Handler,
-		},
-		{
-			MethodName: "UpsertMarkets",
-			Handler:    _Msg_UpsertMarkets_Handler,
-		},
-		{
-			MethodName: "RemoveMarkets",
-			Handler:    _Msg_RemoveMarkets_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/marketmap/v2/tx.proto",
+// MsgSubmitMarketMapProposalResponse defines the Msg/SubmitMarketMapProposal
+// response type.
+type MsgSubmitMarketMapProposalResponse struct {
+	// ProposalId is the identifier of the submitted proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *MsgUpsertMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitMarketMapProposalResponse) Reset()         { *m = MsgSubmitMarketMapProposalResponse{} }
+func (m *MsgSubmitMarketMapProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMarketMapProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitMarketMapProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{19}
+}
+func (m *MsgSubmitMarketMapProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMarketMapProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMarketMapProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubmitMarketMapProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMarketMapProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitMarketMapProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMarketMapProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMarketMapProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpsertMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubmitMarketMapProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitMarketMapProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *MsgUpsertMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+// MsgApproveMarketMapProposal defines the Msg/ApproveMarketMapProposal
+// request type.
+type MsgApproveMarketMapProposal struct {
+	// Signer is the signer of this transaction. It must be the admin or a market
+	// authority.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ProposalId is the identifier of the proposal to approve.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgApproveMarketMapProposal) Reset()         { *m = MsgApproveMarketMapProposal{} }
+func (m *MsgApproveMarketMapProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMarketMapProposal) ProtoMessage()    {}
+func (*MsgApproveMarketMapProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{20}
+}
+func (m *MsgApproveMarketMapProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveMarketMapProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMarketMapProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgApproveMarketMapProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMarketMapProposal.Merge(m, src)
+}
+func (m *MsgApproveMarketMapProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMarketMapProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMarketMapProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMarketMapProposal proto.InternalMessageInfo
+
+func (m *MsgApproveMarketMapProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpsertMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveMarketMapProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpsertMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgApproveMarketMapProposalResponse defines the Msg/ApproveMarketMapProposal
+// response type.
+type MsgApproveMarketMapProposalResponse struct {
+	// Applied is true if the approval applied the proposal to the marketmap.
+	Applied bool `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
 }
 
-func (m *MsgUpsertMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketUpdates) > 0 {
-		keysForMarketUpdates := make([]string, 0, len(m.MarketUpdates))
-		for k := range m.MarketUpdates {
-			keysForMarketUpdates = append(keysForMarketUpdates, string(k))
-		}
-		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMarketUpdates)
-		for iNdEx := len(keysForMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.MarketUpdates[string(keysForMarketUpdates[iNdEx])]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(keysForMarketUpdates[iNdEx])
-			copy(dAtA[i:], keysForMarketUpdates[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(keysForMarketUpdates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCreateMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgApproveMarketMapProposalResponse) Reset()         { *m = MsgApproveMarketMapProposalResponse{} }
+func (m *MsgApproveMarketMapProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMarketMapProposalResponse) ProtoMessage()    {}
+func (*MsgApproveMarketMapProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{21}
 }
-
-func (m *MsgCreateMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveMarketMapProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreateMarkets) > 0 {
-		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgApproveMarketMapProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMarketMapProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgApproveMarketMapProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMarketMapProposalResponse.Merge(m, src)
 }
-
-func (m *MsgCreateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveMarketMapProposalResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgApproveMarketMapProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMarketMapProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveMarketMapProposalResponse proto.InternalMessageInfo
+
+func (m *MsgApproveMarketMapProposalResponse) GetApplied() bool {
+	if m != nil {
+		return m.Applied
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgVetoMarketMapProposal defines the Msg/VetoMarketMapProposal request type.
+type MsgVetoMarketMapProposal struct {
+	// Signer is the signer of this transaction. It must be the admin or a market
+	// authority.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ProposalId is the identifier of the proposal to veto.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *MsgUpdateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdateMarkets) > 0 {
-		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgVetoMarketMapProposal) Reset()         { *m = MsgVetoMarketMapProposal{} }
+func (m *MsgVetoMarketMapProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoMarketMapProposal) ProtoMessage()    {}
+func (*MsgVetoMarketMapProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{22}
+}
+func (m *MsgVetoMarketMapProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoMarketMapProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoMarketMapProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVetoMarketMapProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoMarketMapProposal.Merge(m, src)
 }
-
-func (m *MsgUpdateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVetoMarketMapProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoMarketMapProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoMarketMapProposal.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgVetoMarketMapProposal proto.InternalMessageInfo
+
+func (m *MsgVetoMarketMapProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVetoMarketMapProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgVetoMarketMapProposalResponse defines the Msg/VetoMarketMapProposal
+// response type.
+type MsgVetoMarketMapProposalResponse struct {
+	// Vetoed is true if the veto discarded the proposal.
+	Vetoed bool `protobuf:"varint,1,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
 }
 
-func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgVetoMarketMapProposalResponse) Reset()         { *m = MsgVetoMarketMapProposalResponse{} }
+func (m *MsgVetoMarketMapProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoMarketMapProposalResponse) ProtoMessage()    {}
+func (*MsgVetoMarketMapProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37df9476ca9a2f81, []int{23}
+}
+func (m *MsgVetoMarketMapProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoMarketMapProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoMarketMapProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgVetoMarketMapProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoMarketMapProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoMarketMapProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoMarketMapProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoMarketMapProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgVetoMarketMapProposalResponse proto.InternalMessageInfo
+
+func (m *MsgVetoMarketMapProposalResponse) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpsertMarkets)(nil), "connect.marketmap.v2.MsgUpsertMarkets")
+	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "connect.marketmap.v2.MsgUpsertMarketsResponse.MarketUpdatesEntry")
+	proto.RegisterType((*MsgCreateMarkets)(nil), "connect.marketmap.v2.MsgCreateMarkets")
+	proto.RegisterType((*MsgCreateMarketsResponse)(nil), "connect.marketmap.v2.MsgCreateMarketsResponse")
+	proto.RegisterType((*MsgUpdateMarkets)(nil), "connect.marketmap.v2.MsgUpdateMarkets")
+	proto.RegisterType((*MsgUpdateMarketsResponse)(nil), "connect.marketmap.v2.MsgUpdateMarketsResponse")
+	proto.RegisterType((*MsgParams)(nil), "connect.marketmap.v2.MsgParams")
+	proto.RegisterType((*MsgParamsResponse)(nil), "connect.marketmap.v2.MsgParamsResponse")
+	proto.RegisterType((*MsgRemoveMarketAuthorities)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthorities")
+	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketAuthoritiesResponse")
+	proto.RegisterType((*MsgRemoveMarkets)(nil), "connect.marketmap.v2.MsgRemoveMarkets")
+	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "connect.marketmap.v2.MsgRemoveMarketsResponse")
+	proto.RegisterType((*MsgEnableMarkets)(nil), "connect.marketmap.v2.MsgEnableMarkets")
+	proto.RegisterType((*MsgEnableMarketsResponse)(nil), "connect.marketmap.v2.MsgEnableMarketsResponse")
+	proto.RegisterType((*MsgDisableMarkets)(nil), "connect.marketmap.v2.MsgDisableMarkets")
+	proto.RegisterType((*MsgDisableMarketsResponse)(nil), "connect.marketmap.v2.MsgDisableMarketsResponse")
+	proto.RegisterType((*MsgCancelPendingMarkets)(nil), "connect.marketmap.v2.MsgCancelPendingMarkets")
+	proto.RegisterType((*MsgCancelPendingMarketsResponse)(nil), "connect.marketmap.v2.MsgCancelPendingMarketsResponse")
+	proto.RegisterType((*MsgSubmitMarketMapProposal)(nil), "connect.marketmap.v2.MsgSubmitMarketMapProposal")
+	proto.RegisterType((*MsgSubmitMarketMapProposalResponse)(nil), "connect.marketmap.v2.MsgSubmitMarketMapProposalResponse")
+	proto.RegisterType((*MsgApproveMarketMapProposal)(nil), "connect.marketmap.v2.MsgApproveMarketMapProposal")
+	proto.RegisterType((*MsgApproveMarketMapProposalResponse)(nil), "connect.marketmap.v2.MsgApproveMarketMapProposalResponse")
+	proto.RegisterType((*MsgVetoMarketMapProposal)(nil), "connect.marketmap.v2.MsgVetoMarketMapProposal")
+	proto.RegisterType((*MsgVetoMarketMapProposalResponse)(nil), "connect.marketmap.v2.MsgVetoMarketMapProposalResponse")
 }
 
-func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("connect/marketmap/v2/tx.proto", fileDescriptor_37df9476ca9a2f81) }
+
+var fileDescriptor_37df9476ca9a2f81 = []byte{
+	// 1177 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x4e, 0xda, 0xbc, 0x10, 0x27, 0x59, 0x02, 0xd9, 0x6e, 0xc1, 0x76, 0x37, 0x25,
+	0x0d, 0x41, 0xd9, 0x4d, 0x5d, 0x11, 0x82, 0xa9, 0x04, 0x49, 0x89, 0x44, 0x90, 0x2c, 0x45, 0x5b,
+	0x8a, 0x10, 0x17, 0x6b, 0x63, 0x0f, 0x9b, 0x25, 0xde, 0x1f, 0xda, 0x19, 0x5b, 0x8d, 0x84, 0x04,
+	0x02, 0x21, 0x21, 0x2e, 0xf4, 0xc8, 0x05, 0xf1, 0x43, 0x1c, 0x38, 0xe6, 0xc0, 0x95, 0x7b, 0xc5,
+	0x29, 0xe2, 0xc4, 0x09, 0x50, 0x72, 0x08, 0xff, 0x02, 0x37, 0xe4, 0x9d, 0xd9, 0x89, 0xd7, 0xf1,
+	0x24, 0x76, 0xa1, 0xd0, 0x5e, 0x92, 0xdd, 0x37, 0xdf, 0x9b, 0xf7, 0xbd, 0x6f, 0xde, 0xce, 0x7b,
+	0x86, 0x67, 0x6b, 0x81, 0xef, 0xe3, 0x1a, 0x35, 0x3d, 0x3b, 0xda, 0xc5, 0xd4, 0xb3, 0x43, 0xb3,
+	0x55, 0x32, 0xe9, 0x5d, 0x23, 0x8c, 0x02, 0x1a, 0x28, 0x33, 0x7c, 0xd9, 0x10, 0xcb, 0x46, 0xab,
+	0xa4, 0xcd, 0xd6, 0x02, 0xe2, 0x05, 0xc4, 0xf4, 0x88, 0x63, 0xb6, 0xae, 0xb7, 0xff, 0x31, 0xb8,
+	0x36, 0xe3, 0x04, 0x4e, 0x10, 0x3f, 0x9a, 0xed, 0x27, 0x6e, 0xbd, 0xc4, 0xe0, 0x55, 0xb6, 0xc0,
+	0x5e, 0xf8, 0xd2, 0xb4, 0xed, 0xb9, 0x7e, 0x60, 0xc6, 0x7f, 0xb9, 0xa9, 0xe0, 0x04, 0x81, 0xd3,
+	0xc0, 0x66, 0xfc, 0xb6, 0xdd, 0x7c, 0xcf, 0xa4, 0xae, 0x87, 0x09, 0xb5, 0xbd, 0x90, 0x03, 0xae,
+	0xf4, 0xa4, 0xcc, 0x5e, 0xce, 0x84, 0x84, 0x76, 0x64, 0x7b, 0x49, 0xe4, 0xb9, 0xde, 0x90, 0x28,
+	0x08, 0x03, 0x62, 0x37, 0x18, 0x48, 0xff, 0x29, 0x03, 0x53, 0x15, 0xe2, 0xdc, 0x09, 0x09, 0x8e,
+	0x68, 0x25, 0x06, 0x12, 0x65, 0x05, 0xc6, 0xec, 0x26, 0xdd, 0x09, 0x22, 0x97, 0xee, 0xa9, 0xa8,
+	0x88, 0x16, 0xc6, 0xd6, 0xd5, 0x5f, 0x7e, 0x5c, 0x9a, 0xe1, 0x89, 0xad, 0xd5, 0xeb, 0x11, 0x26,
+	0xe4, 0x36, 0x8d, 0x5c, 0xdf, 0xb1, 0x4e, 0xa0, 0xca, 0x4d, 0xb8, 0xc0, 0x62, 0x11, 0x35, 0x53,
+	0x1c, 0x5e, 0x18, 0x2f, 0x3d, 0x63, 0xf4, 0x52, 0xd7, 0x60, 0x71, 0xd6, 0xb3, 0xf7, 0x7f, 0x2b,
+	0x0c, 0x59, 0x89, 0x8b, 0xf2, 0x02, 0x4c, 0xdb, 0x35, 0xea, 0xb6, 0x6c, 0xea, 0x06, 0x7e, 0x75,
+	0x07, 0xbb, 0xce, 0x0e, 0x55, 0x87, 0x8b, 0x68, 0x21, 0x6b, 0x4d, 0x9d, 0x2c, 0xbc, 0x11, 0xdb,
+	0x95, 0x4d, 0x98, 0xec, 0x00, 0xb7, 0x05, 0x54, 0xb3, 0x45, 0xb4, 0x30, 0x5e, 0xd2, 0x0c, 0xa6,
+	0xae, 0x91, 0xa8, 0x6b, 0xbc, 0x95, 0xa8, 0xbb, 0x9e, 0xbd, 0xf7, 0x7b, 0x01, 0x59, 0xb9, 0x13,
+	0xc7, 0xf6, 0x52, 0xf9, 0x95, 0x3f, 0xbf, 0x29, 0x0c, 0x7d, 0x7c, 0xbc, 0xbf, 0x78, 0x92, 0xc9,
+	0xe7, 0xc7, 0xfb, 0x8b, 0x57, 0x13, 0xf9, 0xee, 0x76, 0x08, 0xd8, 0x2d, 0x95, 0x7e, 0x80, 0x40,
+	0xed, 0x36, 0x5a, 0x98, 0x84, 0x81, 0x4f, 0xb0, 0xd2, 0x80, 0x1c, 0x73, 0xad, 0x36, 0xc3, 0xba,
+	0x4d, 0x31, 0x51, 0x51, 0x2c, 0xcb, 0x9a, 0x44, 0x16, 0xc9, 0x3e, 0x5c, 0xaf, 0x3b, 0x6c, 0x8f,
+	0x0d, 0x9f, 0x46, 0x7b, 0xeb, 0x19, 0x15, 0x59, 0x13, 0x5e, 0xa7, 0x5d, 0x7b, 0x0d, 0x94, 0xd3,
+	0x40, 0x65, 0x0a, 0x86, 0x77, 0x31, 0x3f, 0x45, 0xab, 0xfd, 0xa8, 0xcc, 0xc0, 0x48, 0xcb, 0x6e,
+	0x34, 0xb1, 0x9a, 0x29, 0xa2, 0x85, 0x8b, 0x16, 0x7b, 0x29, 0x67, 0x56, 0x51, 0x39, 0xfb, 0xe5,
+	0xb7, 0x05, 0xa4, 0xff, 0xcc, 0x4a, 0xe2, 0x56, 0x84, 0x6d, 0x8a, 0xff, 0x69, 0x49, 0x6c, 0x42,
+	0xae, 0x16, 0x6f, 0x54, 0x1d, 0xbc, 0x32, 0x26, 0x6a, 0x29, 0x0a, 0x8f, 0x49, 0x7d, 0xa4, 0x74,
+	0xd3, 0x35, 0x50, 0xbb, 0x6d, 0xc9, 0xb1, 0x26, 0x42, 0xb3, 0xe3, 0xfa, 0x17, 0x84, 0x66, 0x45,
+	0xf6, 0x20, 0x42, 0x37, 0xc3, 0xfa, 0xe3, 0x27, 0x74, 0x4a, 0x37, 0x2e, 0x74, 0xca, 0x26, 0x84,
+	0xfe, 0x02, 0xc1, 0x58, 0x85, 0x38, 0x5b, 0xf1, 0xed, 0xa8, 0x94, 0x61, 0x94, 0xdd, 0x93, 0xb1,
+	0xbc, 0x52, 0x85, 0x18, 0x9a, 0x2b, 0xc4, 0x3d, 0xd2, 0xa7, 0x93, 0xe9, 0xfb, 0x74, 0xca, 0xb9,
+	0x74, 0x5a, 0xfa, 0x93, 0x30, 0x2d, 0x08, 0x09, 0x9a, 0x9f, 0x20, 0xd0, 0x2a, 0xc4, 0xb1, 0xb0,
+	0x17, 0xb4, 0x78, 0x0e, 0x6b, 0xdc, 0xc3, 0xc5, 0x44, 0x79, 0x1e, 0xa6, 0xa2, 0x78, 0xa9, 0x6a,
+	0xb3, 0x30, 0xfc, 0x3e, 0x19, 0xb3, 0x26, 0x99, 0x7d, 0x2d, 0x31, 0x2b, 0x06, 0x8c, 0xd8, 0x75,
+	0xcf, 0xf5, 0xcf, 0xa5, 0xc8, 0x60, 0x65, 0x68, 0xd3, 0x63, 0xcf, 0xfa, 0x55, 0xd0, 0xe5, 0x24,
+	0x04, 0x57, 0x0a, 0x53, 0x5d, 0xa8, 0x07, 0x2f, 0x5d, 0x35, 0xdd, 0x36, 0xc6, 0x44, 0x4b, 0x38,
+	0x25, 0xdb, 0x2d, 0x50, 0xbb, 0xa3, 0x8a, 0xcb, 0xf6, 0x1a, 0x4c, 0xd6, 0x71, 0x03, 0x53, 0x5c,
+	0x17, 0x5f, 0x00, 0x53, 0x27, 0xc7, 0xcd, 0x49, 0xa5, 0x7c, 0x85, 0x62, 0xee, 0x1b, 0xbe, 0xbd,
+	0xdd, 0x78, 0x88, 0xdc, 0x5f, 0xea, 0xbf, 0x92, 0x53, 0x54, 0x78, 0x25, 0xa7, 0x6c, 0x42, 0xf6,
+	0xaf, 0x51, 0x5c, 0x38, 0xaf, 0xbb, 0xe4, 0xe1, 0x92, 0x5f, 0x3d, 0x4d, 0xfe, 0x39, 0x09, 0xf9,
+	0x34, 0x17, 0xfd, 0x32, 0x5c, 0x3a, 0x65, 0x14, 0xf4, 0xbf, 0x47, 0x30, 0xdb, 0xbe, 0x0e, 0x6d,
+	0xbf, 0x86, 0x1b, 0x5b, 0xd8, 0xaf, 0xbb, 0xbe, 0xf3, 0x48, 0x9c, 0x40, 0x8a, 0x91, 0x7e, 0x05,
+	0x0a, 0x12, 0x96, 0x22, 0x93, 0x03, 0xf6, 0xad, 0xde, 0x6e, 0x6e, 0x7b, 0x2e, 0xef, 0xd7, 0x15,
+	0x3b, 0xdc, 0xe2, 0xc3, 0xd5, 0xff, 0x33, 0x41, 0x95, 0x6f, 0xf6, 0xbe, 0x40, 0x65, 0x27, 0xc7,
+	0xc8, 0x27, 0x9c, 0xf5, 0x0d, 0xd0, 0x85, 0xf1, 0x54, 0x46, 0xe2, 0x33, 0x2b, 0xc0, 0x78, 0x32,
+	0x42, 0x56, 0xdd, 0x7a, 0x9c, 0x5b, 0xd6, 0x82, 0xc4, 0xb4, 0x59, 0xd7, 0x7f, 0x40, 0x70, 0xb9,
+	0x42, 0x9c, 0xb5, 0x30, 0x8c, 0xc4, 0x57, 0xda, 0x29, 0xcd, 0x32, 0x8c, 0x12, 0xd7, 0xf1, 0x71,
+	0x74, 0xae, 0x2e, 0x1c, 0xd7, 0x1d, 0x32, 0xd3, 0x1d, 0xb2, 0xbc, 0xd2, 0xce, 0x99, 0xa3, 0xdb,
+	0x09, 0xcf, 0x4b, 0x12, 0xe6, 0x9c, 0x44, 0xc6, 0xaf, 0xc2, 0xdc, 0x19, 0x4c, 0x45, 0xca, 0x2a,
+	0x5c, 0xb0, 0xc3, 0xb0, 0xe1, 0x62, 0x96, 0xee, 0x45, 0x2b, 0x79, 0xd5, 0xbf, 0x63, 0xd3, 0xdf,
+	0xdb, 0x98, 0x06, 0xff, 0x49, 0xa2, 0x37, 0xba, 0x12, 0x9d, 0x93, 0x24, 0xda, 0x26, 0x24, 0xb2,
+	0x2c, 0x43, 0x51, 0xc6, 0x51, 0xa4, 0xf8, 0x34, 0x8c, 0xb6, 0x30, 0x0d, 0x44, 0x86, 0xfc, 0xad,
+	0xf4, 0x17, 0xc0, 0x70, 0x85, 0x38, 0x8a, 0x03, 0x13, 0xe9, 0x79, 0x70, 0x5e, 0x3a, 0xc2, 0xa6,
+	0x70, 0x9a, 0xd1, 0x1f, 0x4e, 0x10, 0x71, 0x60, 0x22, 0x3d, 0x0f, 0xcd, 0x9f, 0x31, 0x2b, 0xd7,
+	0xfb, 0x0a, 0xd4, 0x73, 0x26, 0x50, 0xde, 0x81, 0x27, 0xd8, 0x02, 0x9f, 0x0a, 0x0a, 0x52, 0x7f,
+	0x06, 0xd0, 0xae, 0x9d, 0x03, 0x10, 0x3b, 0x7f, 0x8a, 0x60, 0x56, 0xd6, 0xc3, 0x97, 0xa5, 0x9b,
+	0x48, 0x3c, 0xb4, 0xd5, 0x41, 0x3d, 0xd2, 0x52, 0x76, 0xfe, 0xac, 0x9b, 0xef, 0xef, 0x67, 0x87,
+	0x66, 0xf4, 0x87, 0xeb, 0x0c, 0x94, 0x1e, 0x04, 0xe6, 0xfb, 0xe2, 0x7c, 0x56, 0xa0, 0xde, 0x2d,
+	0xde, 0x81, 0x89, 0x74, 0xd7, 0x96, 0x07, 0x4a, 0xe1, 0x34, 0xa3, 0x3f, 0x9c, 0x08, 0xf4, 0x3e,
+	0xe4, 0xba, 0x5a, 0xac, 0xfc, 0xf4, 0xd3, 0x40, 0xcd, 0xec, 0x13, 0x28, 0x62, 0x7d, 0x00, 0x33,
+	0x3d, 0xfb, 0xe1, 0x92, 0xfc, 0xcb, 0xe9, 0x01, 0xd7, 0x5e, 0x1c, 0x08, 0x9e, 0x2a, 0x56, 0x59,
+	0x13, 0x93, 0x17, 0xab, 0xc4, 0x43, 0x5b, 0x1d, 0xd4, 0x43, 0xf0, 0xf8, 0x0c, 0x81, 0x2a, 0x6d,
+	0x19, 0xd7, 0xa5, 0xdb, 0xca, 0x5c, 0xb4, 0x97, 0x07, 0x76, 0x11, 0x54, 0x3e, 0x84, 0xa7, 0x7a,
+	0x5f, 0xe8, 0xf2, 0x2a, 0xea, 0x89, 0xd7, 0x56, 0x06, 0xc3, 0x27, 0x04, 0xb4, 0x91, 0x8f, 0x8e,
+	0xf7, 0x17, 0xd1, 0xfa, 0x9b, 0xf7, 0x0f, 0xf3, 0xe8, 0xe0, 0x30, 0x8f, 0xfe, 0x38, 0xcc, 0xa3,
+	0x7b, 0x47, 0xf9, 0xa1, 0x83, 0xa3, 0xfc, 0xd0, 0xaf, 0x47, 0xf9, 0xa1, 0x77, 0x97, 0x1d, 0x97,
+	0xee, 0x34, 0xb7, 0x8d, 0x5a, 0xe0, 0x99, 0x64, 0xd7, 0x0d, 0x97, 0x3c, 0xdc, 0x32, 0x93, 0x56,
+	0xd0, 0x2a, 0xa5, 0xba, 0x01, 0xdd, 0x0b, 0x31, 0xd9, 0x1e, 0x8d, 0x7f, 0x84, 0xdd, 0xf8, 0x7b,
+	0x00, 0x76, 0xc6, 0xe0, 0x09, 0x0d, 0x13, 0x00, 0x00,
 }
 
-func (m *MsgRemoveMarketAuthorities) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateMarkets creates markets from the given message.
+	CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error)
+	// UpdateMarkets updates markets from the given message.
+	UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error)
+	// UpdateParams defines a method for updating the x/marketmap module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// RemoveMarketAuthorities defines a method for removing market authorities
+	// from the x/marketmap module. the signer must be the admin.
+	RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// UpsertMarkets wraps both Create / Update markets into a single message.
+	// Specifically if a market does not exist it will be created, otherwise it
+	// will be updated. The response will be a map between ticker -> updated.
+	UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the marketmap if:
+	// - they exist in the map
+	// - they are disabled
+	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// EnableMarkets enables the given markets in the marketmap. The markets
+	// must exist, and any markets they are normalized by must be enabled.
+	EnableMarkets(ctx context.Context, in *MsgEnableMarkets, opts ...grpc.CallOption) (*MsgEnableMarketsResponse, error)
+	// DisableMarkets disables the given markets in the marketmap. The markets
+	// must exist, and must not be used to normalize any enabled market.
+	DisableMarkets(ctx context.Context, in *MsgDisableMarkets, opts ...grpc.CallOption) (*MsgDisableMarketsResponse, error)
+	// CancelPendingMarkets cancels the scheduled activations of the given
+	// markets. The markets must have a pending activation.
+	CancelPendingMarkets(ctx context.Context, in *MsgCancelPendingMarkets, opts ...grpc.CallOption) (*MsgCancelPendingMarketsResponse, error)
+	// SubmitMarketMapProposal submits a set of market upserts to be held for
+	// review before being applied to the marketmap. The signer must be a market
+	// authority.
+	SubmitMarketMapProposal(ctx context.Context, in *MsgSubmitMarketMapProposal, opts ...grpc.CallOption) (*MsgSubmitMarketMapProposalResponse, error)
+	// ApproveMarketMapProposal approves a proposal under review. The signer must
+	// be the admin or a market authority. The proposal is applied once it is
+	// approved by the admin or a quorum of market authorities.
+	ApproveMarketMapProposal(ctx context.Context, in *MsgApproveMarketMapProposal, opts ...grpc.CallOption) (*MsgApproveMarketMapProposalResponse, error)
+	// VetoMarketMapProposal vetoes a proposal under review. The signer must be
+	// the admin or a market authority. The proposal is discarded once it is
+	// vetoed by the admin or a quorum of market authorities.
+	VetoMarketMapProposal(ctx context.Context, in *MsgVetoMarketMapProposal, opts ...grpc.CallOption) (*MsgVetoMarketMapProposalResponse, error)
 }
 
-func (m *MsgRemoveMarketAuthorities) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgRemoveMarketAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RemoveAddresses) > 0 {
-		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RemoveAddresses[iNdEx])
-			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateMarkets(ctx context.Context, in *MsgCreateMarkets, opts ...grpc.CallOption) (*MsgCreateMarketsResponse, error) {
+	out := new(MsgCreateMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CreateMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveMarketAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateMarkets(ctx context.Context, in *MsgUpdateMarkets, opts ...grpc.CallOption) (*MsgUpdateMarketsResponse, error) {
+	out := new(MsgUpdateMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
+	out := new(MsgParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Markets[iNdEx])
-			copy(dAtA[i:], m.Markets[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RemoveMarketAuthorities(ctx context.Context, in *MsgRemoveMarketAuthorities, opts ...grpc.CallOption) (*MsgRemoveMarketAuthoritiesResponse, error) {
+	out := new(MsgRemoveMarketAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarketAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpsertMarkets(ctx context.Context, in *MsgUpsertMarkets, opts ...grpc.CallOption) (*MsgUpsertMarketsResponse, error) {
+	out := new(MsgUpsertMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/UpsertMarkets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error) {
+	out := new(MsgRemoveMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/RemoveMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedMarkets) > 0 {
-		for iNdEx := len(m.DeletedMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeletedMarkets[iNdEx])
-			copy(dAtA[i:], m.DeletedMarkets[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedMarkets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *msgClient) EnableMarkets(ctx context.Context, in *MsgEnableMarkets, opts ...grpc.CallOption) (*MsgEnableMarketsResponse, error) {
+	out := new(MsgEnableMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/EnableMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) DisableMarkets(ctx context.Context, in *MsgDisableMarkets, opts ...grpc.CallOption) (*MsgDisableMarketsResponse, error) {
+	out := new(MsgDisableMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/DisableMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpsertMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (c *msgClient) CancelPendingMarkets(ctx context.Context, in *MsgCancelPendingMarkets, opts ...grpc.CallOption) (*MsgCancelPendingMarketsResponse, error) {
+	out := new(MsgCancelPendingMarketsResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/CancelPendingMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpsertMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MarketUpdates) > 0 {
-		for k, v := range m.MarketUpdates {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
+func (c *msgClient) SubmitMarketMapProposal(ctx context.Context, in *MsgSubmitMarketMapProposal, opts ...grpc.CallOption) (*MsgSubmitMarketMapProposalResponse, error) {
+	out := new(MsgSubmitMarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/SubmitMarketMapProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.CreateMarkets) > 0 {
-		for _, e := range m.CreateMarkets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (c *msgClient) ApproveMarketMapProposal(ctx context.Context, in *MsgApproveMarketMapProposal, opts ...grpc.CallOption) (*MsgApproveMarketMapProposalResponse, error) {
+	out := new(MsgApproveMarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/ApproveMarketMapProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) VetoMarketMapProposal(ctx context.Context, in *MsgVetoMarketMapProposal, opts ...grpc.CallOption) (*MsgVetoMarketMapProposalResponse, error) {
+	out := new(MsgVetoMarketMapProposalResponse)
+	err := c.cc.Invoke(ctx, "/connect.marketmap.v2.Msg/VetoMarketMapProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.UpdateMarkets) > 0 {
-		for _, e := range m.UpdateMarkets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateMarkets creates markets from the given message.
+	CreateMarkets(context.Context, *MsgCreateMarkets) (*MsgCreateMarketsResponse, error)
+	// UpdateMarkets updates markets from the given message.
+	UpdateMarkets(context.Context, *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error)
+	// UpdateParams defines a method for updating the x/marketmap module
+	// parameters.
+	UpdateParams(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// RemoveMarketAuthorities defines a method for removing market authorities
+	// from the x/marketmap module. the signer must be the admin.
+	RemoveMarketAuthorities(context.Context, *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error)
+	// UpsertMarkets wraps both Create / Update markets into a single message.
+	// Specifically if a market does not exist it will be created, otherwise it
+	// will be updated. The response will be a map between ticker -> updated.
+	UpsertMarkets(context.Context, *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error)
+	// RemoveMarkets removes the given markets from the marketmap if:
+	// - they exist in the map
+	// - they are disabled
+	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// EnableMarkets enables the given markets in the marketmap. The markets
+	// must exist, and any markets they are normalized by must be enabled.
+	EnableMarkets(context.Context, *MsgEnableMarkets) (*MsgEnableMarketsResponse, error)
+	// DisableMarkets disables the given markets in the marketmap. The markets
+	// must exist, and must not be used to normalize any enabled market.
+	DisableMarkets(context.Context, *MsgDisableMarkets) (*MsgDisableMarketsResponse, error)
+	// CancelPendingMarkets cancels the scheduled activations of the given
+	// markets. The markets must have a pending activation.
+	CancelPendingMarkets(context.Context, *MsgCancelPendingMarkets) (*MsgCancelPendingMarketsResponse, error)
+	// SubmitMarketMapProposal submits a set of market upserts to be held for
+	// review before being applied to the marketmap. The signer must be a market
+	// authority.
+	SubmitMarketMapProposal(context.Context, *MsgSubmitMarketMapProposal) (*MsgSubmitMarketMapProposalResponse, error)
+	// ApproveMarketMapProposal approves a proposal under review. The signer must
+	// be the admin or a market authority. The proposal is applied once it is
+	// approved by the admin or a quorum of market authorities.
+	ApproveMarketMapProposal(context.Context, *MsgApproveMarketMapProposal) (*MsgApproveMarketMapProposalResponse, error)
+	// VetoMarketMapProposal vetoes a proposal under review. The signer must be
+	// the admin or a market authority. The proposal is discarded once it is
+	// vetoed by the admin or a quorum of market authorities.
+	VetoMarketMapProposal(context.Context, *MsgVetoMarketMapProposal) (*MsgVetoMarketMapProposalResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateMarkets(ctx context.Context, req *MsgCreateMarkets) (*MsgCreateMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarkets not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMarkets(ctx context.Context, req *MsgUpdateMarkets) (*MsgUpdateMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarkets not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarketAuthorities(ctx context.Context, req *MsgRemoveMarketAuthorities) (*MsgRemoveMarketAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketAuthorities not implemented")
+}
+func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsertMarkets) (*MsgUpsertMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarkets not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
+}
+func (*UnimplementedMsgServer) EnableMarkets(ctx context.Context, req *MsgEnableMarkets) (*MsgEnableMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMarkets not implemented")
+}
+func (*UnimplementedMsgServer) DisableMarkets(ctx context.Context, req *MsgDisableMarkets) (*MsgDisableMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMarkets not implemented")
+}
+func (*UnimplementedMsgServer) CancelPendingMarkets(ctx context.Context, req *MsgCancelPendingMarkets) (*MsgCancelPendingMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingMarkets not implemented")
+}
+func (*UnimplementedMsgServer) SubmitMarketMapProposal(ctx context.Context, req *MsgSubmitMarketMapProposal) (*MsgSubmitMarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMarketMapProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMarketMapProposal(ctx context.Context, req *MsgApproveMarketMapProposal) (*MsgApproveMarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMarketMapProposal not implemented")
+}
+func (*UnimplementedMsgServer) VetoMarketMapProposal(ctx context.Context, req *MsgVetoMarketMapProposal) (*MsgVetoMarketMapProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoMarketMapProposal not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/CreateMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMarkets(ctx, req.(*MsgCreateMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpdateMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMarkets(ctx, req.(*MsgUpdateMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgParams) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveMarketAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarketAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarketAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarketAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarketAuthorities(ctx, req.(*MsgRemoveMarketAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketAuthorities) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpsertMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RemoveAddresses) > 0 {
-		for _, s := range m.RemoveAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertMarkets(ctx, in)
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/UpsertMarkets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertMarkets(ctx, req.(*MsgUpsertMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/RemoveMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMarkets(ctx, req.(*MsgRemoveMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarkets) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_EnableMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).EnableMarkets(ctx, in)
 	}
-	if len(m.Markets) > 0 {
-		for _, s := range m.Markets {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/EnableMarkets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableMarkets(ctx, req.(*MsgEnableMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DisableMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DeletedMarkets) > 0 {
-		for _, s := range m.DeletedMarkets {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DisableMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/DisableMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableMarkets(ctx, req.(*MsgDisableMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPendingMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingMarkets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/CancelPendingMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingMarkets(ctx, req.(*MsgCancelPendingMarkets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMarketMapProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/SubmitMarketMapProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMarketMapProposal(ctx, req.(*MsgSubmitMarketMapProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMarketMapProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/ApproveMarketMapProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMarketMapProposal(ctx, req.(*MsgApproveMarketMapProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoMarketMapProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoMarketMapProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoMarketMapProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.marketmap.v2.Msg/VetoMarketMapProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoMarketMapProposal(ctx, req.(*MsgVetoMarketMapProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.marketmap.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMarkets",
+			Handler:    _Msg_CreateMarkets_Handler,
+		},
+		{
+			MethodName: "UpdateMarkets",
+			Handler:    _Msg_UpdateMarkets_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RemoveMarketAuthorities",
+			Handler:    _Msg_RemoveMarketAuthorities_Handler,
+		},
+		{
+			MethodName: "UpsertMarkets",
+			Handler:    _Msg_UpsertMarkets_Handler,
+		},
+		{
+			MethodName: "RemoveMarkets",
+			Handler:    _Msg_RemoveMarkets_Handler,
+		},
+		{
+			MethodName: "EnableMarkets",
+			Handler:    _Msg_EnableMarkets_Handler,
+		},
+		{
+			MethodName: "DisableMarkets",
+			Handler:    _Msg_DisableMarkets_Handler,
+		},
+		{
+			MethodName: "CancelPendingMarkets",
+			Handler:    _Msg_CancelPendingMarkets_Handler,
+		},
+		{
+			MethodName: "SubmitMarketMapProposal",
+			Handler:    _Msg_SubmitMarketMapProposal_Handler,
+		},
+		{
+			MethodName: "ApproveMarketMapProposal",
+			Handler:    _Msg_ApproveMarketMapProposal_Handler,
+		},
+		{
+			MethodName: "VetoMarketMapProposal",
+			Handler:    _Msg_VetoMarketMapProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/marketmap/v2/tx.proto",
+}
+
+func (m *MsgUpsertMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		keysForMarketUpdates := make([]string, 0, len(m.MarketUpdates))
+		for k := range m.MarketUpdates {
+			keysForMarketUpdates = append(keysForMarketUpdates, string(k))
+		}
+		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMarketUpdates)
+		for iNdEx := len(keysForMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MarketUpdates[string(keysForMarketUpdates[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForMarketUpdates[iNdEx])
+			copy(dAtA[i:], keysForMarketUpdates[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(keysForMarketUpdates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CreateMarkets) > 0 {
+		for iNdEx := len(m.CreateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for iNdEx := len(m.UpdateMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedMarkets) > 0 {
+		for iNdEx := len(m.DeletedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedMarkets[iNdEx])
+			copy(dAtA[i:], m.DeletedMarkets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedMarkets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPendingMarkets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPendingMarkets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPendingMarkets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Markets[iNdEx])
+			copy(dAtA[i:], m.Markets[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Markets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPendingMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPendingMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPendingMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMarketMapProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMarketMapProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMarketMapProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMarketMapProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMarketMapProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMarketMapProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMarketMapProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMarketMapProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMarketMapProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMarketMapProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMarketMapProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMarketMapProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoMarketMapProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoMarketMapProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoMarketMapProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoMarketMapProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoMarketMapProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoMarketMapProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vetoed {
+		i--
+		if m.Vetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpsertMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if m.ActivationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpsertMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		for k, v := range m.MarketUpdates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CreateMarkets) > 0 {
+		for _, e := range m.CreateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if m.ActivationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UpdateMarkets) > 0 {
+		for _, e := range m.UpdateMarkets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if m.ActivationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarketAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedMarkets) > 0 {
+		for _, s := range m.DeletedMarkets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEnableMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEnableMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDisableMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPendingMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, s := range m.Markets {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelPendingMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitMarketMapProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitMarketMapProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveMarketMapProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveMarketMapProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVetoMarketMapProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoMarketMapProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vetoed {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationTime == nil {
+				m.ActivationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketUpdates == nil {
+				m.MarketUpdates = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MarketUpdates[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateMarkets = append(m.CreateMarkets, Market{})
+			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationTime == nil {
+				m.ActivationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
+			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationTime == nil {
+				m.ActivationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1616,10 +4297,10 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1658,7 +4339,7 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1668,25 +4349,23 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1709,7 +4388,7 @@ func (m *MsgUpsertMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1732,17 +4411,17 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpsertMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedMarkets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1752,106 +4431,137 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketUpdates == nil {
-				m.MarketUpdates = make(map[string]bool)
+			m.DeletedMarkets = append(m.DeletedMarkets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MarketUpdates[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1874,7 +4584,7 @@ func (m *MsgUpsertMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1897,10 +4607,60 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableMarkets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableMarkets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1937,9 +4697,9 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1949,25 +4709,23 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreateMarkets = append(m.CreateMarkets, Market{})
-			if err := m.CreateMarkets[len(m.CreateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1990,7 +4748,7 @@ func (m *MsgCreateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2013,10 +4771,10 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2040,7 +4798,7 @@ func (m *MsgCreateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPendingMarkets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2063,10 +4821,10 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPendingMarkets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPendingMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2103,9 +4861,9 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2115,25 +4873,23 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateMarkets = append(m.UpdateMarkets, Market{})
-			if err := m.UpdateMarkets[len(m.UpdateMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2156,7 +4912,7 @@ func (m *MsgUpdateMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPendingMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2179,10 +4935,10 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPendingMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPendingMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2206,7 +4962,7 @@ func (m *MsgUpdateMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitMarketMapProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,17 +4985,17 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitMarketMapProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitMarketMapProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2249,30 +5005,29 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2282,23 +5037,25 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2321,7 +5078,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitMarketMapProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,12 +5101,31 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitMarketMapProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitMarketMapProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2371,7 +5147,7 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveMarketMapProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,15 +5170,15 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveMarketMapProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveMarketMapProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2430,13 +5206,13 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2446,24 +5222,11 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2485,7 +5248,7 @@ func (m *MsgRemoveMarketAuthorities) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveMarketMapProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2508,12 +5271,32 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveMarketMapProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveMarketMapProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2535,7 +5318,7 @@ func (m *MsgRemoveMarketAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoMarketMapProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2558,15 +5341,15 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarkets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoMarketMapProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarkets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoMarketMapProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2594,13 +5377,13 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2610,24 +5393,11 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Markets = append(m.Markets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2649,7 +5419,7 @@ func (m *MsgRemoveMarkets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoMarketMapProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2672,17 +5442,17 @@ func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoMarketMapProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoMarketMapProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedMarkets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2692,24 +5462,12 @@ func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeletedMarkets = append(m.DeletedMarkets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Vetoed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetPriceHistoryCmd(),
+		GetTWAPCmd(),
+		GetStalePairsCmd(),
+		GetValidatorPerformanceCmd(),
 	)
 
 	return cmd
@@ -95,3 +100,144 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceHistoryCmd returns the cli-command that queries the retained price history for a given CurrencyPair. This is essentially a
+// wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded
+// in the clientCtx.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote]",
+		Short: "Query for the retained price history of a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price history
+			res, err := qc.GetPriceHistory(cmd.Context(), &types.GetPriceHistoryRequest{
+				CurrencyPair: cp.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTWAPCmd returns the cli-command that queries the time-weighted average price of a given CurrencyPair over a window. This is
+// essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a
+// grpc-conn embedded in the clientCtx.
+func GetTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "twap [base] [quote] [window]",
+		Short:   "Query for the time-weighted average price of a specified currency-pair over a window (e.g. 10m)",
+		Example: fmt.Sprintf("$ <appd> query %s twap BTC USD 10m", types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair + window from arguments
+			cp := connecttypes.NewCurrencyPair(args[0], args[1])
+			window, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the twap
+			res, err := qc.GetTWAP(cmd.Context(), &types.GetTWAPRequest{
+				CurrencyPair: cp.String(),
+				Window:       window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetStalePairsCmd returns the cli-command that queries for all CurrencyPairs whose price is stale. This is essentially a wrapper around
+// the module's QueryClient, as under-the-hood it constructs a request to a query-client served over a grpc-conn embedded in the clientCtx.
+func GetStalePairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stale-pairs",
+		Short: "Query for all the currency-pairs whose price has not been updated within the module's maximum staleness",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for all stale CurrencyPairs
+			res, err := qc.GetStalePairs(cmd.Context(), &types.GetStalePairsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorPerformanceCmd returns the cli-command that queries the performance of a validator over the module's performance
+// window. This is essentially a wrapper around the module's QueryClient, as under-the-hood it constructs a request to a query-client
+// served over a grpc-conn embedded in the clientCtx.
+func GetValidatorPerformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [cons-address]",
+		Short: "Query for the oracle performance of a validator, identified by its bech32 consensus address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the validator's performance
+			res, err := qc.ValidatorPerformance(cmd.Context(), &types.ValidatorPerformanceRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
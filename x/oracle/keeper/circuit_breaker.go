@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/marketmap/types/tickermetadata"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// GetCircuitBreaker returns the circuit breaker for the given CurrencyPair. A valid circuit breaker configured in the
+// metadata of the CurrencyPair's market overrides the module's default circuit breaker.
+func (k *Keeper) GetCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair) (types.CircuitBreaker, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.CircuitBreaker{}, err
+	}
+
+	cb := params.CircuitBreaker()
+	if k.mmKeeper == nil {
+		return cb, nil
+	}
+
+	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return cb, nil
+		}
+
+		return types.CircuitBreaker{}, err
+	}
+
+	// fall back to the default circuit breaker if the market does not configure a valid one
+	if market.Ticker.Metadata_JSON == "" {
+		return cb, nil
+	}
+
+	metadata, err := tickermetadata.CircuitBreakerMetadataFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil {
+		sdk.UnwrapSDKContext(ctx).Logger().Error(
+			"failed to parse ticker metadata; using the default circuit breaker",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+
+		return cb, nil
+	}
+
+	if metadata.CircuitBreaker == nil {
+		return cb, nil
+	}
+
+	override := types.NewCircuitBreaker(metadata.CircuitBreaker.MaxPriceChangeBps, metadata.CircuitBreaker.Action)
+	if err := override.ValidateBasic(); err != nil {
+		sdk.UnwrapSDKContext(ctx).Logger().Error(
+			"invalid circuit breaker in ticker metadata; using the default circuit breaker",
+			"currency_pair", cp.String(),
+			"err", err,
+		)
+
+		return cb, nil
+	}
+
+	return override, nil
+}
+
+// ApplyCircuitBreaker checks the given price for a CurrencyPair against the CurrencyPair's latest price and circuit
+// breaker, and returns the price that should be written to state. If the change exceeds the circuit breaker's maximum
+// change, an EventCircuitBreakerTripped is emitted and the circuit breaker's action is taken: the price is clamped, or
+// a CircuitBreakerTrippedError is returned. If the CurrencyPair is halted, a CurrencyPairHaltedError is returned. If the
+// CurrencyPair was resumed by the module authority since its last price update, the price is not bounded.
+func (k *Keeper) ApplyCircuitBreaker(ctx context.Context, cp connecttypes.CurrencyPair, price math.Int) (math.Int, error) {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		// the currency-pair will be created when its price is set
+		if errors.Is(err, collections.ErrNotFound) {
+			return price, nil
+		}
+
+		return math.Int{}, err
+	}
+
+	if cps.Halted {
+		return math.Int{}, types.NewCurrencyPairHaltedError(cp)
+	}
+
+	// there is nothing to bound the first price by, and the authority accepted the move of a resumed price
+	if cps.Price == nil || cps.Resumed {
+		return price, nil
+	}
+
+	cb, err := k.GetCircuitBreaker(ctx, cp)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	clamped, tripped := cb.Check(cps.Price.Price, price)
+	if !tripped {
+		return price, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerTripped{
+		CurrencyPair:      cp.String(),
+		PreviousPrice:     cps.Price.Price,
+		Price:             price,
+		MaxPriceChangeBps: cb.MaxPriceChangeBps,
+		Action:            cb.Action,
+		BlockHeight:       uint64(sdkCtx.BlockHeight()), //nolint:gosec
+	}); err != nil {
+		return math.Int{}, err
+	}
+
+	switch cb.Action {
+	case types.CircuitBreakerActionClamp:
+		return clamped, nil
+	case types.CircuitBreakerActionHalt:
+		cps.Halted = true
+		if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	return math.Int{}, types.NewCircuitBreakerTrippedError(cp, cb.Action)
+}
+
+// IsCurrencyPairHalted returns true if price updates for the given CurrencyPair have been halted by the circuit breaker.
+func (k *Keeper) IsCurrencyPairHalted(ctx context.Context, cp connecttypes.CurrencyPair) (bool, error) {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return false, err
+	}
+
+	return cps.Halted, nil
+}
+
+// ResumeCurrencyPair resumes price updates for the given CurrencyPair after they were halted or skipped by the circuit
+// breaker. The next price update for the CurrencyPair is not bounded by the circuit breaker, so that a real move of the
+// market beyond the circuit breaker's maximum change is accepted, and subsequent updates are bounded by that price.
+func (k *Keeper) ResumeCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+
+	cps.Halted = false
+	cps.Resumed = true
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCurrencyPairResumed{
+		CurrencyPair: cp.String(),
+	})
+}
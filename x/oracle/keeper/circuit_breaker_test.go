@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// setCircuitBreakerPrice sets the latest price of the given CurrencyPair, and mocks its market to configure the
+// given ticker metadata.
+func (s *KeeperTestSuite) setCircuitBreakerPrice(cp connecttypes.CurrencyPair, price int64, metadata string) {
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+		Price:          sdkmath.NewInt(price),
+		BlockTimestamp: time.Now(),
+		BlockHeight:    1,
+	}))
+
+	if metadata == "" {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{}, collections.ErrNotFound)
+		return
+	}
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair:  cp,
+			Metadata_JSON: metadata,
+		},
+	}, nil)
+}
+
+func (s *KeeperTestSuite) TestApplyCircuitBreaker() {
+	s.updateParams(func(params *types.Params) {
+		params.SetCircuitBreaker(types.NewCircuitBreaker(1_000, types.CircuitBreakerActionClamp))
+	})
+
+	s.Run("the first price of a currency pair is not bounded", func() {
+		cp := connecttypes.NewCurrencyPair("FIRST", "USD")
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(1_000_000))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1_000_000), price)
+	})
+
+	s.Run("a price within the maximum change is not modified", func() {
+		cp := connecttypes.NewCurrencyPair("WITHIN", "USD")
+		s.setCircuitBreakerPrice(cp, 100, "")
+
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(110))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(110), price)
+
+		price, err = s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(90))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(90), price)
+	})
+
+	s.Run("a price exceeding the maximum change is clamped", func() {
+		cp := connecttypes.NewCurrencyPair("CLAMP", "USD")
+		s.setCircuitBreakerPrice(cp, 100, "")
+
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(200))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(110), price)
+
+		price, err = s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(0))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(90), price)
+	})
+
+	s.Run("the market metadata overrides the params", func() {
+		cp := connecttypes.NewCurrencyPair("SKIP", "USD")
+		s.setCircuitBreakerPrice(cp, 100, `{"circuit_breaker":{"max_price_change_bps":500,"action":"skip"}}`)
+
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(105))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(105), price)
+
+		_, err = s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(106))
+		s.Require().ErrorAs(err, &types.CircuitBreakerTrippedError{})
+
+		halted, err := s.oracleKeeper.IsCurrencyPairHalted(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(halted)
+	})
+
+	s.Run("invalid market metadata falls back to the params", func() {
+		cp := connecttypes.NewCurrencyPair("INVALID", "USD")
+		s.setCircuitBreakerPrice(cp, 100, `{"circuit_breaker":{"max_price_change_bps":500,"action":"unknown"}}`)
+
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(200))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(110), price)
+	})
+
+	s.Run("a tripped halting circuit breaker halts the currency pair until it is resumed", func() {
+		cp := connecttypes.NewCurrencyPair("HALT", "USD")
+		s.setCircuitBreakerPrice(cp, 100, `{"circuit_breaker":{"max_price_change_bps":1000,"action":"halt"}}`)
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(200))
+		s.Require().ErrorAs(err, &types.CircuitBreakerTrippedError{})
+
+		// expect the tripped event
+		events := s.ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, 1)
+		msg, err := sdk.ParseTypedEvent(events[0])
+		s.Require().NoError(err)
+		s.Require().Equal(&types.EventCircuitBreakerTripped{
+			CurrencyPair:      cp.String(),
+			PreviousPrice:     sdkmath.NewInt(100),
+			Price:             sdkmath.NewInt(200),
+			MaxPriceChangeBps: 1000,
+			Action:            types.CircuitBreakerActionHalt,
+			BlockHeight:       uint64(s.ctx.BlockHeight()), //nolint:gosec
+		}, msg)
+
+		// expect subsequent prices to be rejected, regardless of the change
+		_, err = s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(100))
+		s.Require().ErrorAs(err, &types.CurrencyPairHaltedError{})
+
+		// the halt is retained in genesis
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		for _, cpg := range gs.CurrencyPairGenesis {
+			s.Require().Equal(cpg.CurrencyPair == cp, cpg.Halted)
+		}
+
+		// resume the currency pair
+		ms := keeper.NewMsgServer(s.oracleKeeper)
+		_, err = ms.ResumeCurrencyPairs(s.ctx, &types.MsgResumeCurrencyPairs{
+			Authority:       sdk.AccAddress("invalid").String(),
+			CurrencyPairIds: []string{cp.String()},
+		})
+		s.Require().Error(err)
+
+		_, err = ms.ResumeCurrencyPairs(s.ctx, &types.MsgResumeCurrencyPairs{
+			Authority:       sdk.AccAddress(moduleAuth).String(),
+			CurrencyPairIds: []string{cp.String()},
+		})
+		s.Require().NoError(err)
+
+		// the next price is not bounded, so the move that halted the currency pair is accepted
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(200))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), price)
+	})
+
+	s.Run("a skipped currency pair accepts the next price once it is resumed", func() {
+		cp := connecttypes.NewCurrencyPair("SKIP", "USD")
+		s.setCircuitBreakerPrice(cp, 100, `{"circuit_breaker":{"max_price_change_bps":1000,"action":"skip"}}`)
+
+		_, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(200))
+		s.Require().ErrorAs(err, &types.CircuitBreakerTrippedError{})
+
+		ms := keeper.NewMsgServer(s.oracleKeeper)
+		_, err = ms.ResumeCurrencyPairs(s.ctx, &types.MsgResumeCurrencyPairs{
+			Authority:       sdk.AccAddress(moduleAuth).String(),
+			CurrencyPairIds: []string{cp.String()},
+		})
+		s.Require().NoError(err)
+
+		// the resume is retained in genesis
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		for _, cpg := range gs.CurrencyPairGenesis {
+			if cpg.CurrencyPair == cp {
+				s.Require().True(cpg.Resumed)
+			}
+		}
+
+		price, err := s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(200))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), price)
+
+		// once the price is written, updates are bounded by the new price
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+			Price:          price,
+			BlockTimestamp: time.Now(),
+			BlockHeight:    2,
+		}))
+
+		_, err = s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(400))
+		s.Require().ErrorAs(err, &types.CircuitBreakerTrippedError{})
+
+		price, err = s.oracleKeeper.ApplyCircuitBreaker(s.ctx, cp, sdkmath.NewInt(210))
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(210), price)
+	})
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -17,13 +18,48 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	// set the module parameters
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// initialize all CurrencyPairs + genesis prices
 	for _, cpg := range gs.CurrencyPairGenesis {
 		state := types.NewCurrencyPairState(cpg.Id, cpg.Nonce, cpg.CurrencyPairPrice)
+		state.Halted = cpg.Halted
+		state.Resumed = cpg.Resumed
 
 		if err := k.currencyPairs.Set(ctx, cpg.CurrencyPair.String(), state); err != nil {
 			panic(fmt.Errorf("error in genesis: %w", err))
 		}
+
+		for _, entry := range cpg.PriceHistory {
+			if err := k.priceHistory.Set(ctx, collections.Join(cpg.CurrencyPair.String(), entry.Nonce), entry.Price); err != nil {
+				panic(fmt.Errorf("error in genesis: %w", err))
+			}
+		}
+	}
+
+	// initialize the validator reports, and the performance derived from them
+	for _, vr := range gs.ValidatorReports {
+		validator, err := sdk.ConsAddressFromBech32(vr.Validator)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		if err := k.setValidatorReport(ctx, vr.Height, validator, vr.Report); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		performance, err := k.GetValidatorPerformance(ctx, validator)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+		performance.Add(vr.Report)
+
+		if err := k.validatorPerformance.Set(ctx, validator, performance); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
 	}
 
 	// set the next ID to state
@@ -49,10 +85,16 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// instantiate genesis-state w/ empty array
 	gs := &types.GenesisState{
 		CurrencyPairGenesis: make([]types.CurrencyPairGenesis, 0),
 		NextId:              id,
+		Params:              params,
 	}
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
@@ -63,11 +105,38 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			Id:                cps.Id,
 			Nonce:             cps.Nonce,
 			CurrencyPairPrice: cps.Price,
+			Halted:            cps.Halted,
+			Resumed:           cps.Resumed,
 		})
 	})
 	if err != nil {
 		panic(err)
 	}
 
+	// add the retained price history of each currency pair
+	for i, cpg := range gs.CurrencyPairGenesis {
+		history, err := k.GetPriceHistory(ctx, cpg.CurrencyPair)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		if len(history) > 0 {
+			gs.CurrencyPairGenesis[i].PriceHistory = history
+		}
+	}
+
+	// add the validator reports within the performance window
+	err = k.validatorReports.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.ConsAddress], report types.ValidatorBlockReport) (bool, error) {
+		gs.ValidatorReports = append(gs.ValidatorReports, types.ValidatorReportGenesis{
+			Validator: key.K2().String(),
+			Height:    key.K1(),
+			Report:    report,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	return gs
 }
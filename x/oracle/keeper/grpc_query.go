@@ -68,12 +68,18 @@ func (q queryServer) GetPrice(ctx context.Context, req *types.GetPriceRequest) (
 		return nil, err
 	}
 
+	stale, err := q.k.IsPriceStale(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
 	// return the QuotePrice + Nonce
 	return &types.GetPriceResponse{
 		Price:    &qpn.QuotePrice,
 		Nonce:    qpn.Nonce(),
 		Decimals: decimals,
 		Id:       id,
+		Stale:    stale,
 	}, nil
 }
 
@@ -109,11 +115,17 @@ func (q queryServer) GetPrices(ctx context.Context, req *types.GetPricesRequest)
 			return nil, err
 		}
 
+		stale, err := q.k.IsPriceStale(ctx, cp)
+		if err != nil {
+			return nil, err
+		}
+
 		prices = append(prices, types.GetPriceResponse{
 			Price:    &qpn.QuotePrice,
 			Nonce:    qpn.Nonce(),
 			Decimals: decimals,
 			Id:       id,
+			Stale:    stale,
 		})
 	}
 
@@ -141,3 +153,146 @@ func (q queryServer) GetCurrencyPairMappingList(ctx context.Context, _ *types.Ge
 
 	return &types.GetCurrencyPairMappingListResponse{Mappings: pairs}, nil
 }
+
+// Params returns the current x/oracle module parameters.
+func (q queryServer) Params(ctx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+// GetPriceHistory returns the retained price history for a given CurrencyPair, ordered from oldest to newest. If the
+// request is nil, or the CurrencyPair is incorrectly formatted or not tracked by the module, this method fails.
+func (q queryServer) GetPriceHistory(ctx context.Context, req *types.GetPriceHistoryRequest) (*types.GetPriceHistoryResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp, err := q.currencyPairFromRequest(req.CurrencyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	id, ok := q.k.GetIDForCurrencyPair(ctx, cp)
+	if !ok {
+		return nil, fmt.Errorf("no ID found for CurrencyPair: %s", cp.String())
+	}
+
+	history, err := q.k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceHistoryResponse{
+		Prices:   history,
+		Decimals: decimals,
+		Id:       id,
+	}, nil
+}
+
+// GetTWAP returns the time-weighted average price of a given CurrencyPair over the requested window, ending at the
+// current block time. If the request is nil, the CurrencyPair is incorrectly formatted or not tracked by the module,
+// the window is not positive, or no price history is retained for the CurrencyPair, this method fails.
+func (q queryServer) GetTWAP(ctx context.Context, req *types.GetTWAPRequest) (*types.GetTWAPResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp, err := q.currencyPairFromRequest(req.CurrencyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, fmt.Errorf("the module is not tracking CurrencyPair: %s", cp.String())
+	}
+
+	twap, err := q.k.GetTWAPForCurrencyPair(ctx, cp, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTWAPResponse{
+		Twap:      twap.Price,
+		StartTime: twap.StartTime,
+		EndTime:   twap.EndTime,
+		NumPrices: twap.NumPrices,
+		Decimals:  decimals,
+	}, nil
+}
+
+// GetStalePairs returns all CurrencyPairs whose price has not been updated within the module's maximum staleness.
+// If the maximum staleness is not configured, no CurrencyPair is stale.
+func (q queryServer) GetStalePairs(ctx context.Context, req *types.GetStalePairsRequest) (*types.GetStalePairsResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cps, err := q.k.GetStalePairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetStalePairsResponse{
+		CurrencyPairs: cps,
+	}, nil
+}
+
+// ValidatorPerformance returns the performance of the given validator over the module's performance window.
+func (q queryServer) ValidatorPerformance(ctx context.Context, req *types.ValidatorPerformanceRequest) (*types.ValidatorPerformanceResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator address: %w", err)
+	}
+
+	performance, err := q.k.GetValidatorPerformance(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorPerformanceResponse{
+		Performance:      performance,
+		PerformanceBps:   performance.PerformanceBps(),
+		MeanDeviationBps: performance.MeanDeviationBps(),
+	}, nil
+}
+
+// currencyPairFromRequest parses and validates the stringified CurrencyPair of a request.
+func (q queryServer) currencyPairFromRequest(id string) (connecttypes.CurrencyPair, error) {
+	cp, err := connecttypes.CurrencyPairFromString(id)
+	if err != nil {
+		return connecttypes.CurrencyPair{}, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	if err = cp.ValidateBasic(); err != nil {
+		return connecttypes.CurrencyPair{}, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	return cp, nil
+}
@@ -68,6 +68,25 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// params is the module's parameters.
+	params collections.Item[types.Params]
+
+	// priceHistory is the retained price history of each CP, indexed by (CurrencyPair.String(), nonce).
+	priceHistory collections.Map[collections.Pair[string, uint64], types.QuotePrice]
+
+	// validatorReports are the block reports of each validator within the performance window, indexed by
+	// (height, validator).
+	validatorReports collections.Map[collections.Pair[uint64, sdk.ConsAddress], types.ValidatorBlockReport]
+
+	// validatorReportsByValidator indexes the block reports by (validator, height).
+	validatorReportsByValidator collections.KeySet[collections.Pair[sdk.ConsAddress, uint64]]
+
+	// validatorPerformance is the aggregate of the block reports of each validator within the performance window.
+	validatorPerformance collections.Map[sdk.ConsAddress, types.ValidatorPerformance]
+
+	// slashingHooks are called when a validator's performance falls below the module's minimum.
+	slashingHooks types.OracleSlashingHooks
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -96,9 +115,15 @@ func NewKeeper(
 		mmKeeper:           mmKeeper,
 		numRemoves:         collections.NewItem[uint64](sb, types.NumRemovesKeyPrefix, "removed_cps", types.CounterCodec),
 		numCPs:             collections.NewItem[uint64](sb, types.NumCPsKeyPrefix, "num_cps", types.CounterCodec),
+		params:             collections.NewItem[types.Params](sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		priceHistory:       collections.NewMap(sb, types.PriceHistoryKeyPrefix, "price_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.QuotePrice](cdc)),
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
+
+		validatorReports:            collections.NewMap(sb, types.ValidatorReportsKeyPrefix, "validator_reports", collections.PairKeyCodec(collections.Uint64Key, sdk.ConsAddressKey), codec.CollValue[types.ValidatorBlockReport](cdc)),
+		validatorReportsByValidator: collections.NewKeySet(sb, types.ValidatorReportsByValidatorKeyPrefix, "validator_reports_by_validator", collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key)),
+		validatorPerformance:        collections.NewMap(sb, types.ValidatorPerformanceKeyPrefix, "validator_performance", sdk.ConsAddressKey, codec.CollValue[types.ValidatorPerformance](cdc)),
 	}
 
 	// create the schema
@@ -111,6 +136,20 @@ func NewKeeper(
 	return k
 }
 
+// SetSlashingHooks sets the OracleSlashingHooks of the keeper.
+func (k *Keeper) SetSlashingHooks(hooks types.OracleSlashingHooks) {
+	k.slashingHooks = hooks
+}
+
+// SlashingHooks returns the OracleSlashingHooks of the keeper. If no hooks are set, no-op hooks are returned.
+func (k *Keeper) SlashingHooks() types.OracleSlashingHooks {
+	if k.slashingHooks == nil {
+		return types.NoopOracleSlashingHooks{}
+	}
+
+	return k.slashingHooks
+}
+
 // RemoveCurrencyPair removes a given CurrencyPair from state, i.e. removes its nonce + QuotePrice from the module's store.
 func (k *Keeper) RemoveCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair) error {
 	// check if the currency pair exists.
@@ -121,6 +160,9 @@ func (k *Keeper) RemoveCurrencyPair(ctx context.Context, cp connecttypes.Currenc
 	if err := k.currencyPairs.Remove(ctx, cp.String()); err != nil {
 		return err
 	}
+	if err := k.removePriceHistory(ctx, cp); err != nil {
+		return err
+	}
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
@@ -209,10 +251,17 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx context.Context, cp connecttypes.Cu
 		// update the nonce
 		cps.Nonce++
 		cps.Price = &qp
+		// the price update that bypassed the circuit breaker after a resume has been applied
+		cps.Resumed = false
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	// record the price in the currency-pair's history
+	return k.recordPriceHistory(ctx, cp, cps.Nonce, qp)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
@@ -411,3 +460,19 @@ func (k *Keeper) decrementCPCounter(ctx context.Context) error {
 func (k *Keeper) GetNumCurrencyPairs(ctx context.Context) (uint64, error) {
 	return k.numCPs.Get(ctx)
 }
+
+// SetParams sets the x/oracle module's parameters.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the x/oracle module's parameters. If the parameters have not been set, i.e. on chains
+// that were initialized before the parameters were introduced, the default parameters are returned.
+func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+
+	return params, err
+}
@@ -50,6 +50,15 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
+// updateParams applies the given update to the current params of the module.
+func (s *KeeperTestSuite) updateParams(update func(params *types.Params)) {
+	params, err := s.oracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	update(&params)
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+}
+
 func (s *KeeperTestSuite) SetupWithNoMMKeeper() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	ss := runtime.NewKVStoreService(key)
@@ -92,3 +92,72 @@ func (m *msgServer) RemoveCurrencyPairs(goCtx context.Context, req *types.MsgRem
 
 	return nil, nil
 }
+
+// UpdateParams updates the x/oracle module's parameters. This method fails if the message is invalid, or if the
+// signer is not the authority account of the module. Unlike the currency-pair messages, this message is enabled
+// when using x/marketmap.
+func (m *msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	if err := req.Params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := m.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting params: %w", err)
+	}
+
+	if err := m.k.SetParams(ctx, req.Params); err != nil {
+		return nil, fmt.Errorf("error setting params: %w", err)
+	}
+
+	// the price history is only pruned when prices are written, so the history retained beyond a reduced
+	// length is pruned here.
+	if req.Params.MaxPriceHistory < params.MaxPriceHistory {
+		if err := m.k.PrunePriceHistory(ctx); err != nil {
+			return nil, fmt.Errorf("error pruning price history: %w", err)
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ResumeCurrencyPairs takes a set of CurrencyPairs to resume, after they have been halted by the circuit breaker. This method
+// fails if the message is invalid, if the signer is not the authority account of the module, or if any of the CurrencyPairs is
+// not tracked. Like UpdateParams, this message is enabled when using x/marketmap.
+func (m *msgServer) ResumeCurrencyPairs(goCtx context.Context, req *types.MsgResumeCurrencyPairs) (*types.MsgResumeCurrencyPairsResponse, error) {
+	// check validity of message
+	if req == nil {
+		return nil, fmt.Errorf("message cannot be empty")
+	}
+
+	// check that the authority of the message is the authority of the module
+	if req.Authority != m.k.authority.String() {
+		return nil, fmt.Errorf("message validation failed: authority %s is not module authority %s", req.Authority, m.k.authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, id := range req.CurrencyPairIds {
+		// get cp from identifier string
+		cp, err := connecttypes.CurrencyPairFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving CurrencyPair from request: %w", err)
+		}
+
+		if err := m.k.ResumeCurrencyPair(ctx, cp); err != nil {
+			return nil, fmt.Errorf("error resuming currency pair %s: %w", cp, err)
+		}
+	}
+
+	return &types.MsgResumeCurrencyPairsResponse{}, nil
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// UpdateValidatorPerformance records the prices reported by each validator in the current block against the final
+// prices of the block. Reports older than the module's performance window are pruned for all validators, including
+// those no longer in the validator set. If a validator's performance over a full window is below the module's minimum,
+// an EventValidatorPerformanceBelowThreshold is emitted, the OracleSlashingHooks are called, and the validator's window
+// is reset.
+func (k *Keeper) UpdateValidatorPerformance(
+	ctx context.Context,
+	finalPrices map[connecttypes.CurrencyPair]*big.Int,
+	validators []types.ValidatorPrices,
+) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	tracking := params.PerformanceTracking()
+	if !tracking.Enabled() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() < 0 {
+		return nil
+	}
+	height := uint64(sdkCtx.BlockHeight())
+
+	for _, validator := range validators {
+		report := tracking.Report(finalPrices, validator.Prices)
+		if err := k.setValidatorReport(ctx, height, validator.ConsAddress, report); err != nil {
+			return err
+		}
+
+		performance, err := k.GetValidatorPerformance(ctx, validator.ConsAddress)
+		if err != nil {
+			return err
+		}
+		performance.Add(report)
+
+		if err := k.validatorPerformance.Set(ctx, validator.ConsAddress, performance); err != nil {
+			return err
+		}
+	}
+
+	// prune the reports that have fallen out of the window
+	if height >= tracking.Window {
+		if err := k.pruneValidatorReports(ctx, height-tracking.Window); err != nil {
+			return err
+		}
+	}
+
+	for _, validator := range validators {
+		performance, err := k.GetValidatorPerformance(ctx, validator.ConsAddress)
+		if err != nil {
+			return err
+		}
+
+		if performance.NumBlocks < tracking.Window || performance.PerformanceBps() >= tracking.MinPerformanceBps {
+			continue
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventValidatorPerformanceBelowThreshold{
+			Validator:         validator.ConsAddress.String(),
+			PerformanceBps:    performance.PerformanceBps(),
+			MinPerformanceBps: tracking.MinPerformanceBps,
+		}); err != nil {
+			return err
+		}
+
+		if err := k.SlashingHooks().AfterValidatorPerformanceBelowThreshold(sdkCtx, validator.ConsAddress, performance); err != nil {
+			return err
+		}
+
+		if err := k.resetValidatorPerformance(ctx, validator.ConsAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetValidatorPerformance returns the performance of the given validator over the module's performance window. If no
+// reports have been recorded for the validator, an empty ValidatorPerformance is returned.
+func (k *Keeper) GetValidatorPerformance(ctx context.Context, validator sdk.ConsAddress) (types.ValidatorPerformance, error) {
+	performance, err := k.validatorPerformance.Get(ctx, validator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorPerformance{}, nil
+	}
+
+	return performance, err
+}
+
+// pruneValidatorReports removes the reports of all validators at or below the given height, and subtracts them from
+// the performance of each validator. The performance of a validator without any remaining reports is removed.
+func (k *Keeper) pruneValidatorReports(ctx context.Context, height uint64) error {
+	iter, err := k.validatorReports.Iterate(ctx, collections.NewPrefixUntilPairRange[uint64, sdk.ConsAddress](height))
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		validator := kv.Key.K2()
+		performance, err := k.GetValidatorPerformance(ctx, validator)
+		if err != nil {
+			return err
+		}
+		performance.Sub(kv.Value)
+
+		if performance.NumBlocks == 0 {
+			err = k.validatorPerformance.Remove(ctx, validator)
+		} else {
+			err = k.validatorPerformance.Set(ctx, validator, performance)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := k.removeValidatorReport(ctx, kv.Key.K1(), validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resetValidatorPerformance removes all reports and the performance of the given validator. Only the reports of the
+// validator are walked, through the index of the reports by validator.
+func (k *Keeper) resetValidatorPerformance(ctx context.Context, validator sdk.ConsAddress) error {
+	iter, err := k.validatorReportsByValidator.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](validator))
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.removeValidatorReport(ctx, key.K2(), validator); err != nil {
+			return err
+		}
+	}
+
+	return k.validatorPerformance.Remove(ctx, validator)
+}
+
+// setValidatorReport sets the report of the given validator at the given height, and indexes it by validator.
+func (k *Keeper) setValidatorReport(ctx context.Context, height uint64, validator sdk.ConsAddress, report types.ValidatorBlockReport) error {
+	if err := k.validatorReports.Set(ctx, collections.Join(height, validator), report); err != nil {
+		return err
+	}
+
+	return k.validatorReportsByValidator.Set(ctx, collections.Join(validator, height))
+}
+
+// removeValidatorReport removes the report of the given validator at the given height, and its index entry.
+func (k *Keeper) removeValidatorReport(ctx context.Context, height uint64, validator sdk.ConsAddress) error {
+	if err := k.validatorReports.Remove(ctx, collections.Join(height, validator)); err != nil {
+		return err
+	}
+
+	return k.validatorReportsByValidator.Remove(ctx, collections.Join(validator, height))
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestValidatorPerformance() {
+	btcUsd := connecttypes.NewCurrencyPair("BTC", "USD")
+	ethUsd := connecttypes.NewCurrencyPair("ETH", "USD")
+	finalPrices := map[connecttypes.CurrencyPair]*big.Int{
+		btcUsd: big.NewInt(100),
+		ethUsd: big.NewInt(10),
+	}
+
+	good := sdk.ConsAddress("good")
+	deviating := sdk.ConsAddress("deviating")
+	absent := sdk.ConsAddress("absent")
+	validators := []types.ValidatorPrices{
+		{ConsAddress: good, Prices: map[connecttypes.CurrencyPair]*big.Int{btcUsd: big.NewInt(100), ethUsd: big.NewInt(10)}},
+		{ConsAddress: deviating, Prices: map[connecttypes.CurrencyPair]*big.Int{btcUsd: big.NewInt(150), ethUsd: big.NewInt(10)}},
+		{ConsAddress: absent},
+	}
+
+	s.Run("performance is not tracked by default", func() {
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, good)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{}, performance)
+	})
+
+	s.updateParams(func(params *types.Params) { params.SetPerformanceTracking(types.NewPerformanceTracking(3, 5000, 1000)) })
+
+	hooks := mocks.NewOracleSlashingHooks(s.T())
+	s.oracleKeeper.SetSlashingHooks(hooks)
+
+	s.Run("reports are aggregated over the window", func() {
+		for height := int64(1); height <= 2; height++ {
+			s.ctx = s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators))
+		}
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, good)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{NumBlocks: 2, NumPairs: 4, NumReported: 4}, performance)
+		s.Require().Equal(uint64(types.BasisPoints), performance.PerformanceBps())
+
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, deviating)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{NumBlocks: 2, NumPairs: 4, NumReported: 4, NumDeviating: 2, DeviationBps: 10000}, performance)
+		s.Require().Equal(uint64(5000), performance.PerformanceBps())
+		s.Require().Equal(uint64(2500), performance.MeanDeviationBps())
+
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, absent)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{NumBlocks: 2, NumPairs: 4}, performance)
+		s.Require().Equal(uint64(0), performance.PerformanceBps())
+	})
+
+	s.Run("hooks are called for validators below the threshold over a full window", func() {
+		s.ctx = s.ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+		hooks.On("AfterValidatorPerformanceBelowThreshold", mock.Anything, absent, types.ValidatorPerformance{
+			NumBlocks: 3,
+			NumPairs:  6,
+		}).Return(nil).Once()
+
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators))
+
+		events := s.ctx.EventManager().ABCIEvents()
+		s.Require().Len(events, 1)
+		msg, err := sdk.ParseTypedEvent(events[0])
+		s.Require().NoError(err)
+		s.Require().Equal(&types.EventValidatorPerformanceBelowThreshold{
+			Validator:         absent.String(),
+			PerformanceBps:    0,
+			MinPerformanceBps: 5000,
+		}, msg)
+
+		// the window of the validator is reset
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, absent)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{}, performance)
+
+		// only the reports of the validator are removed
+		reports := make(map[string]int)
+		for _, vr := range s.oracleKeeper.ExportGenesis(s.ctx).ValidatorReports {
+			reports[vr.Validator]++
+		}
+		s.Require().Equal(map[string]int{good.String(): 3, deviating.String(): 3}, reports)
+
+		// the deviating validator is at the threshold
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, deviating)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), performance.NumBlocks)
+	})
+
+	s.Run("reports outside of the window are pruned", func() {
+		s.ctx = s.ctx.WithBlockHeight(4)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators[:2]))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, good)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{NumBlocks: 3, NumPairs: 6, NumReported: 6}, performance)
+	})
+
+	s.Run("reports of validators that left the set are pruned", func() {
+		// the deviating validator leaves the set, and its reports fall out of the window
+		for height := int64(5); height <= 6; height++ {
+			s.ctx = s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators[:1]))
+		}
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, deviating)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), performance.NumBlocks)
+
+		s.ctx = s.ctx.WithBlockHeight(7)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators[:1]))
+
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, deviating)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{}, performance)
+
+		for _, vr := range s.oracleKeeper.ExportGenesis(s.ctx).ValidatorReports {
+			s.Require().Equal(good.String(), vr.Validator)
+		}
+
+		// the deviating validator re-joins the set for the rest of the test
+		s.ctx = s.ctx.WithBlockHeight(8)
+		s.Require().NoError(s.oracleKeeper.UpdateValidatorPerformance(s.ctx, finalPrices, validators[:2]))
+	})
+
+	s.Run("reports and performance are retained in genesis", func() {
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(gs.ValidatorReports, 4)
+		s.Require().NoError(gs.Validate())
+
+		s.SetupTest()
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, good)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{NumBlocks: 3, NumPairs: 6, NumReported: 6}, performance)
+
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, deviating)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ValidatorPerformance{NumBlocks: 1, NumPairs: 2, NumReported: 2, NumDeviating: 1, DeviationBps: 5000}, performance)
+
+		s.Require().Equal(gs.ValidatorReports, s.oracleKeeper.ExportGenesis(s.ctx).ValidatorReports)
+	})
+
+	s.Run("query returns the performance of a validator", func() {
+		qs := keeper.NewQueryServer(s.oracleKeeper)
+
+		res, err := qs.ValidatorPerformance(s.ctx, &types.ValidatorPerformanceRequest{Validator: deviating.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), res.Performance.NumBlocks)
+		s.Require().Equal(uint64(5000), res.PerformanceBps)
+		s.Require().Equal(uint64(2500), res.MeanDeviationBps)
+
+		_, err = qs.ValidatorPerformance(s.ctx, &types.ValidatorPerformanceRequest{Validator: "invalid"})
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// recordPriceHistory records the given QuotePrice in the price history of the given CurrencyPair, if
+// price history is enabled, and prunes the history of the CurrencyPair to the configured length.
+func (k *Keeper) recordPriceHistory(ctx context.Context, cp connecttypes.CurrencyPair, nonce uint64, qp types.QuotePrice) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxPriceHistory == 0 {
+		return nil
+	}
+
+	if err := k.priceHistory.Set(ctx, collections.Join(cp.String(), nonce), qp); err != nil {
+		return err
+	}
+
+	// retain the prices with nonces in (nonce - MaxPriceHistory, nonce].
+	if nonce < params.MaxPriceHistory {
+		return nil
+	}
+
+	ranger := collections.NewPrefixedPairRange[string, uint64](cp.String()).EndInclusive(nonce - params.MaxPriceHistory)
+	return k.priceHistory.Clear(ctx, ranger)
+}
+
+// removePriceHistory removes the entire price history of the given CurrencyPair.
+func (k *Keeper) removePriceHistory(ctx context.Context, cp connecttypes.CurrencyPair) error {
+	return k.priceHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()))
+}
+
+// GetPriceHistory returns the retained price history of the given CurrencyPair, ordered from oldest to newest.
+func (k *Keeper) GetPriceHistory(ctx context.Context, cp connecttypes.CurrencyPair) ([]types.PriceHistoryEntry, error) {
+	it, err := k.priceHistory.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String()))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	history := make([]types.PriceHistoryEntry, 0)
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, types.PriceHistoryEntry{
+			Price: kv.Value,
+			Nonce: kv.Key.K2(),
+		})
+	}
+
+	return history, nil
+}
+
+// GetTWAPForCurrencyPair returns the time-weighted average price of the given CurrencyPair over the given
+// window, ending at the current block time. This fails if no price history is retained for the CurrencyPair.
+func (k *Keeper) GetTWAPForCurrencyPair(ctx context.Context, cp connecttypes.CurrencyPair, window time.Duration) (types.TWAP, error) {
+	if window <= 0 {
+		return types.TWAP{}, fmt.Errorf("window must be positive; got %s", window)
+	}
+
+	history, err := k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return types.TWAP{}, err
+	}
+
+	if len(history) == 0 {
+		return types.TWAP{}, fmt.Errorf("no price history retained for CurrencyPair: %s", cp.String())
+	}
+
+	end := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return types.CalculateTWAP(history, end.Add(-window), end)
+}
+
+// PrunePriceHistory prunes the price history of every CurrencyPair to the configured maximum length, removing
+// the oldest prices first. If price history is disabled, all retained history is removed. Since the history of a
+// CurrencyPair is pruned whenever its price is written, this only needs to be called when the configured length
+// is reduced.
+func (k *Keeper) PrunePriceHistory(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxPriceHistory == 0 {
+		return k.priceHistory.Clear(ctx, nil)
+	}
+
+	// collect the nonces of all currency-pairs before pruning to avoid mutating state while iterating.
+	type cpNonce struct {
+		cp    string
+		nonce uint64
+	}
+	nonces := make([]cpNonce, 0)
+	if err := k.IterateCurrencyPairs(ctx, func(cp connecttypes.CurrencyPair, state types.CurrencyPairState) {
+		nonces = append(nonces, cpNonce{cp: cp.String(), nonce: state.Nonce})
+	}); err != nil {
+		return err
+	}
+
+	for _, n := range nonces {
+		// retain the prices with nonces in (nonce - MaxPriceHistory, nonce].
+		if n.nonce < params.MaxPriceHistory {
+			continue
+		}
+
+		ranger := collections.NewPrefixedPairRange[string, uint64](n.cp).EndInclusive(n.nonce - params.MaxPriceHistory)
+		if err := k.priceHistory.Clear(ctx, ranger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
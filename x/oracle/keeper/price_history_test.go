@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+var historyCP = connecttypes.CurrencyPair{Base: "AA", Quote: "BB"}
+
+// setHistoricalPrices sets one price per block for historyCP, starting at the current block time and advancing
+// the block time by the given interval after each price.
+func (s *KeeperTestSuite) setHistoricalPrices(interval time.Duration, prices ...int64) {
+	for _, price := range prices {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, historyCP, types.QuotePrice{
+			Price:          sdkmath.NewInt(price),
+			BlockTimestamp: s.ctx.BlockTime(),
+			BlockHeight:    uint64(s.ctx.BlockHeight()), //nolint:gosec
+		}))
+
+		s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(interval)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	}
+}
+
+func (s *KeeperTestSuite) historicalPrices() []int64 {
+	history, err := s.oracleKeeper.GetPriceHistory(s.ctx, historyCP)
+	s.Require().NoError(err)
+
+	prices := make([]int64, len(history))
+	for i, entry := range history {
+		prices[i] = entry.Price.Price.Int64()
+	}
+
+	return prices
+}
+
+func (s *KeeperTestSuite) TestPriceHistory() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	s.Run("no history is retained by default", func() {
+		s.setHistoricalPrices(time.Second, 100, 200)
+		s.Require().Empty(s.historicalPrices())
+
+		_, err := s.oracleKeeper.GetTWAPForCurrencyPair(s.ctx, historyCP, time.Minute)
+		s.Require().Error(err)
+	})
+
+	s.Run("history is retained and pruned to the configured length", func() {
+		s.updateParams(func(params *types.Params) { params.MaxPriceHistory = 3 })
+
+		s.setHistoricalPrices(time.Second, 300, 400, 500)
+		s.Require().Equal([]int64{300, 400, 500}, s.historicalPrices())
+
+		s.setHistoricalPrices(time.Second, 600)
+		s.Require().Equal([]int64{400, 500, 600}, s.historicalPrices())
+	})
+
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+	updateMaxPriceHistory := func(maxPriceHistory uint64) {
+		params, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		params.MaxPriceHistory = maxPriceHistory
+		_, err = ms.UpdateParams(s.ctx, &types.MsgUpdateParams{
+			Authority: sdk.AccAddress(moduleAuth).String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+	}
+
+	s.Run("history is pruned when the configured length is reduced", func() {
+		updateMaxPriceHistory(1)
+		s.Require().Equal([]int64{600}, s.historicalPrices())
+	})
+
+	s.Run("history is removed when disabled", func() {
+		updateMaxPriceHistory(0)
+		s.Require().Empty(s.historicalPrices())
+
+		s.setHistoricalPrices(time.Second, 700)
+		s.Require().Empty(s.historicalPrices())
+	})
+
+	s.Run("history is removed with the currency pair", func() {
+		s.updateParams(func(params *types.Params) { params.MaxPriceHistory = 10 })
+		s.setHistoricalPrices(time.Second, 100, 200)
+		s.Require().Len(s.historicalPrices(), 2)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, historyCP))
+		s.Require().Empty(s.historicalPrices())
+	})
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryGenesis() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	s.updateParams(func(params *types.Params) { params.MaxPriceHistory = 2 })
+	s.setHistoricalPrices(time.Second, 100, 200, 300)
+
+	gs := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(gs.Validate())
+
+	s.SetupTest()
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+	s.Require().Equal([]int64{200, 300}, s.historicalPrices())
+	s.Require().Equal(gs, s.oracleKeeper.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetTWAPForCurrencyPair() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.updateParams(func(params *types.Params) { params.MaxPriceHistory = 10 })
+
+	// prices of 100, 200 and 400 are each the latest price for 10 seconds.
+	s.setHistoricalPrices(10*time.Second, 100, 200, 400)
+
+	s.Run("twap over the entire history", func() {
+		twap, err := s.oracleKeeper.GetTWAPForCurrencyPair(s.ctx, historyCP, 30*time.Second)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(233), twap.Price)
+		s.Require().Equal(start, twap.StartTime)
+		s.Require().Equal(uint64(3), twap.NumPrices)
+	})
+
+	s.Run("twap over a partial window", func() {
+		twap, err := s.oracleKeeper.GetTWAPForCurrencyPair(s.ctx, historyCP, 15*time.Second)
+		s.Require().NoError(err)
+		// (200 * 5 + 400 * 10) / 15
+		s.Require().Equal(sdkmath.NewInt(333), twap.Price)
+		s.Require().Equal(uint64(2), twap.NumPrices)
+	})
+
+	s.Run("non-positive window fails", func() {
+		_, err := s.oracleKeeper.GetTWAPForCurrencyPair(s.ctx, historyCP, 0)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryQueries() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	s.updateParams(func(params *types.Params) { params.MaxPriceHistory = 10 })
+	s.setHistoricalPrices(10*time.Second, 100, 300)
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, historyCP.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair: historyCP,
+			Decimals:     8,
+		},
+	}, nil)
+
+	s.Run("params", func() {
+		res, err := qs.Params(s.ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(10), res.Params.MaxPriceHistory)
+	})
+
+	s.Run("price history", func() {
+		res, err := qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{CurrencyPair: historyCP.String()})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 2)
+		s.Require().Equal(sdkmath.NewInt(100), res.Prices[0].Price.Price)
+		s.Require().Equal(uint64(0), res.Prices[0].Nonce)
+		s.Require().Equal(sdkmath.NewInt(300), res.Prices[1].Price.Price)
+		s.Require().Equal(uint64(1), res.Prices[1].Nonce)
+		s.Require().Equal(uint64(8), res.Decimals)
+	})
+
+	s.Run("twap", func() {
+		res, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: historyCP.String(), Window: 20 * time.Second})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), res.Twap)
+		s.Require().Equal(uint64(2), res.NumPrices)
+		s.Require().Equal(uint64(8), res.Decimals)
+	})
+
+	s.Run("invalid requests fail", func() {
+		_, err := qs.GetPriceHistory(s.ctx, nil)
+		s.Require().Error(err)
+
+		_, err = qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{CurrencyPair: "invalid"})
+		s.Require().Error(err)
+
+		_, err = qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: "CC/DD", Window: time.Minute})
+		s.Require().Error(err)
+
+		_, err = qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: historyCP.String()})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+
+	params := types.DefaultParams()
+	params.MaxPriceHistory = 10
+
+	s.Run("if the authority is not the authority of the module - fail", func() {
+		_, err := ms.UpdateParams(s.ctx, &types.MsgUpdateParams{
+			Authority: sdk.AccAddress("not-authority").String(),
+			Params:    params,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("if the params are invalid - fail", func() {
+		_, err := ms.UpdateParams(s.ctx, &types.MsgUpdateParams{
+			Authority: sdk.AccAddress(moduleAuth).String(),
+			Params:    types.Params{MaxPriceHistory: types.MaxPriceHistoryLimit + 1},
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("if the authority is correct and the params are valid - pass", func() {
+		_, err := ms.UpdateParams(s.ctx, &types.MsgUpdateParams{
+			Authority: sdk.AccAddress(moduleAuth).String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		params, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, params)
+	})
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+// IsPriceStale returns true if the latest price of the given CurrencyPair has not been updated within the module's
+// maximum staleness, or if no price update has been made for the CurrencyPair.
+func (k *Keeper) IsPriceStale(ctx context.Context, cp connecttypes.CurrencyPair) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	cps, err := k.currencyPairs.Get(ctx, cp.String())
+	if err != nil {
+		return false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return params.Staleness().IsStale(cps.Price, sdkCtx.BlockHeight(), sdkCtx.BlockTime()), nil
+}
+
+// GetFreshPrice returns the latest QuotePrice of the given CurrencyPair. Unlike GetPriceForCurrencyPair, this method
+// returns a StalePriceError if the price has not been updated within the module's maximum staleness.
+func (k *Keeper) GetFreshPrice(ctx context.Context, cp connecttypes.CurrencyPair) (types.QuotePrice, error) {
+	qp, err := k.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return types.QuotePrice{}, err
+	}
+
+	stale, err := k.IsPriceStale(ctx, cp)
+	if err != nil {
+		return types.QuotePrice{}, err
+	}
+
+	if stale {
+		return types.QuotePrice{}, types.NewStalePriceError(cp)
+	}
+
+	return qp, nil
+}
+
+// GetStalePairs returns all CurrencyPairs whose latest price has not been updated within the module's maximum
+// staleness, including those for which no price update has been made.
+func (k *Keeper) GetStalePairs(ctx context.Context) ([]connecttypes.CurrencyPair, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	staleness := params.Staleness()
+	if !staleness.Enabled() {
+		return nil, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var stale []connecttypes.CurrencyPair
+	err = k.IterateCurrencyPairs(ctx, func(cp connecttypes.CurrencyPair, cps types.CurrencyPairState) {
+		if staleness.IsStale(cps.Price, sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			stale = append(stale, cp)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stale, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	marketmaptypes "github.com/skip-mev/connect/v2/x/marketmap/types"
+	"github.com/skip-mev/connect/v2/x/oracle/keeper"
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestStaleness() {
+	fresh := connecttypes.NewCurrencyPair("FRESH", "USD")
+	oldHeight := connecttypes.NewCurrencyPair("HEIGHT", "USD")
+	oldTime := connecttypes.NewCurrencyPair("TIME", "USD")
+	noPrice := connecttypes.NewCurrencyPair("NONE", "USD")
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(now)
+
+	for cp, qp := range map[connecttypes.CurrencyPair]types.QuotePrice{
+		fresh:     {Price: sdkmath.NewInt(1), BlockHeight: 95, BlockTimestamp: now.Add(-time.Minute)},
+		oldHeight: {Price: sdkmath.NewInt(1), BlockHeight: 89, BlockTimestamp: now.Add(-time.Minute)},
+		oldTime:   {Price: sdkmath.NewInt(1), BlockHeight: 95, BlockTimestamp: now.Add(-time.Hour)},
+	} {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, qp))
+	}
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, noPrice))
+
+	s.Run("prices are never stale by default", func() {
+		for _, cp := range []connecttypes.CurrencyPair{fresh, oldHeight, oldTime, noPrice} {
+			stale, err := s.oracleKeeper.IsPriceStale(s.ctx, cp)
+			s.Require().NoError(err)
+			s.Require().False(stale)
+		}
+
+		stalePairs, err := s.oracleKeeper.GetStalePairs(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(stalePairs)
+
+		qp, err := s.oracleKeeper.GetFreshPrice(s.ctx, oldTime)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(1), qp.Price)
+	})
+
+	s.updateParams(func(params *types.Params) { params.SetStaleness(types.NewStaleness(10, 10*time.Minute)) })
+
+	s.Run("prices exceeding either bound are stale", func() {
+		expected := map[connecttypes.CurrencyPair]bool{
+			fresh:     false,
+			oldHeight: true,
+			oldTime:   true,
+			noPrice:   true,
+		}
+
+		for cp, expectStale := range expected {
+			stale, err := s.oracleKeeper.IsPriceStale(s.ctx, cp)
+			s.Require().NoError(err)
+			s.Require().Equal(expectStale, stale, cp.String())
+		}
+
+		stalePairs, err := s.oracleKeeper.GetStalePairs(s.ctx)
+		s.Require().NoError(err)
+		s.Require().ElementsMatch([]connecttypes.CurrencyPair{oldHeight, oldTime, noPrice}, stalePairs)
+	})
+
+	s.Run("GetFreshPrice fails for stale prices", func() {
+		qp, err := s.oracleKeeper.GetFreshPrice(s.ctx, fresh)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(95), qp.BlockHeight)
+
+		_, err = s.oracleKeeper.GetFreshPrice(s.ctx, oldHeight)
+		s.Require().ErrorAs(err, &types.StalePriceError{})
+
+		_, err = s.oracleKeeper.GetFreshPrice(s.ctx, noPrice)
+		s.Require().ErrorAs(err, &types.QuotePriceNotExistError{})
+	})
+
+	s.Run("queries report stale prices", func() {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, mock.Anything).Return(marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{Decimals: 8},
+		}, nil)
+
+		qs := keeper.NewQueryServer(s.oracleKeeper)
+
+		res, err := qs.GetPrices(s.ctx, &types.GetPricesRequest{
+			CurrencyPairIds: []string{fresh.String(), oldTime.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 2)
+		s.Require().False(res.Prices[0].Stale)
+		s.Require().True(res.Prices[1].Stale)
+
+		priceRes, err := qs.GetPrice(s.ctx, &types.GetPriceRequest{CurrencyPair: oldHeight.String()})
+		s.Require().NoError(err)
+		s.Require().True(priceRes.Stale)
+
+		staleRes, err := qs.GetStalePairs(s.ctx, &types.GetStalePairsRequest{})
+		s.Require().NoError(err)
+		s.Require().ElementsMatch([]connecttypes.CurrencyPair{oldHeight, oldTime, noPrice}, staleRes.CurrencyPairs)
+	})
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// CircuitBreakerActionClamp clamps a price that exceeds the maximum change to the maximum change.
+	CircuitBreakerActionClamp = "clamp"
+	// CircuitBreakerActionSkip skips a price update that exceeds the maximum change.
+	CircuitBreakerActionSkip = "skip"
+	// CircuitBreakerActionHalt skips a price update that exceeds the maximum change, and halts the
+	// currency-pair until it is resumed by the module authority.
+	CircuitBreakerActionHalt = "halt"
+
+	// BasisPoints is the number of basis points in one.
+	BasisPoints = 10_000
+)
+
+// CircuitBreaker bounds the change of a currency-pair's price in a single block.
+type CircuitBreaker struct {
+	// MaxPriceChangeBps is the maximum change, in basis points of the previous price. If zero, the
+	// circuit breaker is disabled.
+	MaxPriceChangeBps uint64
+	// Action is the action taken when the maximum change is exceeded.
+	Action string
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker.
+func NewCircuitBreaker(maxPriceChangeBps uint64, action string) CircuitBreaker {
+	return CircuitBreaker{
+		MaxPriceChangeBps: maxPriceChangeBps,
+		Action:            action,
+	}
+}
+
+// ValidateBasic checks that the action of an enabled CircuitBreaker is valid.
+func (cb CircuitBreaker) ValidateBasic() error {
+	if !cb.Enabled() {
+		return nil
+	}
+
+	switch cb.Action {
+	case CircuitBreakerActionClamp, CircuitBreakerActionSkip, CircuitBreakerActionHalt:
+		return nil
+	default:
+		return fmt.Errorf("invalid circuit breaker action: %q", cb.Action)
+	}
+}
+
+// Enabled returns true if the CircuitBreaker bounds price changes.
+func (cb CircuitBreaker) Enabled() bool {
+	return cb.MaxPriceChangeBps > 0
+}
+
+// Check compares the given price to the previous price. It returns whether the change exceeds the maximum
+// change, along with the given price clamped to the maximum change.
+func (cb CircuitBreaker) Check(previous, price math.Int) (math.Int, bool) {
+	if !cb.Enabled() {
+		return price, false
+	}
+
+	maxChange := previous.Mul(math.NewIntFromUint64(cb.MaxPriceChangeBps)).Quo(math.NewInt(BasisPoints))
+
+	upper := previous.Add(maxChange)
+	if price.GT(upper) {
+		return upper, true
+	}
+
+	lower := previous.Sub(maxChange)
+	if price.LT(lower) {
+		return lower, true
+	}
+
+	return price, false
+}
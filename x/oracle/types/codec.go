@@ -16,6 +16,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	// register the MsgRemoveCurrencyPairs for amino serialization
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveCurrencyPairs{}, "connect/x/oracle/MsgRemoveCurrencyPairs")
+
+	// register the MsgUpdateParams for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "connect/x/oracle/MsgUpdateParams")
+
+	// register the MsgResumeCurrencyPairs for amino serialization
+	legacy.RegisterAminoMsg(cdc, &MsgResumeCurrencyPairs{}, "connect/x/oracle/MsgResumeCurrencyPairs")
 }
 
 // RegisterInterfaces registers the x/oracle messages + message service w/ the InterfaceRegistry (registry).
@@ -24,6 +30,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddCurrencyPairs{},
 		&MsgRemoveCurrencyPairs{},
+		&MsgUpdateParams{},
+		&MsgResumeCurrencyPairs{},
 	)
 
 	// register the x/oracle message-service
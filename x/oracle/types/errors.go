@@ -41,3 +41,40 @@ func NewCurrencyPairAlreadyExistsError(cp connecttypes.CurrencyPair) CurrencyPai
 func (e CurrencyPairAlreadyExistsError) Error() string {
 	return fmt.Sprintf("currency pair already exists: %s", e.cp)
 }
+
+type CurrencyPairHaltedError struct {
+	cp string
+}
+
+func NewCurrencyPairHaltedError(cp connecttypes.CurrencyPair) CurrencyPairHaltedError {
+	return CurrencyPairHaltedError{cp.String()}
+}
+
+func (e CurrencyPairHaltedError) Error() string {
+	return fmt.Sprintf("price updates are halted for CurrencyPair: %s", e.cp)
+}
+
+type CircuitBreakerTrippedError struct {
+	cp     string
+	action string
+}
+
+func NewCircuitBreakerTrippedError(cp connecttypes.CurrencyPair, action string) CircuitBreakerTrippedError {
+	return CircuitBreakerTrippedError{cp.String(), action}
+}
+
+func (e CircuitBreakerTrippedError) Error() string {
+	return fmt.Sprintf("circuit breaker tripped for CurrencyPair: %s (action: %s)", e.cp, e.action)
+}
+
+type StalePriceError struct {
+	cp string
+}
+
+func NewStalePriceError(cp connecttypes.CurrencyPair) StalePriceError {
+	return StalePriceError{cp.String()}
+}
+
+func (e StalePriceError) Error() string {
+	return fmt.Sprintf("price is stale for CurrencyPair: %s", e.cp)
+}
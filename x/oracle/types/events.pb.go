@@ -0,0 +1,1652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventPriceUpdated is emitted in PreBlock for each currency-pair whose price
+// was updated from the aggregated oracle vote extensions.
+type EventPriceUpdated struct {
+	// CurrencyPair is the currency-pair whose price was updated.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Price is the aggregated price written to state.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// Decimals is the number of decimals used for the price.
+	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Nonce is the nonce of the currency-pair after the update.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// NumVotes is the number of validator vote extensions that included a price
+	// for the currency-pair.
+	NumVotes uint64 `protobuf:"varint,5,opt,name=num_votes,json=numVotes,proto3" json:"num_votes,omitempty"`
+	// BlockHeight is the height at which the price was updated.
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventPriceUpdated) Reset()         { *m = EventPriceUpdated{} }
+func (m *EventPriceUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPriceUpdated) ProtoMessage()    {}
+func (*EventPriceUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{0}
+}
+func (m *EventPriceUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceUpdated.Merge(m, src)
+}
+func (m *EventPriceUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceUpdated proto.InternalMessageInfo
+
+func (m *EventPriceUpdated) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *EventPriceUpdated) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *EventPriceUpdated) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventPriceUpdated) GetNumVotes() uint64 {
+	if m != nil {
+		return m.NumVotes
+	}
+	return 0
+}
+
+func (m *EventPriceUpdated) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// EventPriceMissing is emitted in PreBlock for each currency-pair whose price
+// was not updated, i.e. no valid price could be aggregated from the vote
+// extensions.
+type EventPriceMissing struct {
+	// CurrencyPair is the currency-pair whose price was not updated.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// NumVotes is the number of validator vote extensions that included a price
+	// for the currency-pair.
+	NumVotes uint64 `protobuf:"varint,2,opt,name=num_votes,json=numVotes,proto3" json:"num_votes,omitempty"`
+	// Reason describes why the price was not updated.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// BlockHeight is the height at which the price was missing.
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventPriceMissing) Reset()         { *m = EventPriceMissing{} }
+func (m *EventPriceMissing) String() string { return proto.CompactTextString(m) }
+func (*EventPriceMissing) ProtoMessage()    {}
+func (*EventPriceMissing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{1}
+}
+func (m *EventPriceMissing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceMissing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceMissing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceMissing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceMissing.Merge(m, src)
+}
+func (m *EventPriceMissing) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceMissing) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceMissing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceMissing proto.InternalMessageInfo
+
+func (m *EventPriceMissing) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *EventPriceMissing) GetNumVotes() uint64 {
+	if m != nil {
+		return m.NumVotes
+	}
+	return 0
+}
+
+func (m *EventPriceMissing) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventPriceMissing) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// EventCircuitBreakerTripped is emitted when a currency-pair's aggregated price
+// moves by more than the maximum allowed change in a single block.
+type EventCircuitBreakerTripped struct {
+	// CurrencyPair is the currency-pair whose circuit breaker was tripped.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// PreviousPrice is the currency-pair's price before this block.
+	PreviousPrice cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=previous_price,json=previousPrice,proto3,customtype=cosmossdk.io/math.Int" json:"previous_price"`
+	// Price is the aggregated price that tripped the circuit breaker.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// MaxPriceChangeBps is the maximum allowed change in basis points.
+	MaxPriceChangeBps uint64 `protobuf:"varint,4,opt,name=max_price_change_bps,json=maxPriceChangeBps,proto3" json:"max_price_change_bps,omitempty"`
+	// Action is the action taken, i.e. "clamp", "skip", or "halt".
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// BlockHeight is the height at which the circuit breaker was tripped.
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EventCircuitBreakerTripped) Reset()         { *m = EventCircuitBreakerTripped{} }
+func (m *EventCircuitBreakerTripped) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerTripped) ProtoMessage()    {}
+func (*EventCircuitBreakerTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{2}
+}
+func (m *EventCircuitBreakerTripped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerTripped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCircuitBreakerTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerTripped.Merge(m, src)
+}
+func (m *EventCircuitBreakerTripped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerTripped proto.InternalMessageInfo
+
+func (m *EventCircuitBreakerTripped) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerTripped) GetMaxPriceChangeBps() uint64 {
+	if m != nil {
+		return m.MaxPriceChangeBps
+	}
+	return 0
+}
+
+func (m *EventCircuitBreakerTripped) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerTripped) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// EventCurrencyPairResumed is emitted when price updates for a halted
+// currency-pair are resumed.
+type EventCurrencyPairResumed struct {
+	// CurrencyPair is the currency-pair that was resumed.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+}
+
+func (m *EventCurrencyPairResumed) Reset()         { *m = EventCurrencyPairResumed{} }
+func (m *EventCurrencyPairResumed) String() string { return proto.CompactTextString(m) }
+func (*EventCurrencyPairResumed) ProtoMessage()    {}
+func (*EventCurrencyPairResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{3}
+}
+func (m *EventCurrencyPairResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCurrencyPairResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCurrencyPairResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCurrencyPairResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCurrencyPairResumed.Merge(m, src)
+}
+func (m *EventCurrencyPairResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCurrencyPairResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCurrencyPairResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCurrencyPairResumed proto.InternalMessageInfo
+
+func (m *EventCurrencyPairResumed) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+// EventValidatorPerformanceBelowThreshold is emitted when a validator's
+// performance over a full performance window falls below the module's minimum,
+// before the slashing hooks are called and the validator's window is reset.
+type EventValidatorPerformanceBelowThreshold struct {
+	// Validator is the bech32 consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// PerformanceBps is the validator's performance over the window, in basis
+	// points.
+	PerformanceBps uint64 `protobuf:"varint,2,opt,name=performance_bps,json=performanceBps,proto3" json:"performance_bps,omitempty"`
+	// MinPerformanceBps is the module's minimum performance, in basis points.
+	MinPerformanceBps uint64 `protobuf:"varint,3,opt,name=min_performance_bps,json=minPerformanceBps,proto3" json:"min_performance_bps,omitempty"`
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) Reset() {
+	*m = EventValidatorPerformanceBelowThreshold{}
+}
+func (m *EventValidatorPerformanceBelowThreshold) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorPerformanceBelowThreshold) ProtoMessage()    {}
+func (*EventValidatorPerformanceBelowThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad67d2ed2b325f28, []int{4}
+}
+func (m *EventValidatorPerformanceBelowThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorPerformanceBelowThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorPerformanceBelowThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorPerformanceBelowThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorPerformanceBelowThreshold.Merge(m, src)
+}
+func (m *EventValidatorPerformanceBelowThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorPerformanceBelowThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorPerformanceBelowThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorPerformanceBelowThreshold proto.InternalMessageInfo
+
+func (m *EventValidatorPerformanceBelowThreshold) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) GetPerformanceBps() uint64 {
+	if m != nil {
+		return m.PerformanceBps
+	}
+	return 0
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) GetMinPerformanceBps() uint64 {
+	if m != nil {
+		return m.MinPerformanceBps
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventPriceUpdated)(nil), "connect.oracle.v2.EventPriceUpdated")
+	proto.RegisterType((*EventPriceMissing)(nil), "connect.oracle.v2.EventPriceMissing")
+	proto.RegisterType((*EventCircuitBreakerTripped)(nil), "connect.oracle.v2.EventCircuitBreakerTripped")
+	proto.RegisterType((*EventCurrencyPairResumed)(nil), "connect.oracle.v2.EventCurrencyPairResumed")
+	proto.RegisterType((*EventValidatorPerformanceBelowThreshold)(nil), "connect.oracle.v2.EventValidatorPerformanceBelowThreshold")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/events.proto", fileDescriptor_ad67d2ed2b325f28) }
+
+var fileDescriptor_ad67d2ed2b325f28 = []byte{
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x18, 0x8d, 0x7b, 0x89, 0xfe, 0xcc, 0xdf, 0x16, 0xc5, 0x04, 0x64, 0x02, 0x72, 0x4b, 0x58, 0xb4,
+	0x12, 0x8a, 0x2d, 0x85, 0x07, 0x40, 0xa4, 0x42, 0xd0, 0x05, 0x52, 0x64, 0x95, 0x2e, 0xd8, 0x58,
+	0x93, 0xf1, 0x60, 0x8f, 0xe2, 0xb9, 0x68, 0x66, 0x6c, 0xd2, 0xb7, 0xe8, 0x23, 0xf0, 0x10, 0x3c,
+	0x02, 0x8b, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x85, 0x92, 0x35, 0xef, 0x80, 0x3c, 0xe3, 0x90, 0xa6,
+	0xdd, 0x44, 0xdd, 0xe5, 0x7c, 0xe7, 0xbb, 0x9e, 0x13, 0x0f, 0xf0, 0x11, 0x67, 0x0c, 0x23, 0x1d,
+	0x72, 0x09, 0x51, 0x8e, 0xc3, 0x72, 0x10, 0xe2, 0x12, 0x33, 0xad, 0x02, 0x21, 0xb9, 0xe6, 0x6e,
+	0xbb, 0xe6, 0x03, 0xcb, 0x07, 0xe5, 0xa0, 0xdb, 0x49, 0x79, 0xca, 0x0d, 0x1b, 0x56, 0xbf, 0x6c,
+	0x62, 0xf7, 0x09, 0xe2, 0x8a, 0x72, 0x15, 0x5b, 0xc2, 0x02, 0x4b, 0xf5, 0xfe, 0x38, 0xa0, 0xfd,
+	0xb6, 0x6a, 0x3a, 0x92, 0x04, 0xe1, 0x8f, 0x22, 0x81, 0x1a, 0x27, 0xee, 0x0b, 0xb0, 0x8b, 0x0a,
+	0x29, 0x31, 0x43, 0xe7, 0xb1, 0x80, 0x44, 0x7a, 0xce, 0x81, 0x73, 0xd4, 0x8a, 0x76, 0x16, 0xc1,
+	0x11, 0x24, 0xd2, 0x7d, 0x03, 0xb6, 0x45, 0x55, 0xe4, 0x6d, 0x54, 0xe4, 0xf0, 0xe5, 0xe5, 0xf5,
+	0x7e, 0xe3, 0xd7, 0xf5, 0xfe, 0x23, 0xdb, 0x5f, 0x25, 0x93, 0x80, 0xf0, 0x90, 0x42, 0x9d, 0x05,
+	0x27, 0x4c, 0xff, 0xf8, 0xd6, 0x07, 0xf5, 0xe0, 0x13, 0xa6, 0x23, 0x5b, 0xe9, 0x76, 0xc1, 0x7f,
+	0x09, 0x46, 0x84, 0xc2, 0x5c, 0x79, 0x9b, 0x07, 0xce, 0xd1, 0x56, 0xf4, 0x0f, 0xbb, 0x1d, 0xb0,
+	0xcd, 0x38, 0x43, 0xd8, 0xdb, 0x32, 0x84, 0x05, 0xee, 0x53, 0xd0, 0x62, 0x05, 0x8d, 0x4b, 0xae,
+	0xb1, 0xf2, 0xb6, 0x6d, 0x09, 0x2b, 0xe8, 0x59, 0x85, 0xdd, 0xe7, 0x60, 0x67, 0x9c, 0x73, 0x34,
+	0x89, 0x33, 0x4c, 0xd2, 0x4c, 0x7b, 0x4d, 0xc3, 0xff, 0x6f, 0x62, 0xef, 0x4d, 0xa8, 0x77, 0xb1,
+	0x72, 0xef, 0x07, 0xa2, 0x14, 0x61, 0xe9, 0x7a, 0xf7, 0xae, 0x8c, 0xde, 0xb8, 0x35, 0xfa, 0x31,
+	0x68, 0x4a, 0x0c, 0x15, 0x67, 0xe6, 0x8e, 0x56, 0x54, 0xa3, 0x3b, 0x2b, 0x6d, 0xdd, 0x5d, 0xe9,
+	0xfb, 0x06, 0xe8, 0x9a, 0x95, 0x8e, 0x89, 0x44, 0x05, 0xd1, 0x43, 0x89, 0xe1, 0x04, 0xcb, 0x53,
+	0x49, 0x84, 0x58, 0xd7, 0x8b, 0x08, 0xec, 0x09, 0x89, 0x4b, 0xc2, 0x0b, 0x15, 0xdf, 0xdb, 0x94,
+	0xdd, 0x45, 0x0b, 0xa3, 0xcd, 0xd2, 0xdf, 0xcd, 0x7b, 0xfb, 0x1b, 0x82, 0x0e, 0x85, 0x53, 0xbb,
+	0x51, 0x8c, 0x32, 0xc8, 0x52, 0x1c, 0x8f, 0x85, 0xaa, 0x55, 0x68, 0x53, 0x38, 0x35, 0xa3, 0x8e,
+	0x0d, 0x33, 0x14, 0x46, 0x46, 0x88, 0x34, 0xe1, 0xcc, 0x78, 0xdb, 0x8a, 0x6a, 0xb4, 0x8e, 0xb3,
+	0xaf, 0x81, 0x67, 0x55, 0xbc, 0xa1, 0x4b, 0x84, 0x55, 0x41, 0xd7, 0xd4, 0xb0, 0xf7, 0xd5, 0x01,
+	0x87, 0xa6, 0xc3, 0x19, 0xcc, 0x49, 0x02, 0x35, 0x97, 0x23, 0x2c, 0x3f, 0x73, 0x49, 0x21, 0x43,
+	0x78, 0x88, 0x73, 0xfe, 0xe5, 0x34, 0x93, 0x58, 0x65, 0x3c, 0x4f, 0xdc, 0x67, 0xa0, 0x55, 0x2e,
+	0xb2, 0xea, 0x66, 0xcb, 0x80, 0x7b, 0x08, 0x1e, 0x88, 0x65, 0xa9, 0xb9, 0xd8, 0xfe, 0x5f, 0xf6,
+	0x6e, 0x84, 0xab, 0x73, 0x03, 0xf0, 0x90, 0x12, 0x16, 0xdf, 0x4e, 0xde, 0xac, 0xe5, 0x21, 0x6c,
+	0xb4, 0x92, 0x3f, 0x7c, 0x77, 0x39, 0xf3, 0x9d, 0xab, 0x99, 0xef, 0xfc, 0x9e, 0xf9, 0xce, 0xc5,
+	0xdc, 0x6f, 0x5c, 0xcd, 0xfd, 0xc6, 0xcf, 0xb9, 0xdf, 0xf8, 0xd4, 0x4f, 0x89, 0xce, 0x8a, 0x71,
+	0x80, 0x38, 0x0d, 0xd5, 0x84, 0x88, 0x3e, 0xc5, 0x65, 0xb8, 0x78, 0x3f, 0xca, 0x41, 0x38, 0x5d,
+	0x3c, 0x22, 0xfa, 0x5c, 0x60, 0x35, 0x6e, 0x9a, 0xaf, 0xff, 0xd5, 0xdf, 0x01, 0x00, 0x2c, 0x41,
+	0xe6, 0x97, 0x63, 0x04, 0x00, 0x00,
+}
+
+func (m *EventPriceUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumVotes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumVotes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPriceMissing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceMissing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceMissing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NumVotes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCircuitBreakerTripped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerTripped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerTripped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxPriceChangeBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxPriceChangeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreviousPrice.Size()
+		i -= size
+		if _, err := m.PreviousPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCurrencyPairResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCurrencyPairResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCurrencyPairResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPerformanceBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinPerformanceBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerformanceBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PerformanceBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPriceUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovEvents(uint64(m.Decimals))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if m.NumVotes != 0 {
+		n += 1 + sovEvents(uint64(m.NumVotes))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventPriceMissing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NumVotes != 0 {
+		n += 1 + sovEvents(uint64(m.NumVotes))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCircuitBreakerTripped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MaxPriceChangeBps != 0 {
+		n += 1 + sovEvents(uint64(m.MaxPriceChangeBps))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *EventCurrencyPairResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValidatorPerformanceBelowThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PerformanceBps != 0 {
+		n += 1 + sovEvents(uint64(m.PerformanceBps))
+	}
+	if m.MinPerformanceBps != 0 {
+		n += 1 + sovEvents(uint64(m.MinPerformanceBps))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPriceUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVotes", wireType)
+			}
+			m.NumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPriceMissing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceMissing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceMissing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVotes", wireType)
+			}
+			m.NumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCircuitBreakerTripped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCircuitBreakerTripped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCircuitBreakerTripped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeBps", wireType)
+			}
+			m.MaxPriceChangeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceChangeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCurrencyPairResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCurrencyPairResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCurrencyPairResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValidatorPerformanceBelowThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorPerformanceBelowThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorPerformanceBelowThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceBps", wireType)
+			}
+			m.PerformanceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPerformanceBps", wireType)
+			}
+			m.MinPerformanceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPerformanceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ValidateBasic validates that the CurrencyPair is valid, and performs any necessary validation on the
@@ -21,6 +22,17 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 		return fmt.Errorf("invalid nonce, no price update but non-zero nonce: %v", cpg.Nonce)
 	}
 
+	// the price history must be ordered by nonce, and cannot contain prices after the latest update.
+	for i, entry := range cpg.PriceHistory {
+		if entry.Nonce > cpg.Nonce {
+			return fmt.Errorf("invalid price history, nonce %v exceeds the nonce of the currency-pair: %v", entry.Nonce, cpg.Nonce)
+		}
+
+		if i > 0 && entry.Nonce <= cpg.PriceHistory[i-1].Nonce {
+			return fmt.Errorf("invalid price history, nonces must be strictly increasing: %v", entry.Nonce)
+		}
+	}
+
 	return nil
 }
 
@@ -29,6 +41,7 @@ func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	return &GenesisState{
 		CurrencyPairGenesis: cpgs,
 		NextId:              nextID,
+		Params:              DefaultParams(),
 	}
 }
 
@@ -38,8 +51,13 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // Validate validates the currency-pair geneses that the Genesis-State is composed of
-// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated.
+// valid CurrencyPairGenesis, that no ID for a currency-pair is repeated, that the validator reports are valid, and that
+// the params are valid.
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
 	ids := make(map[uint64]struct{})
 	cps := make(map[string]struct{})
 	for _, cpg := range gs.CurrencyPairGenesis {
@@ -53,6 +71,13 @@ func (gs *GenesisState) Validate() error {
 			return fmt.Errorf("invalid id: %v, must be less than next id: %v", cpg.Id, gs.NextId)
 		}
 
+		// check that the price history does not exceed the retained length
+		if uint64(len(cpg.PriceHistory)) > gs.Params.MaxPriceHistory {
+			return fmt.Errorf(
+				"price history of %v exceeds max price history: %v", cpg.CurrencyPair.String(), gs.Params.MaxPriceHistory,
+			)
+		}
+
 		// check for a repeated ID
 		if _, ok := ids[cpg.Id]; ok {
 			return fmt.Errorf("repeated id: %v", cpg.Id)
@@ -70,6 +95,40 @@ func (gs *GenesisState) Validate() error {
 		cps[cpg.CurrencyPair.String()] = struct{}{}
 	}
 
+	return gs.validateValidatorReports()
+}
+
+// validateValidatorReports checks that the validator reports are only set if performance is tracked, that each
+// validator has a valid address, and that no validator has more than one report per height, or more reports than the
+// performance window.
+func (gs *GenesisState) validateValidatorReports() error {
+	tracking := gs.Params.PerformanceTracking()
+	if !tracking.Enabled() && len(gs.ValidatorReports) > 0 {
+		return fmt.Errorf("validator reports set, but performance is not tracked")
+	}
+
+	reports := make(map[string]map[uint64]struct{})
+	for _, vr := range gs.ValidatorReports {
+		if _, err := sdk.ConsAddressFromBech32(vr.Validator); err != nil {
+			return fmt.Errorf("invalid validator address %v: %w", vr.Validator, err)
+		}
+
+		heights, ok := reports[vr.Validator]
+		if !ok {
+			heights = make(map[uint64]struct{})
+			reports[vr.Validator] = heights
+		}
+
+		if _, ok := heights[vr.Height]; ok {
+			return fmt.Errorf("repeated report for validator %v at height %v", vr.Validator, vr.Height)
+		}
+		heights[vr.Height] = struct{}{}
+
+		if uint64(len(heights)) > tracking.Window {
+			return fmt.Errorf("reports of validator %v exceed performance window: %v", vr.Validator, tracking.Window)
+		}
+	}
+
 	return nil
 }
 
@@ -40,6 +40,12 @@ type QuotePrice struct {
 	BlockTimestamp time.Time `protobuf:"bytes,2,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
 	// BlockHeight is height of block mentioned above
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Dispersion is the stake-weighted median of the dispersions reported by
+	// validators alongside their prices, i.e. the median absolute deviation of
+	// the provider prices each validator's oracle aggregated. It is reported with
+	// the same decimals as the price, and is null if validators did not report a
+	// dispersion for the update.
+	Dispersion *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=dispersion,proto3,customtype=cosmossdk.io/math.Int" json:"dispersion,omitempty"`
 }
 
 func (m *QuotePrice) Reset()         { *m = QuotePrice{} }
@@ -99,6 +105,13 @@ type CurrencyPairState struct {
 	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// ID is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Halted is whether price updates for the currency-pair have been halted by
+	// the circuit breaker
+	Halted bool `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+	// Resumed is whether the currency-pair was resumed by the module authority
+	// since its last price update, in which case the next price update is not
+	// bounded by the circuit breaker
+	Resumed bool `protobuf:"varint,5,opt,name=resumed,proto3" json:"resumed,omitempty"`
 }
 
 func (m *CurrencyPairState) Reset()         { *m = CurrencyPairState{} }
@@ -155,6 +168,20 @@ func (m *CurrencyPairState) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairState) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *CurrencyPairState) GetResumed() bool {
+	if m != nil {
+		return m.Resumed
+	}
+	return false
+}
+
 // CurrencyPairGenesis is the information necessary for initialization of a
 // CurrencyPair.
 type CurrencyPairGenesis struct {
@@ -168,6 +195,15 @@ type CurrencyPairGenesis struct {
 	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// id is the ID of the CurrencyPair
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// halted is whether price updates for the CP have been halted by the circuit
+	// breaker
+	Halted bool `protobuf:"varint,5,opt,name=halted,proto3" json:"halted,omitempty"`
+	// price_history is the retained price history of the CP, ordered from
+	// oldest to newest
+	PriceHistory []PriceHistoryEntry `protobuf:"bytes,6,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
+	// resumed is whether the CP was resumed by the module authority since its
+	// last price update
+	Resumed bool `protobuf:"varint,7,opt,name=resumed,proto3" json:"resumed,omitempty"`
 }
 
 func (m *CurrencyPairGenesis) Reset()         { *m = CurrencyPairGenesis{} }
@@ -231,6 +267,147 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+func (m *CurrencyPairGenesis) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *CurrencyPairGenesis) GetPriceHistory() []PriceHistoryEntry {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *CurrencyPairGenesis) GetResumed() bool {
+	if m != nil {
+		return m.Resumed
+	}
+	return false
+}
+
+// PriceHistoryEntry is a single historical QuotePrice of a CurrencyPair.
+type PriceHistoryEntry struct {
+	// QuotePrice is the historical quote-price.
+	Price QuotePrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// nonce is the nonce of the CurrencyPair at the time of the update.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *PriceHistoryEntry) Reset()         { *m = PriceHistoryEntry{} }
+func (m *PriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryEntry) ProtoMessage()    {}
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{3}
+}
+func (m *PriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryEntry.Merge(m, src)
+}
+func (m *PriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryEntry proto.InternalMessageInfo
+
+func (m *PriceHistoryEntry) GetPrice() QuotePrice {
+	if m != nil {
+		return m.Price
+	}
+	return QuotePrice{}
+}
+
+func (m *PriceHistoryEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// ValidatorReportGenesis is the block report of a validator at a height within
+// the module's performance window.
+type ValidatorReportGenesis struct {
+	// validator is the bech32 consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// height is the height of the block the report was recorded for.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// report summarizes the prices reported by the validator in the block.
+	Report ValidatorBlockReport `protobuf:"bytes,3,opt,name=report,proto3" json:"report"`
+}
+
+func (m *ValidatorReportGenesis) Reset()         { *m = ValidatorReportGenesis{} }
+func (m *ValidatorReportGenesis) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReportGenesis) ProtoMessage()    {}
+func (*ValidatorReportGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a688f927817fa7da, []int{4}
+}
+func (m *ValidatorReportGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReportGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReportGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReportGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReportGenesis.Merge(m, src)
+}
+func (m *ValidatorReportGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReportGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReportGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReportGenesis proto.InternalMessageInfo
+
+func (m *ValidatorReportGenesis) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorReportGenesis) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorReportGenesis) GetReport() ValidatorBlockReport {
+	if m != nil {
+		return m.Report
+	}
+	return ValidatorBlockReport{}
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -240,13 +417,19 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// Params are the parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ValidatorReports are the block reports of each validator within the
+	// performance window. The performance of each validator is derived from
+	// them.
+	ValidatorReports []ValidatorReportGenesis `protobuf:"bytes,4,rep,name=validator_reports,json=validatorReports,proto3" json:"validator_reports"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a688f927817fa7da, []int{3}
+	return fileDescriptor_a688f927817fa7da, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,49 +472,79 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetValidatorReports() []ValidatorReportGenesis {
+	if m != nil {
+		return m.ValidatorReports
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "connect.oracle.v2.QuotePrice")
 	proto.RegisterType((*CurrencyPairState)(nil), "connect.oracle.v2.CurrencyPairState")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "connect.oracle.v2.CurrencyPairGenesis")
+	proto.RegisterType((*PriceHistoryEntry)(nil), "connect.oracle.v2.PriceHistoryEntry")
+	proto.RegisterType((*ValidatorReportGenesis)(nil), "connect.oracle.v2.ValidatorReportGenesis")
 	proto.RegisterType((*GenesisState)(nil), "connect.oracle.v2.GenesisState")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/genesis.proto", fileDescriptor_a688f927817fa7da) }
 
 var fileDescriptor_a688f927817fa7da = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0xc0, 0xae, 0x3a, 0xe0, 0x1a, 0xca, 0x6e, 0x44, 0x12, 0x5b, 0x24, 0xc6, 0x90,
-	0x18, 0x66, 0x92, 0x7a, 0xf2, 0x28, 0x1e, 0x56, 0x0e, 0x26, 0xd8, 0xf5, 0xe4, 0xa5, 0x96, 0xe9,
-	0x58, 0x26, 0xd0, 0x4e, 0xd3, 0x0e, 0x64, 0xf7, 0x23, 0x78, 0xdb, 0x0f, 0xe3, 0x17, 0xf0, 0xc6,
-	0x71, 0xe3, 0xc9, 0x68, 0x82, 0x06, 0xbe, 0x88, 0xe9, 0xcc, 0x14, 0x4b, 0x96, 0xc3, 0xde, 0xfa,
-	0xce, 0xbc, 0x7f, 0x9e, 0xdf, 0x33, 0x6f, 0xa1, 0x4d, 0x78, 0x1c, 0x53, 0x22, 0x30, 0x4f, 0x7d,
-	0x32, 0xa7, 0x78, 0xe9, 0xe0, 0x90, 0xc6, 0x34, 0x63, 0x19, 0x4a, 0x52, 0x2e, 0xb8, 0xd9, 0xd4,
-	0x09, 0x48, 0x25, 0xa0, 0xa5, 0xd3, 0x39, 0x0d, 0x79, 0xc8, 0xe5, 0x2d, 0xce, 0xbf, 0x54, 0x62,
-	0xc7, 0x0e, 0x39, 0x0f, 0xe7, 0x14, 0xcb, 0x68, 0xb2, 0xf8, 0x82, 0x05, 0x8b, 0x68, 0x26, 0xfc,
-	0x28, 0xd1, 0x09, 0x4f, 0x08, 0xcf, 0x22, 0x9e, 0x79, 0xaa, 0x52, 0x05, 0xfa, 0xea, 0x79, 0xa1,
-	0x42, 0x5c, 0x25, 0x34, 0xcb, 0x45, 0x90, 0x45, 0x9a, 0xd2, 0x98, 0x5c, 0x79, 0x89, 0xcf, 0x52,
-	0x95, 0xd5, 0xfb, 0x0e, 0x20, 0xfc, 0xb0, 0xe0, 0x82, 0x8e, 0x53, 0x46, 0xa8, 0xf9, 0x06, 0x1e,
-	0x25, 0xf9, 0x47, 0x1b, 0x74, 0x41, 0xff, 0xc1, 0xf0, 0xe5, 0x6a, 0x6d, 0x1b, 0xbf, 0xd6, 0xf6,
-	0x99, 0xea, 0x9c, 0x05, 0x33, 0xc4, 0x38, 0x8e, 0x7c, 0x31, 0x45, 0xa3, 0x58, 0xfc, 0xf8, 0x36,
-	0x80, 0x7a, 0xe4, 0x28, 0x16, 0xae, 0xaa, 0x34, 0xdf, 0xc3, 0x47, 0x93, 0x39, 0x27, 0x33, 0x6f,
-	0xa7, 0xb5, 0x5d, 0xe9, 0x82, 0x7e, 0xdd, 0xe9, 0x20, 0x45, 0x83, 0x0a, 0x1a, 0xf4, 0xb1, 0xc8,
-	0x18, 0xde, 0xcf, 0x07, 0x5d, 0xff, 0xb1, 0x81, 0x7b, 0x22, 0x8b, 0x77, 0x37, 0xe6, 0x33, 0xd8,
-	0x50, 0xed, 0xa6, 0x94, 0x85, 0x53, 0xd1, 0xae, 0x76, 0x41, 0xbf, 0xe6, 0xd6, 0xe5, 0xd9, 0x3b,
-	0x79, 0xd4, 0x13, 0xb0, 0xf9, 0x56, 0xa3, 0x8d, 0x7d, 0x96, 0x5e, 0x08, 0x5f, 0x50, 0xf3, 0x75,
-	0x99, 0xa4, 0xee, 0x3c, 0x45, 0xb7, 0x3c, 0x47, 0xff, 0xb9, 0x87, 0xb5, 0xd5, 0xda, 0x06, 0x05,
-	0xc1, 0x29, 0x3c, 0x8a, 0x79, 0x4c, 0xa8, 0xd4, 0x5d, 0x73, 0x55, 0x60, 0x9e, 0xc0, 0x0a, 0x0b,
-	0xf4, 0xf8, 0x0a, 0x0b, 0x7a, 0xbf, 0x01, 0x6c, 0x95, 0xc7, 0x9e, 0xab, 0x27, 0x36, 0x47, 0xf0,
-	0xe1, 0x9e, 0xd1, 0x5a, 0x80, 0xb5, 0x13, 0x20, 0xdf, 0x23, 0x9f, 0x5f, 0xae, 0x96, 0x0a, 0x0c,
-	0xb7, 0x41, 0x4a, 0x67, 0xe6, 0x05, 0x6c, 0xed, 0xb5, 0xf2, 0x14, 0x51, 0xe5, 0xee, 0x44, 0xcd,
-	0x72, 0xbf, 0xf1, 0x3e, 0x5d, 0xf5, 0x36, 0x5d, 0x6d, 0x47, 0xf7, 0x15, 0xc0, 0x86, 0x26, 0x52,
-	0x7e, 0x7e, 0x86, 0x67, 0xfb, 0x5a, 0xf4, 0x4a, 0xb7, 0x41, 0xb7, 0xda, 0xaf, 0x3b, 0x2f, 0x0e,
-	0xa8, 0x39, 0xe0, 0x8e, 0xc6, 0x6c, 0x91, 0x03, 0xc6, 0x3d, 0x86, 0xf7, 0x62, 0x7a, 0x29, 0x3c,
-	0x16, 0x68, 0xe3, 0x8f, 0xf3, 0x70, 0x14, 0x0c, 0xcf, 0x57, 0x1b, 0x0b, 0xdc, 0x6c, 0x2c, 0xf0,
-	0x77, 0x63, 0x81, 0xeb, 0xad, 0x65, 0xdc, 0x6c, 0x2d, 0xe3, 0xe7, 0xd6, 0x32, 0x3e, 0x0d, 0x42,
-	0x26, 0xa6, 0x8b, 0x09, 0x22, 0x3c, 0xc2, 0xd9, 0x8c, 0x25, 0x83, 0x88, 0x2e, 0x71, 0xb1, 0xf7,
-	0x4b, 0x07, 0x5f, 0x16, 0xbf, 0xa0, 0xf4, 0x7c, 0x72, 0x2c, 0x37, 0xef, 0xd5, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0xbb, 0xf6, 0x48, 0xa1, 0x03, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0xd3, 0x24, 0x6d, 0x37, 0x6d, 0x7f, 0xbf, 0x6c, 0xff, 0xe0, 0x56, 0xe0, 0x84, 0x50,
+	0x41, 0x10, 0xaa, 0x2d, 0x85, 0x03, 0xe2, 0x48, 0x50, 0xd5, 0x46, 0x08, 0x51, 0x5c, 0xc4, 0x01,
+	0x21, 0x05, 0xc7, 0xde, 0x3a, 0xab, 0xc6, 0x5e, 0x6b, 0x77, 0x13, 0x35, 0x2f, 0x81, 0x7a, 0xe1,
+	0xce, 0x89, 0x27, 0xe0, 0x21, 0x7a, 0xac, 0x38, 0x21, 0x0e, 0x05, 0xb5, 0x2f, 0x82, 0xbc, 0xbb,
+	0x4e, 0x6d, 0xc5, 0xaa, 0x2a, 0x6e, 0x3b, 0x3b, 0xdf, 0xcc, 0x7c, 0xf3, 0xed, 0xec, 0x80, 0xba,
+	0x4b, 0xc2, 0x10, 0xb9, 0xdc, 0x22, 0xd4, 0x71, 0x87, 0xc8, 0x1a, 0xb7, 0x2d, 0x1f, 0x85, 0x88,
+	0x61, 0x66, 0x46, 0x94, 0x70, 0x02, 0x6b, 0x0a, 0x60, 0x4a, 0x80, 0x39, 0x6e, 0x6f, 0xad, 0xf9,
+	0xc4, 0x27, 0xc2, 0x6b, 0xc5, 0x27, 0x09, 0xdc, 0xaa, 0xfb, 0x84, 0xf8, 0x43, 0x64, 0x09, 0xab,
+	0x3f, 0x3a, 0xb2, 0x38, 0x0e, 0x10, 0xe3, 0x4e, 0x10, 0x29, 0xc0, 0xa6, 0x4b, 0x58, 0x40, 0x58,
+	0x4f, 0x46, 0x4a, 0x43, 0xb9, 0xb6, 0x13, 0x16, 0x7c, 0x12, 0x21, 0x16, 0x93, 0x70, 0x47, 0x94,
+	0xa2, 0xd0, 0x9d, 0xf4, 0x22, 0x07, 0x53, 0x85, 0x32, 0x66, 0xb9, 0x46, 0x0e, 0x75, 0x82, 0x24,
+	0xcb, 0x83, 0x1c, 0x3f, 0xa2, 0x47, 0x84, 0x06, 0x4e, 0xe8, 0x22, 0x09, 0x6a, 0x7e, 0x2e, 0x02,
+	0xf0, 0x76, 0x44, 0x38, 0x3a, 0xa0, 0xd8, 0x45, 0xf0, 0x05, 0x28, 0x47, 0xf1, 0x41, 0xd7, 0x1a,
+	0x5a, 0x6b, 0xb1, 0xf3, 0xe4, 0xec, 0xa2, 0x5e, 0xf8, 0x75, 0x51, 0x5f, 0x97, 0xf4, 0x98, 0x77,
+	0x6c, 0x62, 0x62, 0x05, 0x0e, 0x1f, 0x98, 0xdd, 0x90, 0xff, 0xf8, 0xbe, 0x03, 0x14, 0xef, 0x6e,
+	0xc8, 0x6d, 0x19, 0x09, 0x5f, 0x83, 0xff, 0xfa, 0x43, 0xe2, 0x1e, 0xf7, 0xa6, 0x0d, 0xeb, 0xc5,
+	0x86, 0xd6, 0xaa, 0xb6, 0xb7, 0x4c, 0x29, 0x89, 0x99, 0x48, 0x62, 0xbe, 0x4b, 0x10, 0x9d, 0x85,
+	0xb8, 0xd0, 0xe9, 0xef, 0xba, 0x66, 0xaf, 0x88, 0xe0, 0xa9, 0x07, 0xde, 0x07, 0x4b, 0x32, 0xdd,
+	0x00, 0x61, 0x7f, 0xc0, 0xf5, 0xb9, 0x86, 0xd6, 0x2a, 0xd9, 0x55, 0x71, 0xb7, 0x2f, 0xae, 0xe0,
+	0x2b, 0x00, 0x3c, 0xcc, 0x22, 0x44, 0x19, 0x26, 0xa1, 0x5e, 0x9a, 0x32, 0xd7, 0x6e, 0xcb, 0x3c,
+	0x15, 0xde, 0xfc, 0xa6, 0x81, 0xda, 0x4b, 0xa5, 0xf6, 0x81, 0x83, 0xe9, 0x21, 0x77, 0x38, 0x82,
+	0xcf, 0xd3, 0xba, 0x54, 0xdb, 0xf7, 0xcc, 0x99, 0x31, 0x30, 0xaf, 0x55, 0xec, 0x94, 0xe2, 0xe2,
+	0x89, 0x1e, 0x6b, 0xa0, 0x1c, 0x92, 0xd0, 0x45, 0x42, 0x85, 0x92, 0x2d, 0x0d, 0xb8, 0x02, 0x8a,
+	0xd8, 0x53, 0xcd, 0x14, 0xb1, 0x07, 0x37, 0x40, 0x65, 0xe0, 0x0c, 0x39, 0xf2, 0x04, 0xff, 0x05,
+	0x5b, 0x59, 0x50, 0x07, 0xf3, 0x14, 0xb1, 0x51, 0x80, 0x3c, 0xbd, 0x2c, 0x1c, 0x89, 0xd9, 0xbc,
+	0x28, 0x82, 0xd5, 0x34, 0xd1, 0x3d, 0x39, 0xa7, 0xb0, 0x0b, 0x96, 0x33, 0xd3, 0xa2, 0x28, 0x1b,
+	0x53, 0xca, 0x62, 0xa8, 0x62, 0xc6, 0xe9, 0x68, 0xc1, 0xb9, 0x60, 0x2f, 0xb9, 0xa9, 0x3b, 0x78,
+	0x08, 0x56, 0x33, 0xa9, 0x7a, 0x52, 0x83, 0xe2, 0xed, 0x35, 0xa8, 0xa5, 0xf3, 0x1d, 0x64, 0xf5,
+	0x98, 0x9b, 0xd5, 0xa3, 0x94, 0xa3, 0x47, 0x39, 0xa3, 0xc7, 0x1b, 0xb0, 0x2c, 0x48, 0xf4, 0x06,
+	0x98, 0x71, 0x42, 0x27, 0x7a, 0xa5, 0x31, 0xd7, 0xaa, 0xb6, 0xb7, 0x73, 0xc8, 0x88, 0x72, 0xfb,
+	0x12, 0xb6, 0x1b, 0x72, 0x3a, 0x49, 0x7a, 0x8c, 0x52, 0x8e, 0xb4, 0xc0, 0xf3, 0x59, 0x81, 0x3d,
+	0x50, 0x9b, 0x49, 0xf1, 0x0f, 0x83, 0x50, 0xb8, 0x71, 0x10, 0x9a, 0x5f, 0x34, 0xb0, 0xf1, 0xde,
+	0x19, 0x62, 0xcf, 0xe1, 0x84, 0xda, 0x28, 0x22, 0x94, 0x27, 0x2f, 0x79, 0x17, 0x2c, 0x8e, 0x13,
+	0x8f, 0xfc, 0x90, 0xf6, 0xf5, 0x85, 0x50, 0x48, 0x7e, 0x09, 0x99, 0x4f, 0x59, 0x70, 0x17, 0x54,
+	0xa8, 0x48, 0x23, 0x04, 0xae, 0xb6, 0x1f, 0xe5, 0x50, 0x9c, 0x16, 0xec, 0xc4, 0xdf, 0x48, 0x56,
+	0x55, 0x64, 0x55, 0x70, 0xf3, 0x6b, 0x11, 0x2c, 0x29, 0x22, 0xf2, 0x0b, 0x7c, 0x02, 0xeb, 0xd9,
+	0x61, 0x50, 0x8b, 0x51, 0xd7, 0xc4, 0x0b, 0x3c, 0xcc, 0x29, 0x93, 0x33, 0x9e, 0xaa, 0xca, 0xaa,
+	0x3b, 0xeb, 0x82, 0x77, 0xc0, 0x7c, 0x88, 0x4e, 0x78, 0x0f, 0x7b, 0x49, 0x4b, 0xb1, 0xd9, 0xf5,
+	0xe0, 0x33, 0x50, 0x91, 0x9b, 0x4d, 0xb5, 0xb4, 0x99, 0xf7, 0xda, 0x02, 0x90, 0x34, 0x21, 0xe1,
+	0xf0, 0x23, 0xa8, 0x4d, 0x05, 0xeb, 0xc9, 0xc6, 0x98, 0x5e, 0x12, 0x7c, 0x1f, 0xdf, 0x24, 0x4b,
+	0xe6, 0x1d, 0x54, 0xce, 0xff, 0xc7, 0x59, 0x2f, 0xeb, 0xec, 0x9d, 0x5d, 0x1a, 0xda, 0xf9, 0xa5,
+	0xa1, 0xfd, 0xb9, 0x34, 0xb4, 0xd3, 0x2b, 0xa3, 0x70, 0x7e, 0x65, 0x14, 0x7e, 0x5e, 0x19, 0x85,
+	0x0f, 0x3b, 0x3e, 0xe6, 0x83, 0x51, 0xdf, 0x74, 0x49, 0x60, 0xb1, 0x63, 0x1c, 0xed, 0x04, 0x68,
+	0x6c, 0x25, 0xeb, 0x78, 0xdc, 0xb6, 0x4e, 0x92, 0x9d, 0x2c, 0xfe, 0x62, 0xbf, 0x22, 0x36, 0xe2,
+	0xd3, 0xbf, 0x03, 0x00, 0x5a, 0x4c, 0x6e, 0x4f, 0x7e, 0x06, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -354,6 +567,18 @@ func (m *QuotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dispersion != nil {
+		{
+			size := m.Dispersion.Size()
+			i -= size
+			if _, err := m.Dispersion.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -400,6 +625,26 @@ func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Resumed {
+		i--
+		if m.Resumed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -445,6 +690,40 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Resumed {
+		i--
+		if m.Resumed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Id != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
 		i--
@@ -480,6 +759,89 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReportGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReportGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReportGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -500,6 +862,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorReports) > 0 {
+		for iNdEx := len(m.ValidatorReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.NextId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
 		i--
@@ -546,6 +932,10 @@ func (m *QuotePrice) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
+	if m.Dispersion != nil {
+		l = m.Dispersion.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -565,6 +955,12 @@ func (m *CurrencyPairState) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
+	if m.Halted {
+		n += 2
+	}
+	if m.Resumed {
+		n += 2
+	}
 	return n
 }
 
@@ -586,6 +982,50 @@ func (m *CurrencyPairGenesis) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovGenesis(uint64(m.Id))
 	}
+	if m.Halted {
+		n += 2
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Resumed {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ValidatorReportGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Report.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -604,7 +1044,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextId))
 	}
-	return n
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorReports) > 0 {
+		for _, e := range m.ValidatorReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
 }
 
 func sovGenesis(x uint64) (n int) {
@@ -728,6 +1176,42 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Dispersion = &v
+			if err := m.Dispersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -852,6 +1336,46 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resumed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resumed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1009,6 +1533,316 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, PriceHistoryEntry{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resumed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resumed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorReportGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReportGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReportGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1112,6 +1946,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorReports = append(m.ValidatorReports, ValidatorReportGenesis{})
+			if err := m.ValidatorReports[len(m.ValidatorReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
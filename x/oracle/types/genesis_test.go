@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
@@ -129,3 +131,88 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisParamsValidation(t *testing.T) {
+	gs := types.DefaultGenesisState()
+	require.NoError(t, gs.Validate())
+
+	gs.Params = paramsWith(func(params *types.Params) {
+		params.MaxPriceHistory = types.MaxPriceHistoryLimit
+	})
+	require.NoError(t, gs.Validate())
+
+	gs.Params = paramsWith(func(params *types.Params) {
+		params.MaxPriceHistory = types.MaxPriceHistoryLimit + 1
+	})
+	require.Error(t, gs.Validate())
+}
+
+func TestGenesisPriceHistoryValidation(t *testing.T) {
+	cpg := types.CurrencyPairGenesis{
+		CurrencyPair:      connecttypes.NewCurrencyPair("AA", "BB"),
+		CurrencyPairPrice: &types.QuotePrice{Price: sdkmath.NewInt(100)},
+		Nonce:             3,
+		PriceHistory: []types.PriceHistoryEntry{
+			{Price: types.QuotePrice{Price: sdkmath.NewInt(50)}, Nonce: 2},
+			{Price: types.QuotePrice{Price: sdkmath.NewInt(100)}, Nonce: 3},
+		},
+	}
+
+	gs := types.NewGenesisState([]types.CurrencyPairGenesis{cpg}, 1)
+	gs.Params.MaxPriceHistory = 2
+	require.NoError(t, gs.Validate())
+
+	t.Run("history exceeds the max price history", func(t *testing.T) {
+		gs.Params.MaxPriceHistory = 1
+		defer func() { gs.Params.MaxPriceHistory = 2 }()
+		require.Error(t, gs.Validate())
+	})
+
+	t.Run("history is not ordered by nonce", func(t *testing.T) {
+		invalid := cpg
+		invalid.PriceHistory = []types.PriceHistoryEntry{cpg.PriceHistory[1], cpg.PriceHistory[0]}
+		require.Error(t, invalid.ValidateBasic())
+	})
+
+	t.Run("history has a nonce after the latest update", func(t *testing.T) {
+		invalid := cpg
+		invalid.Nonce = 2
+		require.Error(t, invalid.ValidateBasic())
+	})
+}
+
+func TestGenesisValidatorReportsValidation(t *testing.T) {
+	validator := sdk.ConsAddress("validator").String()
+	report := types.ValidatorBlockReport{NumPairs: 2, NumReported: 2}
+
+	gs := types.DefaultGenesisState()
+	gs.ValidatorReports = []types.ValidatorReportGenesis{
+		{Validator: validator, Height: 1, Report: report},
+		{Validator: validator, Height: 2, Report: report},
+	}
+
+	t.Run("reports set while performance is not tracked", func(t *testing.T) {
+		require.Error(t, gs.Validate())
+	})
+
+	gs.Params.SetPerformanceTracking(types.NewPerformanceTracking(2, 5000, 1000))
+	require.NoError(t, gs.Validate())
+
+	t.Run("reports exceed the performance window", func(t *testing.T) {
+		gs.Params.SetPerformanceTracking(types.NewPerformanceTracking(1, 5000, 1000))
+		defer gs.Params.SetPerformanceTracking(types.NewPerformanceTracking(2, 5000, 1000))
+		require.Error(t, gs.Validate())
+	})
+
+	t.Run("repeated report at a height", func(t *testing.T) {
+		invalid := *gs
+		invalid.ValidatorReports = []types.ValidatorReportGenesis{gs.ValidatorReports[0], gs.ValidatorReports[0]}
+		require.Error(t, invalid.Validate())
+	})
+
+	t.Run("invalid validator address", func(t *testing.T) {
+		invalid := *gs
+		invalid.ValidatorReports = []types.ValidatorReportGenesis{{Validator: "invalid", Height: 1, Report: report}}
+		require.Error(t, invalid.Validate())
+	})
+}
@@ -0,0 +1,44 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// OracleSlashingHooks is the interface that defines the hooks that can be integrated by other modules to
+// penalize validators that do not report prices.
+//
+//go:generate mockery --name OracleSlashingHooks --output ./mocks/ --case underscore
+type OracleSlashingHooks interface {
+	// AfterValidatorPerformanceBelowThreshold is called in PreBlock when a validator's performance over a
+	// full performance window is below the module's minimum. The validator's window is reset afterwards, so
+	// the hook is called at most once per window.
+	AfterValidatorPerformanceBelowThreshold(ctx sdk.Context, validator sdk.ConsAddress, performance ValidatorPerformance) error
+}
+
+var _ OracleSlashingHooks = MultiOracleSlashingHooks{}
+
+// MultiOracleSlashingHooks defines an array of OracleSlashingHooks which can be executed in sequence.
+type MultiOracleSlashingHooks []OracleSlashingHooks
+
+// AfterValidatorPerformanceBelowThreshold calls all AfterValidatorPerformanceBelowThreshold hooks registered to
+// the MultiOracleSlashingHooks.
+func (mh MultiOracleSlashingHooks) AfterValidatorPerformanceBelowThreshold(
+	ctx sdk.Context,
+	validator sdk.ConsAddress,
+	performance ValidatorPerformance,
+) error {
+	for i := range mh {
+		if err := mh[i].AfterValidatorPerformanceBelowThreshold(ctx, validator, performance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ OracleSlashingHooks = NoopOracleSlashingHooks{}
+
+// NoopOracleSlashingHooks defines oracle slashing hooks that are a no-op.
+type NoopOracleSlashingHooks struct{}
+
+func (NoopOracleSlashingHooks) AfterValidatorPerformanceBelowThreshold(_ sdk.Context, _ sdk.ConsAddress, _ ValidatorPerformance) error {
+	return nil
+}
@@ -33,6 +33,22 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// ParamsKeyPrefix is the key-prefix under which the module parameters are stored.
+	ParamsKeyPrefix = collections.NewPrefix(6)
+
+	// PriceHistoryKeyPrefix is the key-prefix under which the price history of each currency-pair is stored.
+	PriceHistoryKeyPrefix = collections.NewPrefix(7)
+
+	// ValidatorReportsKeyPrefix is the key-prefix under which the block reports of each validator are stored.
+	ValidatorReportsKeyPrefix = collections.NewPrefix(8)
+
+	// ValidatorPerformanceKeyPrefix is the key-prefix under which the performance of each validator is stored.
+	ValidatorPerformanceKeyPrefix = collections.NewPrefix(9)
+
+	// ValidatorReportsByValidatorKeyPrefix is the key-prefix under which the index of the block reports by validator is
+	// stored.
+	ValidatorReportsByValidatorKeyPrefix = collections.NewPrefix(10)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
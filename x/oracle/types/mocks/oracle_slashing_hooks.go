@@ -0,0 +1,84 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	types "github.com/cosmos/cosmos-sdk/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OracleSlashingHooks is an autogenerated mock type for the OracleSlashingHooks type
+type OracleSlashingHooks struct {
+	mock.Mock
+}
+
+type OracleSlashingHooks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OracleSlashingHooks) EXPECT() *OracleSlashingHooks_Expecter {
+	return &OracleSlashingHooks_Expecter{mock: &_m.Mock}
+}
+
+// AfterValidatorPerformanceBelowThreshold provides a mock function with given fields: ctx, validator, performance
+func (_m *OracleSlashingHooks) AfterValidatorPerformanceBelowThreshold(ctx types.Context, validator types.ConsAddress, performance oracletypes.ValidatorPerformance) error {
+	ret := _m.Called(ctx, validator, performance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterValidatorPerformanceBelowThreshold")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, oracletypes.ValidatorPerformance) error); ok {
+		r0 = rf(ctx, validator, performance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterValidatorPerformanceBelowThreshold'
+type OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call struct {
+	*mock.Call
+}
+
+// AfterValidatorPerformanceBelowThreshold is a helper method to define mock.On call
+//   - ctx types.Context
+//   - validator types.ConsAddress
+//   - performance oracletypes.ValidatorPerformance
+func (_e *OracleSlashingHooks_Expecter) AfterValidatorPerformanceBelowThreshold(ctx interface{}, validator interface{}, performance interface{}) *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call {
+	return &OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call{Call: _e.mock.On("AfterValidatorPerformanceBelowThreshold", ctx, validator, performance)}
+}
+
+func (_c *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call) Run(run func(ctx types.Context, validator types.ConsAddress, performance oracletypes.ValidatorPerformance)) *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(types.ConsAddress), args[2].(oracletypes.ValidatorPerformance))
+	})
+	return _c
+}
+
+func (_c *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call) Return(_a0 error) *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call) RunAndReturn(run func(types.Context, types.ConsAddress, oracletypes.ValidatorPerformance) error) *OracleSlashingHooks_AfterValidatorPerformanceBelowThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOracleSlashingHooks creates a new instance of OracleSlashingHooks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOracleSlashingHooks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OracleSlashingHooks {
+	mock := &OracleSlashingHooks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
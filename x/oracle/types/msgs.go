@@ -9,6 +9,8 @@ import (
 var (
 	_ sdk.Msg = &MsgAddCurrencyPairs{}
 	_ sdk.Msg = &MsgRemoveCurrencyPairs{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgResumeCurrencyPairs{}
 )
 
 // NewMsgAddCurrencyPairs returns a new message from a set of currency-pairs and an authority.
@@ -65,3 +67,51 @@ func (m *MsgRemoveCurrencyPairs) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParams returns a new message to update the x/oracle module's parameters.
+func NewMsgUpdateParams(authority string, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that the params are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// NewMsgResumeCurrencyPairs returns a new message to resume price updates for a set of halted or skipped
+// currency-pairs.
+func NewMsgResumeCurrencyPairs(authority string, currencyPairIDs []string) MsgResumeCurrencyPairs {
+	return MsgResumeCurrencyPairs{
+		Authority:       authority,
+		CurrencyPairIds: currencyPairIDs,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that each CurrencyPairID in the message is formatted correctly.
+func (m *MsgResumeCurrencyPairs) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	// check that each CurrencyPairID is correctly formatted
+	for _, id := range m.CurrencyPairIds {
+		if _, err := connecttypes.CurrencyPairFromString(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -105,3 +106,99 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+// paramsWith returns the default params with the given update applied.
+func paramsWith(update func(params *types.Params)) types.Params {
+	params := types.DefaultParams()
+	update(&params)
+	return params
+}
+
+func TestValidateBasicMsgUpdateParams(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.MsgUpdateParams{
+				Authority: "abc",
+			},
+			false,
+		},
+		{
+			"if the max price history exceeds the limit - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = types.MaxPriceHistoryLimit + 1
+			})),
+			false,
+		},
+		{
+			"if the circuit breaker action is invalid - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+				params.SetCircuitBreaker(types.NewCircuitBreaker(100, "unknown"))
+			})),
+			false,
+		},
+		{
+			"if the circuit breaker is enabled without an action - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+				params.SetCircuitBreaker(types.NewCircuitBreaker(100, ""))
+			})),
+			false,
+		},
+		{
+			"if the max staleness duration is negative - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+				params.SetStaleness(types.NewStaleness(0, -time.Second))
+			})),
+			false,
+		},
+		{
+			"if the min performance exceeds 100% - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+				params.SetPerformanceTracking(types.NewPerformanceTracking(100, 10001, 0))
+			})),
+			false,
+		},
+		{
+			"if the performance window exceeds the limit - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+				params.SetPerformanceTracking(types.NewPerformanceTracking(types.MaxPerformanceWindowLimit+1, 0, 0))
+			})),
+			false,
+		},
+		{
+			"if the params are valid + authority is valid - pass",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+			})),
+			true,
+		},
+		{
+			"if the circuit breaker is valid + authority is valid - pass",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), paramsWith(func(params *types.Params) {
+				params.MaxPriceHistory = 100
+				params.SetCircuitBreaker(types.NewCircuitBreaker(500, types.CircuitBreakerActionHalt))
+			})),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+)
+
+// MaxPriceHistoryLimit is the maximum number of historical QuotePrices that may be retained per currency-pair.
+const MaxPriceHistoryLimit = 10_000
+
+// DefaultParams returns default oracle parameters. By default, no price history is retained, the circuit
+// breaker is disabled, prices are never considered stale, and validator performance is not tracked.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// CircuitBreaker returns the default circuit breaker for all currency-pairs.
+func (p *Params) CircuitBreaker() CircuitBreaker {
+	return NewCircuitBreaker(p.MaxPriceChangeBps, p.CircuitBreakerAction)
+}
+
+// Staleness returns the maximum staleness of the prices of all currency-pairs.
+func (p *Params) Staleness() Staleness {
+	return NewStaleness(p.MaxStalenessBlocks, p.MaxStalenessDuration)
+}
+
+// PerformanceTracking returns the configuration of the tracking of validator performance.
+func (p *Params) PerformanceTracking() PerformanceTracking {
+	return NewPerformanceTracking(p.PerformanceWindow, p.MinPerformanceBps, p.MaxDeviationBps)
+}
+
+// SetCircuitBreaker sets the default circuit breaker for all currency-pairs.
+func (p *Params) SetCircuitBreaker(cb CircuitBreaker) {
+	p.MaxPriceChangeBps = cb.MaxPriceChangeBps
+	p.CircuitBreakerAction = cb.Action
+}
+
+// SetStaleness sets the maximum staleness of the prices of all currency-pairs.
+func (p *Params) SetStaleness(s Staleness) {
+	p.MaxStalenessBlocks = s.MaxBlocks
+	p.MaxStalenessDuration = s.MaxDuration
+}
+
+// SetPerformanceTracking sets the configuration of the tracking of validator performance.
+func (p *Params) SetPerformanceTracking(pt PerformanceTracking) {
+	p.PerformanceWindow = pt.Window
+	p.MinPerformanceBps = pt.MinPerformanceBps
+	p.MaxDeviationBps = pt.MaxDeviationBps
+}
+
+// ValidateBasic performs stateless validation of the Params.
+func (p *Params) ValidateBasic() error {
+	if p.MaxPriceHistory > MaxPriceHistoryLimit {
+		return fmt.Errorf("max price history %d exceeds limit %d", p.MaxPriceHistory, MaxPriceHistoryLimit)
+	}
+
+	if err := p.CircuitBreaker().ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := p.Staleness().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return p.PerformanceTracking().ValidateBasic()
+}
@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/oracle module.
+type Params struct {
+	// MaxPriceHistory is the maximum number of historical QuotePrices retained
+	// per currency-pair. The history is used to serve price history and TWAP
+	// queries. If zero, no price history is retained.
+	MaxPriceHistory uint64 `protobuf:"varint,1,opt,name=max_price_history,json=maxPriceHistory,proto3" json:"max_price_history,omitempty"`
+	// MaxPriceChangeBps is the maximum change, in basis points of the previous
+	// price, that a currency-pair's price may move in a single block. Markets
+	// may override this value in their ticker metadata. If zero, the circuit
+	// breaker is disabled.
+	MaxPriceChangeBps uint64 `protobuf:"varint,2,opt,name=max_price_change_bps,json=maxPriceChangeBps,proto3" json:"max_price_change_bps,omitempty"`
+	// CircuitBreakerAction is the action taken when a price update exceeds
+	// MaxPriceChangeBps. It is one of "clamp" (the price is clamped to the
+	// maximum change), "skip" (the update is skipped), or "halt" (the update is
+	// skipped and the currency-pair is halted until it is resumed by the
+	// authority).
+	CircuitBreakerAction string `protobuf:"bytes,3,opt,name=circuit_breaker_action,json=circuitBreakerAction,proto3" json:"circuit_breaker_action,omitempty"`
+	// MaxStalenessBlocks is the maximum number of blocks since a currency-pair's
+	// latest price update before its price is considered stale. If zero, prices
+	// are not considered stale based on their block height.
+	MaxStalenessBlocks uint64 `protobuf:"varint,4,opt,name=max_staleness_blocks,json=maxStalenessBlocks,proto3" json:"max_staleness_blocks,omitempty"`
+	// MaxStalenessDuration is the maximum time since a currency-pair's latest
+	// price update before its price is considered stale. If zero, prices are not
+	// considered stale based on their block timestamp.
+	MaxStalenessDuration time.Duration `protobuf:"bytes,5,opt,name=max_staleness_duration,json=maxStalenessDuration,proto3,stdduration" json:"max_staleness_duration"`
+	// PerformanceWindow is the number of blocks over which the prices reported
+	// by each validator are tracked. If zero, validator performance is not
+	// tracked.
+	PerformanceWindow uint64 `protobuf:"varint,6,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty"`
+	// MinPerformanceBps is the minimum share, in basis points, of currency-pairs
+	// that a validator must report a non-deviating price for over a full
+	// performance window. Validators below the threshold are reported to the
+	// module's slashing hooks.
+	MinPerformanceBps uint64 `protobuf:"varint,7,opt,name=min_performance_bps,json=minPerformanceBps,proto3" json:"min_performance_bps,omitempty"`
+	// MaxDeviationBps is the maximum deviation, in basis points of the final
+	// price, of a validator's reported price before it is considered deviating.
+	// If zero, reported prices are never considered deviating.
+	MaxDeviationBps uint64 `protobuf:"varint,8,opt,name=max_deviation_bps,json=maxDeviationBps,proto3" json:"max_deviation_bps,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3529c71237e76268, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxPriceHistory() uint64 {
+	if m != nil {
+		return m.MaxPriceHistory
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceChangeBps() uint64 {
+	if m != nil {
+		return m.MaxPriceChangeBps
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerAction() string {
+	if m != nil {
+		return m.CircuitBreakerAction
+	}
+	return ""
+}
+
+func (m *Params) GetMaxStalenessBlocks() uint64 {
+	if m != nil {
+		return m.MaxStalenessBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStalenessDuration() time.Duration {
+	if m != nil {
+		return m.MaxStalenessDuration
+	}
+	return 0
+}
+
+func (m *Params) GetPerformanceWindow() uint64 {
+	if m != nil {
+		return m.PerformanceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinPerformanceBps() uint64 {
+	if m != nil {
+		return m.MinPerformanceBps
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxDeviationBps
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "connect.oracle.v2.Params")
+}
+
+func init() { proto.RegisterFile("connect/oracle/v2/params.proto", fileDescriptor_3529c71237e76268) }
+
+var fileDescriptor_3529c71237e76268 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x4f, 0x6e, 0x13, 0x31,
+	0x14, 0x87, 0x33, 0xb4, 0x84, 0x62, 0x16, 0x28, 0x26, 0xaa, 0x86, 0x2e, 0xdc, 0x88, 0x55, 0x84,
+	0x14, 0x1b, 0x05, 0x2e, 0xc0, 0x50, 0x09, 0x96, 0x51, 0x58, 0x20, 0xd8, 0x58, 0x1e, 0xc7, 0x9d,
+	0x58, 0x89, 0xff, 0xc8, 0x76, 0xa6, 0xe9, 0x2d, 0x58, 0x72, 0x0f, 0x2e, 0xd1, 0x65, 0x97, 0xac,
+	0x00, 0x25, 0x17, 0x41, 0xb1, 0xe3, 0x26, 0xec, 0x66, 0xde, 0xf7, 0xb3, 0x9f, 0xdf, 0xa7, 0x07,
+	0x10, 0x37, 0x5a, 0x0b, 0x1e, 0x88, 0x71, 0x8c, 0x2f, 0x05, 0x69, 0xc7, 0xc4, 0x32, 0xc7, 0x94,
+	0xc7, 0xd6, 0x99, 0x60, 0x60, 0x6f, 0xcf, 0x71, 0xe2, 0xb8, 0x1d, 0x5f, 0xf4, 0x1b, 0xd3, 0x98,
+	0x48, 0xc9, 0xee, 0x2b, 0x05, 0x2f, 0x50, 0x63, 0x4c, 0xb3, 0x14, 0x24, 0xfe, 0xd5, 0xab, 0x6b,
+	0x32, 0x5b, 0x39, 0x16, 0xa4, 0xd1, 0x89, 0xbf, 0xfa, 0x79, 0x02, 0xba, 0x93, 0x78, 0x33, 0x7c,
+	0x0d, 0x7a, 0x8a, 0xad, 0xa9, 0x75, 0x92, 0x0b, 0x3a, 0x97, 0x3e, 0x18, 0x77, 0x5b, 0x16, 0x83,
+	0x62, 0x78, 0x3a, 0x7d, 0xae, 0xd8, 0x7a, 0xb2, 0xab, 0x7f, 0x4a, 0x65, 0x48, 0x40, 0xff, 0x90,
+	0xe5, 0x73, 0xa6, 0x1b, 0x41, 0x6b, 0xeb, 0xcb, 0x47, 0x31, 0xde, 0xcb, 0xf1, 0x0f, 0x91, 0x54,
+	0xd6, 0xc3, 0x77, 0xe0, 0x9c, 0x4b, 0xc7, 0x57, 0x32, 0xd0, 0xda, 0x09, 0xb6, 0x10, 0x8e, 0x32,
+	0xbe, 0x7b, 0x47, 0x79, 0x32, 0x28, 0x86, 0x4f, 0xa7, 0xfd, 0x3d, 0xad, 0x12, 0x7c, 0x1f, 0x19,
+	0x7c, 0x93, 0xda, 0xf8, 0xc0, 0x96, 0x42, 0x0b, 0xef, 0x69, 0xbd, 0x34, 0x7c, 0xe1, 0xcb, 0xd3,
+	0xd8, 0x06, 0x2a, 0xb6, 0xfe, 0x9c, 0x51, 0x15, 0x09, 0xfc, 0x0a, 0xce, 0xff, 0x3f, 0x91, 0xe7,
+	0x2d, 0x1f, 0x0f, 0x8a, 0xe1, 0xb3, 0xf1, 0x4b, 0x9c, 0x84, 0xe0, 0x2c, 0x04, 0x5f, 0xed, 0x03,
+	0xd5, 0xd9, 0xdd, 0xef, 0xcb, 0xce, 0x8f, 0x3f, 0x97, 0xc5, 0xb4, 0x7f, 0x7c, 0x71, 0xe6, 0x70,
+	0x04, 0xa0, 0x15, 0xee, 0xda, 0x38, 0xc5, 0x34, 0x17, 0xf4, 0x46, 0xea, 0x99, 0xb9, 0x29, 0xbb,
+	0x69, 0xe2, 0x23, 0xf2, 0x25, 0x02, 0x88, 0xc1, 0x0b, 0x25, 0x35, 0x3d, 0x3e, 0xb2, 0x33, 0xf4,
+	0x64, 0x6f, 0x48, 0xea, 0xc9, 0x81, 0x54, 0xf6, 0x41, 0xff, 0x4c, 0xb4, 0x32, 0xf6, 0x8b, 0xe9,
+	0xb3, 0x07, 0xfd, 0x57, 0xb9, 0x5e, 0x59, 0x5f, 0x7d, 0xbc, 0xdb, 0xa0, 0xe2, 0x7e, 0x83, 0x8a,
+	0xbf, 0x1b, 0x54, 0x7c, 0xdf, 0xa2, 0xce, 0xfd, 0x16, 0x75, 0x7e, 0x6d, 0x51, 0xe7, 0xdb, 0xa8,
+	0x91, 0x61, 0xbe, 0xaa, 0x31, 0x37, 0x8a, 0xf8, 0x85, 0xb4, 0x23, 0x25, 0x5a, 0x92, 0x97, 0xa9,
+	0x1d, 0x93, 0x75, 0xde, 0xa8, 0x70, 0x6b, 0x85, 0xaf, 0xbb, 0x51, 0xc3, 0xdb, 0x7f, 0x03, 0x00,
+	0x17, 0xfd, 0x1c, 0xd8, 0x70, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDeviationBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeviationBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinPerformanceBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPerformanceBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PerformanceWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PerformanceWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStalenessDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStalenessDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxStalenessBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStalenessBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CircuitBreakerAction) > 0 {
+		i -= len(m.CircuitBreakerAction)
+		copy(dAtA[i:], m.CircuitBreakerAction)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakerAction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxPriceChangeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceChangeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxPriceHistory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceHistory))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPriceHistory != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceHistory))
+	}
+	if m.MaxPriceChangeBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceChangeBps))
+	}
+	l = len(m.CircuitBreakerAction)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxStalenessBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxStalenessBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStalenessDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.PerformanceWindow != 0 {
+		n += 1 + sovParams(uint64(m.PerformanceWindow))
+	}
+	if m.MinPerformanceBps != 0 {
+		n += 1 + sovParams(uint64(m.MinPerformanceBps))
+	}
+	if m.MaxDeviationBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxDeviationBps))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceHistory", wireType)
+			}
+			m.MaxPriceHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangeBps", wireType)
+			}
+			m.MaxPriceChangeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceChangeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessBlocks", wireType)
+			}
+			m.MaxStalenessBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStalenessBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStalenessDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+			}
+			m.PerformanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPerformanceBps", wireType)
+			}
+			m.MinPerformanceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPerformanceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBps", wireType)
+			}
+			m.MaxDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+// MaxPerformanceWindowLimit is the maximum number of blocks over which validator performance may be tracked.
+const MaxPerformanceWindowLimit = 100_000
+
+// PerformanceTracking configures the tracking of the prices reported by each validator.
+type PerformanceTracking struct {
+	// Window is the number of blocks over which the reports of each validator are tracked. If zero,
+	// validator performance is not tracked.
+	Window uint64
+	// MinPerformanceBps is the minimum performance, in basis points, of a validator over a full window.
+	MinPerformanceBps uint64
+	// MaxDeviationBps is the maximum deviation, in basis points, of a reported price from the final price
+	// before it is considered deviating. If zero, reported prices are never considered deviating.
+	MaxDeviationBps uint64
+}
+
+// NewPerformanceTracking returns a new PerformanceTracking.
+func NewPerformanceTracking(window, minPerformanceBps, maxDeviationBps uint64) PerformanceTracking {
+	return PerformanceTracking{
+		Window:            window,
+		MinPerformanceBps: minPerformanceBps,
+		MaxDeviationBps:   maxDeviationBps,
+	}
+}
+
+// ValidateBasic checks that the window and the minimum performance of the PerformanceTracking are within bounds.
+func (pt PerformanceTracking) ValidateBasic() error {
+	if pt.Window > MaxPerformanceWindowLimit {
+		return fmt.Errorf("performance window %d exceeds limit %d", pt.Window, MaxPerformanceWindowLimit)
+	}
+
+	if pt.MinPerformanceBps > BasisPoints {
+		return fmt.Errorf("min performance %d bps exceeds %d bps", pt.MinPerformanceBps, BasisPoints)
+	}
+
+	return nil
+}
+
+// Enabled returns true if validator performance is tracked.
+func (pt PerformanceTracking) Enabled() bool {
+	return pt.Window > 0
+}
+
+// ValidatorPrices are the prices reported by a validator in a single block. If the validator did not
+// commit the block, or did not include a vote extension, Prices is nil.
+type ValidatorPrices struct {
+	// ConsAddress is the consensus address of the validator.
+	ConsAddress sdk.ConsAddress
+	// Prices are the prices reported by the validator.
+	Prices map[connecttypes.CurrencyPair]*big.Int
+}
+
+// Report compares the given prices reported by a validator to the final prices of a block.
+func (pt PerformanceTracking) Report(finalPrices, reported map[connecttypes.CurrencyPair]*big.Int) ValidatorBlockReport {
+	var report ValidatorBlockReport
+	for cp, final := range finalPrices {
+		if final == nil || final.Sign() < 0 {
+			continue
+		}
+		report.NumPairs++
+
+		price, ok := reported[cp]
+		if !ok || price == nil {
+			continue
+		}
+		report.NumReported++
+
+		deviation := deviationBps(final, price)
+		report.DeviationBps += deviation
+		if pt.MaxDeviationBps > 0 && deviation > pt.MaxDeviationBps {
+			report.NumDeviating++
+		}
+	}
+
+	return report
+}
+
+// deviationBps returns the deviation of the price from the final price in basis points, capped at BasisPoints.
+func deviationBps(final, price *big.Int) uint64 {
+	if final.Sign() == 0 {
+		if price.Sign() == 0 {
+			return 0
+		}
+
+		return BasisPoints
+	}
+
+	diff := new(big.Int).Sub(price, final)
+	diff.Abs(diff)
+	diff.Mul(diff, big.NewInt(BasisPoints))
+	diff.Quo(diff, final)
+
+	if diff.Cmp(big.NewInt(BasisPoints)) > 0 {
+		return BasisPoints
+	}
+
+	return diff.Uint64()
+}
+
+// Add adds the given block report to the ValidatorPerformance.
+func (vp *ValidatorPerformance) Add(report ValidatorBlockReport) {
+	vp.NumBlocks++
+	vp.NumPairs += report.NumPairs
+	vp.NumReported += report.NumReported
+	vp.NumDeviating += report.NumDeviating
+	vp.DeviationBps += report.DeviationBps
+}
+
+// Sub removes the given block report, which must have been added previously, from the ValidatorPerformance.
+func (vp *ValidatorPerformance) Sub(report ValidatorBlockReport) {
+	vp.NumBlocks--
+	vp.NumPairs -= report.NumPairs
+	vp.NumReported -= report.NumReported
+	vp.NumDeviating -= report.NumDeviating
+	vp.DeviationBps -= report.DeviationBps
+}
+
+// PerformanceBps returns the share, in basis points, of currency-pairs that the validator reported a
+// non-deviating price for. If no currency-pair received a final price, the performance is BasisPoints.
+func (vp *ValidatorPerformance) PerformanceBps() uint64 {
+	if vp.NumPairs == 0 {
+		return BasisPoints
+	}
+
+	valid := new(big.Int).SetUint64(vp.NumReported - vp.NumDeviating)
+	valid.Mul(valid, big.NewInt(BasisPoints))
+	return valid.Quo(valid, new(big.Int).SetUint64(vp.NumPairs)).Uint64()
+}
+
+// MeanDeviationBps returns the mean deviation, in basis points, of the validator's reported prices from
+// the final prices.
+func (vp *ValidatorPerformance) MeanDeviationBps() uint64 {
+	if vp.NumReported == 0 {
+		return 0
+	}
+
+	return vp.DeviationBps / vp.NumReported
+}
@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: connect/oracle/v2/performance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorBlockReport summarizes the prices reported by a validator in a
+// single block, compared to the final prices of the block.
+type ValidatorBlockReport struct {
+	// NumPairs is the number of currency-pairs that received a final price.
+	NumPairs uint64 `protobuf:"varint,1,opt,name=num_pairs,json=numPairs,proto3" json:"num_pairs,omitempty"`
+	// NumReported is the number of those currency-pairs for which the validator
+	// reported a price.
+	NumReported uint64 `protobuf:"varint,2,opt,name=num_reported,json=numReported,proto3" json:"num_reported,omitempty"`
+	// NumDeviating is the number of reported prices that deviated from the final
+	// price by more than the module's maximum deviation.
+	NumDeviating uint64 `protobuf:"varint,3,opt,name=num_deviating,json=numDeviating,proto3" json:"num_deviating,omitempty"`
+	// DeviationBps is the sum of the deviations of the reported prices from the
+	// final prices, in basis points. Each deviation is capped at 10,000 bps.
+	DeviationBps uint64 `protobuf:"varint,4,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+}
+
+func (m *ValidatorBlockReport) Reset()         { *m = ValidatorBlockReport{} }
+func (m *ValidatorBlockReport) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBlockReport) ProtoMessage()    {}
+func (*ValidatorBlockReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17fb5b53b8239dc1, []int{0}
+}
+func (m *ValidatorBlockReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBlockReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBlockReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBlockReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBlockReport.Merge(m, src)
+}
+func (m *ValidatorBlockReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBlockReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBlockReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBlockReport proto.InternalMessageInfo
+
+func (m *ValidatorBlockReport) GetNumPairs() uint64 {
+	if m != nil {
+		return m.NumPairs
+	}
+	return 0
+}
+
+func (m *ValidatorBlockReport) GetNumReported() uint64 {
+	if m != nil {
+		return m.NumReported
+	}
+	return 0
+}
+
+func (m *ValidatorBlockReport) GetNumDeviating() uint64 {
+	if m != nil {
+		return m.NumDeviating
+	}
+	return 0
+}
+
+func (m *ValidatorBlockReport) GetDeviationBps() uint64 {
+	if m != nil {
+		return m.DeviationBps
+	}
+	return 0
+}
+
+// ValidatorPerformance summarizes the prices reported by a validator over the
+// module's performance window.
+type ValidatorPerformance struct {
+	// NumBlocks is the number of blocks in the window for which the validator's
+	// reports were recorded.
+	NumBlocks uint64 `protobuf:"varint,1,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// NumPairs is the number of currency-pairs that received a final price,
+	// summed over the blocks in the window.
+	NumPairs uint64 `protobuf:"varint,2,opt,name=num_pairs,json=numPairs,proto3" json:"num_pairs,omitempty"`
+	// NumReported is the number of those currency-pairs for which the validator
+	// reported a price, summed over the blocks in the window.
+	NumReported uint64 `protobuf:"varint,3,opt,name=num_reported,json=numReported,proto3" json:"num_reported,omitempty"`
+	// NumDeviating is the number of reported prices that deviated from the final
+	// price by more than the module's maximum deviation, summed over the blocks
+	// in the window.
+	NumDeviating uint64 `protobuf:"varint,4,opt,name=num_deviating,json=numDeviating,proto3" json:"num_deviating,omitempty"`
+	// DeviationBps is the sum of the deviations of the reported prices from the
+	// final prices in basis points, summed over the blocks in the window.
+	DeviationBps uint64 `protobuf:"varint,5,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17fb5b53b8239dc1, []int{1}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetNumPairs() uint64 {
+	if m != nil {
+		return m.NumPairs
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetNumReported() uint64 {
+	if m != nil {
+		return m.NumReported
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetNumDeviating() uint64 {
+	if m != nil {
+		return m.NumDeviating
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetDeviationBps() uint64 {
+	if m != nil {
+		return m.DeviationBps
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorBlockReport)(nil), "connect.oracle.v2.ValidatorBlockReport")
+	proto.RegisterType((*ValidatorPerformance)(nil), "connect.oracle.v2.ValidatorPerformance")
+}
+
+func init() {
+	proto.RegisterFile("connect/oracle/v2/performance.proto", fileDescriptor_17fb5b53b8239dc1)
+}
+
+var fileDescriptor_17fb5b53b8239dc1 = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3d, 0x4e, 0xc3, 0x30,
+	0x1c, 0xc5, 0xeb, 0xb6, 0x20, 0x30, 0x30, 0x10, 0x31, 0x44, 0x42, 0x58, 0xd0, 0x2e, 0x2c, 0x8d,
+	0xa5, 0x70, 0x83, 0x08, 0x89, 0xb5, 0xca, 0xc0, 0xc0, 0x52, 0x39, 0x8e, 0x29, 0x56, 0xe3, 0x0f,
+	0xd9, 0x4e, 0x04, 0xb7, 0xe0, 0x08, 0xdc, 0x84, 0x95, 0xb1, 0x23, 0x23, 0x4a, 0x2e, 0x82, 0x9c,
+	0x26, 0x7c, 0x88, 0x81, 0x8e, 0x79, 0xff, 0x5f, 0xe4, 0xf7, 0xd3, 0x83, 0x53, 0xaa, 0xa4, 0x64,
+	0xd4, 0x61, 0x65, 0x08, 0x2d, 0x18, 0xae, 0x62, 0xac, 0x99, 0xb9, 0x57, 0x46, 0x10, 0x49, 0x59,
+	0xa4, 0x8d, 0x72, 0x2a, 0x38, 0xee, 0xa0, 0x68, 0x03, 0x45, 0x55, 0x3c, 0x79, 0x01, 0xf0, 0xe4,
+	0x96, 0x14, 0x3c, 0x27, 0x4e, 0x99, 0xa4, 0x50, 0x74, 0x95, 0x32, 0xad, 0x8c, 0x0b, 0x4e, 0xe1,
+	0xbe, 0x2c, 0xc5, 0x42, 0x13, 0x6e, 0x6c, 0x08, 0xce, 0xc1, 0xe5, 0x38, 0xdd, 0x93, 0xa5, 0x98,
+	0xfb, 0xef, 0xe0, 0x02, 0x1e, 0xfa, 0xa3, 0x69, 0x51, 0x96, 0x87, 0xc3, 0xf6, 0x7e, 0x20, 0x4b,
+	0x91, 0x76, 0x51, 0x30, 0x85, 0x47, 0x1e, 0xc9, 0x59, 0xc5, 0x89, 0xe3, 0x72, 0x19, 0x8e, 0x5a,
+	0xc6, 0xff, 0x77, 0xdd, 0x67, 0x1e, 0xea, 0x00, 0x25, 0x17, 0x99, 0xb6, 0xe1, 0x78, 0x03, 0x7d,
+	0x85, 0x89, 0xb6, 0x93, 0xd7, 0x9f, 0x15, 0xe7, 0xdf, 0x52, 0xc1, 0x19, 0x84, 0xfe, 0x89, 0xcc,
+	0xb7, 0xee, 0x3b, 0xfa, 0xd2, 0xad, 0x86, 0xfd, 0x6d, 0x30, 0xfc, 0xc7, 0x60, 0xb4, 0x85, 0xc1,
+	0x78, 0x1b, 0x83, 0x9d, 0xbf, 0x06, 0xc9, 0xcd, 0x5b, 0x8d, 0xc0, 0xba, 0x46, 0xe0, 0xa3, 0x46,
+	0xe0, 0xb9, 0x41, 0x83, 0x75, 0x83, 0x06, 0xef, 0x0d, 0x1a, 0xdc, 0xcd, 0x96, 0xdc, 0x3d, 0x94,
+	0x59, 0x44, 0x95, 0xc0, 0x76, 0xc5, 0xf5, 0x4c, 0xb0, 0x0a, 0xf7, 0x53, 0x56, 0x31, 0x7e, 0xec,
+	0xf7, 0x74, 0x4f, 0x9a, 0xd9, 0x6c, 0xb7, 0xdd, 0xf1, 0xea, 0x73, 0x00, 0x77, 0x4c, 0x77, 0xf2,
+	0xee, 0x01, 0x00, 0x00,
+}
+
+func (m *ValidatorBlockReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBlockReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBlockReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationBps != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.DeviationBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumDeviating != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumDeviating))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumReported != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumReported))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumPairs != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumPairs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationBps != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.DeviationBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumDeviating != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumDeviating))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumReported != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumReported))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumPairs != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumPairs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorBlockReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPairs != 0 {
+		n += 1 + sovPerformance(uint64(m.NumPairs))
+	}
+	if m.NumReported != 0 {
+		n += 1 + sovPerformance(uint64(m.NumReported))
+	}
+	if m.NumDeviating != 0 {
+		n += 1 + sovPerformance(uint64(m.NumDeviating))
+	}
+	if m.DeviationBps != 0 {
+		n += 1 + sovPerformance(uint64(m.DeviationBps))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		n += 1 + sovPerformance(uint64(m.NumBlocks))
+	}
+	if m.NumPairs != 0 {
+		n += 1 + sovPerformance(uint64(m.NumPairs))
+	}
+	if m.NumReported != 0 {
+		n += 1 + sovPerformance(uint64(m.NumReported))
+	}
+	if m.NumDeviating != 0 {
+		n += 1 + sovPerformance(uint64(m.NumDeviating))
+	}
+	if m.DeviationBps != 0 {
+		n += 1 + sovPerformance(uint64(m.DeviationBps))
+	}
+	return n
+}
+
+func sovPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPerformance(x uint64) (n int) {
+	return sovPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorBlockReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBlockReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBlockReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPairs", wireType)
+			}
+			m.NumPairs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPairs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReported", wireType)
+			}
+			m.NumReported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReported |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDeviating", wireType)
+			}
+			m.NumDeviating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumDeviating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+			}
+			m.DeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPairs", wireType)
+			}
+			m.NumPairs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPairs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReported", wireType)
+			}
+			m.NumReported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReported |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDeviating", wireType)
+			}
+			m.NumDeviating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumDeviating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+			}
+			m.DeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPerformance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,24 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/skip-mev/connect/v2/pkg/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -163,7 +170,8 @@ func (m *GetPriceRequest) GetCurrencyPair() string {
 // the x/oracle query service.
 type GetPriceResponse struct {
 	// QuotePrice represents the quote-price for the CurrencyPair given in
-	// GetPriceRequest (possibly nil if no update has been made)
+	// GetPriceRequest (possibly nil if no update has been made). The quote-price
+	// includes the dispersion of the price, if validators reported one.
 	Price *QuotePrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
 	// nonce represents the nonce for the CurrencyPair if it exists in state
 	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
@@ -172,6 +180,9 @@ type GetPriceResponse struct {
 	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// ID represents the identifier for the CurrencyPair.
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// stale is true if the quote-price has not been updated within the module's
+	// maximum staleness, or if no update has been made.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *GetPriceResponse) Reset()         { *m = GetPriceResponse{} }
@@ -235,6 +246,13 @@ func (m *GetPriceResponse) GetId() uint64 {
 	return 0
 }
 
+func (m *GetPriceResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // GetPricesRequest takes an identifier for the CurrencyPair
 // in the format base/quote.
 type GetPricesRequest struct {
@@ -549,864 +567,3150 @@ func (m *GetCurrencyPairMappingListResponse) GetMappings() []CurrencyPairMapping
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "connect.oracle.v2.GetAllCurrencyPairsRequest")
-	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "connect.oracle.v2.GetAllCurrencyPairsResponse")
-	proto.RegisterType((*GetPriceRequest)(nil), "connect.oracle.v2.GetPriceRequest")
-	proto.RegisterType((*GetPriceResponse)(nil), "connect.oracle.v2.GetPriceResponse")
-	proto.RegisterType((*GetPricesRequest)(nil), "connect.oracle.v2.GetPricesRequest")
-	proto.RegisterType((*GetPricesResponse)(nil), "connect.oracle.v2.GetPricesResponse")
-	proto.RegisterType((*GetCurrencyPairMappingRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingRequest")
-	proto.RegisterType((*GetCurrencyPairMappingResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse")
-	proto.RegisterMapType((map[uint64]types.CurrencyPair)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse.CurrencyPairMappingEntry")
-	proto.RegisterType((*GetCurrencyPairMappingListRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingListRequest")
-	proto.RegisterType((*CurrencyPairMapping)(nil), "connect.oracle.v2.CurrencyPairMapping")
-	proto.RegisterType((*GetCurrencyPairMappingListResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingListResponse")
+// ParamsRequest is the request type for the Query/Params RPC method.
+type ParamsRequest struct {
 }
 
-func init() { proto.RegisterFile("connect/oracle/v2/query.proto", fileDescriptor_85b187574238e3d2) }
-
-var fileDescriptor_85b187574238e3d2 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0xa4, 0x49, 0xd5, 0x9e, 0xde, 0xfe, 0x4d, 0x7b, 0xaf, 0xa2, 0xdc, 0xc6, 0xe9, 0x75,
-	0x73, 0x51, 0x55, 0x51, 0x9b, 0xa6, 0x05, 0x51, 0x16, 0x48, 0x2d, 0x42, 0xa1, 0xfc, 0x48, 0xad,
-	0x17, 0x2c, 0xd8, 0x44, 0xae, 0x33, 0x84, 0xa1, 0x8e, 0xc7, 0xf5, 0x4c, 0x22, 0xb2, 0x60, 0x83,
-	0xd8, 0x17, 0x89, 0x17, 0x80, 0x67, 0xe0, 0x15, 0x58, 0x74, 0x59, 0x89, 0x0d, 0x2b, 0x84, 0x5a,
-	0x1e, 0x04, 0x65, 0x3c, 0x4e, 0x9d, 0xd6, 0x09, 0xc9, 0xce, 0xf6, 0x39, 0xdf, 0x39, 0xdf, 0x77,
-	0xfe, 0x0c, 0x05, 0x87, 0x79, 0x1e, 0x71, 0x84, 0xc9, 0x02, 0xdb, 0x71, 0x89, 0xd9, 0x2a, 0x9b,
-	0xc7, 0x4d, 0x12, 0xb4, 0x0d, 0x3f, 0x60, 0x82, 0xe1, 0x79, 0x65, 0x36, 0x42, 0xb3, 0xd1, 0x2a,
-	0xe7, 0x17, 0xeb, 0xac, 0xce, 0xa4, 0xd5, 0xec, 0x3c, 0x85, 0x8e, 0xf9, 0xa5, 0x3a, 0x63, 0x75,
-	0x97, 0x98, 0xb6, 0x4f, 0x4d, 0xdb, 0xf3, 0x98, 0xb0, 0x05, 0x65, 0x1e, 0x57, 0xd6, 0xe2, 0xf5,
-	0x2c, 0x75, 0xe2, 0x11, 0x4e, 0x23, 0x87, 0x52, 0xe4, 0x20, 0xda, 0x3e, 0xe1, 0x1d, 0xbb, 0xd3,
-	0x0c, 0x02, 0xe2, 0x39, 0xed, 0xaa, 0x6f, 0xd3, 0x20, 0xf4, 0xd2, 0x97, 0x20, 0x5f, 0x21, 0x62,
-	0xc7, 0x75, 0x1f, 0x28, 0xe3, 0xbe, 0x4d, 0x03, 0x6e, 0x91, 0xe3, 0x26, 0xe1, 0x42, 0x7f, 0x0d,
-	0xff, 0x26, 0x5a, 0xb9, 0xcf, 0x3c, 0x4e, 0xf0, 0x13, 0x98, 0xe9, 0x89, 0xc9, 0x73, 0x68, 0x79,
-	0x6c, 0x75, 0xaa, 0xac, 0x19, 0x91, 0x46, 0x99, 0xdb, 0x68, 0x95, 0x8d, 0x78, 0x80, 0xdd, 0xcc,
-	0xe9, 0x8f, 0x62, 0xca, 0x9a, 0x76, 0xe2, 0x41, 0xf5, 0x3b, 0x30, 0x5b, 0x21, 0x62, 0x3f, 0xa0,
-	0x0e, 0x51, 0xe9, 0xf1, 0x0a, 0x4c, 0xf7, 0xc4, 0xcf, 0xa1, 0x65, 0xb4, 0x3a, 0x69, 0xfd, 0x15,
-	0x07, 0xea, 0x27, 0x08, 0xe6, 0x2e, 0x81, 0x8a, 0xd9, 0x36, 0x64, 0xfd, 0xce, 0x07, 0x89, 0x98,
-	0x2a, 0x17, 0x8c, 0x6b, 0x45, 0x37, 0x0e, 0x9a, 0x4c, 0x10, 0x89, 0x92, 0x7c, 0x90, 0x15, 0x22,
-	0xf0, 0x22, 0x64, 0x3d, 0xe6, 0x39, 0x24, 0x97, 0x5e, 0x46, 0xab, 0x19, 0x2b, 0x7c, 0xc1, 0x79,
-	0x98, 0xa8, 0x11, 0x87, 0x36, 0x6c, 0x97, 0xe7, 0xc6, 0xa4, 0xa1, 0xfb, 0x8e, 0x67, 0x20, 0x4d,
-	0x6b, 0xb9, 0x8c, 0xfc, 0x9a, 0xa6, 0x35, 0xfd, 0xfe, 0x25, 0xa1, 0xa8, 0x92, 0x78, 0x0d, 0xe6,
-	0x7b, 0xa4, 0x54, 0x69, 0x2d, 0xac, 0xd6, 0xa4, 0x35, 0x1b, 0x97, 0xb3, 0x57, 0xe3, 0xfa, 0x73,
-	0x98, 0x8f, 0xe1, 0x95, 0xa2, 0x1d, 0x18, 0x97, 0xfc, 0xa2, 0x1a, 0xaf, 0x24, 0x48, 0xba, 0x5a,
-	0x06, 0x55, 0x68, 0x05, 0xd4, 0x8b, 0x50, 0xa8, 0x10, 0x11, 0xef, 0xc4, 0x33, 0xdb, 0xf7, 0xa9,
-	0x57, 0x8f, 0xda, 0x7d, 0x92, 0x06, 0xad, 0x9f, 0x87, 0xa2, 0xf1, 0x1e, 0xc1, 0xdf, 0xbd, 0x42,
-	0x1a, 0xa1, 0x87, 0xa2, 0xf5, 0x38, 0x99, 0xd6, 0x80, 0x90, 0x46, 0x82, 0xed, 0xa1, 0x27, 0x82,
-	0xb6, 0x62, 0xbf, 0xe0, 0x5c, 0xb7, 0xe7, 0x5f, 0x42, 0xae, 0x1f, 0x0c, 0xcf, 0xc1, 0xd8, 0x11,
-	0x69, 0xcb, 0xce, 0x67, 0xac, 0xce, 0x23, 0xde, 0x82, 0x6c, 0xcb, 0x76, 0x9b, 0x61, 0x4b, 0xff,
-	0x38, 0x9e, 0x56, 0xe8, 0x7c, 0x2f, 0x7d, 0x17, 0xe9, 0x2b, 0xf0, 0x5f, 0x32, 0xfb, 0xa7, 0x94,
-	0x8b, 0xa8, 0x6c, 0x3e, 0x2c, 0x24, 0x78, 0xa8, 0xb1, 0x40, 0xd1, 0x58, 0xe0, 0xbd, 0xab, 0xd3,
-	0x3c, 0x14, 0x1b, 0x55, 0x85, 0xde, 0x99, 0xf7, 0x40, 0x1f, 0x44, 0x4b, 0xf5, 0xea, 0x11, 0x4c,
-	0xa8, 0xe6, 0x44, 0x43, 0x73, 0x23, 0xa1, 0x3b, 0x09, 0x51, 0x54, 0xce, 0x2e, 0xba, 0xfc, 0x79,
-	0x1c, 0xb2, 0x07, 0x9d, 0x1b, 0x86, 0x3f, 0x21, 0x58, 0x48, 0x38, 0x09, 0x78, 0x3d, 0xb9, 0xef,
-	0x7d, 0x0e, 0x4b, 0xde, 0x18, 0xd6, 0x3d, 0x94, 0xa2, 0xaf, 0xbd, 0xfb, 0xf6, 0xeb, 0x63, 0xba,
-	0x84, 0x75, 0x33, 0xe9, 0xec, 0x89, 0xaa, 0xed, 0xba, 0x55, 0x41, 0x9d, 0x23, 0x12, 0x70, 0xdc,
-	0x86, 0x89, 0x68, 0x11, 0xb0, 0x3e, 0x70, 0x4b, 0x42, 0x2e, 0xc3, 0x6c, 0x92, 0x5e, 0x92, 0x04,
-	0x34, 0xbc, 0xd4, 0x87, 0x40, 0x78, 0x3b, 0xde, 0xc2, 0x64, 0x77, 0x73, 0xf1, 0xa0, 0xb8, 0xdd,
-	0x42, 0x94, 0x06, 0x3b, 0xa9, 0xec, 0xff, 0xcb, 0xec, 0x45, 0x5c, 0x18, 0x94, 0x9d, 0xe3, 0x2f,
-	0x08, 0xfe, 0x49, 0x9e, 0x0b, 0x7c, 0x6b, 0x84, 0xbd, 0x0c, 0x99, 0x6d, 0x8c, 0xbc, 0xc9, 0xfa,
-	0x96, 0xa4, 0x69, 0xe0, 0x9b, 0x7d, 0x68, 0x26, 0x1e, 0x0e, 0xfc, 0x15, 0xc9, 0x7f, 0x50, 0x9f,
-	0x69, 0xc6, 0x5b, 0x43, 0xf3, 0x88, 0xed, 0x64, 0xfe, 0xf6, 0x88, 0x28, 0xa5, 0x60, 0x5b, 0x2a,
-	0xd8, 0xc4, 0x1b, 0xa3, 0x28, 0xa8, 0xba, 0x94, 0x8b, 0xdd, 0xca, 0xe9, 0xb9, 0x86, 0xce, 0xce,
-	0x35, 0xf4, 0xf3, 0x5c, 0x43, 0x1f, 0x2e, 0xb4, 0xd4, 0xd9, 0x85, 0x96, 0xfa, 0x7e, 0xa1, 0xa5,
-	0x5e, 0xac, 0xd7, 0xa9, 0x78, 0xd5, 0x3c, 0x34, 0x1c, 0xd6, 0x30, 0xf9, 0x11, 0xf5, 0xd7, 0x1b,
-	0xa4, 0xd5, 0x8d, 0xdf, 0x2a, 0x9b, 0x6f, 0xa2, 0x24, 0xf2, 0x00, 0x1c, 0x8e, 0xcb, 0x3f, 0xf3,
-	0xe6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xf8, 0xcc, 0xe9, 0x48, 0x08, 0x00, 0x00,
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{11}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// ParamsResponse is the response type for the Query/Params RPC method.
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
-	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
-	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
-	// that CurrencyPair.
-	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
-	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
-	// Get the mapping of currency pair ID -> currency pair. This is useful for
-	// indexers that have access to the ID of a currency pair, but no way to get
-	// the underlying currency pair from it.
-	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
-	// Get the mapping of currency pair ID <-> currency pair as a list. This is
-	// useful for indexers that have access to the ID of a currency pair, but no
-	// way to get the underlying currency pair from it.
-	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{12}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// GetPriceHistoryRequest takes an identifier for the CurrencyPair in the format
+// base/quote.
+type GetPriceHistoryRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
 }
 
-func (c *queryClient) GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error) {
-	out := new(GetAllCurrencyPairsResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetAllCurrencyPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPriceHistoryRequest) Reset()         { *m = GetPriceHistoryRequest{} }
+func (m *GetPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryRequest) ProtoMessage()    {}
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{13}
+}
+func (m *GetPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryRequest.Merge(m, src)
+}
+func (m *GetPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
-	out := new(GetPriceResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPriceHistoryRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error) {
-	out := new(GetPricesResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+// GetPriceHistoryResponse is the response from the GetPriceHistory grpc method
+// exposed from the x/oracle query service.
+type GetPriceHistoryResponse struct {
+	// prices is the retained price history, ordered from oldest to newest.
+	Prices []PriceHistoryEntry `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+	// decimals represents the number of decimals that the quote-prices are
+	// represented in.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// ID represents the identifier for the CurrencyPair.
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GetPriceHistoryResponse) Reset()         { *m = GetPriceHistoryResponse{} }
+func (m *GetPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryResponse) ProtoMessage()    {}
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{14}
+}
+func (m *GetPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryResponse.Merge(m, src)
+}
+func (m *GetPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
-	out := new(GetCurrencyPairMappingResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPriceHistoryResponse) GetPrices() []PriceHistoryEntry {
+	if m != nil {
+		return m.Prices
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error) {
-	out := new(GetCurrencyPairMappingListResponse)
-	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairMappingList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPriceHistoryResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
-	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
-	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
-	// that CurrencyPair.
-	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
-	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
-	// Get the mapping of currency pair ID -> currency pair. This is useful for
-	// indexers that have access to the ID of a currency pair, but no way to get
-	// the underlying currency pair from it.
-	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
-	// Get the mapping of currency pair ID <-> currency pair as a list. This is
-	// useful for indexers that have access to the ID of a currency pair, but no
-	// way to get the underlying currency pair from it.
-	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+func (m *GetPriceHistoryResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// GetTWAPRequest takes an identifier for the CurrencyPair in the format
+// base/quote, and the window over which to average its price.
+type GetTWAPRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// window is the duration, ending at the current block time, over which the
+	// price is averaged.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
 }
 
-func (*UnimplementedQueryServer) GetAllCurrencyPairs(ctx context.Context, req *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCurrencyPairs not implemented")
+func (m *GetTWAPRequest) Reset()         { *m = GetTWAPRequest{} }
+func (m *GetTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPRequest) ProtoMessage()    {}
+func (*GetTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{15}
 }
-func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequest) (*GetPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+func (m *GetTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
+func (m *GetTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
+func (m *GetTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetCurrencyPairMappingList(ctx context.Context, req *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
+func (m *GetTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_GetTWAPRequest proto.InternalMessageInfo
+
+func (m *GetTWAPRequest) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
 }
 
-func _Query_GetAllCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCurrencyPairsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetTWAPRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllCurrencyPairs(ctx, in)
+	return 0
+}
+
+// GetTWAPResponse is the response from the GetTWAP grpc method exposed from
+// the x/oracle query service.
+type GetTWAPResponse struct {
+	// twap is the time-weighted average price over the window.
+	Twap cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=twap,proto3,customtype=cosmossdk.io/math.Int" json:"twap"`
+	// start_time is the start of the averaged interval. This is later than the
+	// start of the requested window if the retained history does not cover it.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the end of the averaged interval, i.e. the current block
+	// time.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// num_prices is the number of historical quote-prices in the interval.
+	NumPrices uint64 `protobuf:"varint,4,opt,name=num_prices,json=numPrices,proto3" json:"num_prices,omitempty"`
+	// decimals represents the number of decimals that the twap is represented
+	// in.
+	Decimals uint64 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *GetTWAPResponse) Reset()         { *m = GetTWAPResponse{} }
+func (m *GetTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPResponse) ProtoMessage()    {}
+func (*GetTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{16}
+}
+func (m *GetTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetAllCurrencyPairs",
+}
+func (m *GetTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPResponse.Merge(m, src)
+}
+func (m *GetTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTWAPResponse proto.InternalMessageInfo
+
+func (m *GetTWAPResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllCurrencyPairs(ctx, req.(*GetAllCurrencyPairsRequest))
+	return time.Time{}
+}
+
+func (m *GetTWAPResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetTWAPResponse) GetNumPrices() uint64 {
+	if m != nil {
+		return m.NumPrices
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPrice(ctx, req.(*GetPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPrices(ctx, req.(*GetPricesRequest))
+func (m *GetTWAPResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrencyPairMappingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCurrencyPairMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCurrencyPairMapping(ctx, req.(*GetCurrencyPairMappingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// GetStalePairsRequest is the GetStalePairs request type.
+type GetStalePairsRequest struct {
 }
 
-func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrencyPairMappingListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairMappingList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, req.(*GetCurrencyPairMappingListRequest))
+func (m *GetStalePairsRequest) Reset()         { *m = GetStalePairsRequest{} }
+func (m *GetStalePairsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStalePairsRequest) ProtoMessage()    {}
+func (*GetStalePairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{17}
+}
+func (m *GetStalePairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStalePairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStalePairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetStalePairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStalePairsRequest.Merge(m, src)
+}
+func (m *GetStalePairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStalePairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStalePairsRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "connect.oracle.v2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllCurrencyPairs",
-			Handler:    _Query_GetAllCurrencyPairs_Handler,
-		},
-		{
-			MethodName: "GetPrice",
-			Handler:    _Query_GetPrice_Handler,
-		},
-		{
-			MethodName: "GetPrices",
-			Handler:    _Query_GetPrices_Handler,
-		},
-		{
-			MethodName: "GetCurrencyPairMapping",
-			Handler:    _Query_GetCurrencyPairMapping_Handler,
-		},
-		{
-			MethodName: "GetCurrencyPairMappingList",
-			Handler:    _Query_GetCurrencyPairMappingList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "connect/oracle/v2/query.proto",
+var xxx_messageInfo_GetStalePairsRequest proto.InternalMessageInfo
+
+// GetStalePairsResponse is the GetStalePairs response type.
+type GetStalePairsResponse struct {
+	// currency_pairs are the currency pairs whose price is stale.
+	CurrencyPairs []types.CurrencyPair `protobuf:"bytes,1,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs"`
 }
 
-func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetStalePairsResponse) Reset()         { *m = GetStalePairsResponse{} }
+func (m *GetStalePairsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStalePairsResponse) ProtoMessage()    {}
+func (*GetStalePairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{18}
+}
+func (m *GetStalePairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStalePairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStalePairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetStalePairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStalePairsResponse.Merge(m, src)
 }
-
-func (m *GetAllCurrencyPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetStalePairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStalePairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStalePairsResponse.DiscardUnknown(m)
 }
 
-func (m *GetAllCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetStalePairsResponse proto.InternalMessageInfo
+
+func (m *GetStalePairsResponse) GetCurrencyPairs() []types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPairs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAllCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorPerformanceRequest is the ValidatorPerformance request type.
+type ValidatorPerformanceRequest struct {
+	// validator is the bech32 consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *GetAllCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanceRequest{} }
+func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceRequest) ProtoMessage()    {}
+func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{19}
+}
+func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *ValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *GetPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorPerformanceRequest proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorPerformanceResponse is the ValidatorPerformance response type.
+type ValidatorPerformanceResponse struct {
+	// performance summarizes the prices reported by the validator over the
+	// performance window.
+	Performance ValidatorPerformance `protobuf:"bytes,1,opt,name=performance,proto3" json:"performance"`
+	// performance_bps is the share, in basis points, of currency-pairs that the
+	// validator reported a non-deviating price for over the window.
+	PerformanceBps uint64 `protobuf:"varint,2,opt,name=performance_bps,json=performanceBps,proto3" json:"performance_bps,omitempty"`
+	// mean_deviation_bps is the mean deviation of the validator's reported
+	// prices from the final prices, in basis points.
+	MeanDeviationBps uint64 `protobuf:"varint,3,opt,name=mean_deviation_bps,json=meanDeviationBps,proto3" json:"mean_deviation_bps,omitempty"`
 }
 
-func (m *GetPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPair) > 0 {
-		i -= len(m.CurrencyPair)
-		copy(dAtA[i:], m.CurrencyPair)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerformanceResponse{} }
+func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceResponse) ProtoMessage()    {}
+func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85b187574238e3d2, []int{20}
+}
+func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *ValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *GetPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceResponse) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
 	}
-	return dAtA[:n], nil
+	return ValidatorPerformance{}
 }
 
-func (m *GetPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorPerformanceResponse) GetPerformanceBps() uint64 {
+	if m != nil {
+		return m.PerformanceBps
+	}
+	return 0
 }
 
-func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x18
+func (m *ValidatorPerformanceResponse) GetMeanDeviationBps() uint64 {
+	if m != nil {
+		return m.MeanDeviationBps
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Price != nil {
-		{
-			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "connect.oracle.v2.GetAllCurrencyPairsRequest")
+	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "connect.oracle.v2.GetAllCurrencyPairsResponse")
+	proto.RegisterType((*GetPriceRequest)(nil), "connect.oracle.v2.GetPriceRequest")
+	proto.RegisterType((*GetPriceResponse)(nil), "connect.oracle.v2.GetPriceResponse")
+	proto.RegisterType((*GetPricesRequest)(nil), "connect.oracle.v2.GetPricesRequest")
+	proto.RegisterType((*GetPricesResponse)(nil), "connect.oracle.v2.GetPricesResponse")
+	proto.RegisterType((*GetCurrencyPairMappingRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingRequest")
+	proto.RegisterType((*GetCurrencyPairMappingResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse")
+	proto.RegisterMapType((map[uint64]types.CurrencyPair)(nil), "connect.oracle.v2.GetCurrencyPairMappingResponse.CurrencyPairMappingEntry")
+	proto.RegisterType((*GetCurrencyPairMappingListRequest)(nil), "connect.oracle.v2.GetCurrencyPairMappingListRequest")
+	proto.RegisterType((*CurrencyPairMapping)(nil), "connect.oracle.v2.CurrencyPairMapping")
+	proto.RegisterType((*GetCurrencyPairMappingListResponse)(nil), "connect.oracle.v2.GetCurrencyPairMappingListResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "connect.oracle.v2.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "connect.oracle.v2.ParamsResponse")
+	proto.RegisterType((*GetPriceHistoryRequest)(nil), "connect.oracle.v2.GetPriceHistoryRequest")
+	proto.RegisterType((*GetPriceHistoryResponse)(nil), "connect.oracle.v2.GetPriceHistoryResponse")
+	proto.RegisterType((*GetTWAPRequest)(nil), "connect.oracle.v2.GetTWAPRequest")
+	proto.RegisterType((*GetTWAPResponse)(nil), "connect.oracle.v2.GetTWAPResponse")
+	proto.RegisterType((*GetStalePairsRequest)(nil), "connect.oracle.v2.GetStalePairsRequest")
+	proto.RegisterType((*GetStalePairsResponse)(nil), "connect.oracle.v2.GetStalePairsResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "connect.oracle.v2.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "connect.oracle.v2.ValidatorPerformanceResponse")
 }
 
-func (m *GetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() { proto.RegisterFile("connect/oracle/v2/query.proto", fileDescriptor_85b187574238e3d2) }
 
-func (m *GetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CurrencyPairIds[iNdEx])
-			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_85b187574238e3d2 = []byte{
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x1f, 0x4d, 0x5e, 0x48, 0xd2, 0x4e, 0xd3, 0xe2, 0x6c, 0x12, 0x27, 0xd9, 0x84,
+	0xd6, 0x84, 0x66, 0xb7, 0x75, 0xcb, 0x47, 0xa9, 0xa0, 0xaa, 0x5b, 0xe4, 0x86, 0x0f, 0xe1, 0x9a,
+	0xaa, 0x48, 0x5c, 0x56, 0x9b, 0xdd, 0xa9, 0xbb, 0xc4, 0xfb, 0xd1, 0x9d, 0xb1, 0x8b, 0x0f, 0x5c,
+	0x10, 0x17, 0x2e, 0x50, 0xa9, 0x12, 0xe2, 0xc8, 0x85, 0x03, 0x67, 0xf8, 0x13, 0x10, 0xea, 0xb1,
+	0x82, 0x0b, 0xe2, 0x50, 0xaa, 0x86, 0x3f, 0x04, 0xed, 0x7c, 0x38, 0xeb, 0x78, 0xec, 0xda, 0x07,
+	0x6e, 0x9e, 0x79, 0xef, 0xf7, 0xde, 0xef, 0xcd, 0xbc, 0x7d, 0xbf, 0x31, 0xac, 0xba, 0x51, 0x18,
+	0x62, 0x97, 0x5a, 0x51, 0xe2, 0xb8, 0x0d, 0x6c, 0xb5, 0x4a, 0xd6, 0xfd, 0x26, 0x4e, 0xda, 0x66,
+	0x9c, 0x44, 0x34, 0x42, 0x27, 0x84, 0xd9, 0xe4, 0x66, 0xb3, 0x55, 0xd2, 0x17, 0xeb, 0x51, 0x3d,
+	0x62, 0x56, 0x2b, 0xfd, 0xc5, 0x1d, 0xf5, 0x95, 0x7a, 0x14, 0xd5, 0x1b, 0xd8, 0x72, 0x62, 0xdf,
+	0x72, 0xc2, 0x30, 0xa2, 0x0e, 0xf5, 0xa3, 0x90, 0x08, 0x6b, 0x41, 0x58, 0xd9, 0x6a, 0xaf, 0x79,
+	0xd7, 0xf2, 0x9a, 0x09, 0x73, 0x10, 0xf6, 0xb5, 0xa3, 0x76, 0xea, 0x07, 0x98, 0x50, 0x27, 0x88,
+	0x85, 0xc3, 0x92, 0x1b, 0x91, 0x20, 0x22, 0x36, 0xcf, 0xcb, 0x17, 0x12, 0xdb, 0x5b, 0x41, 0x1d,
+	0x87, 0x98, 0xf8, 0x9d, 0xe4, 0xbd, 0x0e, 0xb1, 0x93, 0x38, 0x81, 0xb4, 0x6f, 0x2a, 0xec, 0x38,
+	0xb9, 0x1b, 0x25, 0x81, 0x13, 0xba, 0x58, 0x38, 0x6d, 0x49, 0x27, 0xda, 0x8e, 0x31, 0x49, 0x7d,
+	0xdc, 0x66, 0x92, 0xe0, 0xd0, 0x6d, 0xdb, 0xb1, 0xe3, 0x27, 0xdc, 0xcb, 0x58, 0x01, 0xbd, 0x82,
+	0xe9, 0xb5, 0x46, 0xe3, 0xba, 0x30, 0x56, 0x1d, 0x3f, 0x21, 0x35, 0x7c, 0xbf, 0x89, 0x09, 0x35,
+	0x3e, 0x87, 0x65, 0xa5, 0x95, 0xc4, 0x51, 0x48, 0x30, 0xfa, 0x00, 0xe6, 0xbb, 0x62, 0x92, 0xbc,
+	0xb6, 0x3e, 0x5e, 0x9c, 0x2d, 0x15, 0x4c, 0x79, 0x09, 0x2c, 0xb7, 0xd9, 0x2a, 0x99, 0xd9, 0x00,
+	0xe5, 0x89, 0xc7, 0x4f, 0xd7, 0xc6, 0x6a, 0x73, 0x6e, 0x36, 0xa8, 0xf1, 0x06, 0x2c, 0x54, 0x30,
+	0xad, 0x26, 0xbe, 0x8b, 0x45, 0x7a, 0xb4, 0x09, 0x73, 0x5d, 0xf1, 0xf3, 0xda, 0xba, 0x56, 0x9c,
+	0xa9, 0xbd, 0x94, 0x05, 0x1a, 0x3f, 0x69, 0x70, 0xfc, 0x10, 0x28, 0x98, 0x5d, 0x86, 0xc9, 0x38,
+	0xdd, 0x60, 0x88, 0xd9, 0xd2, 0xaa, 0xd9, 0xd3, 0x15, 0xe6, 0xad, 0x66, 0x44, 0x31, 0x43, 0x31,
+	0x3e, 0x5a, 0x8d, 0x23, 0xd0, 0x22, 0x4c, 0x86, 0x51, 0xe8, 0xe2, 0x7c, 0x6e, 0x5d, 0x2b, 0x4e,
+	0xd4, 0xf8, 0x02, 0xe9, 0x30, 0xed, 0x61, 0xd7, 0x0f, 0x9c, 0x06, 0xc9, 0x8f, 0x33, 0x43, 0x67,
+	0x8d, 0xe6, 0x21, 0xe7, 0x7b, 0xf9, 0x09, 0xb6, 0x9b, 0xf3, 0xbd, 0x34, 0x02, 0xa1, 0x4e, 0x03,
+	0xe7, 0x27, 0xd7, 0xb5, 0xe2, 0x74, 0x8d, 0x2f, 0x8c, 0x77, 0x0f, 0x69, 0xca, 0xf3, 0x45, 0xdb,
+	0x70, 0xa2, 0xab, 0x40, 0xdb, 0xf7, 0xf8, 0x19, 0xce, 0xd4, 0x16, 0xb2, 0x45, 0xee, 0x7a, 0xc4,
+	0xb8, 0x03, 0x27, 0x32, 0x78, 0x51, 0xe7, 0x35, 0x98, 0x62, 0xac, 0xe5, 0xc9, 0x6f, 0x2a, 0x0a,
+	0x3d, 0x7a, 0x38, 0xe2, 0xf8, 0x05, 0xd0, 0x58, 0x83, 0xd5, 0x0a, 0xa6, 0xd9, 0xfb, 0xf9, 0xc8,
+	0x89, 0x63, 0x3f, 0xac, 0xcb, 0x26, 0xf8, 0x2e, 0x07, 0x85, 0x7e, 0x1e, 0x82, 0xc6, 0xd7, 0x1a,
+	0x9c, 0xea, 0x2e, 0x24, 0xe0, 0x1e, 0x82, 0xd6, 0xfb, 0x6a, 0x5a, 0x03, 0x42, 0x9a, 0x0a, 0xdb,
+	0x7b, 0x21, 0x4d, 0xda, 0x82, 0xfd, 0x49, 0xb7, 0xd7, 0xae, 0xdf, 0x85, 0x7c, 0x3f, 0x18, 0x3a,
+	0x0e, 0xe3, 0xfb, 0xb8, 0xcd, 0xfa, 0x61, 0xa2, 0x96, 0xfe, 0x44, 0x97, 0x60, 0xb2, 0xe5, 0x34,
+	0x9a, 0xfc, 0xa2, 0x5f, 0xd8, 0xb4, 0x35, 0xee, 0xfc, 0x76, 0xee, 0x2d, 0xcd, 0xd8, 0x84, 0x0d,
+	0x35, 0xfb, 0x0f, 0x7d, 0x42, 0xe5, 0xb1, 0xc5, 0x70, 0x52, 0xe1, 0x21, 0x9a, 0x45, 0xeb, 0x34,
+	0xcb, 0xee, 0xd1, 0x1e, 0x1f, 0x8a, 0x8d, 0x38, 0x85, 0xee, 0x2f, 0x21, 0x04, 0x63, 0x10, 0x2d,
+	0x71, 0x57, 0x37, 0x61, 0x5a, 0x5c, 0x8e, 0x6c, 0x9a, 0x33, 0x8a, 0xdb, 0x51, 0x44, 0x11, 0x39,
+	0x3b, 0x68, 0x63, 0x01, 0xe6, 0xaa, 0x6c, 0x2c, 0xc9, 0x92, 0x77, 0x61, 0x5e, 0x6e, 0x88, 0x64,
+	0x6f, 0xc2, 0x14, 0x9f, 0x5c, 0xe2, 0x43, 0x5c, 0x52, 0xa4, 0xe2, 0x90, 0x4e, 0x57, 0xb2, 0x95,
+	0xf1, 0x0e, 0x9c, 0x96, 0x7d, 0x7b, 0xd3, 0x27, 0x34, 0x4a, 0xda, 0x23, 0x0d, 0x85, 0x6f, 0x34,
+	0x78, 0xb9, 0x07, 0x2f, 0x38, 0x95, 0x8f, 0x7c, 0x33, 0x5b, 0x2a, 0x4e, 0x19, 0x60, 0xb6, 0xed,
+	0x04, 0xb2, 0x6b, 0x1c, 0xe4, 0x94, 0xe3, 0x60, 0x5c, 0xde, 0xb0, 0x91, 0xc0, 0x7c, 0x05, 0xd3,
+	0xdb, 0x9f, 0x5e, 0xab, 0x8e, 0x52, 0x02, 0xba, 0x02, 0x53, 0x0f, 0xfc, 0xd0, 0x8b, 0x1e, 0x88,
+	0x8e, 0x58, 0x32, 0xb9, 0xe4, 0x98, 0x52, 0x72, 0xcc, 0x1b, 0x42, 0x92, 0xca, 0xd3, 0x29, 0xb7,
+	0x1f, 0xfe, 0x59, 0xd3, 0x6a, 0x02, 0x62, 0x3c, 0xca, 0xc1, 0x42, 0x27, 0xa9, 0xa8, 0xfb, 0x2a,
+	0x4c, 0xd0, 0x07, 0x4e, 0xcc, 0x93, 0x95, 0x5f, 0x4b, 0x31, 0x7f, 0x3f, 0x5d, 0x3b, 0xc5, 0xa5,
+	0x89, 0x78, 0xfb, 0xa6, 0x1f, 0x59, 0x81, 0x43, 0xef, 0x99, 0xbb, 0x21, 0xfd, 0xe3, 0xd7, 0x1d,
+	0xe0, 0x86, 0x74, 0x55, 0x63, 0x40, 0x74, 0x1d, 0x80, 0x50, 0x27, 0xa1, 0x76, 0xaa, 0x75, 0x82,
+	0x95, 0xde, 0xc3, 0xea, 0xb6, 0x14, 0x42, 0x4e, 0xeb, 0x61, 0x4a, 0x6b, 0x86, 0xe1, 0x52, 0x0b,
+	0xba, 0x0a, 0xd3, 0x38, 0xf4, 0x78, 0x88, 0xf1, 0x11, 0x42, 0x1c, 0xc3, 0xa1, 0xc7, 0x02, 0xac,
+	0x02, 0x84, 0xcd, 0xc0, 0x16, 0x57, 0xc8, 0xa7, 0xee, 0x4c, 0xd8, 0x0c, 0xaa, 0xbd, 0x37, 0x33,
+	0xd9, 0x7d, 0x33, 0xc6, 0x69, 0x58, 0xac, 0x60, 0xfa, 0x49, 0x3a, 0x8e, 0xbb, 0x64, 0xce, 0x83,
+	0x53, 0x47, 0xf6, 0xff, 0x0f, 0x81, 0xbb, 0x02, 0xcb, 0x77, 0x9c, 0x86, 0xef, 0x39, 0x34, 0x4a,
+	0xaa, 0x87, 0x72, 0x2d, 0x9b, 0x62, 0x05, 0x66, 0x5a, 0xd2, 0x2c, 0x1a, 0xe2, 0x70, 0xc3, 0xf8,
+	0x5d, 0x83, 0x15, 0x35, 0x5a, 0x50, 0xfd, 0x18, 0x66, 0x33, 0x6f, 0x00, 0xf1, 0xb9, 0x9d, 0x55,
+	0xb4, 0xb6, 0x2a, 0x8a, 0x20, 0x9c, 0x8d, 0x80, 0xce, 0xc2, 0x42, 0x66, 0x69, 0xef, 0xc5, 0xb2,
+	0xd3, 0xe7, 0x33, 0xdb, 0xe5, 0x98, 0xa0, 0x73, 0x80, 0x02, 0xec, 0x84, 0xb6, 0x87, 0x5b, 0x3e,
+	0xeb, 0x47, 0xe6, 0xcb, 0xfb, 0xff, 0x78, 0x6a, 0xb9, 0x21, 0x0d, 0xe5, 0x98, 0x94, 0x9e, 0xcd,
+	0xc2, 0xe4, 0xad, 0xf4, 0xbd, 0x86, 0x7e, 0xd4, 0xe0, 0xa4, 0xe2, 0x75, 0x81, 0x76, 0xd4, 0x62,
+	0xd1, 0xe7, 0x8d, 0xa2, 0x9b, 0xc3, 0xba, 0xf3, 0x83, 0x32, 0xb6, 0xbf, 0xfa, 0xf3, 0xdf, 0x47,
+	0xb9, 0x2d, 0x64, 0x58, 0xaa, 0x67, 0x18, 0xb5, 0x9d, 0x46, 0xc3, 0xa6, 0xbe, 0xbb, 0x8f, 0x13,
+	0x82, 0xda, 0x30, 0x2d, 0xa7, 0x08, 0x32, 0x06, 0x4a, 0x2b, 0xe7, 0x32, 0x8c, 0xfc, 0x1a, 0x5b,
+	0x8c, 0x40, 0x01, 0xad, 0xf4, 0x21, 0xc0, 0x9f, 0x21, 0x5f, 0xc2, 0x8c, 0x44, 0x12, 0x34, 0x28,
+	0x6e, 0xe7, 0x20, 0xb6, 0x06, 0x3b, 0x89, 0xec, 0xaf, 0xb0, 0xec, 0x6b, 0x68, 0x75, 0x50, 0x76,
+	0x82, 0x7e, 0xd1, 0xd8, 0x00, 0x56, 0x29, 0xd8, 0xf9, 0x11, 0xc4, 0x9c, 0x33, 0xbb, 0x30, 0xb2,
+	0xfc, 0x1b, 0x97, 0x18, 0x4d, 0x13, 0x9d, 0xeb, 0x43, 0x53, 0xf9, 0xda, 0x40, 0xbf, 0x69, 0xa0,
+	0xab, 0x03, 0xa7, 0x12, 0x88, 0x2e, 0x0d, 0xcd, 0x23, 0x23, 0xe4, 0xfa, 0xeb, 0x23, 0xa2, 0x44,
+	0x05, 0x97, 0x59, 0x05, 0x17, 0xd1, 0x85, 0x51, 0x2a, 0xb0, 0x1b, 0x29, 0xcf, 0x18, 0xa6, 0xb8,
+	0x28, 0xa2, 0xf5, 0xbe, 0x7a, 0x29, 0xd9, 0x6d, 0x0c, 0xf0, 0x10, 0x4c, 0x36, 0x18, 0x93, 0x65,
+	0xb4, 0x64, 0xf5, 0xfb, 0x5f, 0x81, 0xbe, 0xd7, 0x0e, 0x5f, 0xdf, 0x42, 0xf6, 0xd0, 0xab, 0x03,
+	0xfa, 0xa9, 0x5b, 0x93, 0xf5, 0xed, 0x61, 0x5c, 0x05, 0x9b, 0x73, 0x8c, 0xcd, 0x19, 0xb4, 0x35,
+	0xa8, 0x01, 0xed, 0x7b, 0x82, 0x44, 0x13, 0x8e, 0x09, 0x1d, 0x43, 0x1b, 0xea, 0x24, 0x19, 0x61,
+	0xd5, 0x8d, 0x41, 0x2e, 0x22, 0xff, 0x26, 0xcb, 0xbf, 0x8a, 0x96, 0xfb, 0xe4, 0x67, 0x52, 0xf7,
+	0xad, 0x06, 0x73, 0x5d, 0x92, 0x80, 0xce, 0xaa, 0x43, 0xf7, 0x88, 0x89, 0x5e, 0x7c, 0xb1, 0xe3,
+	0x90, 0x93, 0x88, 0xfd, 0x6f, 0xe0, 0xba, 0x83, 0x7e, 0xd6, 0x60, 0x51, 0x35, 0xb9, 0x91, 0x39,
+	0xe4, 0x88, 0x97, 0xf4, 0xac, 0xa1, 0xfd, 0x05, 0xcb, 0xf3, 0x8c, 0xe5, 0x36, 0x2a, 0x2a, 0x58,
+	0x76, 0xf4, 0xc9, 0xce, 0x6a, 0x42, 0xe5, 0xf1, 0xf3, 0x82, 0xf6, 0xe4, 0x79, 0x41, 0x7b, 0xf6,
+	0xbc, 0xa0, 0x3d, 0x3c, 0x28, 0x8c, 0x3d, 0x39, 0x28, 0x8c, 0xfd, 0x75, 0x50, 0x18, 0xfb, 0x6c,
+	0xa7, 0xee, 0xd3, 0x7b, 0xcd, 0x3d, 0xd3, 0x8d, 0x02, 0x8b, 0xec, 0xfb, 0xf1, 0x4e, 0x80, 0x5b,
+	0x9d, 0xb0, 0xad, 0x92, 0xf5, 0x85, 0x8c, 0xcd, 0x64, 0x75, 0x6f, 0x8a, 0xbd, 0x08, 0x2e, 0xfe,
+	0x37, 0x00, 0x48, 0x25, 0x33, 0xef, 0xf3, 0x0f, 0x00, 0x00,
 }
 
-func (m *GetPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get all the currency pairs the x/oracle module is tracking price-data for.
+	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
+	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
+	// that CurrencyPair.
+	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
+	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// Get the mapping of currency pair ID -> currency pair. This is useful for
+	// indexers that have access to the ID of a currency pair, but no way to get
+	// the underlying currency pair from it.
+	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
+	// Get the mapping of currency pair ID <-> currency pair as a list. This is
+	// useful for indexers that have access to the ID of a currency pair, but no
+	// way to get the underlying currency pair from it.
+	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Given a CurrencyPair, return the retained history of QuotePrices for that
+	// CurrencyPair, ordered from oldest to newest.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average price
+	// of that CurrencyPair over the window ending at the current block time.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Get all the currency pairs whose price is stale, i.e. has not been updated
+	// within the module's maximum staleness.
+	GetStalePairs(ctx context.Context, in *GetStalePairsRequest, opts ...grpc.CallOption) (*GetStalePairsResponse, error)
+	// Given a validator's consensus address, return a summary of the prices it
+	// reported over the module's performance window.
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
 }
 
-func (m *GetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *GetCurrencyPairMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error) {
+	out := new(GetAllCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetAllCurrencyPairs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
+	out := new(GetPriceResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error) {
+	out := new(GetPricesResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
+	out := new(GetCurrencyPairMappingResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairMapping", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error) {
+	out := new(GetCurrencyPairMappingListResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetCurrencyPairMappingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairMapping) > 0 {
-		for k := range m.CurrencyPairMapping {
-			v := m.CurrencyPairMapping[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetPriceHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetStalePairs(ctx context.Context, in *GetStalePairsRequest, opts ...grpc.CallOption) (*GetStalePairsResponse, error) {
+	out := new(GetStalePairsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/GetStalePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CurrencyPairMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Query/ValidatorPerformance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CurrencyPairMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get all the currency pairs the x/oracle module is tracking price-data for.
+	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
+	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
+	// that CurrencyPair.
+	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
+	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// Get the mapping of currency pair ID -> currency pair. This is useful for
+	// indexers that have access to the ID of a currency pair, but no way to get
+	// the underlying currency pair from it.
+	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
+	// Get the mapping of currency pair ID <-> currency pair as a list. This is
+	// useful for indexers that have access to the ID of a currency pair, but no
+	// way to get the underlying currency pair from it.
+	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Given a CurrencyPair, return the retained history of QuotePrices for that
+	// CurrencyPair, ordered from oldest to newest.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window, return the time-weighted average price
+	// of that CurrencyPair over the window ending at the current block time.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Get all the currency pairs whose price is stale, i.e. has not been updated
+	// within the module's maximum staleness.
+	GetStalePairs(context.Context, *GetStalePairsRequest) (*GetStalePairsResponse, error)
+	// Given a validator's consensus address, return a summary of the prices it
+	// reported over the module's performance window.
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
 }
 
-func (m *CurrencyPairMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *GetCurrencyPairMappingListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetAllCurrencyPairs(ctx context.Context, req *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCurrencyPairs not implemented")
 }
-
-func (m *GetCurrencyPairMappingListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequest) (*GetPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+}
+func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
+}
+func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
+}
+func (*UnimplementedQueryServer) GetCurrencyPairMappingList(ctx context.Context, req *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetPriceHistory(ctx context.Context, req *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetTWAP(ctx context.Context, req *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (*UnimplementedQueryServer) GetStalePairs(ctx context.Context, req *GetStalePairsRequest) (*GetStalePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStalePairs not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
 }
 
-func (m *GetCurrencyPairMappingListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Mappings) > 0 {
-		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_GetAllCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCurrencyPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetAllCurrencyPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllCurrencyPairs(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *GetAllCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetAllCurrencyPairs",
 	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllCurrencyPairs(ctx, req.(*GetAllCurrencyPairsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CurrencyPair)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrice(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrice(ctx, req.(*GetPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrices(ctx, in)
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Decimals))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetPrices",
 	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrices(ctx, req.(*GetPricesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairMapping(ctx, in)
 	}
-	return n
-}
-
-func (m *GetPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairMapping",
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairMapping(ctx, req.(*GetCurrencyPairMappingRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairMappingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetCurrencyPairMappingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, req.(*GetCurrencyPairMappingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairMapping) > 0 {
-		for k, v := range m.CurrencyPairMapping {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CurrencyPairMapping) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStalePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStalePairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStalePairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/GetStalePairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStalePairs(ctx, req.(*GetStalePairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "connect.oracle.v2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCurrencyPairs",
+			Handler:    _Query_GetAllCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _Query_GetPrice_Handler,
+		},
+		{
+			MethodName: "GetPrices",
+			Handler:    _Query_GetPrices_Handler,
+		},
+		{
+			MethodName: "GetCurrencyPairMapping",
+			Handler:    _Query_GetCurrencyPairMapping_Handler,
+		},
+		{
+			MethodName: "GetCurrencyPairMappingList",
+			Handler:    _Query_GetCurrencyPairMappingList_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "GetStalePairs",
+			Handler:    _Query_GetStalePairs_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "connect/oracle/v2/query.proto",
+}
+
+func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairMapping) > 0 {
+		for k := range m.CurrencyPairMapping {
+			v := m.CurrencyPairMapping[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumPrices != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPrices))
+		i--
+		dAtA[i] = 0x20
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStalePairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStalePairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStalePairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStalePairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStalePairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStalePairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MeanDeviationBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MeanDeviationBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerformanceBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerformanceBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAllCurrencyPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAllCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCurrencyPairMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCurrencyPairMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairMapping) > 0 {
+		for k, v := range m.CurrencyPairMapping {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetCurrencyPairMappingListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CurrencyPairMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetCurrencyPairMappingListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NumPrices != 0 {
+		n += 1 + sovQuery(uint64(m.NumPrices))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *GetStalePairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetStalePairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Performance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PerformanceBps != 0 {
+		n += 1 + sovQuery(uint64(m.PerformanceBps))
+	}
+	if m.MeanDeviationBps != 0 {
+		n += 1 + sovQuery(uint64(m.MeanDeviationBps))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, GetPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrencyPairMapping == nil {
+				m.CurrencyPairMapping = make(map[uint64]types.CurrencyPair)
+			}
+			var mapkey uint64
+			mapvalue := &types.CurrencyPair{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.CurrencyPair{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CurrencyPairMapping[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *GetCurrencyPairMappingListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Mappings) > 0 {
-		for _, e := range m.Mappings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, CurrencyPairMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,10 +3733,10 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1456,7 +3760,7 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,15 +3783,15 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1514,8 +3818,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1540,7 +3843,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1563,10 +3866,10 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1622,7 +3925,7 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1645,15 +3948,15 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1680,33 +3983,12 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, PriceHistoryEntry{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
@@ -1725,7 +4007,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -1765,7 +4047,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1788,15 +4070,15 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1824,7 +4106,40 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1847,7 +4162,7 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1870,15 +4185,82 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1905,11 +4287,48 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, GetPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPrices", wireType)
+			}
+			m.NumPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1931,7 +4350,7 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *GetStalePairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,10 +4373,10 @@ func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStalePairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStalePairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1981,7 +4400,7 @@ func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *GetStalePairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,15 +4423,15 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStalePairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStalePairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2039,142 +4458,11 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrencyPairMapping == nil {
-				m.CurrencyPairMapping = make(map[uint64]types.CurrencyPair)
-			}
-			var mapkey uint64
-			mapvalue := &types.CurrencyPair{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.CurrencyPair{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CurrencyPairMapping[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2196,7 +4484,7 @@ func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2219,36 +4507,17 @@ func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2258,24 +4527,23 @@ func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2298,7 +4566,7 @@ func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,15 +4589,15 @@ func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2356,11 +4624,48 @@ func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mappings = append(m.Mappings, CurrencyPairMapping{})
-			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceBps", wireType)
+			}
+			m.PerformanceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanDeviationBps", wireType)
+			}
+			m.MeanDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MeanDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Staleness bounds the age of a currency-pair's latest price, after which the price is considered stale.
+type Staleness struct {
+	// MaxBlocks is the maximum number of blocks since the latest price update. If zero, the block height
+	// of the price is not bounded.
+	MaxBlocks uint64
+	// MaxDuration is the maximum time since the latest price update. If zero, the block timestamp of the
+	// price is not bounded.
+	MaxDuration time.Duration
+}
+
+// NewStaleness returns a new Staleness.
+func NewStaleness(maxBlocks uint64, maxDuration time.Duration) Staleness {
+	return Staleness{
+		MaxBlocks:   maxBlocks,
+		MaxDuration: maxDuration,
+	}
+}
+
+// ValidateBasic checks that the maximum duration of the Staleness is not negative.
+func (s Staleness) ValidateBasic() error {
+	if s.MaxDuration < 0 {
+		return fmt.Errorf("max staleness duration cannot be negative: %s", s.MaxDuration)
+	}
+
+	return nil
+}
+
+// Enabled returns true if the Staleness bounds the age of prices.
+func (s Staleness) Enabled() bool {
+	return s.MaxBlocks > 0 || s.MaxDuration > 0
+}
+
+// IsStale returns true if the given price is stale at the given block height and time. If the Staleness is
+// enabled, a nil price (i.e. a currency-pair that has never been updated) is stale.
+func (s Staleness) IsStale(qp *QuotePrice, height int64, blockTime time.Time) bool {
+	if !s.Enabled() {
+		return false
+	}
+
+	if qp == nil {
+		return true
+	}
+
+	if s.MaxBlocks > 0 && height > 0 {
+		if blocks := uint64(height); blocks > qp.BlockHeight && blocks-qp.BlockHeight > s.MaxBlocks {
+			return true
+		}
+	}
+
+	return s.MaxDuration > 0 && blockTime.Sub(qp.BlockTimestamp) > s.MaxDuration
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// TWAP is the time-weighted average price of a currency-pair over an interval.
+type TWAP struct {
+	// Price is the time-weighted average price.
+	Price math.Int
+	// StartTime is the start of the averaged interval.
+	StartTime time.Time
+	// EndTime is the end of the averaged interval.
+	EndTime time.Time
+	// NumPrices is the number of historical prices in the interval.
+	NumPrices uint64
+}
+
+// CalculateTWAP calculates the time-weighted average price of the given price history over the interval
+// [start, end]. The history must be ordered from oldest to newest. Each price is weighted by the time it was
+// the latest price within the interval, i.e. from its block timestamp until the block timestamp of the next
+// price (or end). If the history does not cover the start of the interval, the interval is shortened to begin
+// at the oldest price. If the interval has no duration, the latest price is returned.
+func CalculateTWAP(history []PriceHistoryEntry, start, end time.Time) (TWAP, error) {
+	if end.Before(start) {
+		return TWAP{}, fmt.Errorf("invalid interval: end %s is before start %s", end, start)
+	}
+
+	// find the prices that were the latest price at some point within the interval, i.e. the last price at or
+	// before the start of the interval, and all prices after it up to the end of the interval.
+	first, last := -1, -1
+	for i, entry := range history {
+		ts := entry.Price.BlockTimestamp
+		if ts.After(end) {
+			break
+		}
+
+		if !ts.After(start) || first == -1 {
+			first = i
+		}
+		last = i
+	}
+
+	if last == -1 {
+		return TWAP{}, fmt.Errorf("no price history within interval [%s, %s]", start, end)
+	}
+
+	prices := history[first : last+1]
+	if oldest := prices[0].Price.BlockTimestamp; oldest.After(start) {
+		start = oldest
+	}
+
+	var (
+		sum   = math.ZeroInt()
+		total = math.ZeroInt()
+	)
+	for i, entry := range prices {
+		from := entry.Price.BlockTimestamp
+		if from.Before(start) {
+			from = start
+		}
+
+		to := end
+		if i+1 < len(prices) {
+			to = prices[i+1].Price.BlockTimestamp
+		}
+
+		weight := to.Sub(from)
+		if weight <= 0 {
+			continue
+		}
+
+		w := math.NewInt(int64(weight))
+		sum = sum.Add(entry.Price.Price.Mul(w))
+		total = total.Add(w)
+	}
+
+	twap := prices[len(prices)-1].Price.Price
+	if total.IsPositive() {
+		twap = sum.Quo(total)
+	}
+
+	return TWAP{
+		Price:     twap,
+		StartTime: start,
+		EndTime:   end,
+		NumPrices: uint64(len(prices)),
+	}, nil
+}
@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/connect/v2/x/oracle/types"
+)
+
+func TestCalculateTWAP(t *testing.T) {
+	base := time.Unix(1_700_000_000, 0).UTC()
+	entry := func(price int64, offset time.Duration, nonce uint64) types.PriceHistoryEntry {
+		return types.PriceHistoryEntry{
+			Price: types.QuotePrice{
+				Price:          sdkmath.NewInt(price),
+				BlockTimestamp: base.Add(offset),
+			},
+			Nonce: nonce,
+		}
+	}
+
+	history := []types.PriceHistoryEntry{
+		entry(100, 0, 1),
+		entry(200, 10*time.Second, 2),
+		entry(400, 20*time.Second, 3),
+	}
+
+	tcs := []struct {
+		name       string
+		history    []types.PriceHistoryEntry
+		start, end time.Time
+		expected   types.TWAP
+		expectPass bool
+	}{
+		{
+			"if the interval covers the entire history - pass",
+			history,
+			base,
+			base.Add(30 * time.Second),
+			types.TWAP{
+				// (100 * 10 + 200 * 10 + 400 * 10) / 30
+				Price:     sdkmath.NewInt(233),
+				StartTime: base,
+				EndTime:   base.Add(30 * time.Second),
+				NumPrices: 3,
+			},
+			true,
+		},
+		{
+			"if the interval starts between prices, the earlier price is weighted from the start - pass",
+			history,
+			base.Add(15 * time.Second),
+			base.Add(25 * time.Second),
+			types.TWAP{
+				// (200 * 5 + 400 * 5) / 10
+				Price:     sdkmath.NewInt(300),
+				StartTime: base.Add(15 * time.Second),
+				EndTime:   base.Add(25 * time.Second),
+				NumPrices: 2,
+			},
+			true,
+		},
+		{
+			"if the interval starts before the history, it is shortened to the oldest price - pass",
+			history,
+			base.Add(-time.Minute),
+			base.Add(20 * time.Second),
+			types.TWAP{
+				// (100 * 10 + 200 * 10) / 20
+				Price:     sdkmath.NewInt(150),
+				StartTime: base,
+				EndTime:   base.Add(20 * time.Second),
+				NumPrices: 3,
+			},
+			true,
+		},
+		{
+			"if the interval has no duration, the latest price is returned - pass",
+			history,
+			base.Add(20 * time.Second),
+			base.Add(20 * time.Second),
+			types.TWAP{
+				Price:     sdkmath.NewInt(400),
+				StartTime: base.Add(20 * time.Second),
+				EndTime:   base.Add(20 * time.Second),
+				NumPrices: 1,
+			},
+			true,
+		},
+		{
+			"if the interval ends before the history - fail",
+			history,
+			base.Add(-time.Minute),
+			base.Add(-time.Second),
+			types.TWAP{},
+			false,
+		},
+		{
+			"if the history is empty - fail",
+			nil,
+			base,
+			base.Add(time.Second),
+			types.TWAP{},
+			false,
+		},
+		{
+			"if the end of the interval is before its start - fail",
+			history,
+			base.Add(time.Second),
+			base,
+			types.TWAP{},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := types.CalculateTWAP(tc.history, tc.start, tc.end)
+			if !tc.expectPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, twap)
+		})
+	}
+}
@@ -225,46 +225,250 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the Msg/UpdateParams request type. It contains the
+// new parameters for the x/oracle module.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's parameters
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the new parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgResumeCurrencyPairs defines the Msg/ResumeCurrencyPairs request type.
+// Given an authority + a set of CurrencyPairIDs, the x/oracle module's message
+// service will resume price updates for all of the given CurrencyPairs that
+// have been halted by the circuit breaker.
+type MsgResumeCurrencyPairs struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's CurrencyPairs
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pair_ids are the stringified representation of a currency-pairs
+	// (base/quote) to be resumed
+	CurrencyPairIds []string `protobuf:"bytes,2,rep,name=currency_pair_ids,json=currencyPairIds,proto3" json:"currency_pair_ids,omitempty"`
+}
+
+func (m *MsgResumeCurrencyPairs) Reset()         { *m = MsgResumeCurrencyPairs{} }
+func (m *MsgResumeCurrencyPairs) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeCurrencyPairs) ProtoMessage()    {}
+func (*MsgResumeCurrencyPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{6}
+}
+func (m *MsgResumeCurrencyPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeCurrencyPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeCurrencyPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeCurrencyPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeCurrencyPairs.Merge(m, src)
+}
+func (m *MsgResumeCurrencyPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeCurrencyPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeCurrencyPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeCurrencyPairs proto.InternalMessageInfo
+
+func (m *MsgResumeCurrencyPairs) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResumeCurrencyPairs) GetCurrencyPairIds() []string {
+	if m != nil {
+		return m.CurrencyPairIds
+	}
+	return nil
+}
+
+// MsgResumeCurrencyPairsResponse defines the Msg/ResumeCurrencyPairs response
+// type.
+type MsgResumeCurrencyPairsResponse struct {
+}
+
+func (m *MsgResumeCurrencyPairsResponse) Reset()         { *m = MsgResumeCurrencyPairsResponse{} }
+func (m *MsgResumeCurrencyPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeCurrencyPairsResponse) ProtoMessage()    {}
+func (*MsgResumeCurrencyPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83be677051d99dbe, []int{7}
+}
+func (m *MsgResumeCurrencyPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeCurrencyPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeCurrencyPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeCurrencyPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeCurrencyPairsResponse.Merge(m, src)
+}
+func (m *MsgResumeCurrencyPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeCurrencyPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeCurrencyPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeCurrencyPairsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "connect.oracle.v2.MsgAddCurrencyPairs")
 	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgAddCurrencyPairsResponse")
 	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairs")
 	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "connect.oracle.v2.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "connect.oracle.v2.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResumeCurrencyPairs)(nil), "connect.oracle.v2.MsgResumeCurrencyPairs")
+	proto.RegisterType((*MsgResumeCurrencyPairsResponse)(nil), "connect.oracle.v2.MsgResumeCurrencyPairsResponse")
 }
 
 func init() { proto.RegisterFile("connect/oracle/v2/tx.proto", fileDescriptor_83be677051d99dbe) }
 
 var fileDescriptor_83be677051d99dbe = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0xcf, 0xcb,
-	0x4b, 0x4d, 0x2e, 0xd1, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd2, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xe9, 0x41, 0xe4, 0xf4, 0xca, 0x8c,
-	0xa4, 0xe4, 0x31, 0x95, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0xf4, 0x48, 0x49, 0x26,
-	0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3,
-	0xcf, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50, 0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c,
-	0x7d, 0x30, 0x09, 0x15, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x98, 0x01, 0x62, 0x41, 0x45, 0x55,
-	0x60, 0xb6, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83, 0x2c, 0x4f, 0x2e, 0x2d, 0x2a, 0x4a, 0xcd, 0x4b,
-	0xae, 0x8c, 0x2f, 0x48, 0xcc, 0x2c, 0x82, 0xa8, 0x52, 0xba, 0xce, 0xc8, 0x25, 0xec, 0x5b, 0x9c,
-	0xee, 0x98, 0x92, 0xe2, 0x0c, 0x95, 0x0d, 0x48, 0xcc, 0x2c, 0x2a, 0x16, 0x32, 0xe3, 0xe2, 0x4c,
-	0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92,
-	0xb8, 0xb4, 0x45, 0x57, 0x04, 0xea, 0x48, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92,
-	0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x6f, 0x2e, 0x3e, 0x14, 0x6b, 0x8a, 0x25, 0x98,
-	0x14, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0x60, 0xe1, 0x03, 0x76, 0x8e, 0x5e, 0x99, 0x91, 0x1e,
-	0xb2, 0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xf1, 0x26, 0x23, 0x3b, 0xc2, 0xca, 0xfa,
-	0xc5, 0x02, 0x79, 0x86, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x16, 0x74, 0x3d, 0xdf, 0xa0, 0x05, 0xf7,
-	0x59, 0x05, 0x2c, 0x64, 0xb1, 0xf8, 0x40, 0x49, 0x96, 0x4b, 0x1a, 0x8b, 0x70, 0x50, 0x6a, 0x71,
-	0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd2, 0x4e, 0x46, 0x2e, 0x31, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4, 0xdc,
-	0xfc, 0xb2, 0x54, 0xea, 0xf8, 0x5d, 0x8b, 0x4b, 0x10, 0xc5, 0xef, 0xf1, 0x99, 0x29, 0x10, 0xef,
-	0x73, 0x06, 0xf1, 0x23, 0x7b, 0xcc, 0x33, 0x85, 0x34, 0xaf, 0x05, 0xa7, 0x96, 0xa0, 0x7a, 0x4d,
-	0x81, 0x4b, 0x0e, 0xbb, 0xd3, 0x61, 0xbe, 0x33, 0x7a, 0xcb, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e,
-	0x94, 0xc5, 0x25, 0x80, 0x11, 0xb5, 0x6a, 0x7a, 0x18, 0x49, 0x55, 0x0f, 0x4b, 0x48, 0x49, 0xe9,
-	0x11, 0xa7, 0x0e, 0x66, 0xa7, 0x50, 0x31, 0x97, 0x30, 0xb6, 0xd0, 0xd4, 0xc4, 0x6e, 0x0c, 0x16,
-	0xa5, 0x52, 0x86, 0x44, 0x2b, 0x85, 0x59, 0x2a, 0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x93,
-	0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x67, 0x67, 0x16, 0xe8, 0xe6, 0xa6, 0x96, 0xe9,
-	0xc3, 0x42, 0xb9, 0xcc, 0x08, 0x11, 0xd0, 0xe0, 0x74, 0x99, 0xc4, 0x06, 0xce, 0x16, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xe1, 0x78, 0x05, 0xeb, 0x03, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xc1, 0x8b, 0xd3, 0x4e,
+	0x14, 0xc7, 0x3b, 0xdb, 0xdf, 0x6f, 0xa1, 0xb3, 0xea, 0xda, 0xee, 0xe2, 0xb6, 0x11, 0xd3, 0x52,
+	0x44, 0x6b, 0xa1, 0x13, 0x1a, 0xc1, 0x85, 0x15, 0x84, 0xad, 0x07, 0x11, 0x29, 0x2c, 0x5d, 0xbc,
+	0x78, 0xb0, 0x64, 0x93, 0x61, 0x36, 0x6a, 0x32, 0x61, 0x66, 0x1a, 0xb6, 0x37, 0xf1, 0xe8, 0xc9,
+	0x3f, 0xc1, 0x3f, 0xa1, 0x07, 0x2f, 0xa2, 0x27, 0x4f, 0x7b, 0x5c, 0xbc, 0xe8, 0x49, 0xa4, 0x3d,
+	0xd4, 0x3f, 0x43, 0x9a, 0x4c, 0xda, 0xc6, 0x8c, 0x58, 0x45, 0xf0, 0xd2, 0x66, 0xde, 0xfb, 0x66,
+	0xde, 0xf7, 0x93, 0xf7, 0x66, 0xa0, 0x66, 0x53, 0xdf, 0xc7, 0xb6, 0x30, 0x28, 0xb3, 0xec, 0x67,
+	0xd8, 0x08, 0x4d, 0x43, 0x9c, 0xa0, 0x80, 0x51, 0x41, 0x4b, 0x45, 0x99, 0x43, 0x71, 0x0e, 0x85,
+	0xa6, 0x56, 0xcd, 0xca, 0x09, 0xf6, 0x31, 0x77, 0x79, 0xfc, 0x8e, 0x56, 0xb1, 0x29, 0xf7, 0x28,
+	0xef, 0x47, 0x2b, 0x23, 0x5e, 0xc8, 0xd4, 0x4e, 0xbc, 0x32, 0x3c, 0x4e, 0x8c, 0xb0, 0x3d, 0xfb,
+	0x93, 0x89, 0xa2, 0xe5, 0xb9, 0x3e, 0x35, 0xa2, 0x5f, 0x19, 0xda, 0x26, 0x94, 0xd0, 0x78, 0x8f,
+	0xd9, 0x93, 0x8c, 0x5e, 0x4d, 0xaa, 0x8b, 0x61, 0x80, 0xf9, 0xac, 0xb8, 0x3d, 0x60, 0x0c, 0xfb,
+	0xf6, 0xb0, 0x1f, 0x58, 0x2e, 0x93, 0x2a, 0x3d, 0xeb, 0x31, 0xb0, 0x98, 0xe5, 0x49, 0x1f, 0xf5,
+	0x4f, 0x00, 0x6e, 0x75, 0x39, 0xd9, 0x77, 0x9c, 0xbb, 0xf2, 0xed, 0x03, 0xcb, 0x65, 0xbc, 0x74,
+	0x0b, 0x16, 0xac, 0x81, 0x38, 0xa6, 0xcc, 0x15, 0xc3, 0x32, 0xa8, 0x81, 0x46, 0xa1, 0x53, 0xfe,
+	0xf8, 0xa6, 0xb5, 0x2d, 0x21, 0xf6, 0x1d, 0x87, 0x61, 0xce, 0x0f, 0x05, 0x73, 0x7d, 0xd2, 0x5b,
+	0x48, 0x4b, 0x0f, 0xe0, 0x85, 0x94, 0x0d, 0x5e, 0x5e, 0xab, 0xe5, 0x1b, 0x1b, 0xa6, 0x8e, 0x92,
+	0xef, 0x17, 0xd9, 0x45, 0xa1, 0x89, 0x96, 0x0b, 0x76, 0xfe, 0x3b, 0xfd, 0x52, 0xcd, 0xf5, 0xce,
+	0xdb, 0xcb, 0x26, 0xf6, 0x6e, 0x7f, 0x7b, 0x5d, 0xcd, 0xbd, 0x98, 0x8e, 0x9a, 0x8b, 0x02, 0x2f,
+	0xa7, 0xa3, 0xe6, 0x9c, 0xfc, 0x24, 0xa1, 0x52, 0x10, 0xd4, 0xaf, 0xc0, 0xcb, 0x8a, 0x70, 0x0f,
+	0xf3, 0x80, 0xfa, 0x1c, 0xd7, 0xdf, 0x02, 0x78, 0xa9, 0xcb, 0x49, 0x0f, 0x7b, 0x34, 0xc4, 0x7f,
+	0x87, 0xbd, 0x09, 0x8b, 0x29, 0xf6, 0xbe, 0xeb, 0xc4, 0xf8, 0x85, 0xde, 0xe6, 0x32, 0xd8, 0x7d,
+	0xe7, 0xf7, 0xd0, 0x0e, 0xb1, 0x48, 0xa3, 0xd5, 0xa0, 0xae, 0xb6, 0x3e, 0xa7, 0x7b, 0x07, 0xe0,
+	0x66, 0x97, 0x93, 0x87, 0x81, 0x63, 0x09, 0x7c, 0x10, 0x35, 0xfc, 0x8f, 0xb1, 0x76, 0xe1, 0x7a,
+	0x3c, 0x32, 0xe5, 0xb5, 0x1a, 0x68, 0x6c, 0x98, 0x15, 0x94, 0x39, 0x0a, 0x28, 0x2e, 0x21, 0xbb,
+	0x28, 0xe5, 0x7b, 0xbb, 0x6a, 0xc6, 0x9a, 0x8a, 0x71, 0xd9, 0x69, 0xbd, 0x02, 0x77, 0x7e, 0x08,
+	0xcd, 0xc1, 0xde, 0x27, 0x6d, 0xe3, 0x03, 0xef, 0x1f, 0xb4, 0xed, 0x8e, 0x1a, 0xe9, 0xba, 0x0a,
+	0x49, 0xe1, 0x71, 0xde, 0xb9, 0x4c, 0x26, 0x01, 0x34, 0x3f, 0xe4, 0x61, 0xbe, 0xcb, 0x49, 0xe9,
+	0x09, 0xbc, 0x98, 0x39, 0x94, 0xd7, 0x14, 0x5f, 0x5e, 0x31, 0xe3, 0x1a, 0x5a, 0x4d, 0x97, 0xd4,
+	0x2c, 0x71, 0xb8, 0xa5, 0x3a, 0x07, 0x37, 0xd4, 0xdb, 0x28, 0xa4, 0x5a, 0x7b, 0x65, 0xe9, 0xbc,
+	0xe8, 0x63, 0x78, 0x2e, 0x35, 0x9e, 0x75, 0xf5, 0x16, 0xcb, 0x1a, 0xad, 0xf9, 0x6b, 0x4d, 0x1a,
+	0x2a, 0x3b, 0x25, 0x3f, 0x85, 0xca, 0x48, 0xb5, 0xf6, 0xca, 0xd2, 0xa4, 0xa8, 0xf6, 0xff, 0xf3,
+	0xe9, 0xa8, 0x09, 0x3a, 0xf7, 0x4e, 0xc7, 0x3a, 0x38, 0x1b, 0xeb, 0xe0, 0xeb, 0x58, 0x07, 0xaf,
+	0x26, 0x7a, 0xee, 0x6c, 0xa2, 0xe7, 0x3e, 0x4f, 0xf4, 0xdc, 0xa3, 0x16, 0x71, 0xc5, 0xf1, 0xe0,
+	0x08, 0xd9, 0xd4, 0x33, 0xf8, 0x53, 0x37, 0x68, 0x79, 0x38, 0x34, 0x92, 0xe9, 0x09, 0xcd, 0xc5,
+	0x00, 0x45, 0xd7, 0xe4, 0xd1, 0x7a, 0x74, 0x4b, 0xdf, 0xfc, 0x3e, 0x00, 0x70, 0x3f, 0x82, 0x76,
+	0x9a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +491,13 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResumeCurrencyPairs will be used explicitly by governance to resume price
+	// updates for the given set of currency-pairs after they have been halted by
+	// the circuit breaker.
+	ResumeCurrencyPairs(ctx context.Context, in *MsgResumeCurrencyPairs, opts ...grpc.CallOption) (*MsgResumeCurrencyPairsResponse, error)
 }
 
 type msgClient struct {
@@ -315,6 +526,24 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeCurrencyPairs(ctx context.Context, in *MsgResumeCurrencyPairs, opts ...grpc.CallOption) (*MsgResumeCurrencyPairsResponse, error) {
+	out := new(MsgResumeCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/connect.oracle.v2.Msg/ResumeCurrencyPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddCurrencyPairs will be used only by governance to update the set of
@@ -325,6 +554,13 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResumeCurrencyPairs will be used explicitly by governance to resume price
+	// updates for the given set of currency-pairs after they have been halted by
+	// the circuit breaker.
+	ResumeCurrencyPairs(context.Context, *MsgResumeCurrencyPairs) (*MsgResumeCurrencyPairsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -337,6 +573,12 @@ func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResumeCurrencyPairs(ctx context.Context, req *MsgResumeCurrencyPairs) (*MsgResumeCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCurrencyPairs not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -378,6 +620,42 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeCurrencyPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeCurrencyPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/connect.oracle.v2.Msg/ResumeCurrencyPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeCurrencyPairs(ctx, req.(*MsgResumeCurrencyPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "connect.oracle.v2.Msg",
@@ -391,6 +669,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResumeCurrencyPairs",
+			Handler:    _Msg_ResumeCurrencyPairs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect/oracle/v2/tx.proto",
@@ -509,96 +795,603 @@ func (m *MsgRemoveCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeCurrencyPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResumeCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgAddCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveCurrencyPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,10 +1414,10 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -661,7 +1454,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -688,8 +1481,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -714,7 +1506,7 @@ func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -737,10 +1529,10 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -764,7 +1556,7 @@ func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeCurrencyPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -787,10 +1579,10 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeCurrencyPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -878,7 +1670,7 @@ func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,10 +1693,10 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeCurrencyPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: